@@ -66,7 +66,7 @@ func StartServerWithRepos(t *testing.T, cfg *app.Config, repos *repo.Container)
 		Config: cfg,
 		Repos:  repos,
 		Gateways: &gateway.Container{
-			File: lo.Must(fs.NewFile(afero.NewMemMapFs(), "https://example.com")),
+			File: lo.Must(fs.NewFile(afero.NewMemMapFs(), "https://example.com", nil)),
 		},
 	})
 
@@ -0,0 +1,64 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/app"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+)
+
+// GET|/projects/{projectId}/audit-logs
+func TestIntegrationAuditLogListAPI(t *testing.T) {
+	e := StartServer(t, &app.Config{}, true, baseSeeder)
+
+	e.GET("/api/projects/{projectId}/audit-logs", pid).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	e.GET("/api/projects/{projectId}/audit-logs", id.NewProjectID()).
+		WithHeader("authorization", "Bearer "+secret).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	itm := e.POST("/api/models/{modelId}/items", mId).
+		WithHeader("authorization", "Bearer "+secret).
+		WithJSON(map[string]interface{}{
+			"fields": []interface{}{
+				map[string]string{
+					"id":    fId.String(),
+					"value": "test value",
+				},
+			},
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	itemID := itm.Value("id").String().Raw()
+
+	r := e.GET("/api/projects/{projectId}/audit-logs", pid).
+		WithHeader("authorization", "Bearer "+secret).
+		WithQuery("modelId", mId).
+		WithQuery("type", "item.create").
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	r.Value("totalCount").Number().Equal(1)
+	l := r.Value("items").Array().First().Object()
+	l.Value("type").String().Equal("item.create")
+	l.Value("projectId").String().Equal(pid.String())
+	l.Value("modelId").String().Equal(mId.String())
+	l.Value("itemId").String().Equal(itemID)
+	l.Value("integrationId").String().Equal(iId.String())
+
+	e.GET("/api/projects/{projectId}/audit-logs", pid).
+		WithHeader("authorization", "Bearer "+secret).
+		WithQuery("type", "item.delete").
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("totalCount").Number().Equal(0)
+}
@@ -0,0 +1,82 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/app"
+)
+
+// GET|POST /projects/{projectId}/models/definition
+func TestIntegrationModelDefinitionAPI(t *testing.T) {
+	e := StartServer(t, &app.Config{}, true, baseSeeder)
+
+	e.GET("/api/projects/{projectId}/models/definition", pid).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	d := e.GET("/api/projects/{projectId}/models/definition", pid).
+		WithHeader("authorization", "Bearer "+secret).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	d.Value("version").Number().Equal(1)
+	m := d.Value("models").Array().First().Object()
+	m.Value("key").String().Equal(ikey.String())
+	m.Value("fields").Array().Length().Gt(0)
+
+	// importing the same definition changes nothing
+	e.POST("/api/projects/{projectId}/models/definition", pid).
+		WithHeader("authorization", "Bearer "+secret).
+		WithJSON(d.Raw()).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("applied", false).
+		Value("changes").Array().Length().Equal(0)
+
+	// removing all fields is destructive
+	def := d.Raw()
+	def["models"].([]any)[0].(map[string]any)["fields"] = []any{}
+
+	r := e.POST("/api/projects/{projectId}/models/definition", pid).
+		WithHeader("authorization", "Bearer "+secret).
+		WithQuery("dryRun", true).
+		WithJSON(def).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	r.ValueEqual("applied", false)
+	c := r.Value("changes").Array().First().Object()
+	c.ValueEqual("type", "remove")
+	c.ValueEqual("modelKey", ikey.String())
+	c.ValueEqual("destructive", true)
+
+	e.POST("/api/projects/{projectId}/models/definition", pid).
+		WithHeader("authorization", "Bearer "+secret).
+		WithJSON(def).
+		Expect().
+		Status(http.StatusConflict)
+
+	e.POST("/api/projects/{projectId}/models/definition", pid).
+		WithHeader("authorization", "Bearer "+secret).
+		WithQuery("allowDestructive", true).
+		WithJSON(def).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("applied", true)
+
+	e.GET("/api/projects/{projectId}/models/definition", pid).
+		WithHeader("authorization", "Bearer "+secret).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("models").Array().First().Object().
+		Value("fields").Null()
+}
@@ -0,0 +1,109 @@
+package e2e
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/app"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/samber/lo"
+)
+
+var (
+	whId = id.NewWebhookID()
+	dId  = id.NewWebhookDeliveryID()
+)
+
+func webhookSeeder(ctx context.Context, r *repo.Container) error {
+	wh := integration.NewWebhookBuilder().ID(whId).
+		Name("w1").
+		Url(lo.Must(url.Parse("https://example.com"))).
+		Active(true).
+		Trigger(integration.WebhookTrigger{event.ItemCreate: true}).
+		MustBuild()
+
+	i := integration.New().ID(iId).
+		Type(integration.TypePrivate).
+		Name("i1").
+		LogoUrl(lo.Must(url.Parse("https://test.com"))).
+		Token(secret).
+		Developer(id.NewUserID()).
+		Webhook([]*integration.Webhook{wh}).
+		MustBuild()
+	if err := r.Integration.Save(ctx, i); err != nil {
+		return err
+	}
+
+	now := time.Now().Truncate(time.Millisecond).UTC()
+	d := integration.NewDelivery().ID(dId).
+		Integration(iId).
+		Webhook(whId).
+		Event(id.NewEventID()).
+		EventType(event.ItemCreate).
+		URL("https://example.com").
+		Payload(`{"id":"x"}`).
+		Attempts([]*integration.DeliveryAttempt{integration.NewDeliveryAttempt(now, 500, time.Second, "")}).
+		Status(integration.DeliveryStatusDead).
+		MustBuild()
+	return r.WebhookDelivery.Save(ctx, d)
+}
+
+// GET|/webhooks/{webhookId}/deliveries
+func TestIntegrationWebhookDeliveryListAPI(t *testing.T) {
+	e := StartServer(t, &app.Config{}, true, webhookSeeder)
+
+	e.GET("/api/webhooks/{webhookId}/deliveries", whId).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	e.GET("/api/webhooks/{webhookId}/deliveries", id.NewWebhookID()).
+		WithHeader("authorization", "Bearer "+secret).
+		Expect().
+		Status(http.StatusNotFound)
+
+	r := e.GET("/api/webhooks/{webhookId}/deliveries", whId).
+		WithHeader("authorization", "Bearer "+secret).
+		WithQuery("page", 1).
+		WithQuery("perPage", 10).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	r.Value("totalCount").Number().Equal(1)
+	d := r.Value("items").Array().First().Object()
+	d.Value("id").String().Equal(dId.String())
+	d.Value("status").String().Equal("dead")
+	d.Value("payload").String().Equal(`{"id":"x"}`)
+	d.Value("attempts").Array().First().Object().Value("statusCode").Number().Equal(500)
+}
+
+// POST|/deliveries/{deliveryId}/redeliver
+func TestIntegrationWebhookRedeliverAPI(t *testing.T) {
+	e := StartServer(t, &app.Config{}, true, webhookSeeder)
+
+	e.POST("/api/deliveries/{deliveryId}/redeliver", dId).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	e.POST("/api/deliveries/{deliveryId}/redeliver", id.NewWebhookDeliveryID()).
+		WithHeader("authorization", "Bearer "+secret).
+		Expect().
+		Status(http.StatusNotFound)
+
+	d := e.POST("/api/deliveries/{deliveryId}/redeliver", dId).
+		WithHeader("authorization", "Bearer "+secret).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	d.Value("id").String().NotEqual(dId.String())
+	d.Value("webhookId").String().Equal(whId.String())
+	d.Value("status").String().Equal("pending")
+	d.Value("payload").String().Equal(`{"id":"x"}`)
+}
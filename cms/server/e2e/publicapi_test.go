@@ -150,6 +150,78 @@ func TestPublicAPI(t *testing.T) {
 			"nextCursor": publicAPIItem2ID.String(),
 		})
 
+	// filter
+	e.GET("/api/p/{project}/{model}", publicAPIProjectAlias, publicAPIModelKey).
+		WithQuery("filter["+publicAPIField1Key+"]", "bbb").
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Equal(map[string]any{
+			"results": []map[string]any{
+				{
+					"id":               publicAPIItem2ID.String(),
+					publicAPIField1Key: "bbb",
+				},
+			},
+			"totalCount": 1,
+			"hasMore":    false,
+			"limit":      50,
+			"offset":     0,
+			"page":       1,
+		})
+
+	e.GET("/api/p/{project}/{model}", publicAPIProjectAlias, publicAPIModelKey).
+		WithQuery("filter["+publicAPIField1Key+"][in]", "aaa,bbb").
+		WithQuery("filter["+publicAPIField2Key+"][exists]", "false").
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Path("$.results[*].id").
+		Array().
+		Equal([]string{publicAPIItem2ID.String()})
+
+	// sort and projection
+	e.GET("/api/p/{project}/{model}", publicAPIProjectAlias, publicAPIModelKey).
+		WithQuery("sort", "-"+publicAPIField1Key).
+		WithQuery("fields", publicAPIField1Key).
+		WithQuery("limit", "2").
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Equal(map[string]any{
+			"results": []map[string]any{
+				{
+					"id":               publicAPIItem3ID.String(),
+					publicAPIField1Key: "ccc",
+				},
+				{
+					"id":               publicAPIItem2ID.String(),
+					publicAPIField1Key: "bbb",
+				},
+			},
+			"totalCount": 3,
+			"hasMore":    false,
+			"limit":      2,
+			"offset":     0,
+			"page":       1,
+		})
+
+	// cursor pagination cannot be used with sort
+	e.GET("/api/p/{project}/{model}", publicAPIProjectAlias, publicAPIModelKey).
+		WithQuery("sort", "-"+publicAPIField1Key).
+		WithQuery("start_cursor", publicAPIItem1ID.String()).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Equal(map[string]any{"error": "cursor pagination is not supported with sorting by a field"})
+
+	e.GET("/api/p/{project}/{model}", publicAPIProjectAlias, publicAPIModelKey).
+		WithQuery("filter[invalid-key]", "aaa").
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Equal(map[string]any{"error": "invalid field"})
+
 	e.GET("/api/p/{project}/{model}/{item}", publicAPIProjectAlias, publicAPIModelKey, publicAPIItem1ID).
 		Expect().
 		Status(http.StatusOK).
@@ -199,6 +271,14 @@ func TestPublicAPI(t *testing.T) {
 	prj.Publication().SetAssetPublic(false)
 	lo.Must0(repos.Project.Save(ctx, prj))
 
+	e.GET("/api/p/{project}/assets/{assetid}", publicAPIProjectAlias, publicAPIAsset1ID).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Equal(map[string]any{
+			"error": "not found",
+		})
+
 	e.GET("/api/p/{project}/{model}", publicAPIProjectAlias, publicAPIModelKey).
 		Expect().
 		Status(http.StatusOK).
@@ -257,6 +337,70 @@ func TestPublicAPI(t *testing.T) {
 		Equal(map[string]any{
 			"error": "not found",
 		})
+
+	// make the project limited
+	k1 := lo.Must(project.NewAPIKey("key1", nil, 0))
+	k2 := lo.Must(project.NewAPIKey("key2", id.ModelIDList{id.NewModelID()}, 0))
+	k3 := lo.Must(project.NewAPIKey("key3", id.ModelIDList{publicAPIModelID}, 0))
+	prj.Publication().SetScope(project.PublicationScopeLimited)
+	prj.Publication().SetAssetPublic(true)
+	prj.Publication().SetAPIKeys([]*project.APIKey{k1, k2, k3})
+	lo.Must0(repos.Project.Save(ctx, prj))
+
+	e.GET("/api/p/{project}/{model}/{item}", publicAPIProjectAlias, publicAPIModelKey, publicAPIItem1ID).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Equal(map[string]any{
+			"error": "not found",
+		})
+
+	e.GET("/api/p/{project}/{model}/{item}", publicAPIProjectAlias, publicAPIModelKey, publicAPIItem1ID).
+		WithHeader("Authorization", "Bearer pub_invalid").
+		Expect().
+		Status(http.StatusUnauthorized).
+		JSON().
+		Equal(map[string]any{
+			"error": "invalid API key",
+		})
+
+	// the model is not in the allow-list of the key
+	e.GET("/api/p/{project}/{model}/{item}", publicAPIProjectAlias, publicAPIModelKey, publicAPIItem1ID).
+		WithHeader("Authorization", "Bearer "+k2.Key()).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Equal(map[string]any{
+			"error": "not found",
+		})
+
+	e.GET("/api/p/{project}/{model}/{item}", publicAPIProjectAlias, publicAPIModelKey, publicAPIItem1ID).
+		WithHeader("Authorization", "Bearer "+k1.Key()).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("id").
+		Equal(publicAPIItem1ID.String())
+
+	// the asset is not referenced by items of the models in the allow-list of the key
+	e.GET("/api/p/{project}/assets/{assetid}", publicAPIProjectAlias, publicAPIAsset1ID).
+		WithHeader("Authorization", "Bearer "+k2.Key()).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Equal(map[string]any{
+			"error": "not found",
+		})
+
+	e.GET("/api/p/{project}/assets/{assetid}", publicAPIProjectAlias, publicAPIAsset1ID).
+		WithHeader("Authorization", "Bearer "+k3.Key()).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("id").
+		Equal(publicAPIAsset1ID.String())
 }
 
 func publicAPISeeder(ctx context.Context, r *repo.Container) error {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/appx"
 )
@@ -18,6 +19,7 @@ const (
 	contextOperator ContextKey = "operator"
 	ContextAuthInfo ContextKey = "authinfo"
 	contextUsecases ContextKey = "usecases"
+	contextAPIKey   ContextKey = "apikey"
 )
 
 func AttachUser(ctx context.Context, u *user.User) context.Context {
@@ -28,6 +30,11 @@ func AttachOperator(ctx context.Context, o *usecase.Operator) context.Context {
 	return context.WithValue(ctx, contextOperator, o)
 }
 
+// AttachPublicAPIKey attaches the API key of the public API which has been verified
+func AttachPublicAPIKey(ctx context.Context, k *project.APIKey) context.Context {
+	return context.WithValue(ctx, contextAPIKey, k)
+}
+
 func AttachUsecases(ctx context.Context, u *interfaces.Container) context.Context {
 	ctx = context.WithValue(ctx, contextUsecases, u)
 	return ctx
@@ -69,6 +76,15 @@ func Operator(ctx context.Context) *usecase.Operator {
 	return nil
 }
 
+func PublicAPIKey(ctx context.Context) *project.APIKey {
+	if v := ctx.Value(contextAPIKey); v != nil {
+		if v2, ok := v.(*project.APIKey); ok {
+			return v2
+		}
+	}
+	return nil
+}
+
 func GetAuthInfo(ctx context.Context) *appx.AuthInfo {
 	if v := ctx.Value(ContextAuthInfo); v != nil {
 		if v2, ok := v.(appx.AuthInfo); ok {
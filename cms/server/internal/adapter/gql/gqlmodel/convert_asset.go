@@ -39,6 +39,26 @@ func ToAsset(a *asset.Asset, urlResolver func(a *asset.Asset) string) *Asset {
 		ThreadID:                IDFrom(a.Thread()),
 		ArchiveExtractionStatus: ToArchiveExtractionStatus(a.ArchiveExtractionStatus()),
 		Size:                    int64(a.Size()),
+
+		ArchiveExtractionProgress: ToArchiveExtractionProgress(a.ArchiveExtractionProgress()),
+		ArchiveExtractionFailures: lo.Map(a.ArchiveExtractionFailures(), func(f asset.ArchiveExtractionFailure, _ int) *ArchiveExtractionFailure {
+			return &ArchiveExtractionFailure{Path: f.Path, Error: f.Error}
+		}),
+		CompressionStatus: ToArchiveExtractionStatus(a.CompressionStatus()),
+	}
+}
+
+func ToArchiveExtractionProgress(p *asset.ArchiveExtractionProgress) *ArchiveExtractionProgress {
+	if p == nil {
+		return nil
+	}
+
+	return &ArchiveExtractionProgress{
+		Files:      p.Files,
+		TotalFiles: p.TotalFiles,
+		Failed:     p.Failed,
+		Bytes:      p.Bytes,
+		TotalBytes: p.TotalBytes,
 	}
 }
 
@@ -31,6 +31,8 @@ func TestToAsset(t *testing.T) {
 		URL:           "xxx",
 		ThreadID:      ID(thid.String()),
 		Size:          1000,
+
+		ArchiveExtractionFailures: []*ArchiveExtractionFailure{},
 	}
 
 	var a2 *asset.Asset = nil
@@ -0,0 +1,43 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/user"
+)
+
+func ToAuditLog(e *event.Event[any]) *AuditLog {
+	if e == nil {
+		return nil
+	}
+
+	var pid *ID
+	if p := e.Project(); p != nil {
+		pid = (*ID)(&p.ID)
+	}
+
+	o := e.Operator()
+	return &AuditLog{
+		ID:            IDFrom(e.ID()),
+		Type:          string(e.Type()),
+		WorkspaceID:   IDFromRef(e.Workspace()),
+		ProjectID:     pid,
+		ModelID:       IDFromRef(e.Model()),
+		ItemID:        IDFromRef(e.Item()),
+		UserID:        IDFromRef(o.User()),
+		IntegrationID: IDFromRef(o.Integration()),
+		Machine:       o.Machine(),
+		Member:        ToAuditLogMember(e.Member()),
+		CreatedAt:     e.Timestamp(),
+	}
+}
+
+func ToAuditLogMember(m *event.Member) *AuditLogMember {
+	if m == nil {
+		return nil
+	}
+	return &AuditLogMember{
+		UserID:        IDFromRef(m.User),
+		IntegrationID: IDFromRef(m.Integration),
+		Role:          ToRole(user.Role(m.Role)),
+	}
+}
@@ -0,0 +1,50 @@
+package gqlmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToAuditLog(t *testing.T) {
+	now := time.Now()
+	wid := id.NewWorkspaceID()
+	pid := id.NewProjectID()
+	mid := id.NewModelID()
+	uid := id.NewUserID()
+	iid := id.NewIntegrationID()
+
+	ev := event.New[any]().NewID().Timestamp(now).Type(event.ModelUpdate).Operator(operator.OperatorFromIntegration(iid)).
+		Workspace(&wid).Project(&event.Project{ID: pid.String(), Alias: "prj"}).Model(&mid).MustBuild()
+	assert.Equal(t, &AuditLog{
+		ID:            IDFrom(ev.ID()),
+		Type:          "model.update",
+		WorkspaceID:   IDFromRef(&wid),
+		ProjectID:     IDFromRef(&pid),
+		ModelID:       IDFromRef(&mid),
+		IntegrationID: IDFromRef(&iid),
+		CreatedAt:     now,
+	}, ToAuditLog(ev))
+
+	ev = event.New[any]().NewID().Timestamp(now).Type(event.MemberUpdate).Operator(operator.OperatorFromUser(uid)).
+		Workspace(&wid).Member(&event.Member{User: &uid, Role: "maintainer"}).MustBuild()
+	assert.Equal(t, &AuditLog{
+		ID:          IDFrom(ev.ID()),
+		Type:        "member.update",
+		WorkspaceID: IDFromRef(&wid),
+		UserID:      IDFromRef(&uid),
+		Member: &AuditLogMember{
+			UserID: IDFromRef(&uid),
+			Role:   RoleMaintainer,
+		},
+		CreatedAt: now,
+	}, ToAuditLog(ev))
+
+	ev = event.New[any]().NewID().Timestamp(now).Type(event.ItemPublish).Operator(operator.OperatorFromMachine()).MustBuild()
+	assert.True(t, ToAuditLog(ev).Machine)
+	assert.Nil(t, ToAuditLog(nil))
+}
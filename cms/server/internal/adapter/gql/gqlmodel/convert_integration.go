@@ -63,6 +63,12 @@ func ToWebhook(w *integration.Webhook) *Webhook {
 			OnAssetUpload:     lo.ToPtr(w.Trigger()[event.AssetCreate]),
 			OnAssetDecompress: lo.ToPtr(w.Trigger()[event.AssetDecompress]),
 			OnAssetDelete:     lo.ToPtr(w.Trigger()[event.AssetDelete]),
+			OnRequestSubmit:   lo.ToPtr(w.Trigger()[event.RequestSubmit]),
+			OnRequestReview:   lo.ToPtr(w.Trigger()[event.RequestReview]),
+			OnRequestApprove:  lo.ToPtr(w.Trigger()[event.RequestApprove]),
+			OnRequestBlock:    lo.ToPtr(w.Trigger()[event.RequestBlock]),
+			OnRequestClose:    lo.ToPtr(w.Trigger()[event.RequestClose]),
+			OnCommentCreate:   lo.ToPtr(w.Trigger()[event.CommentCreate]),
 		},
 		Secret:    w.Secret(),
 		CreatedAt: w.CreatedAt(),
@@ -78,3 +84,45 @@ func ToWebhooks(ws []*integration.Webhook) []*Webhook {
 		return ToWebhook(w)
 	})
 }
+
+func ToWebhookDelivery(d *integration.Delivery) *WebhookDelivery {
+	if d == nil {
+		return nil
+	}
+	return &WebhookDelivery{
+		ID:            IDFrom(d.ID()),
+		IntegrationID: IDFrom(d.Integration()),
+		WebhookID:     IDFrom(d.Webhook()),
+		EventID:       IDFrom(d.Event()),
+		EventType:     string(d.EventType()),
+		URL:           d.URL(),
+		Payload:       d.Payload(),
+		Status:        ToWebhookDeliveryStatus(d.Status()),
+		Attempts: util.Map(d.Attempts(), func(a *integration.DeliveryAttempt) *WebhookDeliveryAttempt {
+			return &WebhookDeliveryAttempt{
+				At:         a.At(),
+				StatusCode: util.ToPtrIfNotEmpty(a.StatusCode()),
+				Latency:    int(a.Latency().Milliseconds()),
+				Error:      util.ToPtrIfNotEmpty(a.Error()),
+			}
+		}),
+		NextAttemptAt: d.NextAttemptAt(),
+		CreatedAt:     d.CreatedAt(),
+		UpdatedAt:     d.UpdatedAt(),
+	}
+}
+
+func ToWebhookDeliveryStatus(s integration.DeliveryStatus) WebhookDeliveryStatus {
+	switch s {
+	case integration.DeliveryStatusPending:
+		return WebhookDeliveryStatusPending
+	case integration.DeliveryStatusSucceeded:
+		return WebhookDeliveryStatusSucceeded
+	case integration.DeliveryStatusRetrying:
+		return WebhookDeliveryStatusRetrying
+	case integration.DeliveryStatusDead:
+		return WebhookDeliveryStatusDead
+	default:
+		return ""
+	}
+}
@@ -142,6 +142,12 @@ func TestToWebhook(t *testing.T) {
 					OnAssetUpload:     lo.ToPtr(false),
 					OnAssetDecompress: lo.ToPtr(false),
 					OnAssetDelete:     lo.ToPtr(false),
+					OnRequestSubmit:   lo.ToPtr(false),
+					OnRequestReview:   lo.ToPtr(false),
+					OnRequestApprove:  lo.ToPtr(false),
+					OnRequestBlock:    lo.ToPtr(false),
+					OnRequestClose:    lo.ToPtr(false),
+					OnCommentCreate:   lo.ToPtr(false),
 				},
 				CreatedAt: wId.Timestamp(),
 				UpdatedAt: now,
@@ -160,6 +166,11 @@ func TestToWebhook(t *testing.T) {
 					event.AssetCreate:     true,
 					event.AssetDecompress: true,
 					event.AssetDelete:     true,
+					event.RequestSubmit:   true,
+					event.RequestReview:   true,
+					event.RequestApprove:  true,
+					event.RequestBlock:    true,
+					event.RequestClose:    true,
 				}).
 				MustBuild(),
 			want: &Webhook{
@@ -176,6 +187,12 @@ func TestToWebhook(t *testing.T) {
 					OnAssetUpload:     lo.ToPtr(true),
 					OnAssetDecompress: lo.ToPtr(true),
 					OnAssetDelete:     lo.ToPtr(true),
+					OnRequestSubmit:   lo.ToPtr(true),
+					OnRequestReview:   lo.ToPtr(true),
+					OnRequestApprove:  lo.ToPtr(true),
+					OnRequestBlock:    lo.ToPtr(true),
+					OnRequestClose:    lo.ToPtr(true),
+					OnCommentCreate:   lo.ToPtr(false),
 				},
 				CreatedAt: wId.Timestamp(),
 				UpdatedAt: now,
@@ -227,6 +244,11 @@ func TestToWebhooks(t *testing.T) {
 						event.AssetCreate:     true,
 						event.AssetDecompress: true,
 						event.AssetDelete:     true,
+						event.RequestSubmit:   true,
+						event.RequestReview:   true,
+						event.RequestApprove:  true,
+						event.RequestBlock:    true,
+						event.RequestClose:    true,
 					}).
 					MustBuild(),
 			},
@@ -245,6 +267,12 @@ func TestToWebhooks(t *testing.T) {
 						OnAssetUpload:     lo.ToPtr(false),
 						OnAssetDecompress: lo.ToPtr(false),
 						OnAssetDelete:     lo.ToPtr(false),
+						OnRequestSubmit:   lo.ToPtr(false),
+						OnRequestReview:   lo.ToPtr(false),
+						OnRequestApprove:  lo.ToPtr(false),
+						OnRequestBlock:    lo.ToPtr(false),
+						OnRequestClose:    lo.ToPtr(false),
+						OnCommentCreate:   lo.ToPtr(false),
 					},
 					CreatedAt: wId.Timestamp(),
 					UpdatedAt: now,
@@ -263,6 +291,12 @@ func TestToWebhooks(t *testing.T) {
 						OnAssetUpload:     lo.ToPtr(true),
 						OnAssetDecompress: lo.ToPtr(true),
 						OnAssetDelete:     lo.ToPtr(true),
+						OnRequestSubmit:   lo.ToPtr(true),
+						OnRequestReview:   lo.ToPtr(true),
+						OnRequestApprove:  lo.ToPtr(true),
+						OnRequestBlock:    lo.ToPtr(true),
+						OnRequestClose:    lo.ToPtr(true),
+						OnCommentCreate:   lo.ToPtr(false),
 					},
 					CreatedAt: wId.Timestamp(),
 					UpdatedAt: now,
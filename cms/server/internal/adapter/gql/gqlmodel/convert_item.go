@@ -5,6 +5,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/samber/lo"
@@ -15,6 +16,11 @@ func ToItem(i *item.Item, s *schema.Schema) *Item {
 		return nil
 	}
 
+	var group *string
+	if g := i.Group(); g != "" {
+		group = &g
+	}
+
 	return &Item{
 		ID:            IDFrom(i.ID()),
 		ProjectID:     IDFrom(i.Project()),
@@ -25,16 +31,25 @@ func ToItem(i *item.Item, s *schema.Schema) *Item {
 		ThreadID:      IDFrom(i.Thread()),
 		CreatedAt:     i.ID().Timestamp(),
 		UpdatedAt:     i.Timestamp(),
+		PublishAt:     i.PublishAt(),
+		UnpublishAt:   i.UnpublishAt(),
+		Group:         group,
 		Fields: lo.Map(s.Fields(), func(sf *schema.Field, _ int) *ItemField {
 			f := i.Field(sf.ID())
-			var v any = nil
+			var v, lv any = nil, nil
 			if f != nil {
-				v = ToValue(f.Value(), sf.Multiple())
+				v = sf.ValueInterface(f.Value(), false)
+				if l := f.Localized(); len(l) > 0 {
+					lv = lo.MapValues(l, func(m *value.Multiple, _ string) any {
+						return sf.ValueInterface(m, false)
+					})
+				}
 			}
 			return &ItemField{
-				SchemaFieldID: IDFrom(sf.ID()),
-				Type:          ToValueType(sf.Type()),
-				Value:         v,
+				SchemaFieldID:   IDFrom(sf.ID()),
+				Type:            ToValueType(sf.Type()),
+				Value:           v,
+				LocalizedValues: lv,
 			}
 		}),
 	}
@@ -59,6 +74,32 @@ func ToVersionedItem(v *version.Value[*item.Item], s *schema.Schema) *VersionedI
 	}
 }
 
+func ToItemFieldDiff(d item.FieldDiff, s *schema.Schema) *ItemFieldDiff {
+	toInterface := func(m *value.Multiple) any {
+		if m == nil {
+			return nil
+		}
+		// the field may have been removed from the schema
+		if sf := s.Field(d.Field); sf != nil {
+			return sf.ValueInterface(m, false)
+		}
+		return m.Interface()
+	}
+
+	addedAssets, removedAssets := d.Assets()
+	addedRefs, removedRefs := d.References()
+	return &ItemFieldDiff{
+		SchemaFieldID:       IDFrom(d.Field),
+		Type:                ToValueType(d.Type()),
+		OldValue:            toInterface(d.Old),
+		NewValue:            toInterface(d.New),
+		AddedAssetIds:       lo.Map(addedAssets, func(i id.AssetID, _ int) ID { return IDFrom(i) }),
+		RemovedAssetIds:     lo.Map(removedAssets, func(i id.AssetID, _ int) ID { return IDFrom(i) }),
+		AddedReferenceIds:   lo.Map(addedRefs, func(i id.ItemID, _ int) ID { return IDFrom(i) }),
+		RemovedReferenceIds: lo.Map(removedRefs, func(i id.ItemID, _ int) ID { return IDFrom(i) }),
+	}
+}
+
 func ToItemParam(field *ItemFieldInput) *interfaces.ItemFieldParam {
 	if field == nil {
 		return nil
@@ -69,10 +110,16 @@ func ToItemParam(field *ItemFieldInput) *interfaces.ItemFieldParam {
 		return nil
 	}
 
+	var localized map[string]any
+	if field.LocalizedValues != nil {
+		localized, _ = field.LocalizedValues.(map[string]any)
+	}
+
 	return &interfaces.ItemFieldParam{
-		Field: &fid,
-		Type:  FromValueType(field.Type),
-		Value: field.Value,
+		Field:     &fid,
+		Type:      FromValueType(field.Type),
+		Value:     field.Value,
+		Localized: localized,
 	}
 }
 
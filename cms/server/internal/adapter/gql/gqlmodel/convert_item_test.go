@@ -80,6 +80,42 @@ func TestToItem(t *testing.T) {
 	}
 }
 
+func TestToItemFieldDiff(t *testing.T) {
+	sf1 := schema.NewField(schema.NewAsset().TypeProperty()).NewID().Key(key.Random()).MustBuild()
+	s := schema.New().NewID().Fields([]*schema.Field{sf1}).Workspace(id.NewWorkspaceID()).Project(id.NewProjectID()).MustBuild()
+	a1, a2 := id.NewAssetID(), id.NewAssetID()
+	removed := id.NewFieldID()
+
+	assert.Equal(t, &ItemFieldDiff{
+		SchemaFieldID:       IDFrom(sf1.ID()),
+		Type:                SchemaFieldTypeAsset,
+		OldValue:            a1.String(),
+		NewValue:            a2.String(),
+		AddedAssetIds:       []ID{IDFrom(a2)},
+		RemovedAssetIds:     []ID{IDFrom(a1)},
+		AddedReferenceIds:   []ID{},
+		RemovedReferenceIds: []ID{},
+	}, ToItemFieldDiff(item.FieldDiff{
+		Field: sf1.ID(),
+		Old:   value.TypeAsset.Value(a1).AsMultiple(),
+		New:   value.TypeAsset.Value(a2).AsMultiple(),
+	}, s))
+
+	// the field has been removed from the schema
+	assert.Equal(t, &ItemFieldDiff{
+		SchemaFieldID:       IDFrom(removed),
+		Type:                SchemaFieldTypeText,
+		OldValue:            []any{"a"},
+		AddedAssetIds:       []ID{},
+		RemovedAssetIds:     []ID{},
+		AddedReferenceIds:   []ID{},
+		RemovedReferenceIds: []ID{},
+	}, ToItemFieldDiff(item.FieldDiff{
+		Field: removed,
+		Old:   value.TypeText.Value("a").AsMultiple(),
+	}, s))
+}
+
 func TestToItemParam(t *testing.T) {
 	sfid := id.NewFieldID()
 	tests := []struct {
@@ -1,7 +1,11 @@
 package gqlmodel
 
 import (
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/pkg/definition"
 	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/samber/lo"
 )
 
 func ToModel(m *model.Model) *Model {
@@ -23,3 +27,23 @@ func ToModel(m *model.Model) *Model {
 		UpdatedAt:   m.UpdatedAt(),
 	}
 }
+
+func ToModelDefinitionChange(c definition.Change) *ModelDefinitionChange {
+	var fieldKey *string
+	if c.Field != "" {
+		fieldKey = lo.ToPtr(c.Field)
+	}
+	return &ModelDefinitionChange{
+		Type:        ModelDefinitionChangeType(strings.ToUpper(string(c.Type))),
+		ModelKey:    c.Model,
+		FieldKey:    fieldKey,
+		Destructive: c.Destructive,
+	}
+}
+
+func FromModelDefinitionFormat(f *ModelDefinitionFormat) definition.Format {
+	if f != nil && *f == ModelDefinitionFormatYaml {
+		return definition.FormatYAML
+	}
+	return definition.FormatJSON
+}
@@ -3,10 +3,12 @@ package gqlmodel
 import (
 	"testing"
 
+	"github.com/reearth/reearth-cms/server/pkg/definition"
 	"github.com/reearth/reearth-cms/server/pkg/key"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -52,3 +54,22 @@ func TestToModel(t *testing.T) {
 		})
 	}
 }
+
+func TestToModelDefinitionChange(t *testing.T) {
+	assert.Equal(t, &ModelDefinitionChange{
+		Type:     ModelDefinitionChangeTypeAdd,
+		ModelKey: "city",
+	}, ToModelDefinitionChange(definition.Change{Type: definition.ChangeTypeAdd, Model: "city"}))
+	assert.Equal(t, &ModelDefinitionChange{
+		Type:        ModelDefinitionChangeTypeRemove,
+		ModelKey:    "city",
+		FieldKey:    lo.ToPtr("name"),
+		Destructive: true,
+	}, ToModelDefinitionChange(definition.Change{Type: definition.ChangeTypeRemove, Model: "city", Field: "name", Destructive: true}))
+}
+
+func TestFromModelDefinitionFormat(t *testing.T) {
+	assert.Equal(t, definition.FormatJSON, FromModelDefinitionFormat(nil))
+	assert.Equal(t, definition.FormatJSON, FromModelDefinitionFormat(lo.ToPtr(ModelDefinitionFormatJSON)))
+	assert.Equal(t, definition.FormatYAML, FromModelDefinitionFormat(lo.ToPtr(ModelDefinitionFormatYaml)))
+}
@@ -1,7 +1,11 @@
 package gqlmodel
 
 import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
 )
 
 func ToProject(p *project.Project) *Project {
@@ -10,14 +14,47 @@ func ToProject(p *project.Project) *Project {
 	}
 
 	return &Project{
-		ID:          IDFrom(p.ID()),
-		WorkspaceID: IDFrom(p.Workspace()),
-		CreatedAt:   p.CreatedAt(),
-		Alias:       p.Alias(),
-		Name:        p.Name(),
-		Description: p.Description(),
-		UpdatedAt:   p.UpdatedAt(),
-		Publication: ToProjectPublication(p.Publication()),
+		ID:              IDFrom(p.ID()),
+		WorkspaceID:     IDFrom(p.Workspace()),
+		CreatedAt:       p.CreatedAt(),
+		Alias:           p.Alias(),
+		Name:            p.Name(),
+		Description:     p.Description(),
+		UpdatedAt:       p.UpdatedAt(),
+		Publication:     ToProjectPublication(p.Publication()),
+		RequestPolicy:   ToProjectRequestPolicy(p.RequestPolicy()),
+		ItemGroupPolicy: ToProjectItemGroupPolicy(p.ItemGroupPolicy()),
+	}
+}
+
+func ToProjectItemGroupPolicy(p *project.ItemGroupPolicy) *ProjectItemGroupPolicy {
+	if p == nil {
+		return nil
+	}
+
+	return &ProjectItemGroupPolicy{
+		Field:      p.Field().StringRef(),
+		Restricted: p.Restricted(),
+	}
+}
+
+func ToProjectRequestPolicy(p *project.RequestPolicy) *ProjectRequestPolicy {
+	if p == nil {
+		return nil
+	}
+
+	reviewers := lo.MapToSlice(p.ModelReviewers(), func(m id.ModelID, l id.UserIDList) *ModelReviewers {
+		return &ModelReviewers{
+			ModelID:     IDFrom(m),
+			ReviewersID: lo.Map(l, func(u id.UserID, _ int) ID { return IDFrom(u) }),
+		}
+	})
+	slices.SortFunc(reviewers, func(a, b *ModelReviewers) bool { return a.ModelID < b.ModelID })
+
+	return &ProjectRequestPolicy{
+		RequiredApprovals: p.RequiredApprovals(),
+		RequiredRoles:     lo.Map(p.RequiredRoles(), func(r user.Role, _ int) Role { return ToRole(r) }),
+		ModelReviewers:    reviewers,
 	}
 }
 
@@ -29,6 +66,20 @@ func ToProjectPublication(p *project.Publication) *ProjectPublication {
 	return &ProjectPublication{
 		Scope:       ToProjectPublicationScope(p.Scope()),
 		AssetPublic: p.AssetPublic(),
+		APIKeys:     lo.Map(p.APIKeys(), func(k *project.APIKey, _ int) *ProjectAPIKey { return ToProjectAPIKey(k) }),
+	}
+}
+
+func ToProjectAPIKey(k *project.APIKey) *ProjectAPIKey {
+	if k == nil {
+		return nil
+	}
+
+	return &ProjectAPIKey{
+		ID:        IDFrom(k.ID()),
+		Name:      k.Name(),
+		ModelIds:  lo.Map(k.Models(), func(m id.ModelID, _ int) ID { return IDFrom(m) }),
+		RateLimit: k.RateLimit(),
 	}
 }
 
@@ -31,20 +31,23 @@ func ToRequest(req *request.Request) *Request {
 	})
 
 	return &Request{
-		ID:          IDFrom(req.ID()),
-		Items:       items,
-		Title:       req.Title(),
-		Description: lo.ToPtr(req.Description()),
-		CreatedByID: IDFrom(req.CreatedBy()),
-		WorkspaceID: IDFrom(req.Workspace()),
-		ProjectID:   IDFrom(req.Project()),
-		ThreadID:    IDFrom(req.Thread()),
-		ReviewersID: lo.Map(req.Reviewers(), func(t id.UserID, _ int) ID { return IDFrom(t) }),
-		State:       ToRequestState(req.State()),
-		CreatedAt:   req.CreatedAt(),
-		UpdatedAt:   req.UpdatedAt(),
-		ApprovedAt:  req.ApprovedAt(),
-		ClosedAt:    req.ClosedAt(),
+		ID:           IDFrom(req.ID()),
+		Items:        items,
+		Title:        req.Title(),
+		Description:  lo.ToPtr(req.Description()),
+		CreatedByID:  IDFrom(req.CreatedBy()),
+		WorkspaceID:  IDFrom(req.Workspace()),
+		ProjectID:    IDFrom(req.Project()),
+		ThreadID:     IDFrom(req.Thread()),
+		ReviewersID:  lo.Map(req.Reviewers(), func(t id.UserID, _ int) ID { return IDFrom(t) }),
+		ApprovedByID: lo.Map(req.ApprovedBy(), func(t id.UserID, _ int) ID { return IDFrom(t) }),
+		State:        ToRequestState(req.State()),
+		CreatedAt:    req.CreatedAt(),
+		UpdatedAt:    req.UpdatedAt(),
+		ApprovedAt:   req.ApprovedAt(),
+		ClosedAt:     req.ClosedAt(),
+		PublishAt:    req.PublishAt(),
+		UnpublishAt:  req.UnpublishAt(),
 	}
 }
 func ToRequestState(s request.State) RequestState {
@@ -57,6 +60,8 @@ func ToRequestState(s request.State) RequestState {
 		return RequestStateDraft
 	case request.StateWaiting:
 		return RequestStateWaiting
+	case request.StateBlocked:
+		return RequestStateBlocked
 	default:
 		return ""
 	}
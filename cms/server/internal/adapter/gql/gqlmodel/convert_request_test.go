@@ -34,18 +34,19 @@ func TestToRequest(t *testing.T) {
 			ItemID: IDFrom(itm.Item()),
 			Ref:    lo.ToPtr(version.Public.String()),
 		}},
-		Title:       "foo",
-		Description: lo.ToPtr("xxx"),
-		CreatedByID: IDFrom(req.CreatedBy()),
-		WorkspaceID: IDFrom(req.Workspace()),
-		ProjectID:   IDFrom(req.Project()),
-		ThreadID:    IDFrom(req.Thread()),
-		ReviewersID: []ID{IDFrom(req.Reviewers()[0])},
-		State:       RequestStateClosed,
-		CreatedAt:   req.CreatedAt(),
-		UpdatedAt:   req.UpdatedAt(),
-		ApprovedAt:  req.ApprovedAt(),
-		ClosedAt:    req.ClosedAt(),
+		Title:        "foo",
+		Description:  lo.ToPtr("xxx"),
+		CreatedByID:  IDFrom(req.CreatedBy()),
+		WorkspaceID:  IDFrom(req.Workspace()),
+		ProjectID:    IDFrom(req.Project()),
+		ThreadID:     IDFrom(req.Thread()),
+		ReviewersID:  []ID{IDFrom(req.Reviewers()[0])},
+		ApprovedByID: []ID{},
+		State:        RequestStateClosed,
+		CreatedAt:    req.CreatedAt(),
+		UpdatedAt:    req.UpdatedAt(),
+		ApprovedAt:   req.ApprovedAt(),
+		ClosedAt:     req.ClosedAt(),
 	}, ToRequest(req))
 }
 
@@ -4,6 +4,7 @@ import (
 	"reflect"
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/key"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/i18n"
@@ -42,35 +43,72 @@ func ToSchemaField(sf *schema.Field) *SchemaField {
 		Multiple:     sf.Multiple(),
 		Unique:       sf.Unique(),
 		Required:     sf.Required(),
+		Localized:    sf.Localized(),
+		MinItems:     sf.MinItems(),
+		MaxItems:     sf.MaxItems(),
+		RequiredIf:   ToSchemaFieldRequiredCondition(sf.RequiredIf()),
 		CreatedAt:    sf.CreatedAt(),
 		UpdatedAt:    sf.UpdatedAt(),
 	}
 }
 
+func ToSchemaFieldGroupField(sf *schema.Field) *SchemaFieldGroupField {
+	if sf == nil {
+		return nil
+	}
+
+	return &SchemaFieldGroupField{
+		ID:           IDFrom(sf.ID()),
+		Type:         ToValueType(sf.Type()),
+		TypeProperty: ToSchemaFieldTypeProperty(sf.TypeProperty(), sf.DefaultValue(), sf.Multiple()),
+		Key:          sf.Key().String(),
+		Title:        sf.Name(),
+		Order:        lo.ToPtr(sf.Order()),
+		Description:  lo.ToPtr(sf.Description()),
+		Multiple:     sf.Multiple(),
+		Required:     sf.Required(),
+	}
+}
+
+func ToSchemaFieldRequiredCondition(c *schema.RequiredCondition) *SchemaFieldRequiredCondition {
+	if c == nil {
+		return nil
+	}
+
+	return &SchemaFieldRequiredCondition{
+		FieldID: IDFrom(c.Field()),
+		Values:  c.Values(),
+	}
+}
+
 func ToSchemaFieldTypeProperty(tp *schema.TypeProperty, dv *value.Multiple, multiple bool) (res SchemaFieldTypeProperty) {
 	tp.Match(schema.TypePropertyMatch{
 		Text: func(f *schema.FieldText) {
 			res = &SchemaFieldText{
 				DefaultValue: valueString(dv, multiple),
 				MaxLength:    f.MaxLength(),
+				Pattern:      f.Pattern(),
 			}
 		},
 		TextArea: func(f *schema.FieldTextArea) {
 			res = &SchemaFieldTextArea{
 				DefaultValue: valueString(dv, multiple),
 				MaxLength:    f.MaxLength(),
+				Pattern:      f.Pattern(),
 			}
 		},
 		RichText: func(f *schema.FieldRichText) {
 			res = &SchemaFieldRichText{
 				DefaultValue: valueString(dv, multiple),
 				MaxLength:    f.MaxLength(),
+				Pattern:      f.Pattern(),
 			}
 		},
 		Markdown: func(f *schema.FieldMarkdown) {
 			res = &SchemaFieldMarkdown{
 				DefaultValue: valueString(dv, multiple),
 				MaxLength:    f.MaxLength(),
+				Pattern:      f.Pattern(),
 			}
 		},
 		Select: func(f *schema.FieldSelect) {
@@ -103,6 +141,8 @@ func ToSchemaFieldTypeProperty(tp *schema.TypeProperty, dv *value.Multiple, mult
 			}
 			res = &SchemaFieldDate{
 				DefaultValue: v,
+				Min:          f.Min(),
+				Max:          f.Max(),
 			}
 		},
 		Bool: func(f *schema.FieldBool) {
@@ -150,7 +190,8 @@ func ToSchemaFieldTypeProperty(tp *schema.TypeProperty, dv *value.Multiple, mult
 		},
 		Reference: func(f *schema.FieldReference) {
 			res = &SchemaFieldReference{
-				ModelID: IDFrom(f.Model()),
+				ModelID:  IDFrom(f.Model()),
+				OnDelete: ToReferenceOnDelete(f.OnDelete()),
 			}
 		},
 		URL: func(f *schema.FieldURL) {
@@ -166,6 +207,30 @@ func ToSchemaFieldTypeProperty(tp *schema.TypeProperty, dv *value.Multiple, mult
 			}
 			res = &SchemaFieldURL{
 				DefaultValue: v,
+				Pattern:      f.Pattern(),
+			}
+		},
+		Geometry: func(f *schema.FieldGeometry) {
+			var v any = nil
+			if dv != nil {
+				if multiple {
+					v = dv.Interface()
+				} else {
+					v = dv.First().Interface()
+				}
+			}
+			res = &SchemaFieldGeometry{
+				DefaultValue: v,
+				SupportedTypes: lo.Map(f.SupportedTypes(), func(t value.GeometryType, _ int) GeometryType {
+					return GeometryType(t)
+				}),
+			}
+		},
+		Group: func(f *schema.FieldGroup) {
+			res = &SchemaFieldGroup{
+				Fields: lo.Map(f.Fields(), func(sf *schema.Field, _ int) *SchemaFieldGroupField {
+					return ToSchemaFieldGroupField(sf)
+				}),
 			}
 		},
 	})
@@ -202,7 +267,11 @@ func FromSchemaTypeProperty(tp *SchemaFieldTypePropertyInput, t SchemaFieldType,
 		} else {
 			dv = FromValue(SchemaFieldTypeText, x.DefaultValue).AsMultiple()
 		}
-		tpRes = schema.NewText(x.MaxLength).TypeProperty()
+		tpi := schema.NewText(x.MaxLength)
+		if err := tpi.SetPattern(x.Pattern); err != nil {
+			return nil, nil, err
+		}
+		tpRes = tpi.TypeProperty()
 	case SchemaFieldTypeTextArea:
 		x := tp.TextArea
 		if x == nil {
@@ -213,7 +282,11 @@ func FromSchemaTypeProperty(tp *SchemaFieldTypePropertyInput, t SchemaFieldType,
 		} else {
 			dv = FromValue(SchemaFieldTypeTextArea, x.DefaultValue).AsMultiple()
 		}
-		tpRes = schema.NewTextArea(x.MaxLength).TypeProperty()
+		tpi := schema.NewTextArea(x.MaxLength)
+		if err := tpi.SetPattern(x.Pattern); err != nil {
+			return nil, nil, err
+		}
+		tpRes = tpi.TypeProperty()
 	case SchemaFieldTypeRichText:
 		x := tp.RichText
 		if x == nil {
@@ -224,7 +297,11 @@ func FromSchemaTypeProperty(tp *SchemaFieldTypePropertyInput, t SchemaFieldType,
 		} else {
 			dv = FromValue(SchemaFieldTypeRichText, x.DefaultValue).AsMultiple()
 		}
-		tpRes = schema.NewRichText(x.MaxLength).TypeProperty()
+		tpi := schema.NewRichText(x.MaxLength)
+		if err := tpi.SetPattern(x.Pattern); err != nil {
+			return nil, nil, err
+		}
+		tpRes = tpi.TypeProperty()
 	case SchemaFieldTypeMarkdownText:
 		x := tp.MarkdownText
 		if x == nil {
@@ -235,7 +312,11 @@ func FromSchemaTypeProperty(tp *SchemaFieldTypePropertyInput, t SchemaFieldType,
 		} else {
 			dv = FromValue(SchemaFieldTypeMarkdownText, x.DefaultValue).AsMultiple()
 		}
-		tpRes = schema.NewMarkdown(x.MaxLength).TypeProperty()
+		tpi := schema.NewMarkdown(x.MaxLength)
+		if err := tpi.SetPattern(x.Pattern); err != nil {
+			return nil, nil, err
+		}
+		tpRes = tpi.TypeProperty()
 	case SchemaFieldTypeAsset:
 		x := tp.Asset
 		if x == nil {
@@ -257,7 +338,11 @@ func FromSchemaTypeProperty(tp *SchemaFieldTypePropertyInput, t SchemaFieldType,
 		} else {
 			dv = FromValue(SchemaFieldTypeDate, x.DefaultValue).AsMultiple()
 		}
-		tpRes = schema.NewDateTime().TypeProperty()
+		tpi, err := schema.NewDateTime(x.Min, x.Max)
+		if err != nil {
+			return nil, nil, err
+		}
+		tpRes = tpi.TypeProperty()
 	case SchemaFieldTypeBool:
 		x := tp.Bool
 		if x == nil {
@@ -311,7 +396,13 @@ func FromSchemaTypeProperty(tp *SchemaFieldTypePropertyInput, t SchemaFieldType,
 		if err != nil {
 			return nil, nil, err
 		}
-		tpRes = schema.NewReference(mId).TypeProperty()
+		r := schema.NewReference(mId)
+		if x.OnDelete != nil {
+			if err := r.SetOnDelete(FromReferenceOnDelete(*x.OnDelete)); err != nil {
+				return nil, nil, err
+			}
+		}
+		tpRes = r.TypeProperty()
 	case SchemaFieldTypeURL:
 		x := tp.URL
 		if x == nil {
@@ -322,13 +413,87 @@ func FromSchemaTypeProperty(tp *SchemaFieldTypePropertyInput, t SchemaFieldType,
 		} else {
 			dv = FromValue(SchemaFieldTypeURL, x.DefaultValue).AsMultiple()
 		}
-		tpRes = schema.NewURL().TypeProperty()
+		tpi := schema.NewURL()
+		if err := tpi.SetPattern(x.Pattern); err != nil {
+			return nil, nil, err
+		}
+		tpRes = tpi.TypeProperty()
+	case SchemaFieldTypeGeometry:
+		x := tp.Geometry
+		if x == nil {
+			return nil, nil, ErrInvalidTypeProperty
+		}
+		if multiple {
+			dv = value.NewMultiple(value.TypeGeometry, unpackArray(x.DefaultValue))
+		} else {
+			dv = FromValue(SchemaFieldTypeGeometry, x.DefaultValue).AsMultiple()
+		}
+		tpRes = schema.NewGeometry(lo.Map(x.SupportedTypes, func(t GeometryType, _ int) value.GeometryType {
+			return value.GeometryType(t)
+		})).TypeProperty()
+	case SchemaFieldTypeGroup:
+		x := tp.Group
+		if x == nil {
+			return nil, nil, ErrInvalidTypeProperty
+		}
+		fields, err := util.TryMap(lo.Range(len(x.Fields)), func(i int) (*schema.Field, error) {
+			return FromSchemaFieldGroupField(x.Fields[i], i)
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		tpi, err := schema.NewGroup(fields)
+		if err != nil {
+			return nil, nil, err
+		}
+		tpRes = tpi.TypeProperty()
 	default:
 		return nil, nil, ErrInvalidTypeProperty
 	}
 	return
 }
 
+func FromSchemaFieldGroupField(f *SchemaFieldGroupFieldInput, order int) (*schema.Field, error) {
+	if f == nil {
+		return nil, ErrInvalidTypeProperty
+	}
+
+	tp, dv, err := FromSchemaTypeProperty(f.TypeProperty, f.Type, f.Multiple)
+	if err != nil {
+		return nil, err
+	}
+
+	b := schema.NewField(tp).
+		Name(f.Title).
+		Description(lo.FromPtr(f.Description)).
+		Key(key.New(f.Key)).
+		Multiple(f.Multiple).
+		Required(f.Required).
+		Order(order).
+		DefaultValue(dv)
+	if f.FieldID != nil {
+		fid, err := ToID[id.Field](*f.FieldID)
+		if err != nil {
+			return nil, err
+		}
+		b = b.ID(fid)
+	} else {
+		b = b.NewID()
+	}
+	return b.Build()
+}
+
+func FromSchemaFieldRequiredCondition(c *SchemaFieldRequiredConditionInput) (*schema.RequiredCondition, error) {
+	if c == nil {
+		return nil, nil
+	}
+	fid, err := ToID[id.Field](c.FieldID)
+	if err != nil {
+		return nil, err
+	}
+	return schema.NewRequiredCondition(fid, c.Values), nil
+}
+
 // TODO: move to util
 func unpackArray(s any) []any {
 	if s == nil {
@@ -341,3 +506,23 @@ func unpackArray(s any) []any {
 	}
 	return r
 }
+
+func ToReferenceOnDelete(r schema.ReferenceOnDelete) ReferenceOnDelete {
+	switch r {
+	case schema.ReferenceOnDeleteRestrict:
+		return ReferenceOnDeleteRestrict
+	case schema.ReferenceOnDeleteCascade:
+		return ReferenceOnDeleteCascade
+	}
+	return ReferenceOnDeleteNullify
+}
+
+func FromReferenceOnDelete(r ReferenceOnDelete) schema.ReferenceOnDelete {
+	switch r {
+	case ReferenceOnDeleteRestrict:
+		return schema.ReferenceOnDeleteRestrict
+	case ReferenceOnDeleteCascade:
+		return schema.ReferenceOnDeleteCascade
+	}
+	return schema.ReferenceOnDeleteNullify
+}
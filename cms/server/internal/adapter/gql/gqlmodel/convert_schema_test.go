@@ -126,6 +126,7 @@ func TestToSchemaField(t *testing.T) {
 
 func TestToSchemaFieldTypeProperty(t *testing.T) {
 	mid := id.NewModelID()
+	fid := id.NewFieldID()
 
 	type args struct {
 		tp *schema.TypeProperty
@@ -168,13 +169,13 @@ func TestToSchemaFieldTypeProperty(t *testing.T) {
 		},
 		{
 			name: "datetime",
-			args: args{tp: schema.NewDateTime().TypeProperty()},
+			args: args{tp: lo.Must(schema.NewDateTime(nil, nil)).TypeProperty()},
 			want: &SchemaFieldDate{DefaultValue: nil},
 		},
 		{
 			name: "reference",
 			args: args{tp: schema.NewReference(mid).TypeProperty()},
-			want: &SchemaFieldReference{ModelID: IDFrom(mid)},
+			want: &SchemaFieldReference{ModelID: IDFrom(mid), OnDelete: ReferenceOnDeleteNullify},
 		},
 		{
 			name: "asset",
@@ -201,6 +202,26 @@ func TestToSchemaFieldTypeProperty(t *testing.T) {
 			args: args{tp: schema.NewSelect([]string{"v1"}).TypeProperty()},
 			want: &SchemaFieldSelect{Values: []string{"v1"}, DefaultValue: nil},
 		},
+		{
+			name: "geometry",
+			args: args{tp: schema.NewGeometry([]value.GeometryType{value.GeometryTypePoint}).TypeProperty()},
+			want: &SchemaFieldGeometry{SupportedTypes: []GeometryType{GeometryTypePoint}},
+		},
+		{
+			name: "group",
+			args: args{tp: lo.Must(schema.NewGroup([]*schema.Field{
+				schema.NewField(schema.NewText(nil).TypeProperty()).ID(fid).Key(key.New("name")).Name("Name").MustBuild(),
+			})).TypeProperty()},
+			want: &SchemaFieldGroup{Fields: []*SchemaFieldGroupField{{
+				ID:           IDFrom(fid),
+				Type:         SchemaFieldTypeText,
+				TypeProperty: &SchemaFieldText{},
+				Key:          "name",
+				Title:        "Name",
+				Order:        lo.ToPtr(0),
+				Description:  lo.ToPtr(""),
+			}}},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -214,6 +235,7 @@ func TestToSchemaFieldTypeProperty(t *testing.T) {
 
 func TestFromSchemaFieldTypeProperty(t *testing.T) {
 	mid := id.NewModelID()
+	fid := id.NewFieldID()
 
 	tests := []struct {
 		name      string
@@ -276,7 +298,7 @@ func TestFromSchemaFieldTypeProperty(t *testing.T) {
 				},
 			},
 			argsT:  SchemaFieldTypeDate,
-			wantTp: schema.NewDateTime().TypeProperty(),
+			wantTp: lo.Must(schema.NewDateTime(nil, nil)).TypeProperty(),
 		},
 		{
 			name: "reference",
@@ -288,6 +310,21 @@ func TestFromSchemaFieldTypeProperty(t *testing.T) {
 			argsT:  SchemaFieldTypeReference,
 			wantTp: schema.NewReference(mid).TypeProperty(),
 		},
+		{
+			name: "reference with on-delete behavior",
+			argsInp: &SchemaFieldTypePropertyInput{
+				Reference: &SchemaFieldReferenceInput{
+					ModelID:  ID(mid.String()),
+					OnDelete: lo.ToPtr(ReferenceOnDeleteCascade),
+				},
+			},
+			argsT: SchemaFieldTypeReference,
+			wantTp: func() *schema.TypeProperty {
+				r := schema.NewReference(mid)
+				_ = r.SetOnDelete(schema.ReferenceOnDeleteCascade)
+				return r.TypeProperty()
+			}(),
+		},
 		{
 			name: "asset",
 			argsInp: &SchemaFieldTypePropertyInput{
@@ -320,6 +357,43 @@ func TestFromSchemaFieldTypeProperty(t *testing.T) {
 			argsT:  SchemaFieldTypeSelect,
 			wantTp: schema.NewSelect(nil).TypeProperty(),
 		},
+		{
+			name: "geometry",
+			argsInp: &SchemaFieldTypePropertyInput{
+				Geometry: &SchemaFieldGeometryInput{SupportedTypes: []GeometryType{GeometryTypePolygon}},
+			},
+			argsT:  SchemaFieldTypeGeometry,
+			wantTp: schema.NewGeometry([]value.GeometryType{value.GeometryTypePolygon}).TypeProperty(),
+		},
+		{
+			name: "group",
+			argsInp: &SchemaFieldTypePropertyInput{
+				Group: &SchemaFieldGroupInput{Fields: []*SchemaFieldGroupFieldInput{{
+					FieldID:      IDFromRef(fid.Ref()),
+					Type:         SchemaFieldTypeText,
+					Title:        "Name",
+					Key:          "name",
+					TypeProperty: &SchemaFieldTypePropertyInput{Text: &SchemaFieldTextInput{}},
+				}}},
+			},
+			argsT: SchemaFieldTypeGroup,
+			wantTp: lo.Must(schema.NewGroup([]*schema.Field{
+				schema.NewField(schema.NewText(nil).TypeProperty()).ID(fid).Key(key.New("name")).Name("Name").MustBuild(),
+			})).TypeProperty(),
+		},
+		{
+			name: "nested group",
+			argsInp: &SchemaFieldTypePropertyInput{
+				Group: &SchemaFieldGroupInput{Fields: []*SchemaFieldGroupFieldInput{{
+					Type:         SchemaFieldTypeGroup,
+					Title:        "Nested",
+					Key:          "nested",
+					TypeProperty: &SchemaFieldTypePropertyInput{Group: &SchemaFieldGroupInput{}},
+				}}},
+			},
+			argsT:     SchemaFieldTypeGroup,
+			wantError: schema.ErrInvalidGroupField,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,173 @@
+package gqlmodel
+
+import (
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+func ToTask(t *task.Task) *Task {
+	if t == nil {
+		return nil
+	}
+
+	p := t.Progress()
+	return &Task{
+		ID:            IDFrom(t.ID()),
+		WorkspaceID:   IDFrom(t.Workspace()),
+		ProjectID:     IDFrom(t.Project()),
+		UserID:        IDFromRef(t.User()),
+		IntegrationID: IDFromRef(t.Integration()),
+		Type:          ToTaskType(t.Type()),
+		Status:        ToTaskStatus(t.Status()),
+		Progress: &TaskProgress{
+			Total:     p.Total,
+			Processed: p.Processed,
+			Created:   p.Created,
+			Updated:   p.Updated,
+			Failed:    p.Failed,
+		},
+		Errors: util.Map(t.Errors(), func(e task.Error) *TaskError {
+			return &TaskError{
+				Row:     e.Row,
+				ItemID:  IDFromRef(e.Item),
+				Field:   util.ToPtrIfNotEmpty(e.Field),
+				Message: e.Message,
+			}
+		}),
+		Message:       util.ToPtrIfNotEmpty(t.Message()),
+		ImportItems:   ToImportItemsConfig(t.ImportItems()),
+		ExportItems:   ToExportItemsConfig(t.ExportItems()),
+		MigrateFields: ToMigrateFieldsConfig(t.MigrateFields()),
+		AssetID:       IDFromRef(t.Asset()),
+		CreatedAt:     t.CreatedAt(),
+		UpdatedAt:     t.UpdatedAt(),
+	}
+}
+
+func ToImportItemsConfig(c *task.ImportItemsConfig) *ImportItemsConfig {
+	if c == nil {
+		return nil
+	}
+
+	columns := maps.Keys(c.Fields)
+	slices.Sort(columns)
+	return &ImportItemsConfig{
+		ModelID: IDFrom(c.ModelID),
+		AssetID: IDFrom(c.AssetID),
+		Format:  ImportFormat(strings.ToUpper(c.Format)),
+		Fields: lo.Map(columns, func(col string, _ int) *ImportFieldMapping {
+			return &ImportFieldMapping{Column: col, FieldKey: c.Fields[col]}
+		}),
+		KeyField: util.ToPtrIfNotEmpty(c.KeyField),
+		DryRun:   c.DryRun,
+	}
+}
+
+func ToExportItemsConfig(c *task.ExportItemsConfig) *ExportItemsConfig {
+	if c == nil {
+		return nil
+	}
+
+	return &ExportItemsConfig{
+		ModelID: IDFrom(c.ModelID),
+		Format:  ExportFormat(strings.ToUpper(c.Format)),
+		Keyword: util.ToPtrIfNotEmpty(c.Keyword),
+		Filters: lo.Map(c.Filters, func(f task.ExportFilter, _ int) *ExportFilter {
+			return &ExportFilter{Key: f.Key, Operator: f.Operator, Value: f.Value}
+		}),
+		Bbox:       util.ToPtrIfNotEmpty(c.BBox),
+		Intersects: util.ToPtrIfNotEmpty(c.Intersects),
+	}
+}
+
+func ToMigrateFieldsConfig(c *task.MigrateFieldsConfig) *MigrateFieldsConfig {
+	if c == nil {
+		return nil
+	}
+
+	return &MigrateFieldsConfig{
+		ModelID:        IDFrom(c.ModelID),
+		Type:           FieldMigrationType(strings.ToUpper(c.Type)),
+		SourceFieldIds: lo.Map(c.Sources, func(fid id.FieldID, _ int) ID { return IDFrom(fid) }),
+		Targets:        lo.Map(c.Targets, func(f *schema.Field, _ int) *SchemaField { return ToSchemaField(f) }),
+		Separator:      util.ToPtrIfNotEmpty(c.Separator),
+		DryRun:         c.DryRun,
+	}
+}
+
+func ToTaskType(t task.Type) TaskType {
+	switch t {
+	case task.TypeImportItems:
+		return TaskTypeImportItems
+	case task.TypeExportItems:
+		return TaskTypeExportItems
+	case task.TypeMigrateFields:
+		return TaskTypeMigrateFields
+	default:
+		return ""
+	}
+}
+
+func ToTaskStatus(s task.Status) TaskStatus {
+	switch s {
+	case task.StatusPending:
+		return TaskStatusPending
+	case task.StatusRunning:
+		return TaskStatusRunning
+	case task.StatusCompleted:
+		return TaskStatusCompleted
+	case task.StatusFailed:
+		return TaskStatusFailed
+	default:
+		return ""
+	}
+}
+
+func (f ImportFormat) Into() item.ImportFormat {
+	switch f {
+	case ImportFormatCSV:
+		return item.ImportFormatCSV
+	case ImportFormatJSON:
+		return item.ImportFormatJSON
+	case ImportFormatGeojson:
+		return item.ImportFormatGeoJSON
+	default:
+		return ""
+	}
+}
+
+func (f ExportFormat) Into() item.ExportFormat {
+	switch f {
+	case ExportFormatCSV:
+		return item.ExportFormatCSV
+	case ExportFormatJSONL:
+		return item.ExportFormatJSONL
+	case ExportFormatGeojson:
+		return item.ExportFormatGeoJSON
+	case ExportFormatXlsx:
+		return item.ExportFormatXLSX
+	default:
+		return ""
+	}
+}
+
+func (t FieldMigrationType) Into() item.MigrationType {
+	switch t {
+	case FieldMigrationTypeConvert:
+		return item.MigrationTypeConvert
+	case FieldMigrationTypeSplit:
+		return item.MigrationTypeSplit
+	case FieldMigrationTypeMerge:
+		return item.MigrationTypeMerge
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,70 @@
+package gqlmodel
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToTask(t *testing.T) {
+	uid := id.NewUserID()
+	mid, aid := id.NewModelID(), id.NewAssetID()
+	tk := task.New().NewID().Workspace(id.NewWorkspaceID()).Project(id.NewProjectID()).User(&uid).Type(task.TypeImportItems).ImportItems(&task.ImportItemsConfig{
+		ModelID:  mid,
+		AssetID:  aid,
+		Format:   "geojson",
+		Fields:   map[string]string{"b": "y", "a": "x"},
+		KeyField: "x",
+	}).MustBuild()
+	tk.Start(2)
+	tk.Record(0, true, false)
+	tk.Record(1, false, false, task.Error{Message: "invalid"})
+
+	assert.Nil(t, ToTask(nil))
+	assert.Equal(t, &Task{
+		ID:          IDFrom(tk.ID()),
+		WorkspaceID: IDFrom(tk.Workspace()),
+		ProjectID:   IDFrom(tk.Project()),
+		UserID:      IDFromRef(&uid),
+		Type:        TaskTypeImportItems,
+		Status:      TaskStatusRunning,
+		Progress:    &TaskProgress{Total: 2, Processed: 2, Created: 1, Failed: 1},
+		Errors:      []*TaskError{{Row: 1, Message: "invalid"}},
+		ImportItems: &ImportItemsConfig{
+			ModelID:  IDFrom(mid),
+			AssetID:  IDFrom(aid),
+			Format:   ImportFormatGeojson,
+			Fields:   []*ImportFieldMapping{{Column: "a", FieldKey: "x"}, {Column: "b", FieldKey: "y"}},
+			KeyField: lo.ToPtr("x"),
+		},
+		CreatedAt: tk.CreatedAt(),
+		UpdatedAt: tk.UpdatedAt(),
+	}, ToTask(tk))
+}
+
+func TestToMigrateFieldsConfig(t *testing.T) {
+	mid, fid := id.NewModelID(), id.NewFieldID()
+	f := schema.NewField(schema.NewBool().TypeProperty()).NewID().Key(key.New("a")).MustBuild()
+
+	assert.Nil(t, ToMigrateFieldsConfig(nil))
+	assert.Equal(t, &MigrateFieldsConfig{
+		ModelID:        IDFrom(mid),
+		Type:           FieldMigrationTypeConvert,
+		SourceFieldIds: []ID{IDFrom(fid)},
+		Targets:        []*SchemaField{ToSchemaField(f)},
+		DryRun:         true,
+	}, ToMigrateFieldsConfig(&task.MigrateFieldsConfig{
+		ModelID: mid,
+		Type:    "convert",
+		Sources: id.FieldIDList{fid},
+		Targets: schema.FieldList{f},
+		DryRun:  true,
+	}))
+	assert.Equal(t, item.MigrationTypeMerge, FieldMigrationTypeMerge.Into())
+}
@@ -14,6 +14,7 @@ func ToThread(th *thread.Thread) *Thread {
 		ID:          IDFrom(th.ID()),
 		WorkspaceID: IDFrom(th.Workspace()),
 		Comments:    lo.Map(th.Comments(), func(c *thread.Comment, _ int) *Comment { return ToComment(c, th) }),
+		Resolved:    th.Resolved(),
 	}
 }
 
@@ -33,13 +34,22 @@ func ToComment(c *thread.Comment, th *thread.Thread) *Comment {
 		authorType = OperatorTypeIntegration
 	}
 
+	mentionedUserIDs := []ID{}
+	mentionedIntegrationIDs := []ID{}
+	if m := c.Mentions(); m != nil {
+		mentionedUserIDs = lo.Map(m.Users, func(u thread.UserID, _ int) ID { return IDFrom(u) })
+		mentionedIntegrationIDs = lo.Map(m.Integrations, func(i thread.IntegrationID, _ int) ID { return IDFrom(i) })
+	}
+
 	return &Comment{
-		ID:          IDFrom(c.ID()),
-		ThreadID:    IDFrom(th.ID()),
-		WorkspaceID: IDFrom(th.Workspace()),
-		AuthorID:    authorID,
-		AuthorType:  authorType,
-		Content:     c.Content(),
-		CreatedAt:   c.CreatedAt(),
+		ID:                      IDFrom(c.ID()),
+		ThreadID:                IDFrom(th.ID()),
+		WorkspaceID:             IDFrom(th.Workspace()),
+		AuthorID:                authorID,
+		AuthorType:              authorType,
+		Content:                 c.Content(),
+		MentionedUserIds:        mentionedUserIDs,
+		MentionedIntegrationIds: mentionedIntegrationIDs,
+		CreatedAt:               c.CreatedAt(),
 	}
 }
@@ -35,16 +35,20 @@ func TestConvertThread_ToComment(t *testing.T) {
 	c1 := "xxx"
 
 	th := thread.New().NewID().Workspace(thread.NewWorkspaceID()).MustBuild()
+	uid2 := id.NewUserID()
 	comment1 := thread.NewComment(cid1, operator.OperatorFromUser(uid1), c1)
+	comment1.SetMentions(&thread.Mentions{Users: id.UserIDList{uid2}})
 
 	want1 := Comment{
-		ID:          ID(cid1.String()),
-		ThreadID:    ID(th.ID().String()),
-		WorkspaceID: ID(th.Workspace().String()),
-		AuthorID:    ID(uid1.String()),
-		AuthorType:  OperatorTypeUser,
-		Content:     c1,
-		CreatedAt:   cid1.Timestamp(),
+		ID:                      ID(cid1.String()),
+		ThreadID:                ID(th.ID().String()),
+		WorkspaceID:             ID(th.Workspace().String()),
+		AuthorID:                ID(uid1.String()),
+		AuthorType:              OperatorTypeUser,
+		Content:                 c1,
+		MentionedUserIds:        []ID{ID(uid2.String())},
+		MentionedIntegrationIds: []ID{},
+		CreatedAt:               cid1.Timestamp(),
 	}
 
 	got1 := ToComment(comment1, th)
@@ -1,6 +1,9 @@
 package gqlmodel
 
 import (
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/util"
 )
@@ -60,14 +63,18 @@ func ToWorkspace(t *user.Workspace) *Workspace {
 	members := make([]WorkspaceMember, 0, len(usersMap)+len(integrationsMap))
 	for u, m := range usersMap {
 		members = append(members, &WorkspaceUserMember{
-			UserID: IDFrom(u),
-			Role:   ToRole(m.Role),
+			UserID:       IDFrom(u),
+			Role:         ToRole(m.Role),
+			CustomRoleID: IDFromRef(m.CustomRole),
+			ItemGroups:   append([]string{}, m.Groups...),
 		})
 	}
 	for i, m := range integrationsMap {
 		members = append(members, &WorkspaceIntegrationMember{
 			IntegrationID: IDFrom(i),
 			Role:          ToRole(m.Role),
+			CustomRoleID:  IDFromRef(m.CustomRole),
+			ItemGroups:    append([]string{}, m.Groups...),
 			Active:        !m.Disabled,
 			InvitedByID:   IDFrom(m.InvitedBy),
 			InvitedBy:     nil,
@@ -76,11 +83,57 @@ func ToWorkspace(t *user.Workspace) *Workspace {
 	}
 
 	return &Workspace{
-		ID:       IDFrom(t.ID()),
-		Name:     t.Name(),
-		Personal: t.IsPersonal(),
-		Members:  members,
+		ID:          IDFrom(t.ID()),
+		Name:        t.Name(),
+		Personal:    t.IsPersonal(),
+		Members:     members,
+		CustomRoles: util.Map(t.Roles(), ToCustomRole),
+	}
+}
+
+func ToCustomRole(r *user.CustomRole) *CustomRole {
+	if r == nil {
+		return nil
+	}
+	return &CustomRole{
+		ID:   IDFrom(r.ID()),
+		Name: r.Name(),
+		Permissions: util.Map(r.Permissions(), func(p user.Permission) *CustomRolePermission {
+			return &CustomRolePermission{
+				ProjectID: IDFromRef(p.Project),
+				ModelID:   IDFromRef(p.Model),
+				Actions:   util.Map(p.Actions, func(a user.Action) RoleAction { return RoleAction(strings.ToUpper(string(a))) }),
+			}
+		}),
+	}
+}
+
+func FromCustomRolePermissions(permissions []*CustomRolePermissionInput) ([]user.Permission, error) {
+	res := make([]user.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		if p == nil {
+			continue
+		}
+		perm := user.Permission{
+			Actions: util.Map(p.Actions, func(a RoleAction) user.Action { return user.Action(strings.ToLower(string(a))) }),
+		}
+		if p.ProjectID != nil {
+			pid, err := ToID[id.Project](*p.ProjectID)
+			if err != nil {
+				return nil, err
+			}
+			perm.Project = &pid
+		}
+		if p.ModelID != nil {
+			mid, err := ToID[id.Model](*p.ModelID)
+			if err != nil {
+				return nil, err
+			}
+			perm.Model = &mid
+		}
+		res = append(res, perm)
 	}
+	return res, nil
 }
 
 func FromRole(r Role) user.Role {
@@ -3,7 +3,9 @@ package gqlmodel
 import (
 	"testing"
 
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -80,3 +82,29 @@ func TestFromRole(t *testing.T) {
 		})
 	}
 }
+
+func TestToCustomRole(t *testing.T) {
+	pid, mid := id.NewProjectID(), id.NewModelID()
+	r := lo.Must(user.NewCustomRole(id.NewRoleID(), "staff", []user.Permission{
+		{Project: &pid, Model: &mid, Actions: []user.Action{user.ActionRead, user.ActionApprove}},
+	}))
+
+	got := ToCustomRole(r)
+	assert.Equal(t, &CustomRole{
+		ID:   IDFrom(r.ID()),
+		Name: "staff",
+		Permissions: []*CustomRolePermission{
+			{ProjectID: IDFromRef(&pid), ModelID: IDFromRef(&mid), Actions: []RoleAction{RoleActionRead, RoleActionApprove}},
+		},
+	}, got)
+	assert.Nil(t, ToCustomRole(nil))
+
+	permissions, err := FromCustomRolePermissions([]*CustomRolePermissionInput{
+		{ProjectID: got.Permissions[0].ProjectID, ModelID: got.Permissions[0].ModelID, Actions: got.Permissions[0].Actions},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, r.Permissions(), permissions)
+
+	_, err = FromCustomRolePermissions([]*CustomRolePermissionInput{{ProjectID: lo.ToPtr(ID("xxx"))}})
+	assert.Error(t, err)
+}
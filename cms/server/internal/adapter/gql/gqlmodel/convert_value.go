@@ -28,6 +28,10 @@ func ToValueType(t value.Type) SchemaFieldType {
 		return SchemaFieldTypeReference
 	case value.TypeURL:
 		return SchemaFieldTypeURL
+	case value.TypeGeometry:
+		return SchemaFieldTypeGeometry
+	case value.TypeGroup:
+		return SchemaFieldTypeGroup
 	default:
 		return ""
 	}
@@ -59,6 +63,10 @@ func FromValueType(t SchemaFieldType) value.Type {
 		return value.TypeReference
 	case SchemaFieldTypeURL:
 		return value.TypeURL
+	case SchemaFieldTypeGeometry:
+		return value.TypeGeometry
+	case SchemaFieldTypeGroup:
+		return value.TypeGroup
 	default:
 		return ""
 	}
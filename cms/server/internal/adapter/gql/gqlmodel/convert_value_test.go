@@ -71,6 +71,16 @@ func TestValueType(t *testing.T) {
 			t:    value.TypeURL,
 			want: SchemaFieldTypeURL,
 		},
+		{
+			name: "TypeGeometry",
+			t:    value.TypeGeometry,
+			want: SchemaFieldTypeGeometry,
+		},
+		{
+			name: "TypeGroup",
+			t:    value.TypeGroup,
+			want: SchemaFieldTypeGroup,
+		},
 		{
 			name: "invalid",
 			t:    "some value",
@@ -32,8 +32,10 @@ type WorkspaceMember interface {
 }
 
 type AddCommentInput struct {
-	ThreadID ID     `json:"threadId"`
-	Content  string `json:"content"`
+	ThreadID                ID     `json:"threadId"`
+	Content                 string `json:"content"`
+	MentionedUserIds        []ID   `json:"mentionedUserIds"`
+	MentionedIntegrationIds []ID   `json:"mentionedIntegrationIds"`
 }
 
 type AddIntegrationToWorkspaceInput struct {
@@ -55,22 +57,38 @@ type ApproveRequestInput struct {
 	RequestID ID `json:"requestId"`
 }
 
+type ArchiveExtractionFailure struct {
+	Path  string `json:"path"`
+	Error string `json:"error"`
+}
+
+type ArchiveExtractionProgress struct {
+	Files      int   `json:"files"`
+	TotalFiles int   `json:"totalFiles"`
+	Failed     int   `json:"failed"`
+	Bytes      int64 `json:"bytes"`
+	TotalBytes int64 `json:"totalBytes"`
+}
+
 type Asset struct {
-	ID                      ID                       `json:"id"`
-	Project                 *Project                 `json:"project"`
-	ProjectID               ID                       `json:"projectId"`
-	CreatedAt               time.Time                `json:"createdAt"`
-	CreatedBy               Operator                 `json:"createdBy"`
-	CreatedByType           OperatorType             `json:"createdByType"`
-	CreatedByID             ID                       `json:"createdById"`
-	Items                   []*AssetItem             `json:"items"`
-	Size                    int64                    `json:"size"`
-	PreviewType             *PreviewType             `json:"previewType"`
-	UUID                    string                   `json:"uuid"`
-	Thread                  *Thread                  `json:"thread"`
-	ThreadID                ID                       `json:"threadId"`
-	URL                     string                   `json:"url"`
-	ArchiveExtractionStatus *ArchiveExtractionStatus `json:"archiveExtractionStatus"`
+	ID                        ID                          `json:"id"`
+	Project                   *Project                    `json:"project"`
+	ProjectID                 ID                          `json:"projectId"`
+	CreatedAt                 time.Time                   `json:"createdAt"`
+	CreatedBy                 Operator                    `json:"createdBy"`
+	CreatedByType             OperatorType                `json:"createdByType"`
+	CreatedByID               ID                          `json:"createdById"`
+	Items                     []*AssetItem                `json:"items"`
+	Size                      int64                       `json:"size"`
+	PreviewType               *PreviewType                `json:"previewType"`
+	UUID                      string                      `json:"uuid"`
+	Thread                    *Thread                     `json:"thread"`
+	ThreadID                  ID                          `json:"threadId"`
+	URL                       string                      `json:"url"`
+	ArchiveExtractionStatus   *ArchiveExtractionStatus    `json:"archiveExtractionStatus"`
+	ArchiveExtractionProgress *ArchiveExtractionProgress  `json:"archiveExtractionProgress"`
+	ArchiveExtractionFailures []*ArchiveExtractionFailure `json:"archiveExtractionFailures"`
+	CompressionStatus         *ArchiveExtractionStatus    `json:"compressionStatus"`
 }
 
 func (Asset) IsNode()        {}
@@ -106,15 +124,68 @@ type AssetSort struct {
 	Direction *SortDirection `json:"direction"`
 }
 
+type AssignCustomRoleInput struct {
+	WorkspaceID   ID  `json:"workspaceId"`
+	UserID        *ID `json:"userId"`
+	IntegrationID *ID `json:"integrationId"`
+	RoleID        *ID `json:"roleId"`
+}
+
+type AuditLog struct {
+	ID            ID              `json:"id"`
+	Type          string          `json:"type"`
+	WorkspaceID   *ID             `json:"workspaceId"`
+	ProjectID     *ID             `json:"projectId"`
+	ModelID       *ID             `json:"modelId"`
+	ItemID        *ID             `json:"itemId"`
+	UserID        *ID             `json:"userId"`
+	IntegrationID *ID             `json:"integrationId"`
+	Machine       bool            `json:"machine"`
+	Member        *AuditLogMember `json:"member"`
+	CreatedAt     time.Time       `json:"createdAt"`
+}
+
+type AuditLogConnection struct {
+	Edges      []*AuditLogEdge `json:"edges"`
+	Nodes      []*AuditLog     `json:"nodes"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+type AuditLogEdge struct {
+	Cursor usecasex.Cursor `json:"cursor"`
+	Node   *AuditLog       `json:"node"`
+}
+
+type AuditLogFilter struct {
+	WorkspaceID   *ID        `json:"workspaceId"`
+	ProjectID     *ID        `json:"projectId"`
+	ModelID       *ID        `json:"modelId"`
+	ItemID        *ID        `json:"itemId"`
+	UserID        *ID        `json:"userId"`
+	IntegrationID *ID        `json:"integrationId"`
+	Types         []string   `json:"types"`
+	From          *time.Time `json:"from"`
+	To            *time.Time `json:"to"`
+}
+
+type AuditLogMember struct {
+	UserID        *ID  `json:"userId"`
+	IntegrationID *ID  `json:"integrationId"`
+	Role          Role `json:"role"`
+}
+
 type Comment struct {
-	ID          ID           `json:"id"`
-	ThreadID    ID           `json:"threadId"`
-	WorkspaceID ID           `json:"workspaceId"`
-	Author      Operator     `json:"author"`
-	AuthorType  OperatorType `json:"authorType"`
-	AuthorID    ID           `json:"authorId"`
-	Content     string       `json:"content"`
-	CreatedAt   time.Time    `json:"createdAt"`
+	ID                      ID           `json:"id"`
+	ThreadID                ID           `json:"threadId"`
+	WorkspaceID             ID           `json:"workspaceId"`
+	Author                  Operator     `json:"author"`
+	AuthorType              OperatorType `json:"authorType"`
+	AuthorID                ID           `json:"authorId"`
+	Content                 string       `json:"content"`
+	MentionedUserIds        []ID         `json:"mentionedUserIds"`
+	MentionedIntegrationIds []ID         `json:"mentionedIntegrationIds"`
+	CreatedAt               time.Time    `json:"createdAt"`
 }
 
 type CommentPayload struct {
@@ -133,16 +204,26 @@ type CreateAssetPayload struct {
 	Asset *Asset `json:"asset"`
 }
 
+type CreateCustomRoleInput struct {
+	WorkspaceID ID                           `json:"workspaceId"`
+	Name        string                       `json:"name"`
+	Permissions []*CustomRolePermissionInput `json:"permissions"`
+}
+
 type CreateFieldInput struct {
-	ModelID      ID                            `json:"modelId"`
-	Type         SchemaFieldType               `json:"type"`
-	Title        string                        `json:"title"`
-	Description  *string                       `json:"description"`
-	Key          string                        `json:"key"`
-	Multiple     bool                          `json:"multiple"`
-	Unique       bool                          `json:"unique"`
-	Required     bool                          `json:"required"`
-	TypeProperty *SchemaFieldTypePropertyInput `json:"typeProperty"`
+	ModelID      ID                                 `json:"modelId"`
+	Type         SchemaFieldType                    `json:"type"`
+	Title        string                             `json:"title"`
+	Description  *string                            `json:"description"`
+	Key          string                             `json:"key"`
+	Multiple     bool                               `json:"multiple"`
+	Unique       bool                               `json:"unique"`
+	Required     bool                               `json:"required"`
+	Localized    *bool                              `json:"localized"`
+	MinItems     *int                               `json:"minItems"`
+	MaxItems     *int                               `json:"maxItems"`
+	RequiredIf   *SchemaFieldRequiredConditionInput `json:"requiredIf"`
+	TypeProperty *SchemaFieldTypePropertyInput      `json:"typeProperty"`
 }
 
 type CreateIntegrationInput struct {
@@ -156,6 +237,7 @@ type CreateItemInput struct {
 	SchemaID ID                `json:"schemaId"`
 	ModelID  ID                `json:"modelId"`
 	Fields   []*ItemFieldInput `json:"fields"`
+	Group    *string           `json:"group"`
 }
 
 type CreateModelInput struct {
@@ -165,6 +247,13 @@ type CreateModelInput struct {
 	Key         *string `json:"key"`
 }
 
+type CreateProjectAPIKeyInput struct {
+	ProjectID ID     `json:"projectId"`
+	Name      string `json:"name"`
+	ModelIds  []ID   `json:"modelIds"`
+	RateLimit *int   `json:"rateLimit"`
+}
+
 type CreateProjectInput struct {
 	WorkspaceID ID      `json:"workspaceId"`
 	Name        *string `json:"name"`
@@ -179,6 +268,8 @@ type CreateRequestInput struct {
 	State       *RequestState       `json:"state"`
 	ReviewersID []ID                `json:"reviewersId"`
 	Items       []*RequestItemInput `json:"items"`
+	PublishAt   *time.Time          `json:"publishAt"`
+	UnpublishAt *time.Time          `json:"unpublishAt"`
 }
 
 type CreateThreadInput struct {
@@ -202,6 +293,28 @@ type CreateWorkspacePayload struct {
 	Workspace *Workspace `json:"workspace"`
 }
 
+type CustomRole struct {
+	ID          ID                      `json:"id"`
+	Name        string                  `json:"name"`
+	Permissions []*CustomRolePermission `json:"permissions"`
+}
+
+type CustomRolePayload struct {
+	Workspace *Workspace `json:"workspace"`
+}
+
+type CustomRolePermission struct {
+	ProjectID *ID          `json:"projectId"`
+	ModelID   *ID          `json:"modelId"`
+	Actions   []RoleAction `json:"actions"`
+}
+
+type CustomRolePermissionInput struct {
+	ProjectID *ID          `json:"projectId"`
+	ModelID   *ID          `json:"modelId"`
+	Actions   []RoleAction `json:"actions"`
+}
+
 type DecompressAssetInput struct {
 	AssetID ID `json:"assetId"`
 }
@@ -228,6 +341,11 @@ type DeleteCommentPayload struct {
 	CommentID ID      `json:"commentId"`
 }
 
+type DeleteCustomRoleInput struct {
+	WorkspaceID ID `json:"workspaceId"`
+	RoleID      ID `json:"roleId"`
+}
+
 type DeleteFieldInput struct {
 	ModelID ID `json:"modelId"`
 	FieldID ID `json:"fieldId"`
@@ -269,6 +387,16 @@ type DeleteModelPayload struct {
 	ModelID ID `json:"modelId"`
 }
 
+type DeleteProjectAPIKeyInput struct {
+	ProjectID ID `json:"projectId"`
+	KeyID     ID `json:"keyId"`
+}
+
+type DeleteProjectAPIKeyPayload struct {
+	Project *Project `json:"project"`
+	KeyID   ID       `json:"keyId"`
+}
+
 type DeleteProjectInput struct {
 	ProjectID ID `json:"projectId"`
 }
@@ -303,6 +431,36 @@ type DeleteWorkspacePayload struct {
 	WorkspaceID ID `json:"workspaceId"`
 }
 
+type ExportFilter struct {
+	Key      string `json:"key"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+type ExportFilterInput struct {
+	Key      string `json:"key"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+type ExportItemsConfig struct {
+	ModelID    ID              `json:"modelId"`
+	Format     ExportFormat    `json:"format"`
+	Keyword    *string         `json:"keyword"`
+	Filters    []*ExportFilter `json:"filters"`
+	Bbox       *string         `json:"bbox"`
+	Intersects *string         `json:"intersects"`
+}
+
+type ExportItemsInput struct {
+	ModelID    ID                   `json:"modelId"`
+	Format     ExportFormat         `json:"format"`
+	Keyword    *string              `json:"keyword"`
+	Filters    []*ExportFilterInput `json:"filters"`
+	Bbox       *string              `json:"bbox"`
+	Intersects *string              `json:"intersects"`
+}
+
 type FieldPayload struct {
 	Field *SchemaField `json:"field"`
 }
@@ -311,6 +469,46 @@ type FieldsPayload struct {
 	Fields []*SchemaField `json:"fields"`
 }
 
+type ImportFieldMapping struct {
+	Column   string `json:"column"`
+	FieldKey string `json:"fieldKey"`
+}
+
+type ImportFieldMappingInput struct {
+	Column   string `json:"column"`
+	FieldKey string `json:"fieldKey"`
+}
+
+type ImportItemsConfig struct {
+	ModelID  ID                    `json:"modelId"`
+	AssetID  ID                    `json:"assetId"`
+	Format   ImportFormat          `json:"format"`
+	Fields   []*ImportFieldMapping `json:"fields"`
+	KeyField *string               `json:"keyField"`
+	DryRun   bool                  `json:"dryRun"`
+}
+
+type ImportItemsInput struct {
+	ModelID  ID                         `json:"modelId"`
+	AssetID  ID                         `json:"assetId"`
+	Format   ImportFormat               `json:"format"`
+	Fields   []*ImportFieldMappingInput `json:"fields"`
+	KeyField *string                    `json:"keyField"`
+	DryRun   *bool                      `json:"dryRun"`
+}
+
+type ImportModelsInput struct {
+	ProjectID        ID     `json:"projectId"`
+	Definition       string `json:"definition"`
+	DryRun           *bool  `json:"dryRun"`
+	AllowDestructive *bool  `json:"allowDestructive"`
+}
+
+type ImportModelsPayload struct {
+	Changes []*ModelDefinitionChange `json:"changes"`
+	Applied bool                     `json:"applied"`
+}
+
 type Integration struct {
 	ID          ID                 `json:"id"`
 	Name        string             `json:"name"`
@@ -357,6 +555,9 @@ type Item struct {
 	Assets        []*Asset     `json:"assets"`
 	CreatedAt     time.Time    `json:"createdAt"`
 	UpdatedAt     time.Time    `json:"updatedAt"`
+	PublishAt     *time.Time   `json:"publishAt"`
+	UnpublishAt   *time.Time   `json:"unpublishAt"`
+	Group         *string      `json:"group"`
 }
 
 func (Item) IsNode()        {}
@@ -375,15 +576,28 @@ type ItemEdge struct {
 }
 
 type ItemField struct {
-	SchemaFieldID ID              `json:"schemaFieldId"`
-	Type          SchemaFieldType `json:"type"`
-	Value         interface{}     `json:"value"`
+	SchemaFieldID   ID              `json:"schemaFieldId"`
+	Type            SchemaFieldType `json:"type"`
+	Value           interface{}     `json:"value"`
+	LocalizedValues interface{}     `json:"localizedValues"`
+}
+
+type ItemFieldDiff struct {
+	SchemaFieldID       ID              `json:"schemaFieldId"`
+	Type                SchemaFieldType `json:"type"`
+	OldValue            interface{}     `json:"oldValue"`
+	NewValue            interface{}     `json:"newValue"`
+	AddedAssetIds       []ID            `json:"addedAssetIds"`
+	RemovedAssetIds     []ID            `json:"removedAssetIds"`
+	AddedReferenceIds   []ID            `json:"addedReferenceIds"`
+	RemovedReferenceIds []ID            `json:"removedReferenceIds"`
 }
 
 type ItemFieldInput struct {
-	SchemaFieldID ID              `json:"schemaFieldId"`
-	Type          SchemaFieldType `json:"type"`
-	Value         interface{}     `json:"value"`
+	SchemaFieldID   ID              `json:"schemaFieldId"`
+	Type            SchemaFieldType `json:"type"`
+	Value           interface{}     `json:"value"`
+	LocalizedValues interface{}     `json:"localizedValues"`
 }
 
 type ItemPayload struct {
@@ -424,6 +638,39 @@ type MemberInput struct {
 	Role   Role `json:"role"`
 }
 
+type MigrateFieldsConfig struct {
+	ModelID        ID                 `json:"modelId"`
+	Type           FieldMigrationType `json:"type"`
+	SourceFieldIds []ID               `json:"sourceFieldIds"`
+	Targets        []*SchemaField     `json:"targets"`
+	Separator      *string            `json:"separator"`
+	DryRun         bool               `json:"dryRun"`
+}
+
+type MigrateFieldsInput struct {
+	ModelID        ID                           `json:"modelId"`
+	Type           FieldMigrationType           `json:"type"`
+	SourceFieldIds []ID                         `json:"sourceFieldIds"`
+	Targets        []*MigrationTargetFieldInput `json:"targets"`
+	Separator      *string                      `json:"separator"`
+	DryRun         *bool                        `json:"dryRun"`
+}
+
+type MigrationTargetFieldInput struct {
+	Type         SchemaFieldType                    `json:"type"`
+	Title        string                             `json:"title"`
+	Description  *string                            `json:"description"`
+	Key          string                             `json:"key"`
+	Multiple     bool                               `json:"multiple"`
+	Unique       bool                               `json:"unique"`
+	Required     bool                               `json:"required"`
+	Localized    *bool                              `json:"localized"`
+	MinItems     *int                               `json:"minItems"`
+	MaxItems     *int                               `json:"maxItems"`
+	RequiredIf   *SchemaFieldRequiredConditionInput `json:"requiredIf"`
+	TypeProperty *SchemaFieldTypePropertyInput      `json:"typeProperty"`
+}
+
 type Model struct {
 	ID          ID        `json:"id"`
 	ProjectID   ID        `json:"projectId"`
@@ -448,6 +695,13 @@ type ModelConnection struct {
 	TotalCount int          `json:"totalCount"`
 }
 
+type ModelDefinitionChange struct {
+	Type        ModelDefinitionChangeType `json:"type"`
+	ModelKey    string                    `json:"modelKey"`
+	FieldKey    *string                   `json:"fieldKey"`
+	Destructive bool                      `json:"destructive"`
+}
+
 type ModelEdge struct {
 	Cursor usecasex.Cursor `json:"cursor"`
 	Node   *Model          `json:"node"`
@@ -457,6 +711,16 @@ type ModelPayload struct {
 	Model *Model `json:"model"`
 }
 
+type ModelReviewers struct {
+	ModelID     ID   `json:"modelId"`
+	ReviewersID []ID `json:"reviewersId"`
+}
+
+type ModelReviewersInput struct {
+	ModelID     ID   `json:"modelId"`
+	ReviewersID []ID `json:"reviewersId"`
+}
+
 type PageInfo struct {
 	StartCursor     *usecasex.Cursor `json:"startCursor"`
 	EndCursor       *usecasex.Cursor `json:"endCursor"`
@@ -473,20 +737,35 @@ type Pagination struct {
 }
 
 type Project struct {
-	ID          ID                  `json:"id"`
-	Name        string              `json:"name"`
-	Description string              `json:"description"`
-	Alias       string              `json:"alias"`
-	WorkspaceID ID                  `json:"workspaceId"`
-	Workspace   *Workspace          `json:"workspace"`
-	CreatedAt   time.Time           `json:"createdAt"`
-	UpdatedAt   time.Time           `json:"updatedAt"`
-	Publication *ProjectPublication `json:"publication"`
+	ID              ID                      `json:"id"`
+	Name            string                  `json:"name"`
+	Description     string                  `json:"description"`
+	Alias           string                  `json:"alias"`
+	WorkspaceID     ID                      `json:"workspaceId"`
+	Workspace       *Workspace              `json:"workspace"`
+	CreatedAt       time.Time               `json:"createdAt"`
+	UpdatedAt       time.Time               `json:"updatedAt"`
+	Publication     *ProjectPublication     `json:"publication"`
+	RequestPolicy   *ProjectRequestPolicy   `json:"requestPolicy"`
+	ItemGroupPolicy *ProjectItemGroupPolicy `json:"itemGroupPolicy"`
 }
 
 func (Project) IsNode()        {}
 func (this Project) GetID() ID { return this.ID }
 
+type ProjectAPIKey struct {
+	ID        ID     `json:"id"`
+	Name      string `json:"name"`
+	ModelIds  []ID   `json:"modelIds"`
+	RateLimit int    `json:"rateLimit"`
+}
+
+type ProjectAPIKeyPayload struct {
+	Project *Project       `json:"project"`
+	APIKey  *ProjectAPIKey `json:"apiKey"`
+	Key     *string        `json:"key"`
+}
+
 type ProjectAliasAvailability struct {
 	Alias     string `json:"alias"`
 	Available bool   `json:"available"`
@@ -504,6 +783,11 @@ type ProjectEdge struct {
 	Node   *Project        `json:"node"`
 }
 
+type ProjectItemGroupPolicy struct {
+	Field      *string `json:"field"`
+	Restricted bool    `json:"restricted"`
+}
+
 type ProjectPayload struct {
 	Project *Project `json:"project"`
 }
@@ -511,6 +795,13 @@ type ProjectPayload struct {
 type ProjectPublication struct {
 	Scope       ProjectPublicationScope `json:"scope"`
 	AssetPublic bool                    `json:"assetPublic"`
+	APIKeys     []*ProjectAPIKey        `json:"apiKeys"`
+}
+
+type ProjectRequestPolicy struct {
+	RequiredApprovals int               `json:"requiredApprovals"`
+	RequiredRoles     []Role            `json:"requiredRoles"`
+	ModelReviewers    []*ModelReviewers `json:"modelReviewers"`
 }
 
 type PublishModelInput struct {
@@ -523,6 +814,16 @@ type PublishModelPayload struct {
 	Status  bool `json:"status"`
 }
 
+type RedeliverWebhookInput struct {
+	IntegrationID ID `json:"integrationId"`
+	DeliveryID    ID `json:"deliveryId"`
+}
+
+type RegenerateProjectAPIKeyInput struct {
+	ProjectID ID `json:"projectId"`
+	KeyID     ID `json:"keyId"`
+}
+
 type RemoveIntegrationFromWorkspaceInput struct {
 	WorkspaceID   ID `json:"workspaceId"`
 	IntegrationID ID `json:"integrationId"`
@@ -542,30 +843,38 @@ type RemoveUserFromWorkspaceInput struct {
 }
 
 type Request struct {
-	ID          ID             `json:"id"`
-	Items       []*RequestItem `json:"items"`
-	Title       string         `json:"title"`
-	Description *string        `json:"description"`
-	CreatedByID ID             `json:"createdById"`
-	WorkspaceID ID             `json:"workspaceId"`
-	ProjectID   ID             `json:"projectId"`
-	ThreadID    ID             `json:"threadId"`
-	ReviewersID []ID           `json:"reviewersId"`
-	State       RequestState   `json:"state"`
-	CreatedAt   time.Time      `json:"createdAt"`
-	UpdatedAt   time.Time      `json:"updatedAt"`
-	ApprovedAt  *time.Time     `json:"approvedAt"`
-	ClosedAt    *time.Time     `json:"closedAt"`
-	Thread      *Thread        `json:"thread"`
-	CreatedBy   *User          `json:"createdBy"`
-	Workspace   *Workspace     `json:"workspace"`
-	Project     *Project       `json:"project"`
-	Reviewers   []*User        `json:"reviewers"`
+	ID           ID             `json:"id"`
+	Items        []*RequestItem `json:"items"`
+	Title        string         `json:"title"`
+	Description  *string        `json:"description"`
+	CreatedByID  ID             `json:"createdById"`
+	WorkspaceID  ID             `json:"workspaceId"`
+	ProjectID    ID             `json:"projectId"`
+	ThreadID     ID             `json:"threadId"`
+	ReviewersID  []ID           `json:"reviewersId"`
+	ApprovedByID []ID           `json:"approvedById"`
+	State        RequestState   `json:"state"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	UpdatedAt    time.Time      `json:"updatedAt"`
+	ApprovedAt   *time.Time     `json:"approvedAt"`
+	ClosedAt     *time.Time     `json:"closedAt"`
+	PublishAt    *time.Time     `json:"publishAt"`
+	UnpublishAt  *time.Time     `json:"unpublishAt"`
+	Thread       *Thread        `json:"thread"`
+	CreatedBy    *User          `json:"createdBy"`
+	Workspace    *Workspace     `json:"workspace"`
+	Project      *Project       `json:"project"`
+	Reviewers    []*User        `json:"reviewers"`
+	ApprovedBy   []*User        `json:"approvedBy"`
 }
 
 func (Request) IsNode()        {}
 func (this Request) GetID() ID { return this.ID }
 
+type RequestChangesInput struct {
+	RequestID ID `json:"requestId"`
+}
+
 type RequestConnection struct {
 	Edges      []*RequestEdge `json:"edges"`
 	Nodes      []*Request     `json:"nodes"`
@@ -593,6 +902,22 @@ type RequestPayload struct {
 	Request *Request `json:"request"`
 }
 
+type ResolveThreadInput struct {
+	ThreadID ID   `json:"threadId"`
+	Resolved bool `json:"resolved"`
+}
+
+type RollbackItemInput struct {
+	ItemID  ID     `json:"itemId"`
+	Version string `json:"version"`
+}
+
+type ScheduleItemInput struct {
+	ItemID      ID         `json:"itemId"`
+	PublishAt   *time.Time `json:"publishAt"`
+	UnpublishAt *time.Time `json:"unpublishAt"`
+}
+
 type Schema struct {
 	ID        ID             `json:"id"`
 	ProjectID ID             `json:"projectId"`
@@ -604,20 +929,24 @@ func (Schema) IsNode()        {}
 func (this Schema) GetID() ID { return this.ID }
 
 type SchemaField struct {
-	ID           ID                      `json:"id"`
-	ModelID      ID                      `json:"modelId"`
-	Model        *Model                  `json:"model"`
-	Type         SchemaFieldType         `json:"type"`
-	TypeProperty SchemaFieldTypeProperty `json:"typeProperty"`
-	Key          string                  `json:"key"`
-	Title        string                  `json:"title"`
-	Order        *int                    `json:"order"`
-	Description  *string                 `json:"description"`
-	Multiple     bool                    `json:"multiple"`
-	Unique       bool                    `json:"unique"`
-	Required     bool                    `json:"required"`
-	CreatedAt    time.Time               `json:"createdAt"`
-	UpdatedAt    time.Time               `json:"updatedAt"`
+	ID           ID                            `json:"id"`
+	ModelID      ID                            `json:"modelId"`
+	Model        *Model                        `json:"model"`
+	Type         SchemaFieldType               `json:"type"`
+	TypeProperty SchemaFieldTypeProperty       `json:"typeProperty"`
+	Key          string                        `json:"key"`
+	Title        string                        `json:"title"`
+	Order        *int                          `json:"order"`
+	Description  *string                       `json:"description"`
+	Multiple     bool                          `json:"multiple"`
+	Unique       bool                          `json:"unique"`
+	Required     bool                          `json:"required"`
+	Localized    bool                          `json:"localized"`
+	MinItems     *int                          `json:"minItems"`
+	MaxItems     *int                          `json:"maxItems"`
+	RequiredIf   *SchemaFieldRequiredCondition `json:"requiredIf"`
+	CreatedAt    time.Time                     `json:"createdAt"`
+	UpdatedAt    time.Time                     `json:"updatedAt"`
 }
 
 type SchemaFieldAsset struct {
@@ -642,12 +971,61 @@ type SchemaFieldBoolInput struct {
 
 type SchemaFieldDate struct {
 	DefaultValue interface{} `json:"defaultValue"`
+	Min          *time.Time  `json:"min"`
+	Max          *time.Time  `json:"max"`
 }
 
 func (SchemaFieldDate) IsSchemaFieldTypeProperty() {}
 
 type SchemaFieldDateInput struct {
 	DefaultValue interface{} `json:"defaultValue"`
+	Min          *time.Time  `json:"min"`
+	Max          *time.Time  `json:"max"`
+}
+
+type SchemaFieldGeometry struct {
+	DefaultValue   interface{}    `json:"defaultValue"`
+	SupportedTypes []GeometryType `json:"supportedTypes"`
+}
+
+func (SchemaFieldGeometry) IsSchemaFieldTypeProperty() {}
+
+type SchemaFieldGeometryInput struct {
+	DefaultValue   interface{}    `json:"defaultValue"`
+	SupportedTypes []GeometryType `json:"supportedTypes"`
+}
+
+type SchemaFieldGroup struct {
+	Fields []*SchemaFieldGroupField `json:"fields"`
+}
+
+func (SchemaFieldGroup) IsSchemaFieldTypeProperty() {}
+
+type SchemaFieldGroupField struct {
+	ID           ID                      `json:"id"`
+	Type         SchemaFieldType         `json:"type"`
+	TypeProperty SchemaFieldTypeProperty `json:"typeProperty"`
+	Key          string                  `json:"key"`
+	Title        string                  `json:"title"`
+	Order        *int                    `json:"order"`
+	Description  *string                 `json:"description"`
+	Multiple     bool                    `json:"multiple"`
+	Required     bool                    `json:"required"`
+}
+
+type SchemaFieldGroupFieldInput struct {
+	FieldID      *ID                           `json:"fieldId"`
+	Type         SchemaFieldType               `json:"type"`
+	Title        string                        `json:"title"`
+	Description  *string                       `json:"description"`
+	Key          string                        `json:"key"`
+	Multiple     bool                          `json:"multiple"`
+	Required     bool                          `json:"required"`
+	TypeProperty *SchemaFieldTypePropertyInput `json:"typeProperty"`
+}
+
+type SchemaFieldGroupInput struct {
+	Fields []*SchemaFieldGroupFieldInput `json:"fields"`
 }
 
 type SchemaFieldInteger struct {
@@ -667,23 +1045,37 @@ type SchemaFieldIntegerInput struct {
 type SchemaFieldMarkdown struct {
 	DefaultValue interface{} `json:"defaultValue"`
 	MaxLength    *int        `json:"maxLength"`
+	Pattern      *string     `json:"pattern"`
 }
 
 func (SchemaFieldMarkdown) IsSchemaFieldTypeProperty() {}
 
 type SchemaFieldReference struct {
-	ModelID ID `json:"modelId"`
+	ModelID  ID                `json:"modelId"`
+	OnDelete ReferenceOnDelete `json:"onDelete"`
 }
 
 func (SchemaFieldReference) IsSchemaFieldTypeProperty() {}
 
 type SchemaFieldReferenceInput struct {
-	ModelID ID `json:"modelId"`
+	ModelID  ID                 `json:"modelId"`
+	OnDelete *ReferenceOnDelete `json:"onDelete"`
+}
+
+type SchemaFieldRequiredCondition struct {
+	FieldID ID       `json:"fieldId"`
+	Values  []string `json:"values"`
+}
+
+type SchemaFieldRequiredConditionInput struct {
+	FieldID ID       `json:"fieldId"`
+	Values  []string `json:"values"`
 }
 
 type SchemaFieldRichText struct {
 	DefaultValue interface{} `json:"defaultValue"`
 	MaxLength    *int        `json:"maxLength"`
+	Pattern      *string     `json:"pattern"`
 }
 
 func (SchemaFieldRichText) IsSchemaFieldTypeProperty() {}
@@ -691,6 +1083,7 @@ func (SchemaFieldRichText) IsSchemaFieldTypeProperty() {}
 type SchemaFieldRichTextInput struct {
 	DefaultValue interface{} `json:"defaultValue"`
 	MaxLength    *int        `json:"maxLength"`
+	Pattern      *string     `json:"pattern"`
 }
 
 type SchemaFieldSelect struct {
@@ -720,6 +1113,7 @@ type SchemaFieldTagInput struct {
 type SchemaFieldText struct {
 	DefaultValue interface{} `json:"defaultValue"`
 	MaxLength    *int        `json:"maxLength"`
+	Pattern      *string     `json:"pattern"`
 }
 
 func (SchemaFieldText) IsSchemaFieldTypeProperty() {}
@@ -727,6 +1121,7 @@ func (SchemaFieldText) IsSchemaFieldTypeProperty() {}
 type SchemaFieldTextArea struct {
 	DefaultValue interface{} `json:"defaultValue"`
 	MaxLength    *int        `json:"maxLength"`
+	Pattern      *string     `json:"pattern"`
 }
 
 func (SchemaFieldTextArea) IsSchemaFieldTypeProperty() {}
@@ -734,11 +1129,13 @@ func (SchemaFieldTextArea) IsSchemaFieldTypeProperty() {}
 type SchemaFieldTextAreaInput struct {
 	DefaultValue interface{} `json:"defaultValue"`
 	MaxLength    *int        `json:"maxLength"`
+	Pattern      *string     `json:"pattern"`
 }
 
 type SchemaFieldTextInput struct {
 	DefaultValue interface{} `json:"defaultValue"`
 	MaxLength    *int        `json:"maxLength"`
+	Pattern      *string     `json:"pattern"`
 }
 
 type SchemaFieldTypePropertyInput struct {
@@ -754,21 +1151,26 @@ type SchemaFieldTypePropertyInput struct {
 	Integer      *SchemaFieldIntegerInput   `json:"integer"`
 	Reference    *SchemaFieldReferenceInput `json:"reference"`
 	URL          *SchemaFieldURLInput       `json:"url"`
+	Geometry     *SchemaFieldGeometryInput  `json:"geometry"`
+	Group        *SchemaFieldGroupInput     `json:"group"`
 }
 
 type SchemaFieldURL struct {
 	DefaultValue interface{} `json:"defaultValue"`
+	Pattern      *string     `json:"pattern"`
 }
 
 func (SchemaFieldURL) IsSchemaFieldTypeProperty() {}
 
 type SchemaFieldURLInput struct {
 	DefaultValue interface{} `json:"defaultValue"`
+	Pattern      *string     `json:"pattern"`
 }
 
 type SchemaMarkdownTextInput struct {
 	DefaultValue interface{} `json:"defaultValue"`
 	MaxLength    *int        `json:"maxLength"`
+	Pattern      *string     `json:"pattern"`
 }
 
 type Sort struct {
@@ -776,11 +1178,62 @@ type Sort struct {
 	Reverted *bool  `json:"reverted"`
 }
 
+type Task struct {
+	ID            ID                   `json:"id"`
+	WorkspaceID   ID                   `json:"workspaceId"`
+	ProjectID     ID                   `json:"projectId"`
+	UserID        *ID                  `json:"userId"`
+	IntegrationID *ID                  `json:"integrationId"`
+	Type          TaskType             `json:"type"`
+	Status        TaskStatus           `json:"status"`
+	Progress      *TaskProgress        `json:"progress"`
+	Errors        []*TaskError         `json:"errors"`
+	Message       *string              `json:"message"`
+	ImportItems   *ImportItemsConfig   `json:"importItems"`
+	ExportItems   *ExportItemsConfig   `json:"exportItems"`
+	MigrateFields *MigrateFieldsConfig `json:"migrateFields"`
+	AssetID       *ID                  `json:"assetId"`
+	CreatedAt     time.Time            `json:"createdAt"`
+	UpdatedAt     time.Time            `json:"updatedAt"`
+}
+
+type TaskConnection struct {
+	Edges      []*TaskEdge `json:"edges"`
+	Nodes      []*Task     `json:"nodes"`
+	PageInfo   *PageInfo   `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
+type TaskEdge struct {
+	Cursor usecasex.Cursor `json:"cursor"`
+	Node   *Task           `json:"node"`
+}
+
+type TaskError struct {
+	Row     int     `json:"row"`
+	ItemID  *ID     `json:"itemId"`
+	Field   *string `json:"field"`
+	Message string  `json:"message"`
+}
+
+type TaskPayload struct {
+	Task *Task `json:"task"`
+}
+
+type TaskProgress struct {
+	Total     int `json:"total"`
+	Processed int `json:"processed"`
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Failed    int `json:"failed"`
+}
+
 type Thread struct {
 	ID          ID         `json:"id"`
 	Workspace   *Workspace `json:"workspace"`
 	WorkspaceID ID         `json:"workspaceId"`
 	Comments    []*Comment `json:"comments"`
+	Resolved    bool       `json:"resolved"`
 }
 
 type ThreadPayload struct {
@@ -810,17 +1263,28 @@ type UpdateCommentInput struct {
 	Content   string `json:"content"`
 }
 
+type UpdateCustomRoleInput struct {
+	WorkspaceID ID                           `json:"workspaceId"`
+	RoleID      ID                           `json:"roleId"`
+	Name        string                       `json:"name"`
+	Permissions []*CustomRolePermissionInput `json:"permissions"`
+}
+
 type UpdateFieldInput struct {
-	ModelID      ID                            `json:"modelId"`
-	FieldID      ID                            `json:"fieldId"`
-	Title        *string                       `json:"title"`
-	Description  *string                       `json:"description"`
-	Order        *int                          `json:"order"`
-	Key          *string                       `json:"key"`
-	Required     *bool                         `json:"required"`
-	Unique       *bool                         `json:"unique"`
-	Multiple     *bool                         `json:"multiple"`
-	TypeProperty *SchemaFieldTypePropertyInput `json:"typeProperty"`
+	ModelID      ID                                 `json:"modelId"`
+	FieldID      ID                                 `json:"fieldId"`
+	Title        *string                            `json:"title"`
+	Description  *string                            `json:"description"`
+	Order        *int                               `json:"order"`
+	Key          *string                            `json:"key"`
+	Required     *bool                              `json:"required"`
+	Unique       *bool                              `json:"unique"`
+	Multiple     *bool                              `json:"multiple"`
+	Localized    *bool                              `json:"localized"`
+	MinItems     *int                               `json:"minItems"`
+	MaxItems     *int                               `json:"maxItems"`
+	RequiredIf   *SchemaFieldRequiredConditionInput `json:"requiredIf"`
+	TypeProperty *SchemaFieldTypePropertyInput      `json:"typeProperty"`
 }
 
 type UpdateIntegrationInput struct {
@@ -839,6 +1303,7 @@ type UpdateIntegrationOfWorkspaceInput struct {
 type UpdateItemInput struct {
 	ItemID ID                `json:"itemId"`
 	Fields []*ItemFieldInput `json:"fields"`
+	Group  *string           `json:"group"`
 }
 
 type UpdateMeInput struct {
@@ -854,6 +1319,13 @@ type UpdateMePayload struct {
 	Me *Me `json:"me"`
 }
 
+type UpdateMemberItemGroupsInput struct {
+	WorkspaceID   ID       `json:"workspaceId"`
+	UserID        *ID      `json:"userId"`
+	IntegrationID *ID      `json:"integrationId"`
+	Groups        []string `json:"groups"`
+}
+
 type UpdateMemberOfWorkspacePayload struct {
 	Workspace *Workspace `json:"workspace"`
 }
@@ -866,12 +1338,27 @@ type UpdateModelInput struct {
 	Public      bool    `json:"public"`
 }
 
+type UpdateProjectAPIKeyInput struct {
+	ProjectID ID      `json:"projectId"`
+	KeyID     ID      `json:"keyId"`
+	Name      *string `json:"name"`
+	ModelIds  []ID    `json:"modelIds"`
+	RateLimit *int    `json:"rateLimit"`
+}
+
 type UpdateProjectInput struct {
-	ProjectID   ID                             `json:"projectId"`
-	Name        *string                        `json:"name"`
-	Description *string                        `json:"description"`
-	Alias       *string                        `json:"alias"`
-	Publication *UpdateProjectPublicationInput `json:"publication"`
+	ProjectID       ID                                 `json:"projectId"`
+	Name            *string                            `json:"name"`
+	Description     *string                            `json:"description"`
+	Alias           *string                            `json:"alias"`
+	Publication     *UpdateProjectPublicationInput     `json:"publication"`
+	RequestPolicy   *UpdateProjectRequestPolicyInput   `json:"requestPolicy"`
+	ItemGroupPolicy *UpdateProjectItemGroupPolicyInput `json:"itemGroupPolicy"`
+}
+
+type UpdateProjectItemGroupPolicyInput struct {
+	Field      *string `json:"field"`
+	Restricted *bool   `json:"restricted"`
 }
 
 type UpdateProjectPublicationInput struct {
@@ -879,6 +1366,12 @@ type UpdateProjectPublicationInput struct {
 	AssetPublic *bool                    `json:"assetPublic"`
 }
 
+type UpdateProjectRequestPolicyInput struct {
+	RequiredApprovals *int                   `json:"requiredApprovals"`
+	RequiredRoles     []Role                 `json:"requiredRoles"`
+	ModelReviewers    []*ModelReviewersInput `json:"modelReviewers"`
+}
+
 type UpdateRequestInput struct {
 	RequestID   ID                  `json:"requestId"`
 	Title       *string             `json:"title"`
@@ -886,6 +1379,8 @@ type UpdateRequestInput struct {
 	State       *RequestState       `json:"state"`
 	ReviewersID []ID                `json:"reviewersId"`
 	Items       []*RequestItemInput `json:"items"`
+	PublishAt   *time.Time          `json:"publishAt"`
+	UnpublishAt *time.Time          `json:"unpublishAt"`
 }
 
 type UpdateUserOfWorkspaceInput struct {
@@ -942,6 +1437,44 @@ type Webhook struct {
 	UpdatedAt time.Time       `json:"updatedAt"`
 }
 
+type WebhookDelivery struct {
+	ID            ID                        `json:"id"`
+	IntegrationID ID                        `json:"integrationId"`
+	WebhookID     ID                        `json:"webhookId"`
+	EventID       ID                        `json:"eventId"`
+	EventType     string                    `json:"eventType"`
+	URL           string                    `json:"url"`
+	Payload       string                    `json:"payload"`
+	Status        WebhookDeliveryStatus     `json:"status"`
+	Attempts      []*WebhookDeliveryAttempt `json:"attempts"`
+	NextAttemptAt *time.Time                `json:"nextAttemptAt"`
+	CreatedAt     time.Time                 `json:"createdAt"`
+	UpdatedAt     time.Time                 `json:"updatedAt"`
+}
+
+type WebhookDeliveryAttempt struct {
+	At         time.Time `json:"at"`
+	StatusCode *int      `json:"statusCode"`
+	Latency    int       `json:"latency"`
+	Error      *string   `json:"error"`
+}
+
+type WebhookDeliveryConnection struct {
+	Edges      []*WebhookDeliveryEdge `json:"edges"`
+	Nodes      []*WebhookDelivery     `json:"nodes"`
+	PageInfo   *PageInfo              `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+type WebhookDeliveryEdge struct {
+	Cursor usecasex.Cursor  `json:"cursor"`
+	Node   *WebhookDelivery `json:"node"`
+}
+
+type WebhookDeliveryPayload struct {
+	Delivery *WebhookDelivery `json:"delivery"`
+}
+
 type WebhookPayload struct {
 	Webhook *Webhook `json:"webhook"`
 }
@@ -955,6 +1488,12 @@ type WebhookTrigger struct {
 	OnAssetUpload     *bool `json:"onAssetUpload"`
 	OnAssetDecompress *bool `json:"onAssetDecompress"`
 	OnAssetDelete     *bool `json:"onAssetDelete"`
+	OnRequestSubmit   *bool `json:"onRequestSubmit"`
+	OnRequestReview   *bool `json:"onRequestReview"`
+	OnRequestApprove  *bool `json:"onRequestApprove"`
+	OnRequestBlock    *bool `json:"onRequestBlock"`
+	OnRequestClose    *bool `json:"onRequestClose"`
+	OnCommentCreate   *bool `json:"onCommentCreate"`
 }
 
 type WebhookTriggerInput struct {
@@ -966,13 +1505,20 @@ type WebhookTriggerInput struct {
 	OnAssetUpload     *bool `json:"onAssetUpload"`
 	OnAssetDecompress *bool `json:"onAssetDecompress"`
 	OnAssetDelete     *bool `json:"onAssetDelete"`
+	OnRequestSubmit   *bool `json:"onRequestSubmit"`
+	OnRequestReview   *bool `json:"onRequestReview"`
+	OnRequestApprove  *bool `json:"onRequestApprove"`
+	OnRequestBlock    *bool `json:"onRequestBlock"`
+	OnRequestClose    *bool `json:"onRequestClose"`
+	OnCommentCreate   *bool `json:"onCommentCreate"`
 }
 
 type Workspace struct {
-	ID       ID                `json:"id"`
-	Name     string            `json:"name"`
-	Members  []WorkspaceMember `json:"members"`
-	Personal bool              `json:"personal"`
+	ID          ID                `json:"id"`
+	Name        string            `json:"name"`
+	Members     []WorkspaceMember `json:"members"`
+	CustomRoles []*CustomRole     `json:"customRoles"`
+	Personal    bool              `json:"personal"`
 }
 
 func (Workspace) IsNode()        {}
@@ -981,6 +1527,8 @@ func (this Workspace) GetID() ID { return this.ID }
 type WorkspaceIntegrationMember struct {
 	IntegrationID ID           `json:"integrationId"`
 	Role          Role         `json:"role"`
+	CustomRoleID  *ID          `json:"customRoleId"`
+	ItemGroups    []string     `json:"itemGroups"`
 	Active        bool         `json:"active"`
 	InvitedByID   ID           `json:"invitedById"`
 	InvitedBy     *User        `json:"invitedBy"`
@@ -990,9 +1538,11 @@ type WorkspaceIntegrationMember struct {
 func (WorkspaceIntegrationMember) IsWorkspaceMember() {}
 
 type WorkspaceUserMember struct {
-	UserID ID    `json:"userId"`
-	Role   Role  `json:"role"`
-	User   *User `json:"user"`
+	UserID       ID       `json:"userId"`
+	Role         Role     `json:"role"`
+	CustomRoleID *ID      `json:"customRoleId"`
+	ItemGroups   []string `json:"itemGroups"`
+	User         *User    `json:"user"`
 }
 
 func (WorkspaceUserMember) IsWorkspaceMember() {}
@@ -1087,6 +1637,186 @@ func (e AssetSortType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ExportFormat string
+
+const (
+	ExportFormatCSV     ExportFormat = "CSV"
+	ExportFormatJSONL   ExportFormat = "JSONL"
+	ExportFormatGeojson ExportFormat = "GEOJSON"
+	ExportFormatXlsx    ExportFormat = "XLSX"
+)
+
+var AllExportFormat = []ExportFormat{
+	ExportFormatCSV,
+	ExportFormatJSONL,
+	ExportFormatGeojson,
+	ExportFormatXlsx,
+}
+
+func (e ExportFormat) IsValid() bool {
+	switch e {
+	case ExportFormatCSV, ExportFormatJSONL, ExportFormatGeojson, ExportFormatXlsx:
+		return true
+	}
+	return false
+}
+
+func (e ExportFormat) String() string {
+	return string(e)
+}
+
+func (e *ExportFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExportFormat", str)
+	}
+	return nil
+}
+
+func (e ExportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FieldMigrationType string
+
+const (
+	FieldMigrationTypeConvert FieldMigrationType = "CONVERT"
+	FieldMigrationTypeSplit   FieldMigrationType = "SPLIT"
+	FieldMigrationTypeMerge   FieldMigrationType = "MERGE"
+)
+
+var AllFieldMigrationType = []FieldMigrationType{
+	FieldMigrationTypeConvert,
+	FieldMigrationTypeSplit,
+	FieldMigrationTypeMerge,
+}
+
+func (e FieldMigrationType) IsValid() bool {
+	switch e {
+	case FieldMigrationTypeConvert, FieldMigrationTypeSplit, FieldMigrationTypeMerge:
+		return true
+	}
+	return false
+}
+
+func (e FieldMigrationType) String() string {
+	return string(e)
+}
+
+func (e *FieldMigrationType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FieldMigrationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FieldMigrationType", str)
+	}
+	return nil
+}
+
+func (e FieldMigrationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type GeometryType string
+
+const (
+	GeometryTypePoint           GeometryType = "Point"
+	GeometryTypeMultiPoint      GeometryType = "MultiPoint"
+	GeometryTypeLineString      GeometryType = "LineString"
+	GeometryTypeMultiLineString GeometryType = "MultiLineString"
+	GeometryTypePolygon         GeometryType = "Polygon"
+	GeometryTypeMultiPolygon    GeometryType = "MultiPolygon"
+)
+
+var AllGeometryType = []GeometryType{
+	GeometryTypePoint,
+	GeometryTypeMultiPoint,
+	GeometryTypeLineString,
+	GeometryTypeMultiLineString,
+	GeometryTypePolygon,
+	GeometryTypeMultiPolygon,
+}
+
+func (e GeometryType) IsValid() bool {
+	switch e {
+	case GeometryTypePoint, GeometryTypeMultiPoint, GeometryTypeLineString, GeometryTypeMultiLineString, GeometryTypePolygon, GeometryTypeMultiPolygon:
+		return true
+	}
+	return false
+}
+
+func (e GeometryType) String() string {
+	return string(e)
+}
+
+func (e *GeometryType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GeometryType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GeometryType", str)
+	}
+	return nil
+}
+
+func (e GeometryType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ImportFormat string
+
+const (
+	ImportFormatCSV     ImportFormat = "CSV"
+	ImportFormatJSON    ImportFormat = "JSON"
+	ImportFormatGeojson ImportFormat = "GEOJSON"
+)
+
+var AllImportFormat = []ImportFormat{
+	ImportFormatCSV,
+	ImportFormatJSON,
+	ImportFormatGeojson,
+}
+
+func (e ImportFormat) IsValid() bool {
+	switch e {
+	case ImportFormatCSV, ImportFormatJSON, ImportFormatGeojson:
+		return true
+	}
+	return false
+}
+
+func (e ImportFormat) String() string {
+	return string(e)
+}
+
+func (e *ImportFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ImportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ImportFormat", str)
+	}
+	return nil
+}
+
+func (e ImportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type IntegrationType string
 
 const (
@@ -1216,6 +1946,90 @@ func (e ItemStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ModelDefinitionChangeType string
+
+const (
+	ModelDefinitionChangeTypeAdd    ModelDefinitionChangeType = "ADD"
+	ModelDefinitionChangeTypeUpdate ModelDefinitionChangeType = "UPDATE"
+	ModelDefinitionChangeTypeRemove ModelDefinitionChangeType = "REMOVE"
+)
+
+var AllModelDefinitionChangeType = []ModelDefinitionChangeType{
+	ModelDefinitionChangeTypeAdd,
+	ModelDefinitionChangeTypeUpdate,
+	ModelDefinitionChangeTypeRemove,
+}
+
+func (e ModelDefinitionChangeType) IsValid() bool {
+	switch e {
+	case ModelDefinitionChangeTypeAdd, ModelDefinitionChangeTypeUpdate, ModelDefinitionChangeTypeRemove:
+		return true
+	}
+	return false
+}
+
+func (e ModelDefinitionChangeType) String() string {
+	return string(e)
+}
+
+func (e *ModelDefinitionChangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModelDefinitionChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModelDefinitionChangeType", str)
+	}
+	return nil
+}
+
+func (e ModelDefinitionChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ModelDefinitionFormat string
+
+const (
+	ModelDefinitionFormatJSON ModelDefinitionFormat = "JSON"
+	ModelDefinitionFormatYaml ModelDefinitionFormat = "YAML"
+)
+
+var AllModelDefinitionFormat = []ModelDefinitionFormat{
+	ModelDefinitionFormatJSON,
+	ModelDefinitionFormatYaml,
+}
+
+func (e ModelDefinitionFormat) IsValid() bool {
+	switch e {
+	case ModelDefinitionFormatJSON, ModelDefinitionFormatYaml:
+		return true
+	}
+	return false
+}
+
+func (e ModelDefinitionFormat) String() string {
+	return string(e)
+}
+
+func (e *ModelDefinitionFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModelDefinitionFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModelDefinitionFormat", str)
+	}
+	return nil
+}
+
+func (e ModelDefinitionFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type NodeType string
 
 const (
@@ -1406,11 +2220,55 @@ func (e ProjectPublicationScope) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ReferenceOnDelete string
+
+const (
+	ReferenceOnDeleteNullify  ReferenceOnDelete = "NULLIFY"
+	ReferenceOnDeleteRestrict ReferenceOnDelete = "RESTRICT"
+	ReferenceOnDeleteCascade  ReferenceOnDelete = "CASCADE"
+)
+
+var AllReferenceOnDelete = []ReferenceOnDelete{
+	ReferenceOnDeleteNullify,
+	ReferenceOnDeleteRestrict,
+	ReferenceOnDeleteCascade,
+}
+
+func (e ReferenceOnDelete) IsValid() bool {
+	switch e {
+	case ReferenceOnDeleteNullify, ReferenceOnDeleteRestrict, ReferenceOnDeleteCascade:
+		return true
+	}
+	return false
+}
+
+func (e ReferenceOnDelete) String() string {
+	return string(e)
+}
+
+func (e *ReferenceOnDelete) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReferenceOnDelete(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReferenceOnDelete", str)
+	}
+	return nil
+}
+
+func (e ReferenceOnDelete) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type RequestState string
 
 const (
 	RequestStateDraft    RequestState = "DRAFT"
 	RequestStateWaiting  RequestState = "WAITING"
+	RequestStateBlocked  RequestState = "BLOCKED"
 	RequestStateClosed   RequestState = "CLOSED"
 	RequestStateApproved RequestState = "APPROVED"
 )
@@ -1418,13 +2276,14 @@ const (
 var AllRequestState = []RequestState{
 	RequestStateDraft,
 	RequestStateWaiting,
+	RequestStateBlocked,
 	RequestStateClosed,
 	RequestStateApproved,
 }
 
 func (e RequestState) IsValid() bool {
 	switch e {
-	case RequestStateDraft, RequestStateWaiting, RequestStateClosed, RequestStateApproved:
+	case RequestStateDraft, RequestStateWaiting, RequestStateBlocked, RequestStateClosed, RequestStateApproved:
 		return true
 	}
 	return false
@@ -1496,6 +2355,55 @@ func (e Role) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type RoleAction string
+
+const (
+	RoleActionRead    RoleAction = "READ"
+	RoleActionCreate  RoleAction = "CREATE"
+	RoleActionUpdate  RoleAction = "UPDATE"
+	RoleActionDelete  RoleAction = "DELETE"
+	RoleActionPublish RoleAction = "PUBLISH"
+	RoleActionApprove RoleAction = "APPROVE"
+)
+
+var AllRoleAction = []RoleAction{
+	RoleActionRead,
+	RoleActionCreate,
+	RoleActionUpdate,
+	RoleActionDelete,
+	RoleActionPublish,
+	RoleActionApprove,
+}
+
+func (e RoleAction) IsValid() bool {
+	switch e {
+	case RoleActionRead, RoleActionCreate, RoleActionUpdate, RoleActionDelete, RoleActionPublish, RoleActionApprove:
+		return true
+	}
+	return false
+}
+
+func (e RoleAction) String() string {
+	return string(e)
+}
+
+func (e *RoleAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RoleAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RoleAction", str)
+	}
+	return nil
+}
+
+func (e RoleAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SchemaFieldType string
 
 const (
@@ -1511,6 +2419,8 @@ const (
 	SchemaFieldTypeInteger      SchemaFieldType = "Integer"
 	SchemaFieldTypeReference    SchemaFieldType = "Reference"
 	SchemaFieldTypeURL          SchemaFieldType = "URL"
+	SchemaFieldTypeGeometry     SchemaFieldType = "Geometry"
+	SchemaFieldTypeGroup        SchemaFieldType = "Group"
 )
 
 var AllSchemaFieldType = []SchemaFieldType{
@@ -1526,11 +2436,13 @@ var AllSchemaFieldType = []SchemaFieldType{
 	SchemaFieldTypeInteger,
 	SchemaFieldTypeReference,
 	SchemaFieldTypeURL,
+	SchemaFieldTypeGeometry,
+	SchemaFieldTypeGroup,
 }
 
 func (e SchemaFieldType) IsValid() bool {
 	switch e {
-	case SchemaFieldTypeText, SchemaFieldTypeTextArea, SchemaFieldTypeRichText, SchemaFieldTypeMarkdownText, SchemaFieldTypeAsset, SchemaFieldTypeDate, SchemaFieldTypeBool, SchemaFieldTypeSelect, SchemaFieldTypeTag, SchemaFieldTypeInteger, SchemaFieldTypeReference, SchemaFieldTypeURL:
+	case SchemaFieldTypeText, SchemaFieldTypeTextArea, SchemaFieldTypeRichText, SchemaFieldTypeMarkdownText, SchemaFieldTypeAsset, SchemaFieldTypeDate, SchemaFieldTypeBool, SchemaFieldTypeSelect, SchemaFieldTypeTag, SchemaFieldTypeInteger, SchemaFieldTypeReference, SchemaFieldTypeURL, SchemaFieldTypeGeometry, SchemaFieldTypeGroup:
 		return true
 	}
 	return false
@@ -1598,6 +2510,94 @@ func (e SortDirection) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type TaskStatus string
+
+const (
+	TaskStatusPending   TaskStatus = "PENDING"
+	TaskStatusRunning   TaskStatus = "RUNNING"
+	TaskStatusCompleted TaskStatus = "COMPLETED"
+	TaskStatusFailed    TaskStatus = "FAILED"
+)
+
+var AllTaskStatus = []TaskStatus{
+	TaskStatusPending,
+	TaskStatusRunning,
+	TaskStatusCompleted,
+	TaskStatusFailed,
+}
+
+func (e TaskStatus) IsValid() bool {
+	switch e {
+	case TaskStatusPending, TaskStatusRunning, TaskStatusCompleted, TaskStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e TaskStatus) String() string {
+	return string(e)
+}
+
+func (e *TaskStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TaskStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TaskStatus", str)
+	}
+	return nil
+}
+
+func (e TaskStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TaskType string
+
+const (
+	TaskTypeImportItems   TaskType = "IMPORT_ITEMS"
+	TaskTypeExportItems   TaskType = "EXPORT_ITEMS"
+	TaskTypeMigrateFields TaskType = "MIGRATE_FIELDS"
+)
+
+var AllTaskType = []TaskType{
+	TaskTypeImportItems,
+	TaskTypeExportItems,
+	TaskTypeMigrateFields,
+}
+
+func (e TaskType) IsValid() bool {
+	switch e {
+	case TaskTypeImportItems, TaskTypeExportItems, TaskTypeMigrateFields:
+		return true
+	}
+	return false
+}
+
+func (e TaskType) String() string {
+	return string(e)
+}
+
+func (e *TaskType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TaskType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TaskType", str)
+	}
+	return nil
+}
+
+func (e TaskType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Theme string
 
 const (
@@ -1640,3 +2640,48 @@ func (e *Theme) UnmarshalGQL(v interface{}) error {
 func (e Theme) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusRetrying  WebhookDeliveryStatus = "RETRYING"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "DEAD"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSucceeded,
+	WebhookDeliveryStatusRetrying,
+	WebhookDeliveryStatusDead,
+}
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusRetrying, WebhookDeliveryStatusDead:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
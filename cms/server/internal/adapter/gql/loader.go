@@ -26,6 +26,8 @@ type Loaders struct {
 	Schema      *SchemaLoader
 	Thread      *ThreadLoader
 	Integration *IntegrationLoader
+	Task        *TaskLoader
+	AuditLog    *AuditLogLoader
 }
 
 type DataLoaders struct {
@@ -61,6 +63,8 @@ func NewLoaders(usecases *interfaces.Container) *Loaders {
 		Item:        NewItemLoader(usecases.Item, usecases.Schema),
 		ItemStatus:  NewItemStatusLoader(usecases.Item),
 		Thread:      NewThreadLoader(usecases.Thread),
+		Task:        NewTaskLoader(usecases.Task),
+		AuditLog:    NewAuditLogLoader(usecases.AuditLog),
 	}
 }
 
@@ -31,7 +31,7 @@ func (c *AssetLoader) FindByID(ctx context.Context, assetId gqlmodel.ID) (*gqlmo
 		return nil, err
 	}
 
-	return gqlmodel.ToAsset(a, c.usecase.GetURL), nil
+	return gqlmodel.ToAsset(a, c.usecase.URLResolver(ctx, asset.List{a}, getOperator(ctx))), nil
 }
 
 func (c *AssetLoader) FindByIDs(ctx context.Context, ids []gqlmodel.ID) ([]*gqlmodel.Asset, []error) {
@@ -45,8 +45,9 @@ func (c *AssetLoader) FindByIDs(ctx context.Context, ids []gqlmodel.ID) ([]*gqlm
 		return nil, []error{err}
 	}
 
+	urlResolver := c.usecase.URLResolver(ctx, res, getOperator(ctx))
 	return util.Map(res, func(a *asset.Asset) *gqlmodel.Asset {
-		return gqlmodel.ToAsset(a, c.usecase.GetURL)
+		return gqlmodel.ToAsset(a, urlResolver)
 	}), nil
 }
 
@@ -67,10 +68,11 @@ func (c *AssetLoader) FindByProject(ctx context.Context, projectId gqlmodel.ID,
 		return nil, err
 	}
 
+	urlResolver := c.usecase.URLResolver(ctx, assets, getOperator(ctx))
 	edges := make([]*gqlmodel.AssetEdge, 0, len(assets))
 	nodes := make([]*gqlmodel.Asset, 0, len(assets))
 	for _, a := range assets {
-		asset := gqlmodel.ToAsset(a, c.usecase.GetURL)
+		asset := gqlmodel.ToAsset(a, urlResolver)
 		edges = append(edges, &gqlmodel.AssetEdge{
 			Node:   asset,
 			Cursor: usecasex.Cursor(asset.ID),
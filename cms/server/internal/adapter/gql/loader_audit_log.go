@@ -0,0 +1,60 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+)
+
+type AuditLogLoader struct {
+	usecase interfaces.AuditLog
+}
+
+func NewAuditLogLoader(usecase interfaces.AuditLog) *AuditLogLoader {
+	return &AuditLogLoader{usecase: usecase}
+}
+
+func (c *AuditLogLoader) Search(ctx context.Context, f gqlmodel.AuditLogFilter, p *gqlmodel.Pagination) (*gqlmodel.AuditLogConnection, error) {
+	events, pi, err := c.usecase.Search(ctx, interfaces.AuditLogFilter{
+		Workspace:   gqlmodel.ToIDRef[id.Workspace](f.WorkspaceID),
+		Project:     gqlmodel.ToIDRef[id.Project](f.ProjectID),
+		Model:       gqlmodel.ToIDRef[id.Model](f.ModelID),
+		Item:        gqlmodel.ToIDRef[id.Item](f.ItemID),
+		User:        gqlmodel.ToIDRef[id.User](f.UserID),
+		Integration: gqlmodel.ToIDRef[id.Integration](f.IntegrationID),
+		Types:       lo.Map(f.Types, func(t string, _ int) event.Type { return event.Type(t) }),
+		From:        f.From,
+		To:          f.To,
+	}, p.Into(), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gqlmodel.AuditLogEdge, 0, len(events))
+	nodes := make([]*gqlmodel.AuditLog, 0, len(events))
+	for _, e := range events {
+		l := gqlmodel.ToAuditLog(e)
+		edges = append(edges, &gqlmodel.AuditLogEdge{
+			Node:   l,
+			Cursor: usecasex.Cursor(l.ID),
+		})
+		nodes = append(nodes, l)
+	}
+
+	var totalCount int
+	if pi != nil {
+		totalCount = int(pi.TotalCount)
+	}
+
+	return &gqlmodel.AuditLogConnection{
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   gqlmodel.ToPageInfo(pi),
+		TotalCount: totalCount,
+	}, nil
+}
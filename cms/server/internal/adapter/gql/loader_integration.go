@@ -8,6 +8,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
@@ -55,6 +56,41 @@ func (c *IntegrationLoader) FindByMe(ctx context.Context) ([]*gqlmodel.Integrati
 	return integrations, nil
 }
 
+func (c *IntegrationLoader) FindWebhookDeliveries(ctx context.Context, integrationID, webhookID gqlmodel.ID, p *gqlmodel.Pagination) (*gqlmodel.WebhookDeliveryConnection, error) {
+	iId, wId, err := gqlmodel.ToID2[id.Integration, id.Webhook](integrationID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, pi, err := c.usecase.FindWebhookDeliveries(ctx, iId, wId, p.Into(), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gqlmodel.WebhookDeliveryEdge, 0, len(deliveries))
+	nodes := make([]*gqlmodel.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		delivery := gqlmodel.ToWebhookDelivery(d)
+		edges = append(edges, &gqlmodel.WebhookDeliveryEdge{
+			Node:   delivery,
+			Cursor: usecasex.Cursor(delivery.ID),
+		})
+		nodes = append(nodes, delivery)
+	}
+
+	var totalCount int
+	if pi != nil {
+		totalCount = int(pi.TotalCount)
+	}
+
+	return &gqlmodel.WebhookDeliveryConnection{
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   gqlmodel.ToPageInfo(pi),
+		TotalCount: totalCount,
+	}, nil
+}
+
 // data loaders
 
 type IntegrationDataLoader interface {
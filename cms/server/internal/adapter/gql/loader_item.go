@@ -10,6 +10,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
@@ -106,6 +107,77 @@ func (c *ItemLoader) FindVersionedItems(ctx context.Context, itemID gqlmodel.ID)
 	return vis, nil
 }
 
+func (c *ItemLoader) FindDiff(ctx context.Context, itemID gqlmodel.ID, from string, to *string) ([]*gqlmodel.ItemFieldDiff, error) {
+	op := getOperator(ctx)
+	iId, err := gqlmodel.ToID[id.Item](itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromVersion, err := version.Parse(from)
+	if err != nil {
+		return nil, err
+	}
+	var toVersion *version.Version
+	if to != nil {
+		v, err := version.Parse(*to)
+		if err != nil {
+			return nil, err
+		}
+		toVersion = &v
+	}
+
+	res, err := c.usecase.Diff(ctx, interfaces.DiffItemParam{
+		ItemID: iId,
+		From:   fromVersion,
+		To:     toVersion,
+	}, op)
+	if err != nil {
+		return nil, err
+	}
+
+	itm, err := c.usecase.FindByID(ctx, iId, op)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := c.schemaUsecase.FindByID(ctx, itm.Value().Schema(), op)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(res, func(d item.FieldDiff, _ int) *gqlmodel.ItemFieldDiff {
+		return gqlmodel.ToItemFieldDiff(d, s)
+	}), nil
+}
+
+func (c *ItemLoader) FindBackReferences(ctx context.Context, itemID gqlmodel.ID) ([]*gqlmodel.Item, error) {
+	op := getOperator(ctx)
+	iId, err := gqlmodel.ToID[id.Item](itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.usecase.FindBackReferences(ctx, iId, op)
+	if err != nil {
+		return nil, err
+	}
+
+	ss, err := c.schemaUsecase.FindByIDs(ctx, lo.Uniq(lo.Map(res, func(v item.Versioned, _ int) id.SchemaID {
+		return v.Value().Schema()
+	})), op)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(res, func(v item.Versioned, _ int) *gqlmodel.Item {
+		s, _ := lo.Find(ss, func(s *schema.Schema) bool {
+			return s.ID() == v.Value().Schema()
+		})
+		return gqlmodel.ToItem(v.Value(), s)
+	}), nil
+}
+
 func (c *ItemLoader) FindBySchema(ctx context.Context, schemaID gqlmodel.ID, sort *gqlmodel.ItemSort, p *gqlmodel.Pagination) (*gqlmodel.ItemConnection, error) {
 	op := getOperator(ctx)
 	sid, err := gqlmodel.ToID[id.Schema](schemaID)
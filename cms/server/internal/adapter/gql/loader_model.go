@@ -81,6 +81,24 @@ func (c *ModelLoader) CheckKey(ctx context.Context, projectID gqlmodel.ID, key s
 	return &gqlmodel.KeyAvailability{Key: key, Available: ok}, nil
 }
 
+func (c *ModelLoader) Export(ctx context.Context, projectID gqlmodel.ID, format *gqlmodel.ModelDefinitionFormat) (string, error) {
+	pId, err := gqlmodel.ToID[id.Project](projectID)
+	if err != nil {
+		return "", err
+	}
+
+	d, err := c.usecase.Export(ctx, pId, getOperator(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	res, err := d.Marshal(gqlmodel.FromModelDefinitionFormat(format))
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
+
 // data loaders
 
 type ModelDataLoader interface {
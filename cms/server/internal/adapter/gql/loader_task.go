@@ -0,0 +1,72 @@
+package gql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type TaskLoader struct {
+	usecase interfaces.Task
+}
+
+func NewTaskLoader(usecase interfaces.Task) *TaskLoader {
+	return &TaskLoader{usecase: usecase}
+}
+
+func (c *TaskLoader) FindByID(ctx context.Context, taskID gqlmodel.ID) (*gqlmodel.Task, error) {
+	tid, err := gqlmodel.ToID[id.Task](taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := c.usecase.FindByID(ctx, tid, getOperator(ctx))
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return gqlmodel.ToTask(t), nil
+}
+
+func (c *TaskLoader) FindByProject(ctx context.Context, projectID gqlmodel.ID, p *gqlmodel.Pagination) (*gqlmodel.TaskConnection, error) {
+	pid, err := gqlmodel.ToID[id.Project](projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, pi, err := c.usecase.FindByProject(ctx, pid, p.Into(), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gqlmodel.TaskEdge, 0, len(tasks))
+	nodes := make([]*gqlmodel.Task, 0, len(tasks))
+	for _, t := range tasks {
+		task := gqlmodel.ToTask(t)
+		edges = append(edges, &gqlmodel.TaskEdge{
+			Node:   task,
+			Cursor: usecasex.Cursor(task.ID),
+		})
+		nodes = append(nodes, task)
+	}
+
+	var totalCount int
+	if pi != nil {
+		totalCount = int(pi.TotalCount)
+	}
+
+	return &gqlmodel.TaskConnection{
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   gqlmodel.ToPageInfo(pi),
+		TotalCount: totalCount,
+	}, nil
+}
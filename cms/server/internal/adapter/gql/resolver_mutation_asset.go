@@ -5,6 +5,7 @@ import (
 
 	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 )
 
@@ -31,7 +32,7 @@ func (r *mutationResolver) CreateAsset(ctx context.Context, input gqlmodel.Creat
 	}
 
 	return &gqlmodel.CreateAssetPayload{
-		Asset: gqlmodel.ToAsset(res, uc.GetURL),
+		Asset: gqlmodel.ToAsset(res, uc.URLResolver(ctx, asset.List{res}, getOperator(ctx))),
 	}, nil
 }
 
@@ -51,7 +52,7 @@ func (r *mutationResolver) UpdateAsset(ctx context.Context, input gqlmodel.Updat
 	}
 
 	return &gqlmodel.UpdateAssetPayload{
-		Asset: gqlmodel.ToAsset(res, uc.GetURL),
+		Asset: gqlmodel.ToAsset(res, uc.URLResolver(ctx, asset.List{res}, getOperator(ctx))),
 	}, nil
 }
 
@@ -81,5 +82,5 @@ func (r *mutationResolver) DecompressAsset(ctx context.Context, input gqlmodel.D
 		return nil, err2
 	}
 
-	return &gqlmodel.DecompressAssetPayload{Asset: gqlmodel.ToAsset(res, uc.GetURL)}, nil
+	return &gqlmodel.DecompressAssetPayload{Asset: gqlmodel.ToAsset(res, uc.URLResolver(ctx, asset.List{res}, getOperator(ctx)))}, nil
 }
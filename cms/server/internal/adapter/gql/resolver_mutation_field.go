@@ -30,6 +30,11 @@ func (r *mutationResolver) CreateField(ctx context.Context, input gqlmodel.Creat
 		return nil, err
 	}
 
+	requiredIf, err := gqlmodel.FromSchemaFieldRequiredCondition(input.RequiredIf)
+	if err != nil {
+		return nil, err
+	}
+
 	f, err := usecases(ctx).Schema.CreateField(ctx, interfaces.CreateFieldParam{
 		SchemaId:     m[0].Schema(),
 		Type:         value.Type(input.Type),
@@ -39,6 +44,10 @@ func (r *mutationResolver) CreateField(ctx context.Context, input gqlmodel.Creat
 		Multiple:     input.Multiple,
 		Unique:       input.Unique,
 		Required:     input.Required,
+		Localized:    lo.FromPtr(input.Localized),
+		MinItems:     input.MinItems,
+		MaxItems:     input.MaxItems,
+		RequiredIf:   requiredIf,
 		DefaultValue: dv,
 		TypeProperty: tp,
 	}, getOperator(ctx))
@@ -83,18 +92,28 @@ func (r *mutationResolver) UpdateField(ctx context.Context, input gqlmodel.Updat
 		return nil, err
 	}
 
+	requiredIf, err := gqlmodel.FromSchemaFieldRequiredCondition(input.RequiredIf)
+	if err != nil {
+		return nil, err
+	}
+
 	f, err := usecases(ctx).Schema.UpdateField(ctx, interfaces.UpdateFieldParam{
-		SchemaId:     m.Schema(),
-		FieldId:      fId,
-		Name:         input.Title,
-		Description:  input.Description,
-		Key:          input.Key,
-		Multiple:     input.Multiple,
-		Order:        input.Order,
-		Unique:       input.Unique,
-		Required:     input.Required,
-		DefaultValue: dv,
-		TypeProperty: tp,
+		SchemaId:         m.Schema(),
+		FieldId:          fId,
+		Name:             input.Title,
+		Description:      input.Description,
+		Key:              input.Key,
+		Multiple:         input.Multiple,
+		Order:            input.Order,
+		Unique:           input.Unique,
+		Required:         input.Required,
+		Localized:        input.Localized,
+		MinItems:         input.MinItems,
+		MaxItems:         input.MaxItems,
+		RequiredIf:       requiredIf,
+		RemoveRequiredIf: input.RequiredIf != nil && requiredIf == nil,
+		DefaultValue:     dv,
+		TypeProperty:     tp,
 	}, getOperator(ctx))
 	if err != nil {
 		return nil, err
@@ -163,18 +182,28 @@ func (r *mutationResolver) UpdateFields(ctx context.Context, input []*gqlmodel.U
 		if err != nil {
 			return interfaces.UpdateFieldParam{}, err
 		}
+
+		requiredIf, err := gqlmodel.FromSchemaFieldRequiredCondition(ipt.RequiredIf)
+		if err != nil {
+			return interfaces.UpdateFieldParam{}, err
+		}
 		return interfaces.UpdateFieldParam{
-			SchemaId:     s.ID(),
-			FieldId:      fid,
-			Name:         ipt.Title,
-			Description:  ipt.Description,
-			Key:          ipt.Key,
-			Multiple:     ipt.Multiple,
-			Order:        ipt.Order,
-			Unique:       ipt.Unique,
-			Required:     ipt.Required,
-			DefaultValue: dv,
-			TypeProperty: tp,
+			SchemaId:         s.ID(),
+			FieldId:          fid,
+			Name:             ipt.Title,
+			Description:      ipt.Description,
+			Key:              ipt.Key,
+			Multiple:         ipt.Multiple,
+			Order:            ipt.Order,
+			Unique:           ipt.Unique,
+			Required:         ipt.Required,
+			Localized:        ipt.Localized,
+			MinItems:         ipt.MinItems,
+			MaxItems:         ipt.MaxItems,
+			RequiredIf:       requiredIf,
+			RemoveRequiredIf: ipt.RequiredIf != nil && requiredIf == nil,
+			DefaultValue:     dv,
+			TypeProperty:     tp,
 		}, nil
 	})
 	if err != nil {
@@ -192,3 +221,60 @@ func (r *mutationResolver) UpdateFields(ctx context.Context, input []*gqlmodel.U
 		}),
 	}, nil
 }
+
+func (r *mutationResolver) MigrateFields(ctx context.Context, input gqlmodel.MigrateFieldsInput) (*gqlmodel.TaskPayload, error) {
+	mId, err := gqlmodel.ToID[id.Model](input.ModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	sources, err := gqlmodel.ToIDs[id.Field](input.SourceFieldIds)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]interfaces.CreateFieldParam, 0, len(input.Targets))
+	for _, t := range input.Targets {
+		tp, dv, err := gqlmodel.FromSchemaTypeProperty(t.TypeProperty, t.Type, t.Multiple)
+		if err != nil {
+			return nil, err
+		}
+
+		requiredIf, err := gqlmodel.FromSchemaFieldRequiredCondition(t.RequiredIf)
+		if err != nil {
+			return nil, err
+		}
+
+		targets = append(targets, interfaces.CreateFieldParam{
+			Type:         value.Type(t.Type),
+			Name:         t.Title,
+			Description:  t.Description,
+			Key:          t.Key,
+			Multiple:     t.Multiple,
+			Unique:       t.Unique,
+			Required:     t.Required,
+			Localized:    lo.FromPtr(t.Localized),
+			MinItems:     t.MinItems,
+			MaxItems:     t.MaxItems,
+			RequiredIf:   requiredIf,
+			DefaultValue: dv,
+			TypeProperty: tp,
+		})
+	}
+
+	res, err := usecases(ctx).Schema.MigrateFields(ctx, interfaces.MigrateFieldsParam{
+		ModelID:   mId,
+		Type:      input.Type.Into(),
+		Sources:   sources,
+		Targets:   targets,
+		Separator: lo.FromPtr(input.Separator),
+		DryRun:    lo.FromPtr(input.DryRun),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.TaskPayload{
+		Task: gqlmodel.ToTask(res),
+	}, nil
+}
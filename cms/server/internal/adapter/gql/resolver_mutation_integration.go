@@ -94,6 +94,12 @@ func (r *mutationResolver) CreateWebhook(ctx context.Context, input gqlmodel.Cre
 			event.AssetCreate:     lo.FromPtrOr(input.Trigger.OnAssetUpload, false),
 			event.AssetDecompress: lo.FromPtrOr(input.Trigger.OnAssetDecompress, false),
 			event.AssetDelete:     lo.FromPtrOr(input.Trigger.OnAssetDelete, false),
+			event.RequestSubmit:   lo.FromPtrOr(input.Trigger.OnRequestSubmit, false),
+			event.RequestReview:   lo.FromPtrOr(input.Trigger.OnRequestReview, false),
+			event.RequestApprove:  lo.FromPtrOr(input.Trigger.OnRequestApprove, false),
+			event.RequestBlock:    lo.FromPtrOr(input.Trigger.OnRequestBlock, false),
+			event.RequestClose:    lo.FromPtrOr(input.Trigger.OnRequestClose, false),
+			event.CommentCreate:   lo.FromPtrOr(input.Trigger.OnCommentCreate, false),
 		},
 		Secret: input.Secret,
 	}, getOperator(ctx))
@@ -125,6 +131,12 @@ func (r *mutationResolver) UpdateWebhook(ctx context.Context, input gqlmodel.Upd
 			event.AssetCreate:     lo.FromPtrOr(input.Trigger.OnAssetUpload, false),
 			event.AssetDecompress: lo.FromPtrOr(input.Trigger.OnAssetDecompress, false),
 			event.AssetDelete:     lo.FromPtrOr(input.Trigger.OnAssetDelete, false),
+			event.RequestSubmit:   lo.FromPtrOr(input.Trigger.OnRequestSubmit, false),
+			event.RequestReview:   lo.FromPtrOr(input.Trigger.OnRequestReview, false),
+			event.RequestApprove:  lo.FromPtrOr(input.Trigger.OnRequestApprove, false),
+			event.RequestBlock:    lo.FromPtrOr(input.Trigger.OnRequestBlock, false),
+			event.RequestClose:    lo.FromPtrOr(input.Trigger.OnRequestClose, false),
+			event.CommentCreate:   lo.FromPtrOr(input.Trigger.OnCommentCreate, false),
 		},
 		Secret: input.Secret,
 	}, getOperator(ctx))
@@ -152,3 +164,19 @@ func (r *mutationResolver) DeleteWebhook(ctx context.Context, input gqlmodel.Del
 		WebhookID: input.WebhookID,
 	}, nil
 }
+
+func (r *mutationResolver) RedeliverWebhook(ctx context.Context, input gqlmodel.RedeliverWebhookInput) (*gqlmodel.WebhookDeliveryPayload, error) {
+	iId, dId, err := gqlmodel.ToID2[id.Integration, id.WebhookDelivery](input.IntegrationID, input.DeliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Integration.RedeliverWebhook(ctx, iId, dId, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.WebhookDeliveryPayload{
+		Delivery: gqlmodel.ToWebhookDelivery(res),
+	}, nil
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
@@ -25,6 +28,7 @@ func (r *mutationResolver) CreateItem(ctx context.Context, input gqlmodel.Create
 		SchemaID: sid,
 		ModelID:  mid,
 		Fields:   util.DerefSlice(util.Map(input.Fields, gqlmodel.ToItemParam)),
+		Group:    input.Group,
 	}, op)
 	if err != nil {
 		return nil, err
@@ -47,6 +51,7 @@ func (r *mutationResolver) UpdateItem(ctx context.Context, input gqlmodel.Update
 	res, err := usecases(ctx).Item.Update(ctx, interfaces.UpdateItemParam{
 		ItemID: iid,
 		Fields: util.DerefSlice(util.Map(input.Fields, gqlmodel.ToItemParam)),
+		Group:  input.Group,
 	}, op)
 	if err != nil {
 		return nil, err
@@ -91,3 +96,107 @@ func (r *mutationResolver) UnpublishItem(ctx context.Context, input gqlmodel.Unp
 		Items: lo.Map(res, func(t item.Versioned, _ int) *gqlmodel.Item { return gqlmodel.ToItem(t.Value(), s) }),
 	}, nil
 }
+
+func (r *mutationResolver) ScheduleItem(ctx context.Context, input gqlmodel.ScheduleItemInput) (*gqlmodel.ItemPayload, error) {
+	op := getOperator(ctx)
+	iid, err := gqlmodel.ToID[id.Item](input.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := usecases(ctx).Item.Schedule(ctx, interfaces.ScheduleItemParam{
+		ItemID:      iid,
+		PublishAt:   input.PublishAt,
+		UnpublishAt: input.UnpublishAt,
+	}, op)
+	if err != nil {
+		return nil, err
+	}
+	s, err := usecases(ctx).Schema.FindByID(ctx, res.Value().Schema(), op)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.ItemPayload{
+		Item: gqlmodel.ToItem(res.Value(), s),
+	}, nil
+}
+
+func (r *mutationResolver) RollbackItem(ctx context.Context, input gqlmodel.RollbackItemInput) (*gqlmodel.ItemPayload, error) {
+	op := getOperator(ctx)
+	iid, err := gqlmodel.ToID[id.Item](input.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	v, err := version.Parse(input.Version)
+	if err != nil {
+		return nil, err
+	}
+	res, err := usecases(ctx).Item.Rollback(ctx, interfaces.RollbackItemParam{
+		ItemID:  iid,
+		Version: v,
+	}, op)
+	if err != nil {
+		return nil, err
+	}
+	s, err := usecases(ctx).Schema.FindByID(ctx, res.Value().Schema(), op)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.ItemPayload{
+		Item: gqlmodel.ToItem(res.Value(), s),
+	}, nil
+}
+
+func (r *mutationResolver) ImportItems(ctx context.Context, input gqlmodel.ImportItemsInput) (*gqlmodel.TaskPayload, error) {
+	mid, aid, err := gqlmodel.ToID2[id.Model, id.Asset](input.ModelID, input.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyField *key.Key
+	if input.KeyField != nil {
+		keyField = lo.ToPtr(key.New(*input.KeyField))
+	}
+
+	res, err := usecases(ctx).Item.Import(ctx, interfaces.ImportItemsParam{
+		ModelID: mid,
+		AssetID: aid,
+		Format:  input.Format.Into(),
+		Fields: lo.SliceToMap(input.Fields, func(f *gqlmodel.ImportFieldMappingInput) (string, string) {
+			return f.Column, f.FieldKey
+		}),
+		KeyField: keyField,
+		DryRun:   lo.FromPtr(input.DryRun),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.TaskPayload{
+		Task: gqlmodel.ToTask(res),
+	}, nil
+}
+
+func (r *mutationResolver) ExportItems(ctx context.Context, input gqlmodel.ExportItemsInput) (*gqlmodel.TaskPayload, error) {
+	mid, err := gqlmodel.ToID[id.Model](input.ModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Item.Export(ctx, interfaces.ExportItemsParam{
+		ModelID: mid,
+		Format:  input.Format.Into(),
+		Keyword: lo.FromPtr(input.Keyword),
+		Filters: lo.Map(input.Filters, func(f *gqlmodel.ExportFilterInput, _ int) task.ExportFilter {
+			return task.ExportFilter{Key: f.Key, Operator: f.Operator, Value: f.Value}
+		}),
+		BBox:       lo.FromPtr(input.Bbox),
+		Intersects: lo.FromPtr(input.Intersects),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.TaskPayload{
+		Task: gqlmodel.ToTask(res),
+	}, nil
+}
@@ -2,9 +2,11 @@ package gql
 
 import (
 	"context"
+	"errors"
 
 	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/definition"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/samber/lo"
 )
@@ -84,3 +86,34 @@ func (r *mutationResolver) PublishModel(ctx context.Context, input gqlmodel.Publ
 		Status:  s,
 	}, nil
 }
+
+func (r *mutationResolver) ImportModels(ctx context.Context, input gqlmodel.ImportModelsInput) (*gqlmodel.ImportModelsPayload, error) {
+	pId, err := gqlmodel.ToID[id.Project](input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := definition.Parse([]byte(input.Definition))
+	if err != nil {
+		return nil, err
+	}
+
+	dryRun := lo.FromPtr(input.DryRun)
+	changes, err := usecases(ctx).Model.Import(ctx, interfaces.ImportModelsParam{
+		ProjectID:        pId,
+		Definition:       d,
+		DryRun:           dryRun,
+		AllowDestructive: lo.FromPtr(input.AllowDestructive),
+	}, getOperator(ctx))
+	// changes which are not applied are returned so that removals and changes of types of fields can be reviewed
+	if err != nil && !errors.Is(err, interfaces.ErrDestructiveChanges) && !errors.Is(err, interfaces.ErrFieldTypeChanged) {
+		return nil, err
+	}
+
+	return &gqlmodel.ImportModelsPayload{
+		Changes: lo.Map(changes, func(c definition.Change, _ int) *gqlmodel.ModelDefinitionChange {
+			return gqlmodel.ToModelDefinitionChange(c)
+		}),
+		Applied: err == nil && !dryRun && len(changes) > 0,
+	}, nil
+}
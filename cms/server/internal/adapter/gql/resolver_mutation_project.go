@@ -7,6 +7,8 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
 
@@ -47,12 +49,30 @@ func (r *mutationResolver) UpdateProject(ctx context.Context, input gqlmodel.Upd
 		}
 	}
 
+	var policy *interfaces.UpdateProjectRequestPolicyParam
+	if input.RequestPolicy != nil {
+		policy, err = fromRequestPolicyInput(input.RequestPolicy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var groups *interfaces.UpdateProjectItemGroupPolicyParam
+	if input.ItemGroupPolicy != nil {
+		groups = &interfaces.UpdateProjectItemGroupPolicyParam{
+			Field:      input.ItemGroupPolicy.Field,
+			Restricted: input.ItemGroupPolicy.Restricted,
+		}
+	}
+
 	res, err := usecases(ctx).Project.Update(ctx, interfaces.UpdateProjectParam{
-		ID:          pid,
-		Name:        input.Name,
-		Description: input.Description,
-		Alias:       input.Alias,
-		Publication: pub,
+		ID:              pid,
+		Name:            input.Name,
+		Description:     input.Description,
+		Alias:           input.Alias,
+		Publication:     pub,
+		RequestPolicy:   policy,
+		ItemGroupPolicy: groups,
 	}, getOperator(ctx))
 	if err != nil {
 		return nil, err
@@ -73,3 +93,115 @@ func (r *mutationResolver) DeleteProject(ctx context.Context, input gqlmodel.Del
 
 	return &gqlmodel.DeleteProjectPayload{ProjectID: input.ProjectID}, nil
 }
+
+func fromRequestPolicyInput(input *gqlmodel.UpdateProjectRequestPolicyInput) (*interfaces.UpdateProjectRequestPolicyParam, error) {
+	var roles []user.Role
+	if input.RequiredRoles != nil {
+		roles = lo.Map(input.RequiredRoles, func(r gqlmodel.Role, _ int) user.Role { return gqlmodel.FromRole(r) })
+	}
+
+	var reviewers map[id.ModelID]id.UserIDList
+	if input.ModelReviewers != nil {
+		reviewers = make(map[id.ModelID]id.UserIDList, len(input.ModelReviewers))
+		for _, mr := range input.ModelReviewers {
+			mid, err := gqlmodel.ToID[id.Model](mr.ModelID)
+			if err != nil {
+				return nil, err
+			}
+			uids, err := gqlmodel.ToIDs[id.User](mr.ReviewersID)
+			if err != nil {
+				return nil, err
+			}
+			reviewers[mid] = uids
+		}
+	}
+
+	return &interfaces.UpdateProjectRequestPolicyParam{
+		RequiredApprovals: input.RequiredApprovals,
+		RequiredRoles:     roles,
+		ModelReviewers:    reviewers,
+	}, nil
+}
+
+func (r *mutationResolver) CreateProjectAPIKey(ctx context.Context, input gqlmodel.CreateProjectAPIKeyInput) (*gqlmodel.ProjectAPIKeyPayload, error) {
+	pid, err := gqlmodel.ToID[id.Project](input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	models, err := gqlmodel.ToIDs[id.Model](input.ModelIds)
+	if err != nil {
+		return nil, err
+	}
+
+	p, k, err := usecases(ctx).Project.CreateAPIKey(ctx, interfaces.CreateAPIKeyParam{
+		ProjectID: pid,
+		Name:      input.Name,
+		Models:    models,
+		RateLimit: lo.FromPtr(input.RateLimit),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ProjectAPIKeyPayload{Project: gqlmodel.ToProject(p), APIKey: gqlmodel.ToProjectAPIKey(k), Key: util.ToPtrIfNotEmpty(k.Key())}, nil
+}
+
+func (r *mutationResolver) UpdateProjectAPIKey(ctx context.Context, input gqlmodel.UpdateProjectAPIKeyInput) (*gqlmodel.ProjectAPIKeyPayload, error) {
+	pid, kid, err := gqlmodel.ToID2[id.Project, id.APIKey](input.ProjectID, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var models id.ModelIDList
+	if input.ModelIds != nil {
+		if models, err = gqlmodel.ToIDs[id.Model](input.ModelIds); err != nil {
+			return nil, err
+		}
+		if models == nil {
+			// an empty list clears the allow-list
+			models = id.ModelIDList{}
+		}
+	}
+
+	p, k, err := usecases(ctx).Project.UpdateAPIKey(ctx, interfaces.UpdateAPIKeyParam{
+		ProjectID: pid,
+		KeyID:     kid,
+		Name:      input.Name,
+		Models:    models,
+		RateLimit: input.RateLimit,
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ProjectAPIKeyPayload{Project: gqlmodel.ToProject(p), APIKey: gqlmodel.ToProjectAPIKey(k)}, nil
+}
+
+func (r *mutationResolver) RegenerateProjectAPIKey(ctx context.Context, input gqlmodel.RegenerateProjectAPIKeyInput) (*gqlmodel.ProjectAPIKeyPayload, error) {
+	pid, kid, err := gqlmodel.ToID2[id.Project, id.APIKey](input.ProjectID, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, k, err := usecases(ctx).Project.RegenerateAPIKey(ctx, pid, kid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ProjectAPIKeyPayload{Project: gqlmodel.ToProject(p), APIKey: gqlmodel.ToProjectAPIKey(k), Key: util.ToPtrIfNotEmpty(k.Key())}, nil
+}
+
+func (r *mutationResolver) DeleteProjectAPIKey(ctx context.Context, input gqlmodel.DeleteProjectAPIKeyInput) (*gqlmodel.DeleteProjectAPIKeyPayload, error) {
+	pid, kid, err := gqlmodel.ToID2[id.Project, id.APIKey](input.ProjectID, input.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := usecases(ctx).Project.DeleteAPIKey(ctx, pid, kid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.DeleteProjectAPIKeyPayload{Project: gqlmodel.ToProject(p), KeyID: input.KeyID}, nil
+}
@@ -39,6 +39,8 @@ func (r *mutationResolver) CreateRequest(ctx context.Context, input gqlmodel.Cre
 		State:       lo.ToPtr(request.StateFrom(input.State.String())),
 		Reviewers:   reviewers,
 		Items:       items,
+		PublishAt:   input.PublishAt,
+		UnpublishAt: input.UnpublishAt,
 	}
 
 	res, err := uc.Create(ctx, params, getOperator(ctx))
@@ -79,6 +81,8 @@ func (r *mutationResolver) UpdateRequest(ctx context.Context, input gqlmodel.Upd
 		State:       lo.ToPtr(request.StateFrom(input.State.String())),
 		Reviewers:   reviewers,
 		Items:       items,
+		PublishAt:   input.PublishAt,
+		UnpublishAt: input.UnpublishAt,
 	}
 
 	res, err := uc.Update(ctx, params, getOperator(ctx))
@@ -106,6 +110,21 @@ func (r *mutationResolver) ApproveRequest(ctx context.Context, input gqlmodel.Ap
 	}, nil
 }
 
+func (r *mutationResolver) RequestChanges(ctx context.Context, input gqlmodel.RequestChangesInput) (*gqlmodel.RequestPayload, error) {
+	rid, err := gqlmodel.ToID[id.Request](input.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := usecases(ctx).Request.RequestChanges(ctx, rid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.RequestPayload{
+		Request: gqlmodel.ToRequest(res),
+	}, nil
+}
+
 func (r *mutationResolver) DeleteRequest(ctx context.Context, input gqlmodel.DeleteRequestInput) (*gqlmodel.DeleteRequestPayload, error) {
 	rids, err := gqlmodel.ToIDs[id.Request](input.RequestsID)
 	if err != nil {
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/samber/lo"
 )
 
@@ -26,8 +28,25 @@ func (r *mutationResolver) CreateThread(ctx context.Context, input gqlmodel.Crea
 func (r *mutationResolver) AddComment(ctx context.Context, input gqlmodel.AddCommentInput) (*gqlmodel.CommentPayload, error) {
 	thid := lo.Must(gqlmodel.ToID[id.Thread](input.ThreadID))
 
+	users, err := gqlmodel.ToIDs[id.User](input.MentionedUserIds)
+	if err != nil {
+		return nil, err
+	}
+
+	integrations, err := gqlmodel.ToIDs[id.Integration](input.MentionedIntegrationIds)
+	if err != nil {
+		return nil, err
+	}
+
 	uc := usecases(ctx).Thread
-	th, c, err := uc.AddComment(ctx, thid, input.Content, getOperator(ctx))
+	th, c, err := uc.AddComment(ctx, interfaces.AddCommentParam{
+		ThreadID: thid,
+		Content:  input.Content,
+		Mentions: &thread.Mentions{
+			Users:        users,
+			Integrations: integrations,
+		},
+	}, getOperator(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -83,3 +102,18 @@ func (r *mutationResolver) DeleteComment(ctx context.Context, input gqlmodel.Del
 		CommentID: gqlmodel.IDFrom(cid),
 	}, nil
 }
+
+func (r *mutationResolver) ResolveThread(ctx context.Context, input gqlmodel.ResolveThreadInput) (*gqlmodel.ThreadPayload, error) {
+	thid, err := gqlmodel.ToID[id.Thread](input.ThreadID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc := usecases(ctx).Thread
+	th, err := uc.ResolveThread(ctx, thid, input.Resolved, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ThreadPayload{Thread: gqlmodel.ToThread(th)}, nil
+}
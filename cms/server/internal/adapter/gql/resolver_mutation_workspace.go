@@ -4,8 +4,11 @@ import (
 	"context"
 
 	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
 )
 
 func (r *mutationResolver) CreateWorkspace(ctx context.Context, input gqlmodel.CreateWorkspaceInput) (*gqlmodel.CreateWorkspacePayload, error) {
@@ -134,3 +137,136 @@ func (r *mutationResolver) UpdateIntegrationOfWorkspace(ctx context.Context, inp
 
 	return &gqlmodel.UpdateMemberOfWorkspacePayload{Workspace: gqlmodel.ToWorkspace(res)}, nil
 }
+
+func (r *mutationResolver) CreateCustomRole(ctx context.Context, input gqlmodel.CreateCustomRoleInput) (*gqlmodel.CustomRolePayload, error) {
+	wid, err := gqlmodel.ToID[id.Workspace](input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	permissions, err := gqlmodel.FromCustomRolePermissions(input.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Workspace.CreateCustomRole(ctx, interfaces.CreateCustomRoleParam{
+		WorkspaceID: wid,
+		Name:        input.Name,
+		Permissions: permissions,
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.CustomRolePayload{Workspace: gqlmodel.ToWorkspace(res)}, nil
+}
+
+func (r *mutationResolver) UpdateCustomRole(ctx context.Context, input gqlmodel.UpdateCustomRoleInput) (*gqlmodel.CustomRolePayload, error) {
+	wid, rid, err := gqlmodel.ToID2[id.Workspace, id.Role](input.WorkspaceID, input.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	permissions, err := gqlmodel.FromCustomRolePermissions(input.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Workspace.UpdateCustomRole(ctx, interfaces.UpdateCustomRoleParam{
+		WorkspaceID: wid,
+		RoleID:      rid,
+		Name:        input.Name,
+		Permissions: permissions,
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.CustomRolePayload{Workspace: gqlmodel.ToWorkspace(res)}, nil
+}
+
+func (r *mutationResolver) DeleteCustomRole(ctx context.Context, input gqlmodel.DeleteCustomRoleInput) (*gqlmodel.CustomRolePayload, error) {
+	wid, rid, err := gqlmodel.ToID2[id.Workspace, id.Role](input.WorkspaceID, input.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Workspace.RemoveCustomRole(ctx, wid, rid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.CustomRolePayload{Workspace: gqlmodel.ToWorkspace(res)}, nil
+}
+
+func (r *mutationResolver) AssignCustomRole(ctx context.Context, input gqlmodel.AssignCustomRoleInput) (*gqlmodel.UpdateMemberOfWorkspacePayload, error) {
+	wid, err := gqlmodel.ToID[id.Workspace](input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	var rid *id.RoleID
+	if input.RoleID != nil {
+		r, err := gqlmodel.ToID[id.Role](*input.RoleID)
+		if err != nil {
+			return nil, err
+		}
+		rid = &r
+	}
+
+	var res *user.Workspace
+	switch {
+	case input.UserID != nil && input.IntegrationID == nil:
+		uid, err := gqlmodel.ToID[id.User](*input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		res, err = usecases(ctx).Workspace.AssignUserCustomRole(ctx, wid, uid, rid, getOperator(ctx))
+		if err != nil {
+			return nil, err
+		}
+	case input.IntegrationID != nil && input.UserID == nil:
+		iid, err := gqlmodel.ToID[id.Integration](*input.IntegrationID)
+		if err != nil {
+			return nil, err
+		}
+		res, err = usecases(ctx).Workspace.AssignIntegrationCustomRole(ctx, wid, iid, rid, getOperator(ctx))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, rerror.NewE(i18n.T("either user or integration should be specified"))
+	}
+
+	return &gqlmodel.UpdateMemberOfWorkspacePayload{Workspace: gqlmodel.ToWorkspace(res)}, nil
+}
+
+func (r *mutationResolver) UpdateMemberItemGroups(ctx context.Context, input gqlmodel.UpdateMemberItemGroupsInput) (*gqlmodel.UpdateMemberOfWorkspacePayload, error) {
+	wid, err := gqlmodel.ToID[id.Workspace](input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *user.Workspace
+	switch {
+	case input.UserID != nil && input.IntegrationID == nil:
+		uid, err := gqlmodel.ToID[id.User](*input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		res, err = usecases(ctx).Workspace.UpdateUserItemGroups(ctx, wid, uid, input.Groups, getOperator(ctx))
+		if err != nil {
+			return nil, err
+		}
+	case input.IntegrationID != nil && input.UserID == nil:
+		iid, err := gqlmodel.ToID[id.Integration](*input.IntegrationID)
+		if err != nil {
+			return nil, err
+		}
+		res, err = usecases(ctx).Workspace.UpdateIntegrationItemGroups(ctx, wid, iid, input.Groups, getOperator(ctx))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, rerror.NewE(i18n.T("either user or integration should be specified"))
+	}
+
+	return &gqlmodel.UpdateMemberOfWorkspacePayload{Workspace: gqlmodel.ToWorkspace(res)}, nil
+}
@@ -211,10 +211,22 @@ func (r *queryResolver) CheckModelKeyAvailability(ctx context.Context, projectID
 	return loaders(ctx).Model.CheckKey(ctx, projectID, key)
 }
 
+func (r *queryResolver) ExportModels(ctx context.Context, projectID gqlmodel.ID, format *gqlmodel.ModelDefinitionFormat) (string, error) {
+	return loaders(ctx).Model.Export(ctx, projectID, format)
+}
+
 func (r *queryResolver) VersionsByItem(ctx context.Context, itemID gqlmodel.ID) ([]*gqlmodel.VersionedItem, error) {
 	return loaders(ctx).Item.FindVersionedItems(ctx, itemID)
 }
 
+func (r *queryResolver) ItemDiff(ctx context.Context, itemID gqlmodel.ID, from string, to *string) ([]*gqlmodel.ItemFieldDiff, error) {
+	return loaders(ctx).Item.FindDiff(ctx, itemID, from, to)
+}
+
+func (r *queryResolver) BackReferences(ctx context.Context, itemID gqlmodel.ID) ([]*gqlmodel.Item, error) {
+	return loaders(ctx).Item.FindBackReferences(ctx, itemID)
+}
+
 func (r *queryResolver) Items(ctx context.Context, schemaID gqlmodel.ID, sort *gqlmodel.ItemSort, p *gqlmodel.Pagination) (*gqlmodel.ItemConnection, error) {
 	return loaders(ctx).Item.FindBySchema(ctx, schemaID, sort, p)
 }
@@ -231,6 +243,22 @@ func (r *queryResolver) SearchItem(ctx context.Context, query gqlmodel.ItemQuery
 	return loaders(ctx).Item.Search(ctx, query, sort, p)
 }
 
+func (r *queryResolver) Task(ctx context.Context, id gqlmodel.ID) (*gqlmodel.Task, error) {
+	return loaders(ctx).Task.FindByID(ctx, id)
+}
+
+func (r *queryResolver) Tasks(ctx context.Context, projectID gqlmodel.ID, pagination *gqlmodel.Pagination) (*gqlmodel.TaskConnection, error) {
+	return loaders(ctx).Task.FindByProject(ctx, projectID, pagination)
+}
+
+func (r *queryResolver) AuditLogs(ctx context.Context, filter gqlmodel.AuditLogFilter, pagination *gqlmodel.Pagination) (*gqlmodel.AuditLogConnection, error) {
+	return loaders(ctx).AuditLog.Search(ctx, filter, pagination)
+}
+
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, integrationID gqlmodel.ID, webhookID gqlmodel.ID, pagination *gqlmodel.Pagination) (*gqlmodel.WebhookDeliveryConnection, error) {
+	return loaders(ctx).Integration.FindWebhookDeliveries(ctx, integrationID, webhookID, pagination)
+}
+
 func (r *queryResolver) Requests(ctx context.Context, projectID gqlmodel.ID, key *string, state []gqlmodel.RequestState, reviewer, createdBy *gqlmodel.ID, p *gqlmodel.Pagination, sort *gqlmodel.Sort) (*gqlmodel.RequestConnection, error) {
 	return loaders(ctx).Request.FindByProject(ctx, projectID, key, state, reviewer, createdBy, p, sort)
 }
@@ -32,6 +32,14 @@ func (r requestResolver) Reviewers(ctx context.Context, obj *gqlmodel.Request) (
 	return res, nil
 }
 
+func (r requestResolver) ApprovedBy(ctx context.Context, obj *gqlmodel.Request) ([]*gqlmodel.User, error) {
+	res, errors := dataloaders(ctx).User.LoadAll(obj.ApprovedByID)
+	if len(res) > 0 && errors[0] != nil {
+		return nil, errors[0]
+	}
+	return res, nil
+}
+
 func (r requestResolver) CreatedBy(ctx context.Context, obj *gqlmodel.Request) (*gqlmodel.User, error) {
 	return dataloaders(ctx).User.Load(obj.CreatedByID)
 }
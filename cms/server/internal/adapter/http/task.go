@@ -2,33 +2,62 @@ package http
 
 import (
 	"context"
+	"errors"
 
 	"github.com/reearth/reearth-cms/server/internal/adapter"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/worker/pkg/decompressor"
+	"github.com/reearth/reearth-cms/worker/pkg/webhook"
+)
+
+const (
+	notifyTypeWebhookDelivered        = "webhookDelivered"
+	notifyTypeAssetDecompressProgress = "assetDecompressProgress"
+	notifyTypeAssetCompressed         = "assetCompressed"
 )
 
 type TaskController struct {
-	usecase interfaces.Asset
+	usecase            interfaces.Asset
+	integrationUsecase interfaces.Integration
 }
 
 type NotifyInput struct {
-	Type    string                         `json:"type"`
-	AssetID string                         `json:"assetId"`
-	Status  *asset.ArchiveExtractionStatus `json:"status"`
+	Type     string                         `json:"type"`
+	AssetID  string                         `json:"assetId"`
+	Status   *asset.ArchiveExtractionStatus `json:"status"`
+	Progress *decompressor.Progress         `json:"progress"`
+	Delivery *webhook.Result                `json:"delivery"`
 }
 
-func NewTaskController(uc interfaces.Asset) *TaskController {
-	return &TaskController{usecase: uc}
+func NewTaskController(uc interfaces.Asset, iuc interfaces.Integration) *TaskController {
+	return &TaskController{usecase: uc, integrationUsecase: iuc}
 }
 
 func (tc *TaskController) Notify(ctx context.Context, input NotifyInput) error {
+	if input.Type == notifyTypeWebhookDelivered {
+		return tc.notifyWebhookDelivered(ctx, input.Delivery)
+	}
+
 	aID, err := id.AssetIDFrom(input.AssetID)
 	if err != nil {
 		return err
 	}
 
+	if input.Type == notifyTypeAssetDecompressProgress {
+		if input.Progress == nil {
+			return errors.New("progress is missing")
+		}
+		_, err = tc.usecase.UpdateExtractionProgress(ctx, aID, ArchiveExtractionProgressFrom(*input.Progress), adapter.Operator(ctx))
+		return err
+	}
+
+	if input.Type == notifyTypeAssetCompressed {
+		_, err = tc.usecase.UpdateCompression(ctx, aID, input.Status, adapter.Operator(ctx))
+		return err
+	}
+
 	_, err = tc.usecase.UpdateFiles(ctx, aID, input.Status, adapter.Operator(ctx))
 	if err != nil {
 		return err
@@ -36,3 +65,43 @@ func (tc *TaskController) Notify(ctx context.Context, input NotifyInput) error {
 
 	return nil
 }
+
+func (tc *TaskController) notifyWebhookDelivered(ctx context.Context, r *webhook.Result) error {
+	if r == nil {
+		return errors.New("delivery is missing")
+	}
+
+	param, err := RecordWebhookDeliveryParamFrom(r)
+	if err != nil {
+		return err
+	}
+
+	return tc.integrationUsecase.RecordWebhookDelivery(ctx, param, adapter.Operator(ctx))
+}
+
+func ArchiveExtractionProgressFrom(p decompressor.Progress) asset.ArchiveExtractionProgress {
+	return asset.ArchiveExtractionProgress{
+		Files:      p.Files,
+		TotalFiles: p.TotalFiles,
+		Failed:     p.Failed,
+		Bytes:      p.Bytes,
+		TotalBytes: p.TotalBytes,
+	}
+}
+
+func RecordWebhookDeliveryParamFrom(r *webhook.Result) (interfaces.RecordWebhookDeliveryParam, error) {
+	dID, err := id.WebhookDeliveryIDFrom(r.DeliveryID)
+	if err != nil {
+		return interfaces.RecordWebhookDeliveryParam{}, err
+	}
+
+	return interfaces.RecordWebhookDeliveryParam{
+		DeliveryID: dID,
+		Attempt:    r.Attempt,
+		At:         r.At,
+		Payload:    r.Body,
+		StatusCode: r.StatusCode,
+		Latency:    r.Latency,
+		Error:      r.Error,
+	}, nil
+}
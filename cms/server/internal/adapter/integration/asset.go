@@ -41,8 +41,9 @@ func (s Server) AssetFilter(ctx context.Context, request AssetFilterRequestObjec
 		return AssetFilter400Response{}, err
 	}
 
+	assetURL := assetURLResolver(ctx, assets)
 	itemList, err := util.TryMap(assets, func(a *asset.Asset) (integrationapi.Asset, error) {
-		aurl := uc.Asset.GetURL(a)
+		aurl := assetURL(a)
 		aa := integrationapi.NewAsset(a, nil, aurl, true)
 		return *aa, nil
 	})
@@ -95,7 +96,7 @@ func (s Server) AssetCreate(ctx context.Context, request AssetCreateRequestObjec
 		return AssetCreate400Response{}, err
 	}
 
-	aurl := uc.Asset.GetURL(a)
+	aurl := assetURLResolver(ctx, asset.List{a})(a)
 	aa := integrationapi.NewAsset(a, af, aurl, true)
 	return AssetCreate200JSONResponse(*aa), nil
 }
@@ -133,7 +134,43 @@ func (s Server) AssetGet(ctx context.Context, request AssetGetRequestObject) (As
 		return AssetGet400Response{}, err
 	}
 
-	aurl := uc.Asset.GetURL(a)
+	aurl := assetURLResolver(ctx, asset.List{a})(a)
 	aa := integrationapi.NewAsset(a, f, aurl, true)
 	return AssetGet200JSONResponse(*aa), nil
 }
+
+func (s Server) AssetCompress(ctx context.Context, request AssetCompressRequestObject) (AssetCompressResponseObject, error) {
+	uc := adapter.Usecases(ctx)
+	op := adapter.Operator(ctx)
+
+	var p *string
+	if request.Body != nil {
+		p = request.Body.Path
+	}
+
+	a, err := uc.Asset.Compress(ctx, interfaces.CompressAssetParam{
+		AssetID: request.AssetId,
+		Path:    p,
+	}, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return AssetCompress404Response{}, err
+		}
+		return AssetCompress400Response{}, err
+	}
+
+	f, err := uc.Asset.FindFileByID(ctx, request.AssetId, op)
+	if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+		return AssetCompress400Response{}, err
+	}
+
+	aurl := assetURLResolver(ctx, asset.List{a})(a)
+	aa := integrationapi.NewAsset(a, f, aurl, true)
+	return AssetCompress200JSONResponse(*aa), nil
+}
+
+// assetURLResolver returns a resolver of asset URLs for integrations.
+// Assets of projects whose assets are not public are resolved to signed URLs which expire.
+func assetURLResolver(ctx context.Context, assets asset.List) asset.URLResolver {
+	return adapter.Usecases(ctx).Asset.URLResolver(ctx, assets, adapter.Operator(ctx))
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
@@ -52,7 +53,11 @@ func (s Server) AssetCommentCreate(ctx context.Context, request AssetCommentCrea
 	}
 
 	threadID := asset.Thread()
-	_, comment, err := uc.Thread.AddComment(ctx, threadID, *request.Body.Content, op)
+	_, comment, err := uc.Thread.AddComment(ctx, interfaces.AddCommentParam{
+		ThreadID: threadID,
+		Content:  *request.Body.Content,
+		Mentions: integrationapi.FromCommentMentions(request.Body.Mentions),
+	}, op)
 	if err != nil {
 		return nil, err
 	}
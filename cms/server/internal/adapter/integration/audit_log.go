@@ -0,0 +1,54 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s Server) AuditLogList(ctx context.Context, request AuditLogListRequestObject) (AuditLogListResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	events, pi, err := uc.AuditLog.Search(ctx, interfaces.AuditLogFilter{
+		Project:     &request.ProjectId,
+		Model:       request.Params.ModelId,
+		Item:        request.Params.ItemId,
+		User:        request.Params.UserId,
+		Integration: request.Params.IntegrationId,
+		Types:       lo.Map(lo.FromPtr(request.Params.Type), func(t string, _ int) event.Type { return event.Type(t) }),
+		From:        request.Params.From,
+		To:          request.Params.To,
+	}, fromPagination(request.Params.Page, request.Params.PerPage), op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return AuditLogList404Response{}, err
+		}
+		if errors.Is(err, interfaces.ErrOperationDenied) {
+			return AuditLogList401Response{}, err
+		}
+		return AuditLogList400Response{}, err
+	}
+
+	items := lo.Map(events, func(e *event.Event[any], _ int) integrationapi.AuditLog {
+		return *integrationapi.NewAuditLog(e)
+	})
+
+	var totalCount int
+	if pi != nil {
+		totalCount = int(pi.TotalCount)
+	}
+
+	return AuditLogList200JSONResponse{
+		Items:      &items,
+		Page:       request.Params.Page,
+		PerPage:    request.Params.PerPage,
+		TotalCount: lo.ToPtr(totalCount),
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
 	"github.com/reearth/reearth-cms/server/pkg/key"
 	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
 )
 
 const maxPerPage = 100
@@ -45,9 +46,10 @@ func fromItemFieldParam(f integrationapi.Field) interfaces.ItemFieldParam {
 	}
 
 	return interfaces.ItemFieldParam{
-		Field: f.Id,
-		Key:   k,
-		Type:  integrationapi.FromValueType(f.Type),
-		Value: v,
+		Field:     f.Id,
+		Key:       k,
+		Type:      integrationapi.FromValueType(f.Type),
+		Value:     v,
+		Localized: lo.FromPtr(f.LocalizedValues),
 	}
 }
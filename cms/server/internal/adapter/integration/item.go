@@ -10,8 +10,11 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
@@ -33,7 +36,7 @@ func (s Server) ItemFilter(ctx context.Context, request ItemFilterRequestObject)
 	}
 
 	p := fromPagination(request.Params.Page, request.Params.PerPage)
-	items, pi, err := adapter.Usecases(ctx).Item.FindBySchema(ctx, ss.ID(), nil, p, op)
+	items, pi, err := findItems(ctx, ss, request.Params.Bbox, request.Params.Intersects, p)
 	if err != nil {
 		if errors.Is(err, rerror.ErrNotFound) {
 			return ItemFilter404Response{}, err
@@ -46,9 +49,10 @@ func (s Server) ItemFilter(ctx context.Context, request ItemFilterRequestObject)
 		return ItemFilter500Response{}, err
 	}
 
+	ac := assetContext(ctx, assets, request.Params.Asset)
 	return ItemFilter200JSONResponse{
 		Items: lo.ToPtr(util.Map(items, func(i item.Versioned) integrationapi.VersionedItem {
-			return integrationapi.NewVersionedItem(i, ss, assetContext(ctx, assets, request.Params.Asset))
+			return integrationapi.NewVersionedItem(i, ss, ac, lo.FromPtr(request.Params.Lang))
 		})),
 		Page:       request.Params.Page,
 		PerPage:    request.Params.PerPage,
@@ -83,7 +87,7 @@ func (s Server) ItemFilterWithProject(ctx context.Context, request ItemFilterWit
 
 	p := fromPagination(request.Params.Page, request.Params.PerPage)
 	// TODO: support sort
-	items, pi, err := adapter.Usecases(ctx).Item.FindBySchema(ctx, ss.ID(), nil, p, op)
+	items, pi, err := findItems(ctx, ss, request.Params.Bbox, request.Params.Intersects, p)
 	if err != nil {
 		if errors.Is(err, rerror.ErrNotFound) {
 			return ItemFilterWithProject404Response{}, err
@@ -96,9 +100,10 @@ func (s Server) ItemFilterWithProject(ctx context.Context, request ItemFilterWit
 		return ItemFilterWithProject500Response{}, err
 	}
 
+	ac := assetContext(ctx, assets, request.Params.Asset)
 	return ItemFilterWithProject200JSONResponse{
 		Items: lo.ToPtr(util.Map(items, func(i item.Versioned) integrationapi.VersionedItem {
-			return integrationapi.NewVersionedItem(i, ss, assetContext(ctx, assets, request.Params.Asset))
+			return integrationapi.NewVersionedItem(i, ss, ac, lo.FromPtr(request.Params.Lang))
 		})),
 		Page:       request.Params.Page,
 		PerPage:    request.Params.PerPage,
@@ -106,6 +111,23 @@ func (s Server) ItemFilterWithProject(ctx context.Context, request ItemFilterWit
 	}, nil
 }
 
+// findItems returns items of the schema. They are narrowed down by the area when bbox or intersects is specified.
+func findItems(ctx context.Context, s *schema.Schema, bbox, intersects *string, p *usecasex.Pagination) (item.VersionedList, *usecasex.PageInfo, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	sf, err := item.ParseSpatialFilter(lo.FromPtr(bbox), lo.FromPtr(intersects))
+	if err != nil {
+		return nil, nil, err
+	}
+	if sf == nil {
+		return uc.Item.FindBySchema(ctx, s.ID(), nil, p, op)
+	}
+
+	q := item.NewQuery(s.Project(), s.ID().Ref(), "", nil).WithSpatialFilter(sf)
+	return uc.Item.Search(ctx, q, nil, p, op)
+}
+
 func (s Server) ItemCreate(ctx context.Context, request ItemCreateRequestObject) (ItemCreateResponseObject, error) {
 	op := adapter.Operator(ctx)
 	uc := adapter.Usecases(ctx)
@@ -143,7 +165,7 @@ func (s Server) ItemCreate(ctx context.Context, request ItemCreateRequestObject)
 		return ItemCreate400Response{}, err
 	}
 
-	return ItemCreate200JSONResponse(integrationapi.NewVersionedItem(i, ss, nil)), nil
+	return ItemCreate200JSONResponse(integrationapi.NewVersionedItem(i, ss, nil, "")), nil
 }
 
 func (s Server) ItemCreateWithProject(ctx context.Context, request ItemCreateWithProjectRequestObject) (ItemCreateWithProjectResponseObject, error) {
@@ -191,7 +213,7 @@ func (s Server) ItemCreateWithProject(ctx context.Context, request ItemCreateWit
 		return ItemCreateWithProject400Response{}, err
 	}
 
-	return ItemCreateWithProject200JSONResponse(integrationapi.NewVersionedItem(i, ss, nil)), nil
+	return ItemCreateWithProject200JSONResponse(integrationapi.NewVersionedItem(i, ss, nil, "")), nil
 }
 
 func (s Server) ItemUpdate(ctx context.Context, request ItemUpdateRequestObject) (ItemUpdateResponseObject, error) {
@@ -232,7 +254,7 @@ func (s Server) ItemUpdate(ctx context.Context, request ItemUpdateRequestObject)
 		return ItemUpdate500Response{}, err
 	}
 
-	return ItemUpdate200JSONResponse(integrationapi.NewVersionedItem(i, ss, assetContext(ctx, assets, request.Body.Asset))), nil
+	return ItemUpdate200JSONResponse(integrationapi.NewVersionedItem(i, ss, assetContext(ctx, assets, request.Body.Asset), "")), nil
 }
 
 func (s Server) ItemDelete(ctx context.Context, request ItemDeleteRequestObject) (ItemDeleteResponseObject, error) {
@@ -251,6 +273,92 @@ func (s Server) ItemDelete(ctx context.Context, request ItemDeleteRequestObject)
 	}, nil
 }
 
+func (s Server) ItemDiff(ctx context.Context, request ItemDiffRequestObject) (ItemDiffResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	i, err := uc.Item.FindByID(ctx, request.ItemId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemDiff404Response{}, err
+		}
+		return ItemDiff400Response{}, err
+	}
+
+	ss, err := uc.Schema.FindByID(ctx, i.Value().Schema(), op)
+	if err != nil {
+		return ItemDiff400Response{}, err
+	}
+
+	diffs, err := uc.Item.Diff(ctx, interfaces.DiffItemParam{
+		ItemID: request.ItemId,
+		From:   version.Version(request.Params.From),
+		To:     (*version.Version)(request.Params.To),
+	}, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemDiff404Response{}, err
+		}
+		return ItemDiff400Response{}, err
+	}
+
+	return ItemDiff200JSONResponse{
+		Fields: lo.ToPtr(lo.Map(diffs, func(d item.FieldDiff, _ int) integrationapi.FieldDiff {
+			return integrationapi.NewFieldDiff(d, ss)
+		})),
+	}, nil
+}
+
+func (s Server) ItemBackReferences(ctx context.Context, request ItemBackReferencesRequestObject) (ItemBackReferencesResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	items, err := uc.Item.FindBackReferences(ctx, request.ItemId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemBackReferences404Response{}, err
+		}
+		return ItemBackReferences400Response{}, err
+	}
+
+	ss, err := uc.Schema.FindByIDs(ctx, lo.Uniq(lo.Map(items, func(i item.Versioned, _ int) id.SchemaID {
+		return i.Value().Schema()
+	})), op)
+	if err != nil {
+		return ItemBackReferences400Response{}, err
+	}
+
+	return ItemBackReferences200JSONResponse{
+		Items: lo.ToPtr(lo.Map(items, func(i item.Versioned, _ int) integrationapi.VersionedItem {
+			s, _ := lo.Find(ss, func(s *schema.Schema) bool { return s.ID() == i.Value().Schema() })
+			return integrationapi.NewVersionedItem(i, s, nil, "")
+		})),
+	}, nil
+}
+
+func (s Server) ItemRollback(ctx context.Context, request ItemRollbackRequestObject) (ItemRollbackResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	i, err := uc.Item.Rollback(ctx, interfaces.RollbackItemParam{
+		ItemID:  request.ItemId,
+		Version: version.Version(request.Body.Version),
+	}, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemRollback404Response{}, err
+		}
+		return ItemRollback400Response{}, err
+	}
+
+	ss, err := uc.Schema.FindByID(ctx, i.Value().Schema(), op)
+	if err != nil {
+		return ItemRollback400Response{}, err
+	}
+
+	return ItemRollback200JSONResponse(integrationapi.NewVersionedItem(i, ss, nil, "")), nil
+}
+
 func (s Server) ItemGet(ctx context.Context, request ItemGetRequestObject) (ItemGetResponseObject, error) {
 	op := adapter.Operator(ctx)
 	uc := adapter.Usecases(ctx)
@@ -273,15 +381,13 @@ func (s Server) ItemGet(ctx context.Context, request ItemGetRequestObject) (Item
 		return ItemGet500Response{}, err
 	}
 
-	return ItemGet200JSONResponse(integrationapi.NewVersionedItem(i, ss, assetContext(ctx, assets, request.Params.Asset))), nil
+	return ItemGet200JSONResponse(integrationapi.NewVersionedItem(i, ss, assetContext(ctx, assets, request.Params.Asset), lo.FromPtr(request.Params.Lang))), nil
 }
 
 func assetContext(ctx context.Context, m asset.Map, asset *integrationapi.AssetEmbedding) *integrationapi.AssetContext {
-	uc := adapter.Usecases(ctx)
-
 	return &integrationapi.AssetContext{
 		Map:     m,
-		BaseURL: uc.Asset.GetURL,
+		BaseURL: assetURLResolver(ctx, m.List()),
 		All:     asset != nil && *asset == integrationapi.AssetEmbedding("all"),
 	}
 }
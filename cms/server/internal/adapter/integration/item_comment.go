@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/reearth/reearthx/rerror"
@@ -49,7 +50,11 @@ func (s Server) ItemCommentCreate(ctx context.Context, request ItemCommentCreate
 	}
 
 	thId := i.Value().Thread()
-	_, comment, err := uc.Thread.AddComment(ctx, thId, *request.Body.Content, op)
+	_, comment, err := uc.Thread.AddComment(ctx, interfaces.AddCommentParam{
+		ThreadID: thId,
+		Content:  *request.Body.Content,
+		Mentions: integrationapi.FromCommentMentions(request.Body.Mentions),
+	}, op)
 	if err != nil {
 		return ItemCommentCreate400Response{}, err
 	}
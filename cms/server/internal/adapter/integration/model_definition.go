@@ -0,0 +1,74 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s Server) ModelDefinitionExport(ctx context.Context, request ModelDefinitionExportRequestObject) (ModelDefinitionExportResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	d, err := uc.Model.Export(ctx, request.ProjectId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ModelDefinitionExport404Response{}, err
+		}
+		if errors.Is(err, interfaces.ErrOperationDenied) {
+			return ModelDefinitionExport401Response{}, err
+		}
+		return ModelDefinitionExport400Response{}, err
+	}
+
+	res, err := integrationapi.NewModelDefinition(d)
+	if err != nil {
+		return ModelDefinitionExport400Response{}, err
+	}
+	return ModelDefinitionExport200JSONResponse(res), nil
+}
+
+func (s Server) ModelDefinitionImport(ctx context.Context, request ModelDefinitionImportRequestObject) (ModelDefinitionImportResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	if request.Body == nil {
+		return ModelDefinitionImport400Response{}, nil
+	}
+	d, err := request.Body.Definition()
+	if err != nil {
+		return ModelDefinitionImport400Response{}, err
+	}
+
+	dryRun := lo.FromPtr(request.Params.DryRun)
+	changes, err := uc.Model.Import(ctx, interfaces.ImportModelsParam{
+		ProjectID:        request.ProjectId,
+		Definition:       d,
+		DryRun:           dryRun,
+		AllowDestructive: lo.FromPtr(request.Params.AllowDestructive),
+	}, op)
+	if err != nil {
+		if errors.Is(err, interfaces.ErrDestructiveChanges) || errors.Is(err, interfaces.ErrFieldTypeChanged) {
+			return ModelDefinitionImport409JSONResponse{
+				Changes: lo.ToPtr(integrationapi.NewModelDefinitionChanges(changes)),
+			}, nil
+		}
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ModelDefinitionImport404Response{}, err
+		}
+		if errors.Is(err, interfaces.ErrOperationDenied) {
+			return ModelDefinitionImport401Response{}, err
+		}
+		return ModelDefinitionImport400Response{}, err
+	}
+
+	return ModelDefinitionImport200JSONResponse{
+		Changes: lo.ToPtr(integrationapi.NewModelDefinitionChanges(changes)),
+		Applied: lo.ToPtr(!dryRun && len(changes) > 0),
+	}, nil
+}
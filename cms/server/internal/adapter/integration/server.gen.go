@@ -43,6 +43,12 @@ type ServerInterface interface {
 	// Update AssetComment
 	// (PATCH /assets/{assetId}/comments/{commentId})
 	AssetCommentUpdate(ctx echo.Context, assetId AssetIdParam, commentId CommentIdParam) error
+	// Compress the extracted files of an asset.
+	// (POST /assets/{assetId}/compress)
+	AssetCompress(ctx echo.Context, assetId AssetIdParam) error
+	// Redeliver an event.
+	// (POST /deliveries/{deliveryId}/redeliver)
+	WebhookRedeliver(ctx echo.Context, deliveryId DeliveryIdParam) error
 	// delete an item
 	// (DELETE /items/{itemId})
 	ItemDelete(ctx echo.Context, itemId ItemIdParam) error
@@ -52,6 +58,9 @@ type ServerInterface interface {
 	// Update an item.
 	// (PATCH /items/{itemId})
 	ItemUpdate(ctx echo.Context, itemId ItemIdParam) error
+	// Returns items which reference an item.
+	// (GET /items/{itemId}/back-references)
+	ItemBackReferences(ctx echo.Context, itemId ItemIdParam) error
 
 	// (GET /items/{itemId}/comments)
 	ItemCommentList(ctx echo.Context, itemId ItemIdParam) error
@@ -64,6 +73,12 @@ type ServerInterface interface {
 	// Update Item Comment
 	// (PATCH /items/{itemId}/comments/{commentId})
 	ItemCommentUpdate(ctx echo.Context, itemId ItemIdParam, commentId CommentIdParam) error
+	// Returns changes of fields between two versions of an item.
+	// (GET /items/{itemId}/diff)
+	ItemDiff(ctx echo.Context, itemId ItemIdParam, params ItemDiffParams) error
+	// Rollback an item to an old version.
+	// (POST /items/{itemId}/rollback)
+	ItemRollback(ctx echo.Context, itemId ItemIdParam) error
 	// Returns a model.
 	// (GET /models/{modelId})
 	ModelGet(ctx echo.Context, modelId ModelIdParam) error
@@ -88,6 +103,18 @@ type ServerInterface interface {
 	// Create an new asset.
 	// (POST /projects/{projectId}/assets)
 	AssetCreate(ctx echo.Context, projectId ProjectIdParam) error
+	// Returns the audit log of the project.
+	// (GET /projects/{projectId}/audit-logs)
+	AuditLogList(ctx echo.Context, projectId ProjectIdParam, params AuditLogListParams) error
+	// Exports models and schemas of the project as a definition.
+	// (GET /projects/{projectId}/models/definition)
+	ModelDefinitionExport(ctx echo.Context, projectId ProjectIdParam) error
+	// Imports a definition of models and schemas into the project.
+	// (POST /projects/{projectId}/models/definition)
+	ModelDefinitionImport(ctx echo.Context, projectId ProjectIdParam, params ModelDefinitionImportParams) error
+	// Returns a list of deliveries of the webhook.
+	// (GET /webhooks/{webhookId}/deliveries)
+	WebhookDeliveryList(ctx echo.Context, webhookId WebhookIdParam, params WebhookDeliveryListParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -215,6 +242,42 @@ func (w *ServerInterfaceWrapper) AssetCommentUpdate(ctx echo.Context) error {
 	return err
 }
 
+// AssetCompress converts echo context to params.
+func (w *ServerInterfaceWrapper) AssetCompress(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetId", runtime.ParamLocationPath, ctx.Param("assetId"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AssetCompress(ctx, assetId)
+	return err
+}
+
+// WebhookRedeliver converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookRedeliver(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId DeliveryIdParam
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "deliveryId", runtime.ParamLocationPath, ctx.Param("deliveryId"), &deliveryId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deliveryId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WebhookRedeliver(ctx, deliveryId)
+	return err
+}
+
 // ItemDelete converts echo context to params.
 func (w *ServerInterfaceWrapper) ItemDelete(ctx echo.Context) error {
 	var err error
@@ -262,6 +325,13 @@ func (w *ServerInterfaceWrapper) ItemGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset: %s", err))
 	}
 
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lang", ctx.QueryParams(), &params.Lang)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lang: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.ItemGet(ctx, itemId, params)
 	return err
@@ -285,6 +355,24 @@ func (w *ServerInterfaceWrapper) ItemUpdate(ctx echo.Context) error {
 	return err
 }
 
+// ItemBackReferences converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemBackReferences(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "itemId", runtime.ParamLocationPath, ctx.Param("itemId"), &itemId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ItemBackReferences(ctx, itemId)
+	return err
+}
+
 // ItemCommentList converts echo context to params.
 func (w *ServerInterfaceWrapper) ItemCommentList(ctx echo.Context) error {
 	var err error
@@ -373,6 +461,58 @@ func (w *ServerInterfaceWrapper) ItemCommentUpdate(ctx echo.Context) error {
 	return err
 }
 
+// ItemDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "itemId", runtime.ParamLocationPath, ctx.Param("itemId"), &itemId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemDiffParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ItemDiff(ctx, itemId, params)
+	return err
+}
+
+// ItemRollback converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemRollback(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "itemId", runtime.ParamLocationPath, ctx.Param("itemId"), &itemId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ItemRollback(ctx, itemId)
+	return err
+}
+
 // ModelGet converts echo context to params.
 func (w *ServerInterfaceWrapper) ModelGet(ctx echo.Context) error {
 	var err error
@@ -448,6 +588,27 @@ func (w *ServerInterfaceWrapper) ItemFilter(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset: %s", err))
 	}
 
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lang", ctx.QueryParams(), &params.Lang)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lang: %s", err))
+	}
+
+	// ------------- Optional query parameter "bbox" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bbox", ctx.QueryParams(), &params.Bbox)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bbox: %s", err))
+	}
+
+	// ------------- Optional query parameter "intersects" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "intersects", ctx.QueryParams(), &params.Intersects)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter intersects: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.ItemFilter(ctx, modelId, params)
 	return err
@@ -562,6 +723,27 @@ func (w *ServerInterfaceWrapper) ItemFilterWithProject(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset: %s", err))
 	}
 
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lang", ctx.QueryParams(), &params.Lang)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lang: %s", err))
+	}
+
+	// ------------- Optional query parameter "bbox" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bbox", ctx.QueryParams(), &params.Bbox)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bbox: %s", err))
+	}
+
+	// ------------- Optional query parameter "intersects" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "intersects", ctx.QueryParams(), &params.Intersects)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter intersects: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.ItemFilterWithProject(ctx, projectIdOrAlias, modelIdOrKey, params)
 	return err
@@ -659,6 +841,175 @@ func (w *ServerInterfaceWrapper) AssetCreate(ctx echo.Context) error {
 	return err
 }
 
+// AuditLogList converts echo context to params.
+func (w *ServerInterfaceWrapper) AuditLogList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId ProjectIdParam
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectId", runtime.ParamLocationPath, ctx.Param("projectId"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuditLogListParams
+	// ------------- Optional query parameter "modelId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "modelId", ctx.QueryParams(), &params.ModelId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelId: %s", err))
+	}
+
+	// ------------- Optional query parameter "itemId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "itemId", ctx.QueryParams(), &params.ItemId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	// ------------- Optional query parameter "userId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userId", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	// ------------- Optional query parameter "integrationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "integrationId", ctx.QueryParams(), &params.IntegrationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter integrationId: %s", err))
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", ctx.QueryParams(), &params.PerPage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AuditLogList(ctx, projectId, params)
+	return err
+}
+
+// ModelDefinitionExport converts echo context to params.
+func (w *ServerInterfaceWrapper) ModelDefinitionExport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId ProjectIdParam
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectId", runtime.ParamLocationPath, ctx.Param("projectId"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ModelDefinitionExport(ctx, projectId)
+	return err
+}
+
+// ModelDefinitionImport converts echo context to params.
+func (w *ServerInterfaceWrapper) ModelDefinitionImport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId ProjectIdParam
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectId", runtime.ParamLocationPath, ctx.Param("projectId"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ModelDefinitionImportParams
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dryRun: %s", err))
+	}
+
+	// ------------- Optional query parameter "allowDestructive" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "allowDestructive", ctx.QueryParams(), &params.AllowDestructive)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter allowDestructive: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ModelDefinitionImport(ctx, projectId, params)
+	return err
+}
+
+// WebhookDeliveryList converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookDeliveryList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookIdParam
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, ctx.Param("webhookId"), &webhookId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhookDeliveryListParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", ctx.QueryParams(), &params.PerPage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WebhookDeliveryList(ctx, webhookId, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -693,13 +1044,18 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/assets/:assetId/comments", wrapper.AssetCommentCreate)
 	router.DELETE(baseURL+"/assets/:assetId/comments/:commentId", wrapper.AssetCommentDelete)
 	router.PATCH(baseURL+"/assets/:assetId/comments/:commentId", wrapper.AssetCommentUpdate)
+	router.POST(baseURL+"/assets/:assetId/compress", wrapper.AssetCompress)
+	router.POST(baseURL+"/deliveries/:deliveryId/redeliver", wrapper.WebhookRedeliver)
 	router.DELETE(baseURL+"/items/:itemId", wrapper.ItemDelete)
 	router.GET(baseURL+"/items/:itemId", wrapper.ItemGet)
 	router.PATCH(baseURL+"/items/:itemId", wrapper.ItemUpdate)
+	router.GET(baseURL+"/items/:itemId/back-references", wrapper.ItemBackReferences)
 	router.GET(baseURL+"/items/:itemId/comments", wrapper.ItemCommentList)
 	router.POST(baseURL+"/items/:itemId/comments", wrapper.ItemCommentCreate)
 	router.DELETE(baseURL+"/items/:itemId/comments/:commentId", wrapper.ItemCommentDelete)
 	router.PATCH(baseURL+"/items/:itemId/comments/:commentId", wrapper.ItemCommentUpdate)
+	router.GET(baseURL+"/items/:itemId/diff", wrapper.ItemDiff)
+	router.POST(baseURL+"/items/:itemId/rollback", wrapper.ItemRollback)
 	router.GET(baseURL+"/models/:modelId", wrapper.ModelGet)
 	router.GET(baseURL+"/models/:modelId/items", wrapper.ItemFilter)
 	router.POST(baseURL+"/models/:modelId/items", wrapper.ItemCreate)
@@ -708,6 +1064,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/projects/:projectIdOrAlias/models/:modelIdOrKey/items", wrapper.ItemCreateWithProject)
 	router.GET(baseURL+"/projects/:projectId/assets", wrapper.AssetFilter)
 	router.POST(baseURL+"/projects/:projectId/assets", wrapper.AssetCreate)
+	router.GET(baseURL+"/projects/:projectId/audit-logs", wrapper.AuditLogList)
+	router.GET(baseURL+"/projects/:projectId/models/definition", wrapper.ModelDefinitionExport)
+	router.POST(baseURL+"/projects/:projectId/models/definition", wrapper.ModelDefinitionImport)
+	router.GET(baseURL+"/webhooks/:webhookId/deliveries", wrapper.WebhookDeliveryList)
 
 }
 
@@ -964,67 +1324,148 @@ func (response AssetCommentUpdate404Response) VisitAssetCommentUpdateResponse(w
 	return nil
 }
 
-type ItemDeleteRequestObject struct {
-	ItemId ItemIdParam `json:"itemId"`
+type AssetCompressRequestObject struct {
+	AssetId AssetIdParam `json:"assetId"`
+	Body    *AssetCompressJSONRequestBody
 }
 
-type ItemDeleteResponseObject interface {
-	VisitItemDeleteResponse(w http.ResponseWriter) error
+type AssetCompressResponseObject interface {
+	VisitAssetCompressResponse(w http.ResponseWriter) error
 }
 
-type ItemDelete200JSONResponse struct {
-	Id *id.ItemID `json:"id,omitempty"`
-}
+type AssetCompress200JSONResponse Asset
 
-func (response ItemDelete200JSONResponse) VisitItemDeleteResponse(w http.ResponseWriter) error {
+func (response AssetCompress200JSONResponse) VisitAssetCompressResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ItemDelete400Response struct {
+type AssetCompress400Response struct {
 }
 
-func (response ItemDelete400Response) VisitItemDeleteResponse(w http.ResponseWriter) error {
+func (response AssetCompress400Response) VisitAssetCompressResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemDelete401Response = UnauthorizedErrorResponse
+type AssetCompress401Response = UnauthorizedErrorResponse
 
-func (response ItemDelete401Response) VisitItemDeleteResponse(w http.ResponseWriter) error {
+func (response AssetCompress401Response) VisitAssetCompressResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemDelete404Response struct {
+type AssetCompress404Response struct {
 }
 
-func (response ItemDelete404Response) VisitItemDeleteResponse(w http.ResponseWriter) error {
+func (response AssetCompress404Response) VisitAssetCompressResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemGetRequestObject struct {
-	ItemId ItemIdParam `json:"itemId"`
-	Params ItemGetParams
+type WebhookRedeliverRequestObject struct {
+	DeliveryId DeliveryIdParam `json:"deliveryId"`
 }
 
-type ItemGetResponseObject interface {
-	VisitItemGetResponse(w http.ResponseWriter) error
+type WebhookRedeliverResponseObject interface {
+	VisitWebhookRedeliverResponse(w http.ResponseWriter) error
 }
 
-type ItemGet200JSONResponse VersionedItem
+type WebhookRedeliver200JSONResponse WebhookDelivery
 
-func (response ItemGet200JSONResponse) VisitItemGetResponse(w http.ResponseWriter) error {
+func (response WebhookRedeliver200JSONResponse) VisitWebhookRedeliverResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ItemGet400Response struct {
+type WebhookRedeliver400Response struct {
+}
+
+func (response WebhookRedeliver400Response) VisitWebhookRedeliverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type WebhookRedeliver401Response = UnauthorizedErrorResponse
+
+func (response WebhookRedeliver401Response) VisitWebhookRedeliverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type WebhookRedeliver404Response struct {
+}
+
+func (response WebhookRedeliver404Response) VisitWebhookRedeliverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemDeleteRequestObject struct {
+	ItemId ItemIdParam `json:"itemId"`
+}
+
+type ItemDeleteResponseObject interface {
+	VisitItemDeleteResponse(w http.ResponseWriter) error
+}
+
+type ItemDelete200JSONResponse struct {
+	Id *id.ItemID `json:"id,omitempty"`
+}
+
+func (response ItemDelete200JSONResponse) VisitItemDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemDelete400Response struct {
+}
+
+func (response ItemDelete400Response) VisitItemDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemDelete401Response = UnauthorizedErrorResponse
+
+func (response ItemDelete401Response) VisitItemDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemDelete404Response struct {
+}
+
+func (response ItemDelete404Response) VisitItemDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemGetRequestObject struct {
+	ItemId ItemIdParam `json:"itemId"`
+	Params ItemGetParams
+}
+
+type ItemGetResponseObject interface {
+	VisitItemGetResponse(w http.ResponseWriter) error
+}
+
+type ItemGet200JSONResponse VersionedItem
+
+func (response ItemGet200JSONResponse) VisitItemGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemGet400Response struct {
 }
 
 func (response ItemGet400Response) VisitItemGetResponse(w http.ResponseWriter) error {
@@ -1104,6 +1545,48 @@ func (response ItemUpdate500Response) VisitItemUpdateResponse(w http.ResponseWri
 	return nil
 }
 
+type ItemBackReferencesRequestObject struct {
+	ItemId ItemIdParam `json:"itemId"`
+}
+
+type ItemBackReferencesResponseObject interface {
+	VisitItemBackReferencesResponse(w http.ResponseWriter) error
+}
+
+type ItemBackReferences200JSONResponse struct {
+	Items *[]VersionedItem `json:"items,omitempty"`
+}
+
+func (response ItemBackReferences200JSONResponse) VisitItemBackReferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemBackReferences400Response struct {
+}
+
+func (response ItemBackReferences400Response) VisitItemBackReferencesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemBackReferences401Response = UnauthorizedErrorResponse
+
+func (response ItemBackReferences401Response) VisitItemBackReferencesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemBackReferences404Response struct {
+}
+
+func (response ItemBackReferences404Response) VisitItemBackReferencesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
 type ItemCommentListRequestObject struct {
 	ItemId ItemIdParam `json:"itemId"`
 }
@@ -1272,6 +1755,90 @@ func (response ItemCommentUpdate404Response) VisitItemCommentUpdateResponse(w ht
 	return nil
 }
 
+type ItemDiffRequestObject struct {
+	ItemId ItemIdParam `json:"itemId"`
+	Params ItemDiffParams
+}
+
+type ItemDiffResponseObject interface {
+	VisitItemDiffResponse(w http.ResponseWriter) error
+}
+
+type ItemDiff200JSONResponse struct {
+	Fields *[]FieldDiff `json:"fields,omitempty"`
+}
+
+func (response ItemDiff200JSONResponse) VisitItemDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemDiff400Response struct {
+}
+
+func (response ItemDiff400Response) VisitItemDiffResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemDiff401Response = UnauthorizedErrorResponse
+
+func (response ItemDiff401Response) VisitItemDiffResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemDiff404Response struct {
+}
+
+func (response ItemDiff404Response) VisitItemDiffResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemRollbackRequestObject struct {
+	ItemId ItemIdParam `json:"itemId"`
+	Body   *ItemRollbackJSONRequestBody
+}
+
+type ItemRollbackResponseObject interface {
+	VisitItemRollbackResponse(w http.ResponseWriter) error
+}
+
+type ItemRollback200JSONResponse VersionedItem
+
+func (response ItemRollback200JSONResponse) VisitItemRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemRollback400Response struct {
+}
+
+func (response ItemRollback400Response) VisitItemRollbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemRollback401Response = UnauthorizedErrorResponse
+
+func (response ItemRollback401Response) VisitItemRollbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemRollback404Response struct {
+}
+
+func (response ItemRollback404Response) VisitItemRollbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
 type ModelGetRequestObject struct {
 	ModelId ModelIdParam `json:"modelId"`
 }
@@ -1633,6 +2200,194 @@ func (response AssetCreate404Response) VisitAssetCreateResponse(w http.ResponseW
 	return nil
 }
 
+type AuditLogListRequestObject struct {
+	ProjectId ProjectIdParam `json:"projectId"`
+	Params    AuditLogListParams
+}
+
+type AuditLogListResponseObject interface {
+	VisitAuditLogListResponse(w http.ResponseWriter) error
+}
+
+type AuditLogList200JSONResponse struct {
+	Items      *[]AuditLog `json:"items,omitempty"`
+	Page       *int        `json:"page,omitempty"`
+	PerPage    *int        `json:"perPage,omitempty"`
+	TotalCount *int        `json:"totalCount,omitempty"`
+}
+
+func (response AuditLogList200JSONResponse) VisitAuditLogListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuditLogList400Response struct {
+}
+
+func (response AuditLogList400Response) VisitAuditLogListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AuditLogList401Response = UnauthorizedErrorResponse
+
+func (response AuditLogList401Response) VisitAuditLogListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AuditLogList404Response struct {
+}
+
+func (response AuditLogList404Response) VisitAuditLogListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ModelDefinitionExportRequestObject struct {
+	ProjectId ProjectIdParam `json:"projectId"`
+}
+
+type ModelDefinitionExportResponseObject interface {
+	VisitModelDefinitionExportResponse(w http.ResponseWriter) error
+}
+
+type ModelDefinitionExport200JSONResponse ModelDefinition
+
+func (response ModelDefinitionExport200JSONResponse) VisitModelDefinitionExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModelDefinitionExport400Response struct {
+}
+
+func (response ModelDefinitionExport400Response) VisitModelDefinitionExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ModelDefinitionExport401Response = UnauthorizedErrorResponse
+
+func (response ModelDefinitionExport401Response) VisitModelDefinitionExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ModelDefinitionExport404Response struct {
+}
+
+func (response ModelDefinitionExport404Response) VisitModelDefinitionExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ModelDefinitionImportRequestObject struct {
+	ProjectId ProjectIdParam `json:"projectId"`
+	Params    ModelDefinitionImportParams
+	Body      *ModelDefinitionImportJSONRequestBody
+}
+
+type ModelDefinitionImportResponseObject interface {
+	VisitModelDefinitionImportResponse(w http.ResponseWriter) error
+}
+
+type ModelDefinitionImport200JSONResponse struct {
+	Applied *bool                    `json:"applied,omitempty"`
+	Changes *[]ModelDefinitionChange `json:"changes,omitempty"`
+}
+
+func (response ModelDefinitionImport200JSONResponse) VisitModelDefinitionImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModelDefinitionImport400Response struct {
+}
+
+func (response ModelDefinitionImport400Response) VisitModelDefinitionImportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ModelDefinitionImport401Response = UnauthorizedErrorResponse
+
+func (response ModelDefinitionImport401Response) VisitModelDefinitionImportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ModelDefinitionImport404Response struct {
+}
+
+func (response ModelDefinitionImport404Response) VisitModelDefinitionImportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ModelDefinitionImport409JSONResponse struct {
+	Changes *[]ModelDefinitionChange `json:"changes,omitempty"`
+}
+
+func (response ModelDefinitionImport409JSONResponse) VisitModelDefinitionImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryListRequestObject struct {
+	WebhookId WebhookIdParam `json:"webhookId"`
+	Params    WebhookDeliveryListParams
+}
+
+type WebhookDeliveryListResponseObject interface {
+	VisitWebhookDeliveryListResponse(w http.ResponseWriter) error
+}
+
+type WebhookDeliveryList200JSONResponse struct {
+	Items      *[]WebhookDelivery `json:"items,omitempty"`
+	Page       *int               `json:"page,omitempty"`
+	PerPage    *int               `json:"perPage,omitempty"`
+	TotalCount *int               `json:"totalCount,omitempty"`
+}
+
+func (response WebhookDeliveryList200JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WebhookDeliveryList400Response struct {
+}
+
+func (response WebhookDeliveryList400Response) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type WebhookDeliveryList401Response = UnauthorizedErrorResponse
+
+func (response WebhookDeliveryList401Response) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type WebhookDeliveryList404Response struct {
+}
+
+func (response WebhookDeliveryList404Response) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
@@ -1653,6 +2408,12 @@ type StrictServerInterface interface {
 	// Update AssetComment
 	// (PATCH /assets/{assetId}/comments/{commentId})
 	AssetCommentUpdate(ctx context.Context, request AssetCommentUpdateRequestObject) (AssetCommentUpdateResponseObject, error)
+	// Compress the extracted files of an asset.
+	// (POST /assets/{assetId}/compress)
+	AssetCompress(ctx context.Context, request AssetCompressRequestObject) (AssetCompressResponseObject, error)
+	// Redeliver an event.
+	// (POST /deliveries/{deliveryId}/redeliver)
+	WebhookRedeliver(ctx context.Context, request WebhookRedeliverRequestObject) (WebhookRedeliverResponseObject, error)
 	// delete an item
 	// (DELETE /items/{itemId})
 	ItemDelete(ctx context.Context, request ItemDeleteRequestObject) (ItemDeleteResponseObject, error)
@@ -1662,6 +2423,9 @@ type StrictServerInterface interface {
 	// Update an item.
 	// (PATCH /items/{itemId})
 	ItemUpdate(ctx context.Context, request ItemUpdateRequestObject) (ItemUpdateResponseObject, error)
+	// Returns items which reference an item.
+	// (GET /items/{itemId}/back-references)
+	ItemBackReferences(ctx context.Context, request ItemBackReferencesRequestObject) (ItemBackReferencesResponseObject, error)
 
 	// (GET /items/{itemId}/comments)
 	ItemCommentList(ctx context.Context, request ItemCommentListRequestObject) (ItemCommentListResponseObject, error)
@@ -1674,6 +2438,12 @@ type StrictServerInterface interface {
 	// Update Item Comment
 	// (PATCH /items/{itemId}/comments/{commentId})
 	ItemCommentUpdate(ctx context.Context, request ItemCommentUpdateRequestObject) (ItemCommentUpdateResponseObject, error)
+	// Returns changes of fields between two versions of an item.
+	// (GET /items/{itemId}/diff)
+	ItemDiff(ctx context.Context, request ItemDiffRequestObject) (ItemDiffResponseObject, error)
+	// Rollback an item to an old version.
+	// (POST /items/{itemId}/rollback)
+	ItemRollback(ctx context.Context, request ItemRollbackRequestObject) (ItemRollbackResponseObject, error)
 	// Returns a model.
 	// (GET /models/{modelId})
 	ModelGet(ctx context.Context, request ModelGetRequestObject) (ModelGetResponseObject, error)
@@ -1698,6 +2468,18 @@ type StrictServerInterface interface {
 	// Create an new asset.
 	// (POST /projects/{projectId}/assets)
 	AssetCreate(ctx context.Context, request AssetCreateRequestObject) (AssetCreateResponseObject, error)
+	// Returns the audit log of the project.
+	// (GET /projects/{projectId}/audit-logs)
+	AuditLogList(ctx context.Context, request AuditLogListRequestObject) (AuditLogListResponseObject, error)
+	// Exports models and schemas of the project as a definition.
+	// (GET /projects/{projectId}/models/definition)
+	ModelDefinitionExport(ctx context.Context, request ModelDefinitionExportRequestObject) (ModelDefinitionExportResponseObject, error)
+	// Imports a definition of models and schemas into the project.
+	// (POST /projects/{projectId}/models/definition)
+	ModelDefinitionImport(ctx context.Context, request ModelDefinitionImportRequestObject) (ModelDefinitionImportResponseObject, error)
+	// Returns a list of deliveries of the webhook.
+	// (GET /webhooks/{webhookId}/deliveries)
+	WebhookDeliveryList(ctx context.Context, request WebhookDeliveryListRequestObject) (WebhookDeliveryListResponseObject, error)
 }
 
 type StrictHandlerFunc func(ctx echo.Context, args interface{}) (interface{}, error)
@@ -1877,6 +2659,62 @@ func (sh *strictHandler) AssetCommentUpdate(ctx echo.Context, assetId AssetIdPar
 	return nil
 }
 
+// AssetCompress operation middleware
+func (sh *strictHandler) AssetCompress(ctx echo.Context, assetId AssetIdParam) error {
+	var request AssetCompressRequestObject
+
+	request.AssetId = assetId
+
+	var body AssetCompressJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AssetCompress(ctx.Request().Context(), request.(AssetCompressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AssetCompress")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AssetCompressResponseObject); ok {
+		return validResponse.VisitAssetCompressResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WebhookRedeliver operation middleware
+func (sh *strictHandler) WebhookRedeliver(ctx echo.Context, deliveryId DeliveryIdParam) error {
+	var request WebhookRedeliverRequestObject
+
+	request.DeliveryId = deliveryId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookRedeliver(ctx.Request().Context(), request.(WebhookRedeliverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookRedeliver")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WebhookRedeliverResponseObject); ok {
+		return validResponse.VisitWebhookRedeliverResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ItemDelete operation middleware
 func (sh *strictHandler) ItemDelete(ctx echo.Context, itemId ItemIdParam) error {
 	var request ItemDeleteRequestObject
@@ -1959,6 +2797,31 @@ func (sh *strictHandler) ItemUpdate(ctx echo.Context, itemId ItemIdParam) error
 	return nil
 }
 
+// ItemBackReferences operation middleware
+func (sh *strictHandler) ItemBackReferences(ctx echo.Context, itemId ItemIdParam) error {
+	var request ItemBackReferencesRequestObject
+
+	request.ItemId = itemId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemBackReferences(ctx.Request().Context(), request.(ItemBackReferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemBackReferences")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemBackReferencesResponseObject); ok {
+		return validResponse.VisitItemBackReferencesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ItemCommentList operation middleware
 func (sh *strictHandler) ItemCommentList(ctx echo.Context, itemId ItemIdParam) error {
 	var request ItemCommentListRequestObject
@@ -2073,6 +2936,63 @@ func (sh *strictHandler) ItemCommentUpdate(ctx echo.Context, itemId ItemIdParam,
 	return nil
 }
 
+// ItemDiff operation middleware
+func (sh *strictHandler) ItemDiff(ctx echo.Context, itemId ItemIdParam, params ItemDiffParams) error {
+	var request ItemDiffRequestObject
+
+	request.ItemId = itemId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemDiff(ctx.Request().Context(), request.(ItemDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemDiff")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemDiffResponseObject); ok {
+		return validResponse.VisitItemDiffResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemRollback operation middleware
+func (sh *strictHandler) ItemRollback(ctx echo.Context, itemId ItemIdParam) error {
+	var request ItemRollbackRequestObject
+
+	request.ItemId = itemId
+
+	var body ItemRollbackJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemRollback(ctx.Request().Context(), request.(ItemRollbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemRollback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemRollbackResponseObject); ok {
+		return validResponse.VisitItemRollbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ModelGet operation middleware
 func (sh *strictHandler) ModelGet(ctx echo.Context, modelId ModelIdParam) error {
 	var request ModelGetRequestObject
@@ -2305,47 +3225,191 @@ func (sh *strictHandler) AssetCreate(ctx echo.Context, projectId ProjectIdParam)
 	return nil
 }
 
+// AuditLogList operation middleware
+func (sh *strictHandler) AuditLogList(ctx echo.Context, projectId ProjectIdParam, params AuditLogListParams) error {
+	var request AuditLogListRequestObject
+
+	request.ProjectId = projectId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AuditLogList(ctx.Request().Context(), request.(AuditLogListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuditLogList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AuditLogListResponseObject); ok {
+		return validResponse.VisitAuditLogListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ModelDefinitionExport operation middleware
+func (sh *strictHandler) ModelDefinitionExport(ctx echo.Context, projectId ProjectIdParam) error {
+	var request ModelDefinitionExportRequestObject
+
+	request.ProjectId = projectId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ModelDefinitionExport(ctx.Request().Context(), request.(ModelDefinitionExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ModelDefinitionExport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ModelDefinitionExportResponseObject); ok {
+		return validResponse.VisitModelDefinitionExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ModelDefinitionImport operation middleware
+func (sh *strictHandler) ModelDefinitionImport(ctx echo.Context, projectId ProjectIdParam, params ModelDefinitionImportParams) error {
+	var request ModelDefinitionImportRequestObject
+
+	request.ProjectId = projectId
+	request.Params = params
+
+	var body ModelDefinitionImportJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ModelDefinitionImport(ctx.Request().Context(), request.(ModelDefinitionImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ModelDefinitionImport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ModelDefinitionImportResponseObject); ok {
+		return validResponse.VisitModelDefinitionImportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WebhookDeliveryList operation middleware
+func (sh *strictHandler) WebhookDeliveryList(ctx echo.Context, webhookId WebhookIdParam, params WebhookDeliveryListParams) error {
+	var request WebhookDeliveryListRequestObject
+
+	request.WebhookId = webhookId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookDeliveryList(ctx.Request().Context(), request.(WebhookDeliveryListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookDeliveryList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WebhookDeliveryListResponseObject); ok {
+		return validResponse.VisitWebhookDeliveryListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbX3PbuBH/Khi0j4zlNHcvenPtXMdtc+epk+lDJuOBiaWEMwnwANCOTqPv3sGCpCj+",
-	"p6zUsaKXxBIBaP/89re7ILCmoUpSJUFaQ+drmjLNErCg8RMzBuw1v3Ffus8cTKhFaoWSdE6vr4iKiF0C",
-	"MRBDaIETnEADKtzzlNklDahkCdB5sRYNqIY/MqGB07nVGQTUhEtImFvfrlI31Fgt5IIG9OubhXqTfyn4",
-	"2QUucUU3m8Av1yHYbQqhiAQY8rQEuwTt5SKcWUaYBgLJPXAOnAiJ8mswWWxNIfgfGehVTXJalfOvGiI6",
-	"p3+ZbY0380/NDEe/xx9wSjhZQ5UkICcZMp/SbspyvecY8zJfxJtTWEimyOfGtwvnV3qOZNduBS9WojjE",
-	"1/w3/S9Y9QinyQOsChlxTuHZVKvfIeywY3X1vQXGRc6ur/wqFaEHjTlZ0OcY9QMu4a2asgV0SPfJACdW",
-	"5Y72krEFdERG/mgrBIeIZbGl87cBTYQUSZbg34Uc0sICtBcC9M3B5PBrtYvy83lAE/Y1l+X8fFgy7woH",
-	"jItYMNMLPOZGFB7tdWJ92b29mS+EmPMr7Ug9PojzKeRJ2GUOw/LZk9IPJmUhDCjTq0UNgzf5JI9CDdE4",
-	"5zOiIXK2fgTdAQDHx63OpzGzYJxHQDqPf95+kWb3sQjpl6BmbCebUdpeCT0gH4dISEC7Kc1BEy40hG5Q",
-	"YWoNJlXSAImFsQF5EnFM7oGIhVTasWhUmSwMkcqSVIMBaYF3qMqF7lDVCVlRlOEn/LJTx6kKtqnVIadb",
-	"vkPQUAOzwC+qbql+l6U8/7tFcESO/3msUT5Jltml0uJP4O+1VrqpzkUYgjHEqgeQzsyJMEbIhYOUkI8s",
-	"FtwbxGfwsvDBekirFLQV/reYDpfiEd5/tZqhn28tsxk+KvRIQXIfskLepVotNBgX6lxJF0oREzHwFr1c",
-	"lSAtSPsRv1+3PC8tNF/TSOmEodOZhTdWJG7xxpRIxDBUr+AYVwHwKRVY4eYWOVMNjwKeCj0Kw4gk52/3",
-	"/515dKsvQPl/797xu48iBpN/TB4dDjDt3b1zkZDJB6meZKvhtlw0rECFggJqlWXxrfizqofMknuXB6oo",
-	"HG3vTMctJtlUKfKzM3Sww59uVjAYAOoeU0tR+m4rzIqNWexWciyMUIsNdCDNF5dNfGMkDRuSyRUKgsPr",
-	"js5MTtMWFtoyF4I9cD8U1EfBd7fmbRg2EhDzplVGLf2Lm+th9QCrVq1sbqi+aHxkcQZo0U1A8QOdrzuE",
-	"9bG9K2u4FDHXIFFuC4kZG/6FZ7VmqzFs1B3+rk5oe2DaA61NNwz8FuWmw2InEaz3hE1ZO3f7NmbGflDc",
-	"dZ18vHQ9HLonpeVFzXbWvVIxMEnL/DZqzVs/9GovHmzz6BbXFaaw8NWxnfvvQgNzpaQIlx/9twnTD9xR",
-	"flA24O43aYAqucoCq0M3ny0KvkHm0RCBBomlq+PWNvZ5BG2EksBds3kQpCF5mAlx57imJfBGIbJokctm",
-	"syMUdbGvU8pUKpNlmIg6WGorj4Zod4XBGXskztwdIwRsgsshG8JMC7tC2HoP3gPToC8yT0ZodQwH/Hq7",
-	"7NLa1BeVQkaqWTr+B94zbZdvLj/ckmvMaAzr+4uba7eIsI6EB0aVytG3Z+dn505flYJkqaBz+u7s/Owd",
-	"9bSJgvsNJDNb5xtmGy9UDBZjx6EUF3c+p1iPXfmHtbr4b+fniORtmmVpGosQJ89+N97a2/J8j5xX3Y+r",
-	"O6XOvT6Ije8YNgH9yYtX61F9NU5cuQTGknI3kvhUiPPedoVWqf6s2RPgzJ+av/irsiRSmfQdgGUL44gJ",
-	"FTP0y8ZVo7bD7P9ATnqWzQf3EY/IkNWd5c/tv7sdMtvZed64+Y24mOVlLFq+2015zfdv36keMESqPz+K",
-	"8ouyu0GZo8On2Bb+zr1f0DE6ukrEn79svtTAUar0fJAENFVmAAaXmNjzTSsw9u+Kr56Fga4mpt2nu1tl",
-	"m29IHyXYmlA6Otz0ssNsXb4uGU6lOUpeLKP2NqhNT3pdCJNkhx5OxLBDDMHg+NoLOqQSZsNlP0o+pfyH",
-	"5xJvA3JxJAg0WZIwvaooVvE3HaAgLAJma/8CtJdrXPv2YhxTeb06gWDwhe+r92pNn61Dr7GAqxT89TbQ",
-	"ZlqaYuIZDVo86huCaUxVvgQbwVKVIw9Oq28W7rt7Iy2ouPie4RDQn9tlsqAli4kB/QiagF9vCnhqIDBn",
-	"rfiZ5v/quYudtNNKs73wO3A6Kl+BTTnscqhtsJfOeKcQ6M2KFRzWA6CZCIf7dDf3SNp09wvH1aVf7l+G",
-	"N8ittT2veP/Unb/+7vyyryie3JVXwPH6mvIqGZxo4PDNeAUcp1682osfA/AaRYfzNmm24jW+wfehZrbO",
-	"34tuKkVHR0vnz+E2a2p86/+NX/L4gw5t9SX55+1vvxKsPIiKSGZAE8kSMD9s17X1U+F69NA+jLRzQtu/",
-	"XarjZlbWigPocTWe8xCOx6O0JBKxBUcXhEnuT04KuWjv2n7BsZP3DbaHN0cw6s5p1hHjt+fDxwyunuMe",
-	"Mf6ldjxqib/w7qiWoNYONo884Nn0+br3ZHl55H14IB5MvFSZ16sce956XH2wIDlxSZNLdqL2EBs5NUrp",
-	"bXYO2+X8KDsu3xWKp6DOHybr2XR2+Sc/8Wdm6/o9kU09N+FNowMUNv8VdnlT3lg51TjHVeO032IakXSb",
-	"d+58hTQZof/fEmoXzadq6lRNnaqpF6+m8oD0D16axIZrsnpGPJVnR1uedSG0I9Nt8gNmE7JZfk53UjrD",
-	"cyVHtiXwkqkmP0T9ylLMazjh/Yws4dU7owc4Jl679F7l+F3hL/MejEh4yo+quUjUKBzeac6v/PiHHZF5",
-	"4M7dPIj0CpxmGkxx/6W8Jo33NwMqszhm9zEUt+ub97o6b502L8okWWxFyrSdRUonbzizrD93+TuG5Z2c",
-	"eyEZXvIe8VZkc7oc8czQuSw3DkrUtkbNZrP5XwAAAP//5v41kshHAAA=",
+	"H4sIAAAAAAAC/+xdUXfbNrL+Kzi895Gx3Nvuw819cu3sHt9t2pw42X1IfXIgciShJgEWAG2rPvrvezAA",
+	"SJACJVJWmtj1S2KRIDjAfJgZzAyGD0kmykpw4Folrx+SikpaggaJv6hSoC/zd+ai+Z2DyiSrNBM8eZ1c",
+	"XhCxIHoFREEBmYac4ANJmjBzv6J6laQJpyUkr31fSZpI+L1mEvLktZY1pInKVlBS079eV6ap0pLxZZIm",
+	"96+W4pW7yPKTM+ziItlsUtvdAGFXFWRswUCRuxXoFUhLF8mppoRKIFDOIc8hJ4wj/RJUXWjlCf+9Brnu",
+	"UZ6EdP63hEXyOvmvWTt5M3tXzbD1G3yBGYShdT4X9wOkvgddS66I4MWaMA2loVkoIEsQJWhpBiEc/YqI",
+	"W5AFrZDmuai5eQOZi3s/joWQpeHJr8kdKJ0qUetVClTplAupV78mAyM0BCbDjDBjyERZAp8EBvdIHA5N",
+	"f48BxLnrxEIih4LdglyPoPEO5ishboh/JE5j2+FjiPy3fdeF78wSy7hZZJBpdXRk/APE/1/98rNvuB5g",
+	"ekvBHtabl0/hu2kfn1Db02Mm89L0YGewoHy5Z+5uaVGbaVqQQmS0YH9AThYMilz5FYPXgag6WxGqyK8J",
+	"8F+TE/Kv5kHTKIcFrQvtGxsJIvENkBsJ49ae6ZesqCJc2Bf7x+1jJwNsMMPYw4BS5FBc5r/If8J6Bxsk",
+	"uYG1fyk+40dZSfEbZAMrMez9YNZgJyeXF7aXgOi9sJlM6GPg8xa7sPip6BIGqPuoICdaOEhbyugSBjjo",
+	"brVEOLwkr79Lk5JxVtYl/u3p4BqWIC0RIN8djQ7bV5yUv52mSUnvHS2np/sps6wwwDgrGFU7gUdNC8/R",
+	"nUzsd3swN11HiDnbU4fq8eLKPULumF45GDb37oS8URXNYM9gdo6ih8F37iGLQgmLccynRMLCzPUtyAEA",
+	"GKskyvykoBqU4Qhww/FP7YWqnhcsS67TiNxRQuoLJvfQl8OCccB5EzIHSXImITON/FRLUJXgCkjBlE7J",
+	"HSsKMgfCllxIoy8WwcPMyE9NKgkKuIZ8YKg5kwNDNUQGA6X4Cy8OjnHqAGPDGqDTdD9AaCaBasjPQraE",
+	"1+oqd39HCXcmzARjx/3ERS4pNouiuun5CFYPohxhbucKtxUfOa31Skijj99IKeQ29WdZBkoRLW6AG0yU",
+	"TClj7ApJGL+lBcst96zR3exVcAsjRQVSM/suKrMVu4U391pSBOWVprrGW37SK0BDGifjcyXFUoIycikX",
+	"3Kz7BWUF5BEmWMN+m/YfQ8vcTfoShKqoZrSwu5Cewf4J7XViDXaCFjtBk/06sfYXEmxaU8S4qOeFoc1R",
+	"xOtybsR2c4FKSdeJNdvNUlKQf5RFBOLvf/IU/sEqsmCFIZWDNMgjCylKvAd29tB6KkAlkanwL+rMcG9b",
+	"htcPe9/jeZUJroHrD3j9IXK/WXqdmaYaXmlWQmzMhrh920FsY4zofMoG16/FCJ2VhFsGd34cfmJY6QwD",
+	"8/9ndWt6X4Kw/37+Pv/8wc2k+VneGgGD9tTn780yr/kNF3c8OnGtkts/gEC3pYkWmhZX7I9wHC1SW/E2",
+	"er7rPRBGEXBCLrWRGJQotjQWumlwt2LZisB9xaT1CvBm49SxWdC2R/2DavEkiUneViZ+MmxNO2aAoTHd",
+	"K8fFHC0k78do3QUBR2lhejJiF4FdKIiyh9Y50z+J5bboOwDRo1D65tbtue0u1umSUfi4DNrbDuyOcPzG",
+	"z1ix2YrxEFRzIQqgPGl3HVO2Ao9B+JAwqRXIUd19NA2tJbgFDe8/2dZpqD3390/52iLENO8LDENi4jmo",
+	"qVlMO8TmsUTmKIAFbp00MX8xwdU+Qetm661vvmtK3wZ9dqe2A2h7xWvfqdDuq2KLiUl9enT0O4sNDf0P",
+	"kQGNmfC/m2fti25gHeV140CxrhGEYZ4zM1xavAtfuUl7Erp1ptC+H4bcwBpyMl87J4ny0luUTGvvXiko",
+	"X5qLyvl180AutxPgF+MukKBfBlfCJk3whyF4cDov2GIRWX55Djmq6knMDJR7HxrY43tYgASewTTYNXKx",
+	"3+mxOM/h7l9+ptJEFHnwS0Ipbo88Ga7PY0/HRHjEMWHtvZ6aXbEil8A7ZI4xCbdt9d0W6rBJaLZvsRsq",
+	"bnzFxoaK8yg2RGfxPxyoAgLtPCiRqNJvRY4CYTx1O+zqA40A50GJmiOW5aP6vLJNLw6yjQc5egELxpnn",
+	"RVxi2519V2a/E1LTeQHW+cG8Nwf7VITynDg0n5ArsL6RW5Bm90e+I5biwHvuezDIj8ruHq3nK8qXkaWW",
+	"g9KyzjS7HTD/UGr/cwAw+JKhm7pnHtE8b2z3xAuluCdmazStGAk61HBvdgPmvzMJ1PTJstUHe7Wk8iY3",
+	"G7C0iTa6F5vBJWlinYDmebr0Vhvab9KLyWbvEYR9llLUVdSsc8yC3AjMoyx7G1eZIASNuXKo2gp2A0MG",
+	"P8pF6ePa2z6Uusa92wAQQnW02KWBYobe9J2tY8cIAmN4u+tGGCMWi9ZQVno8d3o9ntnno0prOlLg1gZ+",
+	"J+008aFB1TgKNJFArLFu7rUb3pQxVHRdCBqHnRrwfwmOIUHnwkqJqrMMIIc8JdIsWMaXKFlzoFFkHu4x",
+	"2breunineXL3g89DJYLBCQjxTuGI0tfAs/X25LobhHFSsqJgCjLB88BV2cS2PIPORR6CKYx99QZpHoGs",
+	"lkyvUUvbAc2BSpBntbW9cOWgNsLL7XtXWlfWB874QsRi1W+o1KtX52+vSLCHJGfvLk0nTBubc0+rRnwk",
+	"352cnpyaMYoKOK1Y8jr5/uT05PvEWolIuE1RUbMHl5KzsUQVoHE+DNMaj06ChvqFvdlz4//P6SnqitZD",
+	"QKuqYBk+PPtNWXnWRgwO2JiGGT99pmztM51HD6MxmzT5wZLXi4rY4AGR8HsNSpMm38mG7O1z3w2Jx2b4",
+	"s+0QBj75w/YbfxaaLETNbcBC06Uy1oDbMV1vjL7WA9P+DzQEHjXnezOVntFEhrlrn+LvbZvMOrltG/P8",
+	"1rrwPiWc+WE2OXfVTzYKeMQlEr5+lNr2HsMRDqMBrvukrW+c+14cI6NDQfzpenPdA0czpMeDJE0qofbA",
+	"4BwNIhc6BaV/FPn6URgY9r8e0TXajWuYHeHmC0qeBqfbKHx2kNspWGYPTR7kfi3sAPbVlHE323KvPLFj",
+	"IdSF2kggnF5kSiNT0r3te5m3KIWozla7UfKx9Vx8WTH0DcsSOwfk7JkgUNVlSc32vhlYwO/kABGECRv9",
+	"rP9HKMWeC5FmN7FsDgwEceIScyxrUkwiJAtRYA4YJseUKWFcC0LbVBGlMWGMFoIvFcshjPp/WAEJklCI",
+	"rDlm+c5pdrOUZo5xc820Inb/R5giEiohtT0IsJXB0k0rSNKB1Va5FJTjLDTvy+/PpV55ctwkadFQbAcv",
+	"BYKpCDLU+jPPFGkTgmxszQXazPB4XRR0brab1iM8YqFvvsb25OmvX4+aXcvDg25ro2NWszuZwEDNHtpT",
+	"CpuZBPdr+qrun54YXthXwHPlkpHRC9Z6+m0XhC4pM0MglHC4C67z3GXO2+fDm9sLzDme3jdj+oJg67tQ",
+	"I7DrE/z0YdjMrAEcOlhDwLnp95DDDejswSbs7DRWLzWUX81IDQ6ITLBQ8cjKk+dnbzwtKy/ReRA4m+LH",
+	"ZdyD2yvRPG+dUdNkSpPcPsLMDQ70jWjdHv4xU/DFxEI3TBaB0Nm3jJ00+VucJg2S04IokGbxW2/7RMnR",
+	"QYw6iYJtGljCY2adTU7UqN+J1SNvfprU8innPo8VEf3a+6uXJbBzDxbgsL8AtrXmzGxGXslOZtNOiYzH",
+	"B/Gojk+vQPvQHwdl2Yo0vdnDHRpKMl8HVy0K4+vkR5rdBHlWx9XWHvOjwN9D2SH+68udk/IcrDWLiTjz",
+	"d+DwcYI4guK9YRHz5mcSFcEF9ayCIueHuy63VHQ0GhJw/yUY8pcOhpx3fZADYmRsECTA1dOLgYRy5EWC",
+	"HD/2EYDjJfQRhj6eA/C2rG7DbbId+dgtb3J3mmOnxZ1h6jFa2a5SyBz0HQAn+k50jPCdO1A8ObK1OLqv",
+	"/BAkTTtvPpWAp2AHTpO7W8OHsvcmj46kQQsbU+juPQhTpFb2xH4QN4hRqkUyha7ro0ryQzbeyLCDLMTz",
+	"PmKez1bjoMXwxbcfUhSF2UhPj3EM2a89hqLR6oMXHvvtILFSiD3y0J5s40QUuW8clwnvPd3H0kyTssbD",
+	"U8v+wesX39KftaIc7xsMaRHBTMyDZI/czB7cQYfNXg1G7SmdbQzimaovnFNqj5HFOEmwJhnKVbNgagWS",
+	"GGWh/rK+85ZPnu/IoUNkZqfYlhWafdzMGl24Bz0FUzpwMhpZt2CFBunPJ2ARHMaXcSn3d2w7OVTU1uEZ",
+	"sS3oFCYa0b4t9TWmcViSa0T7PyXItb9xW2RyRON+9cEjG2BH9f3aSm2mk10Vy5pSavsbYl2Sc1HbcTVt",
+	"T9MxR0FeBNsIwdYRIcewCXvybafn8bgux79KEO+bQvEU1NkDiDuSHowydIe71eyhX39w01eUWMHyCFbW",
+	"v5levWsqIb4YXM/L4IpXxxyhebdruVpzbTJC/1x7rovmF9PuxbR7Me1eTLtQlDrpYG98bYm630Dsq+cX",
+	"W/HZ2opDCB1Quxt3VmaCanUHpifpVsznf2bOkq+patxxkSemYp7CUftHaAk7vOgxloP1w7jwjYve2IN/",
+	"7bkTDNu4mjW7D3Yd142gblh1AcERs04tcKzuOngAK6hvFa/nEq1YUtaFZhWVerYQsnyVU0136y5b264J",
+	"JM0ZpxjR/VaPgT2rpXPeeDEa1A4e/orrrDpn+lUhlvv1Fp702ap87D+BEsR8Ue6mbhGn0eJvP8OdsSht",
+	"j5kogSyYVPqE/MKLNSkp45oyDnLrdRnlRLnKcUg7KURMS7rqxi6NtCcyYpkH7VdCDvkqSLzP5rs1B3yn",
+	"Zud3fTwrKlcBfb7GCWlq826T4ioLTyClLTF8CCmRrwVEviPU1oCeMkfdirkj6bMwMr2o4LM9mHxgC2Th",
+	"V67gviqwvpOVpNEUFUNISO7YCm/jCLUJ2iLLaolnljUecV4Y2YMDsKWvdmT5RLJndlaAPICoOSyEhH30",
+	"DOXy7KHmmZqZvtz6k7M0vZB9PnZmR3f0FExHezqeHcXsHNTAzkubdyqu7tlAVvEyq7QovLbtf5+AN18w",
+	"s3eYbJXxh261VaNi50BY2dQ4wKQTgV9EDKYpEr9oS7G+ua/sJ2y+bAQjqFMbwa79Rlgws08ewnZaVcSm",
+	"2uK4wUk79ONGL/btpd7SGxhDJH40i5ZgqO3W/D0hb9unHXKpBFJSna0aM4Pht+vUCfHJlP54Uyluwb/f",
+	"p90x6WIZQoY9IgIht/rOfuKjKMTdRVszmDBFjD1wQj6g+dCmNWaUc6HNarHmb/5//t3tp/3MAGieE+p+",
+	"Izl+Od3AmjCuNNB875K6LN2S2pkdHAo5b5ObV4pa41CxXKhewVCucC7X72ses8ea/eS2zXBmZqzdA1gu",
+	"FEKFqY5WTQ58KrU347tff3347nqiQNkc1WJwSIuXoHZzN9qgiJe+PigTOdi6lf1F961GLH44/d/HnM34",
+	"epPdV7YCd7qKBDXKe+vIf1sIF4kRVDLcbOuuSJqmTqxI6WqKeL12awUMGEqNQjFGjiuJomYPTbXgTVB4",
+	"ZoJ7vH2o90k67z8IGgQ+hKGCML5IS9wn8Dy3HVv1aZ7Y7qP3wWXD6efq8x5Ge7y4zlQE9z78uLnebDb/",
+	"CQAA//9Yxio2x30AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
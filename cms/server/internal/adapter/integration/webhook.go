@@ -0,0 +1,65 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s Server) WebhookDeliveryList(ctx context.Context, request WebhookDeliveryListRequestObject) (WebhookDeliveryListResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	if op.Integration == nil {
+		return WebhookDeliveryList400Response{}, interfaces.ErrInvalidOperator
+	}
+
+	deliveries, pi, err := uc.Integration.FindWebhookDeliveries(ctx, *op.Integration, request.WebhookId, fromPagination(request.Params.Page, request.Params.PerPage), op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return WebhookDeliveryList404Response{}, err
+		}
+		return WebhookDeliveryList400Response{}, err
+	}
+
+	items := lo.Map(deliveries, func(d *integration.Delivery, _ int) integrationapi.WebhookDelivery {
+		return *integrationapi.NewWebhookDelivery(d)
+	})
+
+	var totalCount int
+	if pi != nil {
+		totalCount = int(pi.TotalCount)
+	}
+
+	return WebhookDeliveryList200JSONResponse{
+		Items:      &items,
+		Page:       request.Params.Page,
+		PerPage:    request.Params.PerPage,
+		TotalCount: lo.ToPtr(totalCount),
+	}, nil
+}
+
+func (s Server) WebhookRedeliver(ctx context.Context, request WebhookRedeliverRequestObject) (WebhookRedeliverResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	if op.Integration == nil {
+		return WebhookRedeliver400Response{}, interfaces.ErrInvalidOperator
+	}
+
+	d, err := uc.Integration.RedeliverWebhook(ctx, *op.Integration, request.DeliveryId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return WebhookRedeliver404Response{}, err
+		}
+		return WebhookRedeliver400Response{}, err
+	}
+
+	return WebhookRedeliver200JSONResponse(*integrationapi.NewWebhookDelivery(d)), nil
+}
@@ -2,13 +2,18 @@ package publicapi
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
 )
 
 var contextKey = struct{}{}
@@ -41,7 +46,7 @@ func PublicApiItemOrAsset() echo.HandlerFunc {
 		if m == "assets" {
 			res, err = ctrl.GetAsset(ctx, p, i)
 		} else {
-			res, err = ctrl.GetItem(ctx, p, m, i)
+			res, err = ctrl.GetItem(ctx, p, m, i, c.QueryParam("lang"))
 		}
 
 		if err != nil {
@@ -64,6 +69,9 @@ func PublicApiItemList() echo.HandlerFunc {
 
 		res, err := ctrl.GetItems(ctx, c.Param("project"), c.Param("model"), p)
 		if err != nil {
+			if errors.Is(err, repo.ErrUnsupportedPagination) {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+			}
 			return err
 		}
 
@@ -121,9 +129,62 @@ func listParamFromEchoContext(c echo.Context) (ListParam, error) {
 
 	return ListParam{
 		Pagination: p,
+		Keyword:    c.QueryParam("q"),
+		Filters:    filterParamsFromEchoContext(c),
+		Sort:       sortParamFromEchoContext(c),
+		Fields:     splitParam(c.QueryParam("fields")),
+		BBox:       c.QueryParam("bbox"),
+		Intersects: c.QueryParam("intersects"),
+		Lang:       c.QueryParam("lang"),
 	}, err
 }
 
+var filterParamRe = regexp.MustCompile(`^filter\[([^\[\]]+)\](?:\[([^\[\]]*)\])?$`)
+
+// filterParamsFromEchoContext reads filters in the form of "filter[key]=value" or "filter[key][op]=value"
+func filterParamsFromEchoContext(c echo.Context) []FilterParam {
+	var res []FilterParam
+	for k, values := range c.QueryParams() {
+		m := filterParamRe.FindStringSubmatch(k)
+		if m == nil {
+			continue
+		}
+		for _, v := range values {
+			res = append(res, FilterParam{
+				Key:      m[1],
+				Operator: m[2],
+				Value:    v,
+			})
+		}
+	}
+	slices.SortStableFunc(res, func(a, b FilterParam) bool {
+		return a.Key < b.Key || a.Key == b.Key && a.Operator < b.Operator
+	})
+	return res
+}
+
+// sortParamFromEchoContext reads "sort=key" for ascending order and "sort=-key" for descending order
+func sortParamFromEchoContext(c echo.Context) *SortParam {
+	s := c.QueryParam("sort")
+	if s == "" || s == "-" {
+		return nil
+	}
+	if strings.HasPrefix(s, "-") {
+		return &SortParam{Key: strings.TrimPrefix(s, "-"), Desc: true}
+	}
+	return &SortParam{Key: s}
+}
+
+func splitParam(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return lo.FilterMap(strings.Split(s, ","), func(s string, _ int) (string, bool) {
+		s = strings.TrimSpace(s)
+		return s, s != ""
+	})
+}
+
 func intParams(c echo.Context, params ...string) (int64, bool) {
 	for _, p := range params {
 		if q := c.QueryParam(p); q != "" {
@@ -49,3 +49,38 @@ func TestListParamFromEchoContext(t *testing.T) {
 		},
 	}, p)
 }
+
+func TestListParamFromEchoContext_Query(t *testing.T) {
+	e := echo.New()
+
+	p, err := listParamFromEchoContext(e.NewContext(
+		httptest.NewRequest("GET", "/?q=foo&filter[b][in]=x,y&filter[a][gte]=1&filter[a][lt]=5&filter[c]=z&filter=x&sort=-a&fields=a,%20b,,", nil), nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", p.Keyword)
+	assert.Equal(t, []FilterParam{
+		{Key: "a", Operator: "gte", Value: "1"},
+		{Key: "a", Operator: "lt", Value: "5"},
+		{Key: "b", Operator: "in", Value: "x,y"},
+		{Key: "c", Operator: "", Value: "z"},
+	}, p.Filters)
+	assert.Equal(t, &SortParam{Key: "a", Desc: true}, p.Sort)
+	assert.Equal(t, []string{"a", "b"}, p.Fields)
+	assert.True(t, p.HasQuery())
+
+	p, err = listParamFromEchoContext(e.NewContext(
+		httptest.NewRequest("GET", "/?sort=a", nil), nil))
+	assert.NoError(t, err)
+	assert.Equal(t, &SortParam{Key: "a"}, p.Sort)
+
+	p, err = listParamFromEchoContext(e.NewContext(
+		httptest.NewRequest("GET", "/?bbox=139,35,140,36", nil), nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "139,35,140,36", p.BBox)
+	assert.True(t, p.HasQuery())
+
+	p, err = listParamFromEchoContext(e.NewContext(
+		httptest.NewRequest("GET", "/?intersects=%7B%22type%22%3A%22Point%22%2C%22coordinates%22%3A%5B139%2C35%5D%7D", nil), nil))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"Point","coordinates":[139,35]}`, p.Intersects)
+	assert.True(t, p.HasQuery())
+}
@@ -9,11 +9,16 @@ import (
 )
 
 func (c *Controller) GetAsset(ctx context.Context, prj, i string) (Asset, error) {
-	_, err := c.checkProject(ctx, prj)
+	pr, err := c.checkProject(ctx, prj)
 	if err != nil {
 		return Asset{}, err
 	}
 
+	assetURL := c.assetFileURLResolver(ctx, pr)
+	if assetURL == nil {
+		return Asset{}, rerror.ErrNotFound
+	}
+
 	iid, err := id.AssetIDFrom(i)
 	if err != nil {
 		return Asset{}, rerror.ErrNotFound
@@ -26,11 +31,21 @@ func (c *Controller) GetAsset(ctx context.Context, prj, i string) (Asset, error)
 		}
 		return Asset{}, err
 	}
+	if a.Project() != pr.ID() {
+		return Asset{}, rerror.ErrNotFound
+	}
+	ok, err := c.checkAsset(ctx, pr, a)
+	if err != nil {
+		return Asset{}, err
+	}
+	if !ok {
+		return Asset{}, rerror.ErrNotFound
+	}
 
 	f, err := c.usecases.Asset.FindFileByID(ctx, iid, nil)
 	if err != nil {
 		return Asset{}, err
 	}
 
-	return NewAsset(a, f, c.assetUrlResolver), nil
+	return NewAsset(a, f, assetURL), nil
 }
@@ -3,16 +3,27 @@ package publicapi
 import (
 	"context"
 	"errors"
+	"net/url"
+	"path"
 
+	"github.com/reearth/reearth-cms/server/internal/adapter"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
 )
 
-var ErrInvalidProject = rerror.NewE(i18n.T("invalid project"))
+var (
+	ErrInvalidProject = rerror.NewE(i18n.T("invalid project"))
+	ErrInvalidField   = rerror.NewE(i18n.T("invalid field"))
+)
 
 type Controller struct {
 	project          repo.Project
@@ -37,17 +48,115 @@ func (c *Controller) checkProject(ctx context.Context, prj string) (*project.Pro
 		return nil, ErrInvalidProject
 	}
 
-	if p := pr.Publication(); p == nil || p.Scope() != project.PublicationScopePublic {
+	p := pr.Publication()
+	if p == nil {
 		return nil, rerror.ErrNotFound
 	}
 
-	// TODO: check token if the scope is limited
-	// if pr.Publication().Scope() == project.PublicationScopeLimited {
-	// 	t := pr.Publication().Token()
-	// 	if op := adapter.Operator(ctx); op == nil || t == "" || op.PublicAPIToken != t {
-	// 		return nil, ErrInvalidProject
-	// 	}
-	// }
+	switch p.Scope() {
+	case project.PublicationScopePublic:
+	case project.PublicationScopeLimited:
+		// the key has been verified by the middleware, but it may be a key of another project
+		if k := adapter.PublicAPIKey(ctx); k == nil || p.APIKey(k.ID()) == nil {
+			return nil, rerror.ErrNotFound
+		}
+	default:
+		return nil, rerror.ErrNotFound
+	}
 
 	return pr, nil
 }
+
+// assetURLResolver returns a resolver of asset URLs of the project. It returns nil when assets of the project are not accessible via the public API.
+func (c *Controller) assetURLResolver(ctx context.Context, pr *project.Project) asset.URLResolver {
+	r := c.assetFileURLResolver(ctx, pr)
+	if r == nil {
+		return nil
+	}
+	return func(a *asset.Asset) string {
+		return r(a, "")
+	}
+}
+
+// assetFileURLResolver returns a resolver of URLs of files in directories of assets of the project. The URL of the asset itself is resolved when the path is empty.
+// It returns nil when assets of the project are not accessible via the public API.
+// Assets of projects with the limited scope are resolved to signed URLs which expire, so the storage does not have to be public.
+// Each file is signed separately, as a signed URL is valid only for the file it is issued for.
+func (c *Controller) assetFileURLResolver(ctx context.Context, pr *project.Project) func(*asset.Asset, string) string {
+	p := pr.Publication()
+	if p == nil {
+		return nil
+	}
+
+	sign := func(a *asset.Asset, fp string) string {
+		u, err := c.usecases.Asset.GetSignedURL(ctx, a, fp)
+		if err != nil {
+			log.Errorf("publicapi: failed to sign asset url: %v", err)
+			return ""
+		}
+		return u
+	}
+
+	if p.AssetPublic() {
+		return func(a *asset.Asset, fp string) string {
+			u := c.assetUrlResolver(a)
+			if fp == "" {
+				return u
+			}
+			base, err := url.Parse(u)
+			if err != nil || base.RawQuery != "" {
+				// the storage issued a signed URL for the asset, from which URLs of its files cannot be derived
+				return sign(a, fp)
+			}
+			base.Path = path.Join(path.Dir(base.Path), fp)
+			return base.String()
+		}
+	}
+	if p.Scope() != project.PublicationScopeLimited {
+		return nil
+	}
+	return sign
+}
+
+// checkModel returns false if the model cannot be read with the API key of the request
+func (c *Controller) checkModel(ctx context.Context, pr *project.Project, m *model.Model) bool {
+	if !m.Public() {
+		return false
+	}
+	if pr.Publication().Scope() != project.PublicationScopeLimited {
+		return true
+	}
+	k := adapter.PublicAPIKey(ctx)
+	return k != nil && k.AllowsModel(m.ID())
+}
+
+// checkAsset returns false if the asset cannot be read with the API key of the request.
+// When the key limits models, the asset has to be referenced by an item of one of the models, as items are checked by checkModel.
+func (c *Controller) checkAsset(ctx context.Context, pr *project.Project, a *asset.Asset) (bool, error) {
+	if pr.Publication().Scope() != project.PublicationScopeLimited {
+		return true, nil
+	}
+	k := adapter.PublicAPIKey(ctx)
+	if k == nil {
+		return false, nil
+	}
+	if len(k.Models()) == 0 {
+		return true, nil
+	}
+
+	items, err := c.usecases.Item.FindByAssets(ctx, id.AssetIDList{a.ID()}, nil)
+	if err != nil {
+		return false, err
+	}
+	mids := lo.Uniq(lo.Map(items[a.ID()], func(i item.Versioned, _ int) id.ModelID { return i.Value().Model() }))
+	if len(mids) == 0 {
+		return false, nil
+	}
+	models, err := c.usecases.Model.FindByIDs(ctx, mids, nil)
+	if err != nil {
+		return false, err
+	}
+	return lo.ContainsBy(models, func(m *model.Model) bool {
+		return m != nil && m.Project() == pr.ID() && c.checkModel(ctx, pr, m)
+	}), nil
+}
@@ -3,16 +3,21 @@ package publicapi
 import (
 	"context"
 	"errors"
+	"strconv"
 
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
 
-func (c *Controller) GetItem(ctx context.Context, prj, mkey, i string) (Item, error) {
+func (c *Controller) GetItem(ctx context.Context, prj, mkey, i, lang string) (Item, error) {
 	pr, err := c.checkProject(ctx, prj)
 	if err != nil {
 		return Item{}, err
@@ -41,7 +46,7 @@ func (c *Controller) GetItem(ctx context.Context, prj, mkey, i string) (Item, er
 		return Item{}, err
 	}
 
-	if m.Key().String() != mkey || !m.Public() {
+	if m.Project() != pr.ID() || m.Key().String() != mkey || !c.checkModel(ctx, pr, m) {
 		return Item{}, rerror.ErrNotFound
 	}
 
@@ -51,14 +56,15 @@ func (c *Controller) GetItem(ctx context.Context, prj, mkey, i string) (Item, er
 	}
 
 	var assets asset.List
-	if pr.Publication().AssetPublic() {
+	assetURL := c.assetURLResolver(ctx, pr)
+	if assetURL != nil {
 		assets, err = c.usecases.Asset.FindByIDs(ctx, itv.AssetIDs(), nil)
 		if err != nil {
 			return Item{}, err
 		}
 	}
 
-	return NewItem(itv, s, assets, c.assetUrlResolver), nil
+	return NewItem(itv, s, assets, assetURL, lang), nil
 }
 
 func (c *Controller) GetItems(ctx context.Context, prj, model string, p ListParam) (ListResult[Item], error) {
@@ -71,7 +77,7 @@ func (c *Controller) GetItems(ctx context.Context, prj, model string, p ListPara
 	if err != nil {
 		return ListResult[Item]{}, err
 	}
-	if !m.Public() {
+	if !c.checkModel(ctx, pr, m) {
 		return ListResult[Item]{}, rerror.ErrNotFound
 	}
 
@@ -80,13 +86,27 @@ func (c *Controller) GetItems(ctx context.Context, prj, model string, p ListPara
 		return ListResult[Item]{}, err
 	}
 
-	items, pi, err := c.usecases.Item.FindPublicByModel(ctx, m.ID(), p.Pagination, nil)
-	if err != nil {
-		return ListResult[Item]{}, err
+	var items item.VersionedList
+	var pi *usecasex.PageInfo
+	if p.HasQuery() {
+		q, err := itemQueryFrom(pr.ID(), s, p)
+		if err != nil {
+			return ListResult[Item]{}, err
+		}
+		items, pi, err = c.usecases.Item.Search(ctx, q, nil, p.Pagination, nil)
+		if err != nil {
+			return ListResult[Item]{}, err
+		}
+	} else {
+		items, pi, err = c.usecases.Item.FindPublicByModel(ctx, m.ID(), p.Pagination, nil)
+		if err != nil {
+			return ListResult[Item]{}, err
+		}
 	}
 
 	var assets asset.List
-	if pr.Publication().AssetPublic() {
+	assetURL := c.assetURLResolver(ctx, pr)
+	if assetURL != nil {
 		assetIDs := lo.FlatMap(items.Unwrap(), func(i *item.Item, _ int) []id.AssetID {
 			return i.AssetIDs()
 		})
@@ -97,7 +117,72 @@ func (c *Controller) GetItems(ctx context.Context, prj, model string, p ListPara
 	}
 
 	res := NewListResult(util.Map(items.Unwrap(), func(i *item.Item) Item {
-		return NewItem(i, s, assets, c.assetUrlResolver)
+		it := NewItem(i, s, assets, assetURL, p.Lang)
+		it.Fields = it.Fields.Pick(p.Fields)
+		return it
 	}), pi, p.Pagination)
 	return res, nil
 }
+
+// itemQueryFrom resolves keys of fields in the param with the schema and builds a query for public items
+func itemQueryFrom(pid id.ProjectID, s *schema.Schema, p ListParam) (*item.Query, error) {
+	q := item.NewQuery(pid, s.ID().Ref(), p.Keyword, version.Public.Ref())
+
+	for _, f := range p.Filters {
+		sf := s.FieldByIDOrKey(nil, id.NewKey(f.Key).Ref())
+		if sf == nil {
+			return nil, ErrInvalidField
+		}
+
+		op := item.FilterOperatorFrom(f.Operator)
+		if op == item.FilterOperatorExists {
+			exists, err := strconv.ParseBool(f.Value)
+			if err != nil {
+				return nil, item.ErrInvalidFilter
+			}
+			q = q.WithFilters(item.NewExistsFieldFilter(sf.ID(), exists))
+			continue
+		}
+
+		raw := []string{f.Value}
+		if op == item.FilterOperatorIn {
+			raw = splitParam(f.Value)
+		}
+		values := make([]*value.Value, 0, len(raw))
+		for _, r := range raw {
+			v := sf.Type().Value(r)
+			if v == nil {
+				return nil, item.ErrInvalidFilter
+			}
+			values = append(values, v)
+		}
+
+		ff, err := item.NewFieldFilter(sf.ID(), op, values...)
+		if err != nil {
+			return nil, err
+		}
+		q = q.WithFilters(ff)
+	}
+
+	if p.Sort != nil {
+		sf := s.FieldByIDOrKey(nil, id.NewKey(p.Sort.Key).Ref())
+		if sf == nil {
+			return nil, ErrInvalidField
+		}
+		dir := item.AscDirection
+		if p.Sort.Desc {
+			dir = item.DescDirection
+		}
+		q = q.WithSort(item.NewFieldSort(sf.ID(), dir))
+	}
+
+	sf, err := item.ParseSpatialFilter(p.BBox, p.Intersects)
+	if err != nil {
+		return nil, err
+	}
+	if sf != nil {
+		q = q.WithSpatialFilter(sf)
+	}
+
+	return q, nil
+}
@@ -0,0 +1,69 @@
+package publicapi
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemQueryFrom(t *testing.T) {
+	pid := id.NewProjectID()
+	sf1 := schema.NewField(lo.Must(schema.NewInteger(nil, nil)).TypeProperty()).NewID().Key(id.NewKey("num")).MustBuild()
+	sf2 := schema.NewField(schema.NewSelect([]string{"a", "b"}).TypeProperty()).NewID().Key(id.NewKey("sel")).MustBuild()
+	s := schema.New().NewID().Project(pid).Workspace(id.NewWorkspaceID()).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+
+	q, err := itemQueryFrom(pid, s, ListParam{
+		Keyword: "foo",
+		Filters: []FilterParam{
+			{Key: "num", Operator: "gte", Value: "10"},
+			{Key: "sel", Operator: "in", Value: "a,b"},
+			{Key: "sel", Operator: "exists", Value: "true"},
+		},
+		Sort: &SortParam{Key: "num", Desc: true},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", q.Q())
+	assert.Equal(t, s.ID().Ref(), q.Schema())
+	assert.Equal(t, version.Public.Ref(), q.Ref())
+	assert.Equal(t, item.NewFieldSort(sf1.ID(), item.DescDirection), q.Sort())
+	assert.Equal(t, item.FieldFilterList{
+		mustFieldFilter(item.NewFieldFilter(sf1.ID(), item.FilterOperatorGte, value.TypeInteger.Value(10))),
+		mustFieldFilter(item.NewFieldFilter(sf2.ID(), item.FilterOperatorIn, value.TypeSelect.Value("a"), value.TypeSelect.Value("b"))),
+		item.NewExistsFieldFilter(sf2.ID(), true),
+	}, q.Filters())
+
+	_, err = itemQueryFrom(pid, s, ListParam{Filters: []FilterParam{{Key: "xxx", Value: "1"}}})
+	assert.Equal(t, ErrInvalidField, err)
+
+	_, err = itemQueryFrom(pid, s, ListParam{Sort: &SortParam{Key: "xxx"}})
+	assert.Equal(t, ErrInvalidField, err)
+
+	_, err = itemQueryFrom(pid, s, ListParam{Filters: []FilterParam{{Key: "num", Value: "a"}}})
+	assert.Equal(t, item.ErrInvalidFilter, err)
+
+	_, err = itemQueryFrom(pid, s, ListParam{Filters: []FilterParam{{Key: "sel", Operator: "gt", Value: "a"}}})
+	assert.Equal(t, item.ErrInvalidFilter, err)
+
+	_, err = itemQueryFrom(pid, s, ListParam{Filters: []FilterParam{{Key: "num", Operator: "xxx", Value: "1"}}})
+	assert.Equal(t, item.ErrInvalidFilter, err)
+
+	q, err = itemQueryFrom(pid, s, ListParam{BBox: "139,35,140,36"})
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{139, 35, 140, 36}, q.SpatialFilter().Area().BBox())
+
+	_, err = itemQueryFrom(pid, s, ListParam{BBox: "139,35,140"})
+	assert.Equal(t, item.ErrInvalidSpatialFilter, err)
+}
+
+func mustFieldFilter(f *item.FieldFilter, err error) *item.FieldFilter {
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
@@ -2,8 +2,6 @@ package publicapi
 
 import (
 	"encoding/json"
-	"net/url"
-	"path"
 	"reflect"
 
 	"github.com/reearth/reearth-cms/server/pkg/asset"
@@ -52,6 +50,32 @@ func NewListResult[T any](results []T, pi *usecasex.PageInfo, p *usecasex.Pagina
 
 type ListParam struct {
 	Pagination *usecasex.Pagination
+	Keyword    string
+	Filters    []FilterParam
+	Sort       *SortParam
+	Fields     []string
+	// BBox is an area in the form of "west,south,east,north"
+	BBox string
+	// Intersects is an area in the form of a GeoJSON geometry
+	Intersects string
+	// Lang is the locale in which values of localized fields are returned
+	Lang string
+}
+
+// HasQuery returns true when items have to be searched rather than listed
+func (p ListParam) HasQuery() bool {
+	return p.Keyword != "" || len(p.Filters) > 0 || p.Sort != nil || p.BBox != "" || p.Intersects != ""
+}
+
+type FilterParam struct {
+	Key      string
+	Operator string
+	Value    string
+}
+
+type SortParam struct {
+	Key  string
+	Desc bool
 }
 
 type Item struct {
@@ -65,15 +89,24 @@ func (i Item) MarshalJSON() ([]byte, error) {
 	return json.Marshal(m)
 }
 
-func NewItem(i *item.Item, s *schema.Schema, assets asset.List, urlResolver asset.URLResolver) Item {
+// NewItem converts the item. Values of localized fields are returned in the locale, falling back to the default locale.
+func NewItem(i *item.Item, s *schema.Schema, assets asset.List, urlResolver asset.URLResolver, lang string) Item {
 	return Item{
 		ID:     i.ID().String(),
-		Fields: NewItemFields(i.Fields(), s.Fields(), assets, urlResolver),
+		Fields: NewItemFields(i.Fields(), s.Fields(), assets, urlResolver, lang),
 	}
 }
 
 type ItemFields map[string]any
 
+// Pick returns only the fields of the keys. All fields are returned when no key is given.
+func (i ItemFields) Pick(keys []string) ItemFields {
+	if len(keys) == 0 {
+		return i
+	}
+	return lo.PickByKeys(i, keys)
+}
+
 func (i ItemFields) DropEmptyFields() ItemFields {
 	for k, v := range i {
 		if v == nil {
@@ -87,12 +120,13 @@ func (i ItemFields) DropEmptyFields() ItemFields {
 	return i
 }
 
-func NewItemFields(fields []*item.Field, sfields schema.FieldList, assets asset.List, urlResolver asset.URLResolver) ItemFields {
+func NewItemFields(fields []*item.Field, sfields schema.FieldList, assets asset.List, urlResolver asset.URLResolver, lang string) ItemFields {
 	return ItemFields(lo.SliceToMap(fields, func(f *item.Field) (k string, val any) {
 		sf := sfields.Find(f.FieldID())
 		if sf == nil {
 			return k, nil
 		}
+		m := f.ValueIn(lang)
 
 		if sf != nil {
 			k = sf.Key().String()
@@ -103,7 +137,7 @@ func NewItemFields(fields []*item.Field, sfields schema.FieldList, assets asset.
 
 		if sf.Type() == value.TypeAsset {
 			var itemAssets []ItemAsset
-			for _, v := range f.Value().Values() {
+			for _, v := range m.Values() {
 				aid, ok := v.ValueAsset()
 				if !ok {
 					continue
@@ -118,10 +152,8 @@ func NewItemFields(fields []*item.Field, sfields schema.FieldList, assets asset.
 			} else if len(itemAssets) > 0 {
 				val = itemAssets[0]
 			}
-		} else if sf.Multiple() {
-			val = f.Value().Interface()
 		} else {
-			val = f.Value().First().Interface()
+			val = sf.ValueInterface(m, true)
 		}
 
 		return
@@ -129,25 +161,23 @@ func NewItemFields(fields []*item.Field, sfields schema.FieldList, assets asset.
 }
 
 type Asset struct {
-	Type        string   `json:"type"`
-	ID          string   `json:"id,omitempty"`
-	URL         string   `json:"url,omitempty"`
-	ContentType string   `json:"contentType,omitempty"`
-	Files       []string `json:"files,omitempty"`
+	Type        string    `json:"type"`
+	ID          string    `json:"id,omitempty"`
+	URL         string    `json:"url,omitempty"`
+	ContentType string    `json:"contentType,omitempty"`
+	Files       []string  `json:"files,omitempty"`
+	BBox        []float64 `json:"bbox,omitempty"`
 }
 
-func NewAsset(a *asset.Asset, f *asset.File, urlResolver asset.URLResolver) Asset {
+// NewAsset returns an asset with URLs of its files. The URL of the asset itself is resolved when the path passed to the resolver is empty.
+func NewAsset(a *asset.Asset, f *asset.File, fileURLResolver func(*asset.Asset, string) string) Asset {
 	u := ""
 	var files []string
-	if urlResolver != nil {
-		u = urlResolver(a)
-		base, _ := url.Parse(u)
-		base.Path = path.Dir(base.Path)
-
-		files = lo.Map(f.Files(), func(f *asset.File, _ int) string {
-			b := *base
-			b.Path = path.Join(b.Path, f.Path())
-			return b.String()
+	if fileURLResolver != nil {
+		u = fileURLResolver(a, "")
+		files = lo.FilterMap(f.Files(), func(f *asset.File, _ int) (string, bool) {
+			u := fileURLResolver(a, f.Path())
+			return u, u != ""
 		})
 	}
 
@@ -157,6 +187,7 @@ func NewAsset(a *asset.Asset, f *asset.File, urlResolver asset.URLResolver) Asse
 		URL:         u,
 		ContentType: f.ContentType(),
 		Files:       files,
+		BBox:        a.BBox(),
 	}
 }
 
@@ -58,7 +58,7 @@ func TestNewItem(t *testing.T) {
 		}),
 	}, NewItem(it, s, asset.List{as}, func(a *asset.Asset) string {
 		return "https://example.com/" + a.ID().String() + af.Path()
-	}))
+	}, ""))
 
 	// no assets
 	assert.Equal(t, Item{
@@ -66,7 +66,35 @@ func TestNewItem(t *testing.T) {
 		Fields: ItemFields(map[string]any{
 			"aaaaa": "aaaa",
 		}),
-	}, NewItem(it, s, nil, nil))
+	}, NewItem(it, s, nil, nil, ""))
+}
+
+func TestNewItem_Localized(t *testing.T) {
+	s := schema.New().
+		NewID().
+		Project(id.NewProjectID()).
+		Workspace(id.NewWorkspaceID()).
+		Fields([]*schema.Field{
+			schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(key.New("city")).Localized(true).MustBuild(),
+		}).
+		MustBuild()
+	it := item.New().
+		NewID().
+		Schema(s.ID()).
+		Project(s.Project()).
+		Model(id.NewModelID()).
+		Thread(id.NewThreadID()).
+		Fields([]*item.Field{
+			item.NewLocalizedField(s.Fields()[0].ID(), value.TypeText.Value("東京都").AsMultiple(), map[string]*value.Multiple{
+				"en": value.TypeText.Value("Tokyo").AsMultiple(),
+			}),
+		}).
+		MustBuild()
+
+	assert.Equal(t, ItemFields{"city": "東京都"}, NewItem(it, s, nil, nil, "").Fields)
+	assert.Equal(t, ItemFields{"city": "Tokyo"}, NewItem(it, s, nil, nil, "en").Fields)
+	assert.Equal(t, ItemFields{"city": "Tokyo"}, NewItem(it, s, nil, nil, "en-US").Fields)
+	assert.Equal(t, ItemFields{"city": "東京都"}, NewItem(it, s, nil, nil, "fr").Fields)
 }
 
 func TestNewItem_Multiple(t *testing.T) {
@@ -112,7 +140,7 @@ func TestNewItem_Multiple(t *testing.T) {
 		}),
 	}, NewItem(it, s, asset.List{as}, func(a *asset.Asset) string {
 		return "https://example.com/" + a.ID().String() + af.Path()
-	}))
+	}, ""))
 
 	// no assets
 	assert.Equal(t, Item{
@@ -120,7 +148,23 @@ func TestNewItem_Multiple(t *testing.T) {
 		Fields: ItemFields(map[string]any{
 			"aaaaa": []any{"aaaa"},
 		}),
-	}, NewItem(it, s, nil, nil))
+	}, NewItem(it, s, nil, nil, ""))
+}
+
+func TestNewAsset(t *testing.T) {
+	a := asset.New().NewID().Project(id.NewProjectID()).CreatedByUser(id.NewUserID()).Size(1).Thread(id.NewThreadID()).NewUUID().MustBuild()
+	f := asset.NewFile().Name("a.zip").Path("a.zip").Children([]*asset.File{
+		asset.NewFile().Name("b.txt").Path("a/b.txt").Build(),
+	}).Build()
+
+	// each file is signed separately
+	got := NewAsset(a, f, func(_ *asset.Asset, p string) string { return "https://example.com" + p + "?signature=" + p })
+	assert.Equal(t, "https://example.com?signature=", got.URL)
+	assert.Equal(t, []string{"https://example.com/a/b.txt?signature=/a/b.txt"}, got.Files)
+
+	got = NewAsset(a, f, nil)
+	assert.Empty(t, got.URL)
+	assert.Empty(t, got.Files)
 }
 
 func TestItem_MarshalJSON(t *testing.T) {
@@ -65,6 +65,7 @@ func initEcho(ctx context.Context, cfg *ServerConfig) *echo.Echo {
 	usecaseMiddleware := UsecaseMiddleware(cfg.Repos, cfg.Gateways, interactor.ContainerConfig{
 		SignupSecret:    cfg.Config.SignupSecret,
 		AuthSrvUIDomain: cfg.Config.Host_Web,
+		SignedURLExpiry: cfg.Config.SignedURL.Expiry,
 	})
 
 	// apis
@@ -93,7 +94,7 @@ func initEcho(ctx context.Context, cfg *ServerConfig) *echo.Echo {
 		private,
 	), integration.NewStrictHandler(integration.NewServer(), nil))
 
-	serveFiles(e, cfg.Gateways.File)
+	serveFiles(e, cfg.Gateways.File, cfg.Repos, cfg.URLSigner, cfg.Config.SignedURL.Required)
 	Web(e, cfg.Config.Web, cfg.Config.AuthForWeb(), cfg.Config.Web_Disabled, nil)
 	return e
 }
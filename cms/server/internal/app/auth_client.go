@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/reearth/reearth-cms/server/internal/adapter"
@@ -13,6 +14,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/appx"
 	"github.com/reearth/reearthx/rerror"
@@ -129,31 +131,40 @@ func attachIntegrationOperator(ctx context.Context, req *http.Request, cfg *Serv
 	return ctx, nil
 }
 
+// PublicAPIAuthMiddleware verifies the API key of the public API for the project in the path.
+// Projects with the limited publication scope can be read only with their API keys, which are checked by the controller of the public API.
+// Rate limits of keys are counted on memory of each server instance, so they apply per instance when the server is scaled out.
 func PublicAPIAuthMiddleware(cfg *ServerConfig) echo.MiddlewareFunc {
+	limiter := newRateLimiter(time.Minute)
+
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// TODO: support limit publication scope
-
-			// req := c.Request()
-			// ctx := req.Context()
-			// if token := req.Header.Get("Reearth-Token"); token != "" {
-			// 	ws, err := cfg.Repos.Project.FindByPublicAPIToken(ctx, token)
-			// 	if err != nil {
-			// 		if errors.Is(err, rerror.ErrNotFound) {
-			// 			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid token"})
-			// 		}
-			// 		return err
-			// 	}
-
-			// 	if !ws.IsPublic() {
-			// 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid token"})
-			// 	}
-
-			// 	c.SetRequest(req.WithContext(adapter.AttachOperator(ctx, &usecase.Operator{
-			// 		PublicAPIProject: ws.ID(),
-			// 	})))
-			// }
+			req := c.Request()
+			ctx := req.Context()
+
+			key := getPublicAPIKey(req)
+			if key == "" {
+				return next(c)
+			}
+
+			prj, err := cfg.Repos.Project.FindByPublicName(ctx, c.Param("project"))
+			if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+				return err
+			}
 
+			var k *project.APIKey
+			if prj != nil && prj.Publication() != nil {
+				k = prj.Publication().APIKeyByKey(key)
+			}
+			if k == nil {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid API key"})
+			}
+
+			if !limiter.Allow(k.ID().String(), k.RateLimit()) {
+				return c.JSON(http.StatusTooManyRequests, map[string]string{"error": "too many requests"})
+			}
+
+			c.SetRequest(req.WithContext(adapter.AttachPublicAPIKey(ctx, k)))
 			return next(c)
 		}
 	}
@@ -179,6 +190,14 @@ func M2MAuthMiddleware(email string) echo.MiddlewareFunc {
 	}
 }
 
+func getPublicAPIKey(req *http.Request) string {
+	token := strings.TrimPrefix(req.Header.Get("authorization"), "Bearer ")
+	if strings.HasPrefix(token, project.APIKeyPrefix) {
+		return token
+	}
+	return ""
+}
+
 func getIntegrationToken(req *http.Request) string {
 	token := strings.TrimPrefix(req.Header.Get("authorization"), "Bearer ")
 	if strings.HasPrefix(token, "secret_") {
@@ -203,8 +222,10 @@ func generateUserOperator(ctx context.Context, cfg *ServerConfig, u *user.User,
 	ww := w.FilterByUserRole(uid, user.RoleWriter).IDs()
 	mw := w.FilterByUserRole(uid, user.RoleMaintainer).IDs()
 	ow := w.FilterByUserRole(uid, user.RoleOwner).IDs()
+	roles := customRoles(w, func(ws *user.Workspace) *user.CustomRole { return ws.UserCustomRole(uid) })
+	groups := itemGroups(w, mw, ow, func(ws *user.Workspace) []string { return ws.Members().UserGroups(uid) })
 
-	rp, wp, mp, op, err := operatorProjects(ctx, cfg, w, rw, ww, mw, ow)
+	rp, wp, mp, op, pr, ig, err := operatorProjects(ctx, cfg, w, rw, ww, mw, ow, roles, groups)
 	if err != nil {
 		return nil, err
 	}
@@ -229,14 +250,41 @@ func generateUserOperator(ctx context.Context, cfg *ServerConfig, u *user.User,
 		WritableProjects:     wp,
 		MaintainableProjects: mp,
 		OwningProjects:       op,
+		CustomRoles:          pr,
+		ItemGroups:           ig,
 	}, nil
 }
 
-func operatorProjects(ctx context.Context, cfg *ServerConfig, w user.WorkspaceList, rw, ww, mw, ow user.WorkspaceIDList) (id.ProjectIDList, id.ProjectIDList, id.ProjectIDList, id.ProjectIDList, error) {
+// customRoles returns the custom roles assigned to the operator by workspaces
+func customRoles(w user.WorkspaceList, role func(*user.Workspace) *user.CustomRole) map[id.WorkspaceID]*user.CustomRole {
+	res := map[id.WorkspaceID]*user.CustomRole{}
+	for _, ws := range w {
+		if r := role(ws); r != nil {
+			res[ws.ID()] = r
+		}
+	}
+	return res
+}
+
+// itemGroups returns the item groups of the operator by workspaces where the operator is neither an owner nor a maintainer
+func itemGroups(w user.WorkspaceList, mw, ow user.WorkspaceIDList, groups func(*user.Workspace) []string) map[id.WorkspaceID][]string {
+	res := map[id.WorkspaceID][]string{}
+	for _, ws := range w {
+		if mw.Has(ws.ID()) || ow.Has(ws.ID()) {
+			continue
+		}
+		res[ws.ID()] = groups(ws)
+	}
+	return res
+}
+
+func operatorProjects(ctx context.Context, cfg *ServerConfig, w user.WorkspaceList, rw, ww, mw, ow user.WorkspaceIDList, roles map[id.WorkspaceID]*user.CustomRole, groups map[id.WorkspaceID][]string) (id.ProjectIDList, id.ProjectIDList, id.ProjectIDList, id.ProjectIDList, map[id.ProjectID]*user.CustomRole, map[id.ProjectID][]string, error) {
 	rp := id.ProjectIDList{}
 	wp := id.ProjectIDList{}
 	mp := id.ProjectIDList{}
 	op := id.ProjectIDList{}
+	pr := map[id.ProjectID]*user.CustomRole{}
+	ig := map[id.ProjectID][]string{}
 
 	var cur *usecasex.Cursor
 	for {
@@ -245,10 +293,16 @@ func operatorProjects(ctx context.Context, cfg *ServerConfig, w user.WorkspaceLi
 			First: lo.ToPtr(int64(100)),
 		}.Wrap())
 		if err != nil {
-			return nil, nil, nil, nil, err
+			return nil, nil, nil, nil, nil, nil, err
 		}
 
 		for _, p := range projects {
+			if r, ok := roles[p.Workspace()]; ok {
+				pr[p.ID()] = r
+			}
+			if g, ok := groups[p.Workspace()]; ok && p.ItemGroupPolicy().Restricted() {
+				ig[p.ID()] = g
+			}
 			if ow.Has(p.Workspace()) {
 				op = append(op, p.ID())
 			} else if mw.Has(p.Workspace()) {
@@ -265,7 +319,7 @@ func operatorProjects(ctx context.Context, cfg *ServerConfig, w user.WorkspaceLi
 		}
 		cur = pi.EndCursor
 	}
-	return rp, wp, op, mp, nil
+	return rp, wp, op, mp, pr, ig, nil
 }
 
 func generateIntegrationOperator(ctx context.Context, cfg *ServerConfig, i *integration.Integration, lang string) (*usecase.Operator, error) {
@@ -283,8 +337,10 @@ func generateIntegrationOperator(ctx context.Context, cfg *ServerConfig, i *inte
 	ww := w.FilterByIntegrationRole(iId, user.RoleWriter).IDs()
 	mw := w.FilterByIntegrationRole(iId, user.RoleMaintainer).IDs()
 	ow := w.FilterByIntegrationRole(iId, user.RoleOwner).IDs()
+	roles := customRoles(w, func(ws *user.Workspace) *user.CustomRole { return ws.IntegrationCustomRole(iId) })
+	groups := itemGroups(w, mw, ow, func(ws *user.Workspace) []string { return ws.Members().IntegrationGroups(iId) })
 
-	rp, wp, mp, op, err := operatorProjects(ctx, cfg, w, rw, ww, mw, ow)
+	rp, wp, mp, op, pr, ig, err := operatorProjects(ctx, cfg, w, rw, ww, mw, ow, roles, groups)
 	if err != nil {
 		return nil, err
 	}
@@ -302,6 +358,8 @@ func generateIntegrationOperator(ctx context.Context, cfg *ServerConfig, i *inte
 		WritableProjects:     wp,
 		MaintainableProjects: mp,
 		OwningProjects:       op,
+		CustomRoles:          pr,
+		ItemGroups:           ig,
 	}, nil
 }
 
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/gcp"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/local"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/s3"
 	"github.com/reearth/reearthx/appx"
 	"github.com/reearth/reearthx/log"
 	"github.com/samber/lo"
@@ -30,10 +33,19 @@ type Config struct {
 	SendGrid     SendGridConfig
 	SignupSecret string
 	GCS          GCSConfig
+	S3           s3.Config
 	Task         gcp.TaskConfig
-	AssetBaseURL string
-	Web          WebConfig
-	Web_Disabled bool
+	LocalTask    local.TaskConfig
+	// interval to retry failed webhook deliveries. 0 disables retries.
+	WebhookRetryInterval time.Duration `default:"1m"`
+	// interval to publish and unpublish scheduled items. 0 disables the scheduler.
+	ItemScheduleInterval time.Duration `default:"1m"`
+	// interval to run background tasks such as importing items. 0 disables the processor.
+	TaskProcessInterval time.Duration `default:"10s"`
+	AssetBaseURL        string
+	SignedURL           SignedURLConfig
+	Web                 WebConfig
+	Web_Disabled        bool
 	// auth
 	Auth          AuthConfigs
 	Auth0         Auth0Config
@@ -87,6 +99,15 @@ type GCSConfig struct {
 	PublicationCacheControl string
 }
 
+type SignedURLConfig struct {
+	// Secret is the HMAC key to sign URLs of assets served by the CMS server. A random key is used when it is empty.
+	Secret string
+	// Expiry is the lifetime of signed URLs issued for assets which are not public
+	Expiry time.Duration `default:"1h"`
+	// Required makes the CMS server reject requests for files of assets without valid signatures unless the projects of the assets make their assets public
+	Required bool `default:"true"`
+}
+
 type AuthM2MConfig struct {
 	ISS   string
 	AUD   []string
@@ -247,7 +268,7 @@ func ReadConfig(debug bool) (*Config, error) {
 
 func (c Config) Print() string {
 	s := fmt.Sprintf("%+v", c)
-	for _, secret := range []string{c.DB, c.Auth0.ClientSecret} {
+	for _, secret := range []string{c.DB, c.Auth0.ClientSecret, c.SignedURL.Secret, c.S3.SecretAccessKey} {
 		if secret == "" {
 			continue
 		}
@@ -1,18 +1,30 @@
 package app
 
 import (
+	"context"
 	"io"
 	"mime"
 	"net/http"
+	"net/url"
 	"path"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/samber/lo"
 )
 
+// serveFiles serves asset files. When the signer is given, signatures of signed URLs are verified.
+// If signatureRequired is true, requests without signatures are rejected unless the project of the asset makes its assets public.
 func serveFiles(
 	ec *echo.Echo,
 	repo gateway.File,
+	repos *repo.Container,
+	signer *fs.URLSigner,
+	signatureRequired bool,
 ) {
 	if repo == nil {
 		return
@@ -36,14 +48,61 @@ func serveFiles(
 	}
 
 	ec.GET(
-		"/assets/:uuid1/:uuid2/:filename",
+		// files extracted from archives are served from subdirectories of assets
+		"/assets/:uuid1/:uuid2/*",
 		fileHandler(func(ctx echo.Context) (io.Reader, string, error) {
-			filename := ctx.Param("filename")
+			filename := ctx.Param("*")
+			if fn, err := url.PathUnescape(filename); err == nil {
+				filename = fn
+			}
+			// paths escaping the directory of the asset would read files of other assets without their signatures
+			if hasDotDotSegment(filename) {
+				return nil, "", echo.ErrBadRequest
+			}
 			uuid1 := ctx.Param("uuid1")
 			uuid2 := ctx.Param("uuid2")
 			uuid := uuid1 + uuid2
+			// the asset is looked up only for requests without signatures, as signatures are verified anyway
+			required := signatureRequired && !fs.HasSignature(ctx.QueryParams()) && !assetPublic(ctx.Request().Context(), repos, uuid)
+			if err := verifySignature(ctx, signer, required, path.Join(uuid1, uuid2, filename)); err != nil {
+				return nil, "", err
+			}
 			r, err := repo.ReadAsset(ctx.Request().Context(), uuid, filename)
 			return r, filename, err
 		}),
 	)
 }
+
+// hasDotDotSegment returns true if the path contains ".." as a segment
+func hasDotDotSegment(p string) bool {
+	return lo.Contains(strings.FieldsFunc(p, func(r rune) bool { return r == '/' || r == '\\' }), "..")
+}
+
+func verifySignature(ctx echo.Context, signer *fs.URLSigner, required bool, p string) error {
+	if signer == nil {
+		return nil
+	}
+
+	q := ctx.QueryParams()
+	if !required && !fs.HasSignature(q) {
+		return nil
+	}
+
+	if err := signer.Verify(p, q, time.Now()); err != nil {
+		return echo.ErrForbidden
+	}
+	return nil
+}
+
+// assetPublic returns true if the project of the asset whose files are stored under the UUID makes its assets public
+func assetPublic(ctx context.Context, repos *repo.Container, uuid string) bool {
+	if repos == nil {
+		return false
+	}
+	a, err := repos.Asset.FindByUUID(ctx, uuid)
+	if err != nil {
+		return false
+	}
+	p, err := repos.Project.FindByID(ctx, a.Project())
+	return err == nil && p.Publication() != nil && p.Publication().AssetPublic()
+}
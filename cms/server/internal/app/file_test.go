@@ -0,0 +1,61 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServeFiles(t *testing.T) {
+	ctx := context.Background()
+	pub := "0123456789abcdef0123456789abcdef"
+	priv := "fedcba9876543210fedcba9876543210"
+	mfs := afero.NewMemMapFs()
+	lo.Must0(afero.WriteFile(mfs, "assets/01/23456789abcdef0123456789abcdef/a.txt", []byte("public"), 0644))
+	lo.Must0(afero.WriteFile(mfs, "assets/fe/dcba9876543210fedcba9876543210/b.txt", []byte("private"), 0644))
+
+	db := memory.New()
+	p1 := project.New().NewID().Workspace(id.NewWorkspaceID()).MustBuild()
+	p1.SetPublication(project.NewPublication(project.PublicationScopePublic, true))
+	p2 := project.New().NewID().Workspace(id.NewWorkspaceID()).MustBuild()
+	lo.Must0(db.Project.Save(ctx, p1))
+	lo.Must0(db.Project.Save(ctx, p2))
+	lo.Must0(db.Asset.Save(ctx, asset.New().NewID().Project(p1.ID()).Thread(id.NewThreadID()).UUID(pub).FileName("a.txt").Size(1).CreatedByUser(id.NewUserID()).MustBuild()))
+	lo.Must0(db.Asset.Save(ctx, asset.New().NewID().Project(p2.ID()).Thread(id.NewThreadID()).UUID(priv).FileName("b.txt").Size(1).CreatedByUser(id.NewUserID()).MustBuild()))
+
+	e := echo.New()
+	serveFiles(e, lo.Must(fs.NewFile(mfs, "", nil)), db, fs.NewURLSigner("secret"), true)
+
+	tests := []struct {
+		name string
+		path string
+		want int
+	}{
+		{"public", "/assets/01/23456789abcdef0123456789abcdef/a.txt", http.StatusOK},
+		{"private without signature", "/assets/fe/dcba9876543210fedcba9876543210/b.txt", http.StatusForbidden},
+		{"encoded traversal", "/assets/01/23456789abcdef0123456789abcdef/..%2F..%2Ffe%2Fdcba9876543210fedcba9876543210%2Fb.txt", http.StatusBadRequest},
+		{"traversal", "/assets/01/23456789abcdef0123456789abcdef/x/..%2F..%2F..%2Ffe/dcba9876543210fedcba9876543210/b.txt", http.StatusBadRequest},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, tc.want, rec.Code)
+			assert.NotContains(t, rec.Body.String(), "private")
+		})
+	}
+}
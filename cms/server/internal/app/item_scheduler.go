@@ -0,0 +1,38 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearthx/log"
+)
+
+// startItemScheduler periodically publishes and unpublishes items whose scheduled time has come
+func startItemScheduler(ctx context.Context, interval time.Duration, repos *repo.Container, gateways *gateway.Container) {
+	if interval <= 0 {
+		return
+	}
+
+	uc := interactor.NewItem(repos, gateways)
+	op := &usecase.Operator{Machine: true}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := uc.RunSchedule(ctx, op); err != nil {
+					log.Errorf("item: failed to run schedule: %v", err)
+				}
+			}
+		}
+	}()
+}
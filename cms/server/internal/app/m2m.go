@@ -28,8 +28,8 @@ func NotifyHandler() echo.HandlerFunc {
 		ctx := c.Request().Context()
 
 		log.Infof("notified and updating files begin: assetID=%s type=%s status=%s", input.AssetID, input.Type, input.Status)
-		assetUC := adapter.Usecases(ctx).Asset
-		controller := rhttp.NewTaskController(assetUC)
+		uc := adapter.Usecases(ctx)
+		controller := rhttp.NewTaskController(uc.Asset, uc.Integration)
 		if err := controller.Notify(ctx, input); err != nil {
 			log.Errorf("failed to update files: assetID=%s, type=%s, status=$s", input.AssetID, input.Type, input.Status)
 			return err
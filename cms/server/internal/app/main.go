@@ -9,6 +9,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"golang.org/x/net/http2"
 
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearthx/log"
@@ -26,15 +27,28 @@ func Start(debug bool, version string) {
 	}
 	log.Infof("config: %s", conf.Print())
 
+	// Sign URLs of assets served by the server
+	urlSigner := fs.NewURLSigner(conf.SignedURL.Secret)
+
 	// Init repositories
-	repos, gateways := initReposAndGateways(ctx, conf, debug)
+	repos, gateways := initReposAndGateways(ctx, conf, urlSigner, debug)
+
+	// Retry failed webhook deliveries
+	startWebhookRetrier(ctx, conf.WebhookRetryInterval, repos, gateways)
+
+	// Publish and unpublish scheduled items
+	startItemScheduler(ctx, conf.ItemScheduleInterval, repos, gateways)
+
+	// Run background tasks such as importing items
+	startTaskProcessor(ctx, conf.TaskProcessInterval, repos, gateways)
 
 	// Start web server
 	NewServer(ctx, &ServerConfig{
-		Config:   conf,
-		Debug:    debug,
-		Repos:    repos,
-		Gateways: gateways,
+		Config:    conf,
+		Debug:     debug,
+		Repos:     repos,
+		Gateways:  gateways,
+		URLSigner: urlSigner,
 	}).Run()
 }
 
@@ -48,6 +62,8 @@ type ServerConfig struct {
 	Debug    bool
 	Repos    *repo.Container
 	Gateways *gateway.Container
+	// URLSigner verifies signed URLs of asset files. Signatures are not verified when it is nil.
+	URLSigner *fs.URLSigner
 }
 
 func NewServer(ctx context.Context, cfg *ServerConfig) *WebServer {
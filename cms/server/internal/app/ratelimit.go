@@ -0,0 +1,60 @@
+package app
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiter counts requests per key in fixed windows. Counts are kept on memory, so limits are applied per server instance.
+type rateLimiter struct {
+	window time.Duration
+	now    func() time.Time
+	lock   sync.Mutex
+	counts map[string]*rateLimitCount
+}
+
+type rateLimitCount struct {
+	start time.Time
+	count int
+}
+
+func newRateLimiter(window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		window: window,
+		now:    time.Now,
+		counts: map[string]*rateLimitCount{},
+	}
+}
+
+// Allow counts a request and returns false if the number of requests in the current window exceeds the limit. Zero limit means unlimited.
+func (r *rateLimiter) Allow(key string, limit int) bool {
+	if limit <= 0 {
+		return true
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.now()
+	c, ok := r.counts[key]
+	if !ok || now.Sub(c.start) >= r.window {
+		r.gc(now)
+		c = &rateLimitCount{start: now}
+		r.counts[key] = c
+	}
+
+	if c.count >= limit {
+		return false
+	}
+	c.count++
+	return true
+}
+
+// gc removes counts of expired windows
+func (r *rateLimiter) gc(now time.Time) {
+	for k, c := range r.counts {
+		if now.Sub(c.start) >= r.window {
+			delete(r.counts, k)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := newRateLimiter(time.Minute)
+	r.now = func() time.Time { return now }
+
+	assert.True(t, r.Allow("a", 2))
+	assert.True(t, r.Allow("a", 2))
+	assert.False(t, r.Allow("a", 2))
+	assert.True(t, r.Allow("b", 2))
+
+	// unlimited
+	for i := 0; i < 10; i++ {
+		assert.True(t, r.Allow("c", 0))
+	}
+
+	// next window
+	now = now.Add(time.Minute)
+	assert.True(t, r.Allow("a", 2))
+	assert.Len(t, r.counts, 1)
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/auth0"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/gcp"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/local"
 	mongorepo "github.com/reearth/reearth-cms/server/internal/infrastructure/mongo"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/s3"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearthx/log"
@@ -21,7 +23,7 @@ import (
 
 const databaseName = "reearth_cms"
 
-func initReposAndGateways(ctx context.Context, conf *Config, debug bool) (*repo.Container, *gateway.Container) {
+func initReposAndGateways(ctx context.Context, conf *Config, urlSigner *fs.URLSigner, debug bool) (*repo.Container, *gateway.Container) {
 	gateways := &gateway.Container{}
 
 	// Mongo
@@ -43,16 +45,22 @@ func initReposAndGateways(ctx context.Context, conf *Config, debug bool) (*repo.
 
 	// File
 	var fileRepo gateway.File
-	if conf.GCS.BucketName == "" {
-		log.Infoln("file: local storage is used")
-		datafs := afero.NewBasePathFs(afero.NewOsFs(), "data")
-		fileRepo, err = fs.NewFile(datafs, conf.AssetBaseURL)
-	} else {
+	if conf.GCS.BucketName != "" {
 		log.Infof("file: GCS storage is used: %s", conf.GCS.BucketName)
 		fileRepo, err = gcp.NewFile(conf.GCS.BucketName, conf.AssetBaseURL, conf.GCS.PublicationCacheControl)
 		if err != nil {
 			log.Fatalf("file: failed to init GCS storage: %s\n", err.Error())
 		}
+	} else if conf.S3.BucketName != "" {
+		log.Infof("file: S3 storage is used: %s", conf.S3.BucketName)
+		fileRepo, err = s3.NewFile(conf.S3, conf.AssetBaseURL)
+		if err != nil {
+			log.Fatalf("file: failed to init S3 storage: %s\n", err.Error())
+		}
+	} else {
+		log.Infoln("file: local storage is used")
+		datafs := afero.NewBasePathFs(afero.NewOsFs(), "data")
+		fileRepo, err = fs.NewFile(datafs, conf.AssetBaseURL, urlSigner)
 	}
 	if err != nil {
 		log.Fatalln(fmt.Sprintf("file: init error: %+v", err))
@@ -71,7 +79,14 @@ func initReposAndGateways(ctx context.Context, conf *Config, debug bool) (*repo.
 		}
 		gateways.TaskRunner = taskRunner
 	} else {
-		log.Infof("task runner: not used")
+		log.Infof("task runner: local task runner is used")
+		queue := mongorepo.NewTaskQueue(mongox.NewClient(databaseName, client))
+		if err := queue.Init(); err != nil {
+			log.Fatalf("task runner: failed to init queue: %+v\n", err)
+		}
+		taskRunner := local.NewTaskRunner(conf.LocalTask, gateways.File, queue, &cmsNotifier{repos: repos, gateways: gateways})
+		taskRunner.Start(ctx)
+		gateways.TaskRunner = taskRunner
 	}
 
 	return repos, gateways
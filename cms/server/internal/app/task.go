@@ -0,0 +1,60 @@
+package app
+
+import (
+	"context"
+
+	rhttp "github.com/reearth/reearth-cms/server/internal/adapter/http"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/worker/pkg/decompressor"
+	"github.com/reearth/reearth-cms/worker/pkg/webhook"
+)
+
+// cmsNotifier reflects results of the local task runner to CMS directly instead of the notify API
+type cmsNotifier struct {
+	repos    *repo.Container
+	gateways *gateway.Container
+}
+
+func (n *cmsNotifier) NotifyAssetDecompressed(ctx context.Context, assetID string, status *asset.ArchiveExtractionStatus) error {
+	aid, err := id.AssetIDFrom(assetID)
+	if err != nil {
+		return err
+	}
+
+	_, err = interactor.NewAsset(n.repos, n.gateways).UpdateFiles(ctx, aid, status, &usecase.Operator{Machine: true})
+	return err
+}
+
+func (n *cmsNotifier) NotifyAssetDecompressProgress(ctx context.Context, assetID string, p decompressor.Progress) error {
+	aid, err := id.AssetIDFrom(assetID)
+	if err != nil {
+		return err
+	}
+
+	_, err = interactor.NewAsset(n.repos, n.gateways).UpdateExtractionProgress(ctx, aid, rhttp.ArchiveExtractionProgressFrom(p), &usecase.Operator{Machine: true})
+	return err
+}
+
+func (n *cmsNotifier) NotifyAssetCompressed(ctx context.Context, assetID string, status *asset.ArchiveExtractionStatus) error {
+	aid, err := id.AssetIDFrom(assetID)
+	if err != nil {
+		return err
+	}
+
+	_, err = interactor.NewAsset(n.repos, n.gateways).UpdateCompression(ctx, aid, status, &usecase.Operator{Machine: true})
+	return err
+}
+
+func (n *cmsNotifier) NotifyWebhookDelivered(ctx context.Context, r *webhook.Result) error {
+	param, err := rhttp.RecordWebhookDeliveryParamFrom(r)
+	if err != nil {
+		return err
+	}
+
+	return interactor.NewIntegration(n.repos, n.gateways).RecordWebhookDelivery(ctx, param, &usecase.Operator{Machine: true})
+}
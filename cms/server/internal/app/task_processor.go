@@ -0,0 +1,38 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearthx/log"
+)
+
+// startTaskProcessor periodically runs pending tasks and resumes interrupted ones
+func startTaskProcessor(ctx context.Context, interval time.Duration, repos *repo.Container, gateways *gateway.Container) {
+	if interval <= 0 {
+		return
+	}
+
+	uc := interactor.NewTask(repos, gateways)
+	op := &usecase.Operator{Machine: true}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := uc.Run(ctx, op); err != nil {
+					log.Errorf("task: failed to run tasks: %v", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearthx/log"
+)
+
+// startWebhookRetrier periodically dispatches failed webhook deliveries whose backoff has elapsed
+func startWebhookRetrier(ctx context.Context, interval time.Duration, repos *repo.Container, gateways *gateway.Container) {
+	if interval <= 0 || gateways.TaskRunner == nil {
+		return
+	}
+
+	uc := interactor.NewIntegration(repos, gateways)
+	op := &usecase.Operator{Machine: true}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := uc.RetryWebhookDeliveries(ctx, op); err != nil {
+					log.Errorf("webhook: failed to retry deliveries: %v", err)
+				}
+			}
+		}
+	}()
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kennygrant/sanitize"
@@ -16,15 +17,18 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/file"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
 	"github.com/spf13/afero"
 )
 
 type fileRepo struct {
 	fs      afero.Fs
 	urlBase *url.URL
+	signer  *URLSigner
 }
 
-func NewFile(fs afero.Fs, urlBase string) (gateway.File, error) {
+// NewFile returns a file gateway on the file system. Signed URLs are disabled when the signer is nil.
+func NewFile(fs afero.Fs, urlBase string, signer *URLSigner) (gateway.File, error) {
 	var b *url.URL
 	if urlBase == "" {
 		urlBase = defaultBase
@@ -39,6 +43,7 @@ func NewFile(fs afero.Fs, urlBase string) (gateway.File, error) {
 	return &fileRepo{
 		fs:      fs,
 		urlBase: b,
+		signer:  signer,
 	}, nil
 }
 
@@ -125,6 +130,91 @@ func (f *fileRepo) GetURL(a *asset.Asset) string {
 	return f.urlBase.JoinPath(assetDir, uuid[:2], uuid[2:], url.PathEscape(a.FileName())).String()
 }
 
+// GetSignedURL implements gateway.File. The URL is signed with HMAC and verified by the file server of the CMS.
+func (f *fileRepo) GetSignedURL(_ context.Context, a *asset.Asset, p string, expiry time.Duration) (string, error) {
+	if f.signer == nil {
+		return "", gateway.ErrSignedURLDisabled
+	}
+
+	if p = strings.TrimPrefix(p, "/"); p == "" {
+		p = a.FileName()
+	}
+	uuid := a.UUID()
+	elems := append([]string{assetDir, uuid[:2], uuid[2:]}, lo.Map(strings.Split(p, "/"), func(s string, _ int) string { return url.PathEscape(s) })...)
+	u := f.urlBase.JoinPath(elems...)
+	f.signer.Sign(u, path.Join(uuid[:2], uuid[2:], p), time.Now().Add(expiry))
+	return u.String(), nil
+}
+
+// Read implements gateway.File
+func (f *fileRepo) Read(ctx context.Context, p string) (gateway.ReadAtCloser, int64, error) {
+	if p == "" {
+		return nil, 0, rerror.ErrNotFound
+	}
+
+	file, err := f.fs.Open(sanitize.Path(path.Join(assetDir, p)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, 0, rerror.ErrNotFound
+		}
+		return nil, 0, rerror.ErrInternalBy(err)
+	}
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, 0, rerror.ErrInternalBy(err)
+	}
+
+	return file, fileInfo.Size(), nil
+}
+
+// Upload implements gateway.File
+func (f *fileRepo) Upload(ctx context.Context, name string) (io.WriteCloser, error) {
+	if name == "" {
+		return nil, gateway.ErrFailedToUploadFile
+	}
+
+	sn := sanitize.Path(path.Join(assetDir, name))
+	if fnd := path.Dir(sn); fnd != "" {
+		if err := f.fs.MkdirAll(fnd, 0755); err != nil {
+			return nil, rerror.ErrInternalBy(err)
+		}
+	}
+
+	dest, err := f.fs.Create(sn)
+	if err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return dest, nil
+}
+
+// List implements gateway.File
+func (f *fileRepo) List(ctx context.Context, dir string) ([]string, error) {
+	if dir == "" {
+		return nil, rerror.ErrNotFound
+	}
+
+	p := sanitize.Path(path.Join(assetDir, dir))
+	var files []string
+	err := afero.Walk(f.fs, p, func(name string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files = append(files, strings.TrimPrefix(name, assetDir+"/"))
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) || os.IsNotExist(err) {
+			return nil, rerror.ErrNotFound
+		}
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return files, nil
+}
+
 // helpers
 
 func (f *fileRepo) read(ctx context.Context, filename string) (io.ReadCloser, error) {
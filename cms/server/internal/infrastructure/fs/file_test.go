@@ -8,6 +8,7 @@ import (
 	"path"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
@@ -19,17 +20,17 @@ import (
 )
 
 func TestNewFile(t *testing.T) {
-	f, err := NewFile(mockFs(), "")
+	f, err := NewFile(mockFs(), "", nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, f)
 
-	f1, err := NewFile(mockFs(), "htp:#$%&''()00lde/fdaslk")
+	f1, err := NewFile(mockFs(), "htp:#$%&''()00lde/fdaslk", nil)
 	assert.Equal(t, err, ErrInvalidBaseURL)
 	assert.Nil(t, f1)
 }
 
 func TestFile_ReadAsset(t *testing.T) {
-	f, _ := NewFile(mockFs(), "")
+	f, _ := NewFile(mockFs(), "", nil)
 	u := "5130c89f-8f67-4766-b127-49ee6796d464"
 
 	r, err := f.ReadAsset(context.Background(), u, "xxx.txt")
@@ -54,7 +55,7 @@ func TestFile_ReadAsset(t *testing.T) {
 
 func TestFile_GetAssetFiles(t *testing.T) {
 	fs := mockFs()
-	f, _ := NewFile(fs, "")
+	f, _ := NewFile(fs, "", nil)
 
 	files, err := f.GetAssetFiles(context.Background(), "5130c89f-8f67-4766-b127-49ee6796d464")
 	assert.NoError(t, err)
@@ -66,7 +67,7 @@ func TestFile_GetAssetFiles(t *testing.T) {
 
 func TestFile_UploadAsset(t *testing.T) {
 	fs := mockFs()
-	f, _ := NewFile(fs, "https://example.com/assets")
+	f, _ := NewFile(fs, "https://example.com/assets", nil)
 
 	u, _, err := f.UploadAsset(context.Background(), &file.File{
 		Path:    "aaa.txt",
@@ -102,7 +103,7 @@ func TestFile_DeleteAsset(t *testing.T) {
 	u := newUUID()
 	n := "aaa.txt"
 	fs := mockFs()
-	f, _ := NewFile(fs, "https://example.com/assets")
+	f, _ := NewFile(fs, "https://example.com/assets", nil)
 	err := f.DeleteAsset(context.Background(), u, n)
 	assert.NoError(t, err)
 
@@ -112,15 +113,69 @@ func TestFile_DeleteAsset(t *testing.T) {
 	u1 := ""
 	n1 := ""
 	fs1 := mockFs()
-	f1, _ := NewFile(fs1, "https://example.com/assets")
+	f1, _ := NewFile(fs1, "https://example.com/assets", nil)
 	err1 := f1.DeleteAsset(context.Background(), u1, n1)
 	assert.Same(t, gateway.ErrInvalidFile, err1)
 }
 
+func TestFile_Read(t *testing.T) {
+	f, _ := NewFile(mockFs(), "", nil)
+
+	r, size, err := f.Read(context.Background(), "51/30c89f-8f67-4766-b127-49ee6796d464/xxx.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), size)
+	b := make([]byte, 3)
+	n, err := r.ReadAt(b, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "llo", string(b[:n]))
+	assert.NoError(t, r.Close())
+
+	r, size, err = f.Read(context.Background(), "51/30c89f-8f67-4766-b127-49ee6796d464/aaa.txt")
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	assert.Nil(t, r)
+	assert.Zero(t, size)
+
+	_, _, err = f.Read(context.Background(), "")
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+}
+
+func TestFile_Upload(t *testing.T) {
+	fs := mockFs()
+	f, _ := NewFile(fs, "", nil)
+
+	w, err := f.Upload(context.Background(), "51/30c89f-8f67-4766-b127-49ee6796d464/zzz/a.txt")
+	assert.NoError(t, err)
+	_, err = w.Write([]byte("aaa"))
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+
+	uf, err := fs.Open("assets/51/30c89f-8f67-4766-b127-49ee6796d464/zzz/a.txt")
+	assert.NoError(t, err)
+	c, _ := io.ReadAll(uf)
+	assert.Equal(t, "aaa", string(c))
+
+	_, err = f.Upload(context.Background(), "")
+	assert.Same(t, gateway.ErrFailedToUploadFile, err)
+}
+
+func TestFile_List(t *testing.T) {
+	f, _ := NewFile(mockFs(), "", nil)
+
+	files, err := f.List(context.Background(), "51/30c89f-8f67-4766-b127-49ee6796d464")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"51/30c89f-8f67-4766-b127-49ee6796d464/xxx.txt", "51/30c89f-8f67-4766-b127-49ee6796d464/yyy/hello.txt"}, files)
+
+	_, err = f.List(context.Background(), "51/none")
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	_, err = f.List(context.Background(), "")
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+}
+
 func TestFile_GetURL(t *testing.T) {
 	host := "https://example.com"
 	fs := mockFs()
-	r, err := NewFile(fs, host)
+	r, err := NewFile(fs, host, nil)
 	assert.NoError(t, err)
 
 	u := newUUID()
@@ -139,6 +194,42 @@ func TestFile_GetURL(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestFile_GetSignedURL(t *testing.T) {
+	ctx := context.Background()
+	a := asset.New().NewID().
+		Project(id.NewProjectID()).
+		CreatedByUser(id.NewUserID()).
+		Size(1000).FileName("a b.txt").
+		NewUUID().
+		Thread(id.NewThreadID()).
+		MustBuild()
+	u := a.UUID()
+
+	r, _ := NewFile(mockFs(), "https://example.com", nil)
+	_, err := r.GetSignedURL(ctx, a, "", time.Hour)
+	assert.ErrorIs(t, err, gateway.ErrSignedURLDisabled)
+
+	signer := NewURLSigner("secret")
+	r, _ = NewFile(mockFs(), "https://example.com", signer)
+	got, err := r.GetSignedURL(ctx, a, "", time.Hour)
+	assert.NoError(t, err)
+
+	su, err := url.Parse(got)
+	assert.NoError(t, err)
+	assert.Equal(t, "/"+path.Join(assetDir, u[:2], u[2:], "a b.txt"), su.Path)
+	assert.NoError(t, signer.Verify(path.Join(u[:2], u[2:], "a b.txt"), su.Query(), time.Now()))
+	assert.ErrorIs(t, signer.Verify(path.Join(u[:2], u[2:], "other.txt"), su.Query(), time.Now()), ErrInvalidSignature)
+	assert.ErrorIs(t, signer.Verify(path.Join(u[:2], u[2:], "a b.txt"), su.Query(), time.Now().Add(2*time.Hour)), ErrInvalidSignature)
+
+	// a file extracted from the asset
+	got, err = r.GetSignedURL(ctx, a, "/a b/c d.txt", time.Hour)
+	assert.NoError(t, err)
+	su, err = url.Parse(got)
+	assert.NoError(t, err)
+	assert.Equal(t, "/"+path.Join(assetDir, u[:2], u[2:], "a b/c d.txt"), su.Path)
+	assert.NoError(t, signer.Verify(path.Join(u[:2], u[2:], "a b/c d.txt"), su.Query(), time.Now()))
+}
+
 func TestFile_GetFSObjectPath(t *testing.T) {
 	u := newUUID()
 	n := "xxx.yyy"
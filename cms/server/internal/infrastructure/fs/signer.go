@@ -0,0 +1,78 @@
+package fs
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+const (
+	signedURLExpiresParam   = "expires"
+	signedURLSignatureParam = "signature"
+)
+
+var ErrInvalidSignature = rerror.NewE(i18n.T("invalid signature"))
+
+// URLSigner signs URLs of assets served by the CMS server with HMAC-SHA256.
+// The signature covers the path of the file relative to the asset directory and the expiration time.
+type URLSigner struct {
+	secret []byte
+}
+
+// NewURLSigner returns a signer with the secret. A random secret is generated when it is empty, so URLs are invalidated when the server restarts.
+func NewURLSigner(secret string) *URLSigner {
+	if secret == "" {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			panic(err)
+		}
+		return &URLSigner{secret: b}
+	}
+	return &URLSigner{secret: []byte(secret)}
+}
+
+// Sign adds the expiration time and the signature of the file to the query of the URL
+func (s *URLSigner) Sign(u *url.URL, p string, expiresAt time.Time) {
+	exp := strconv.FormatInt(expiresAt.Unix(), 10)
+	q := u.Query()
+	q.Set(signedURLExpiresParam, exp)
+	q.Set(signedURLSignatureParam, s.signature(p, exp))
+	u.RawQuery = q.Encode()
+}
+
+// Verify checks the signature in the query of a request for the file
+func (s *URLSigner) Verify(p string, q url.Values, now time.Time) error {
+	exp := q.Get(signedURLExpiresParam)
+	sig := q.Get(signedURLSignatureParam)
+	if exp == "" || sig == "" {
+		return ErrInvalidSignature
+	}
+
+	expiresAt, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil || now.Unix() > expiresAt {
+		return ErrInvalidSignature
+	}
+
+	if !hmac.Equal([]byte(sig), []byte(s.signature(p, exp))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// HasSignature returns true if the query has any of parameters of signed URLs
+func HasSignature(q url.Values) bool {
+	return q.Has(signedURLExpiresParam) || q.Has(signedURLSignatureParam)
+}
+
+func (s *URLSigner) signature(p, exp string) string {
+	h := hmac.New(sha256.New, s.secret)
+	_, _ = h.Write([]byte(p + "\n" + exp))
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,36 @@
+package fs
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURLSigner(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewURLSigner("secret")
+
+	u, _ := url.Parse("https://example.com/assets/aa/bbb/x.txt?a=b")
+	s.Sign(u, "aa/bbb/x.txt", now.Add(time.Minute))
+	q := u.Query()
+	assert.Equal(t, "b", q.Get("a"))
+	assert.True(t, HasSignature(q))
+
+	assert.NoError(t, s.Verify("aa/bbb/x.txt", q, now))
+	assert.NoError(t, s.Verify("aa/bbb/x.txt", q, now.Add(time.Minute)))
+	assert.ErrorIs(t, s.Verify("aa/bbb/x.txt", q, now.Add(time.Minute+time.Second)), ErrInvalidSignature)
+	assert.ErrorIs(t, s.Verify("aa/bbb/y.txt", q, now), ErrInvalidSignature)
+	assert.ErrorIs(t, NewURLSigner("other").Verify("aa/bbb/x.txt", q, now), ErrInvalidSignature)
+	assert.ErrorIs(t, s.Verify("aa/bbb/x.txt", url.Values{}, now), ErrInvalidSignature)
+	assert.False(t, HasSignature(url.Values{}))
+
+	// tampered expiration time
+	q2 := u.Query()
+	q2.Set("expires", "99999999999")
+	assert.ErrorIs(t, s.Verify("aa/bbb/x.txt", q2, now), ErrInvalidSignature)
+
+	// random secret
+	assert.NotEqual(t, NewURLSigner("").secret, NewURLSigner("").secret)
+}
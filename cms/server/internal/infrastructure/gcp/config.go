@@ -1,15 +1,27 @@
 package gcp
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type TaskConfig struct {
 	GCPProject    string
 	GCPRegion     string
 	QueueName     string
 	SubscriberURL string
-	Topic         string
-	GCSHost       string
-	Timeout       int64 `default:"1800"` // second
+	// CompressURL is the endpoint of the worker to compress assets. It is derived from SubscriberURL when it is empty.
+	CompressURL string
+	Topic       string
+	GCSHost     string
+	Timeout     int64 `default:"1800"` // second
+}
+
+func (c *TaskConfig) compressURL() string {
+	if c.CompressURL != "" {
+		return c.CompressURL
+	}
+	return strings.TrimSuffix(c.SubscriberURL, "/decompress") + "/compress"
 }
 
 func (c *TaskConfig) buildQueueUrl() (string, error) {
@@ -55,3 +55,8 @@ func TestCloudTasksConfig_buildQueueUrl(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskConfig_compressURL(t *testing.T) {
+	assert.Equal(t, "https://worker/api/compress", (&TaskConfig{SubscriberURL: "https://worker/api/decompress"}).compressURL())
+	assert.Equal(t, "https://worker2/compress", (&TaskConfig{SubscriberURL: "https://worker/api/decompress", CompressURL: "https://worker2/compress"}).compressURL())
+}
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/google/uuid"
@@ -140,6 +142,107 @@ func (f *fileRepo) GetURL(a *asset.Asset) string {
 	return getURL(f.base, a.UUID(), a.FileName())
 }
 
+// GetSignedURL implements gateway.File. The URL is signed with V4 signing scheme, so the credential of the server must be able to sign blobs.
+func (f *fileRepo) GetSignedURL(ctx context.Context, a *asset.Asset, fp string, expiry time.Duration) (string, error) {
+	if fp == "" {
+		fp = a.FileName()
+	}
+	p := getGCSObjectPath(a.UUID(), fp)
+	if p == "" {
+		return "", gateway.ErrInvalidFile
+	}
+
+	bucket, err := f.bucket(ctx)
+	if err != nil {
+		log.Errorf("gcs: signed url bucket err: %+v\n", err)
+		return "", rerror.ErrInternalBy(err)
+	}
+
+	u, err := bucket.SignedURL(p, &storage.SignedURLOptions{
+		Scheme:  storage.SigningSchemeV4,
+		Method:  http.MethodGet,
+		Expires: time.Now().Add(expiry),
+	})
+	if err != nil {
+		log.Errorf("gcs: signed url err: %+v\n", err)
+		return "", rerror.ErrInternalBy(err)
+	}
+	return u, nil
+}
+
+// Read implements gateway.File
+func (f *fileRepo) Read(ctx context.Context, p string) (gateway.ReadAtCloser, int64, error) {
+	if p == "" {
+		return nil, 0, rerror.ErrNotFound
+	}
+
+	bucket, err := f.bucket(ctx)
+	if err != nil {
+		log.Errorf("gcs: read bucket err: %+v\n", err)
+		return nil, 0, rerror.ErrInternalBy(err)
+	}
+
+	obj := bucket.Object(path.Join(gcsAssetBasePath, p))
+	attrs, err := obj.Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, 0, rerror.ErrNotFound
+		}
+		log.Errorf("gcs: read attrs err: %+v\n", err)
+		return nil, 0, rerror.ErrInternalBy(err)
+	}
+
+	return &objectReaderAt{ctx: ctx, obj: obj}, attrs.Size, nil
+}
+
+// Upload implements gateway.File
+func (f *fileRepo) Upload(ctx context.Context, name string) (io.WriteCloser, error) {
+	if name == "" {
+		return nil, gateway.ErrInvalidFile
+	}
+
+	bucket, err := f.bucket(ctx)
+	if err != nil {
+		log.Errorf("gcs: upload bucket err: %+v\n", err)
+		return nil, rerror.ErrInternalBy(err)
+	}
+
+	writer := bucket.Object(path.Join(gcsAssetBasePath, name)).NewWriter(ctx)
+	writer.ObjectAttrs.CacheControl = f.cacheControl
+	return writer, nil
+}
+
+// List implements gateway.File
+func (f *fileRepo) List(ctx context.Context, dir string) ([]string, error) {
+	if dir == "" {
+		return nil, rerror.ErrNotFound
+	}
+
+	bucket, err := f.bucket(ctx)
+	if err != nil {
+		log.Errorf("gcs: list bucket err: %+v\n", err)
+		return nil, rerror.ErrInternalBy(err)
+	}
+
+	it := bucket.Objects(ctx, &storage.Query{
+		Prefix: path.Join(gcsAssetBasePath, dir) + "/",
+	})
+
+	var files []string
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			log.Errorf("gcs: list err: %+v\n", err)
+			return nil, rerror.ErrInternalBy(err)
+		}
+		files = append(files, strings.TrimPrefix(attrs.Name, gcsAssetBasePath+"/"))
+	}
+	return files, nil
+}
+
 func (f *fileRepo) read(ctx context.Context, filename string) (io.ReadCloser, error) {
 	if filename == "" {
 		return nil, rerror.ErrNotFound
@@ -224,6 +327,30 @@ func (f *fileRepo) delete(ctx context.Context, filename string) error {
 	return nil
 }
 
+// objectReaderAt implements io.ReaderAt with range requests to a GCS object
+type objectReaderAt struct {
+	ctx context.Context
+	obj *storage.ObjectHandle
+}
+
+func (r *objectReaderAt) ReadAt(b []byte, off int64) (int, error) {
+	rc, err := r.obj.NewRangeReader(r.ctx, off, int64(len(b)))
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	n, err := io.ReadFull(rc, b)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (r *objectReaderAt) Close() error {
+	return nil
+}
+
 func getGCSObjectPath(uuid, objectName string) string {
 	if uuid == "" || !IsValidUUID(uuid) {
 		return ""
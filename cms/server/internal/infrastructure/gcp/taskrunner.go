@@ -58,6 +58,9 @@ func (t *TaskRunner) Run(ctx context.Context, p task.Payload) error {
 }
 
 func (t *TaskRunner) runCloudTask(ctx context.Context, p task.Payload) error {
+	if p.CompressAsset != nil {
+		return t.runCompressTask(ctx, p.CompressAsset)
+	}
 	if p.DecompressAsset == nil {
 		return nil
 	}
@@ -81,6 +84,25 @@ func (t *TaskRunner) runCloudTask(ctx context.Context, p task.Payload) error {
 	return nil
 }
 
+func (t *TaskRunner) runCompressTask(ctx context.Context, p *task.CompressAssetPayload) error {
+	bPayload, err := json.Marshal(struct {
+		AssetID string `json:"assetId"`
+		Path    string `json:"path"`
+		Output  string `json:"output"`
+	}{AssetID: p.AssetID, Path: p.Path, Output: p.Output})
+	if err != nil {
+		return err
+	}
+
+	req := t.buildRequest(t.conf.compressURL(), bPayload)
+	if _, err := t.createTask(ctx, req); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	log.Infof("task request has been sent: body %#v", p)
+
+	return nil
+}
+
 func (t *TaskRunner) runPubSub(ctx context.Context, p task.Payload) error {
 	if p.Webhook == nil {
 		return nil
@@ -18,9 +18,26 @@ type webhookData struct {
 	EventType string                  `json:"type"`
 	EventData any                     `json:"data"`
 	Operator  integrationapi.Operator `json:"operator"`
+
+	DeliveryID string          `json:"deliveryId,omitempty"`
+	Attempt    int             `json:"attempt,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
 }
 
 func marshalWebhookData(w *task.WebhookPayload, urlResolver asset.URLResolver) ([]byte, error) {
+	if d := w.Delivery; d != nil && d.Payload() != "" {
+		return json.Marshal(webhookData{
+			URL:        w.Webhook.URL().String(),
+			Secret:     w.Webhook.Secret(),
+			WebhookID:  w.Webhook.ID().String(),
+			EventID:    d.Event().String(),
+			EventType:  string(d.EventType()),
+			DeliveryID: d.ID().String(),
+			Attempt:    d.NextAttempt(),
+			Body:       json.RawMessage(d.Payload()),
+		})
+	}
+
 	ed, err := integrationapi.NewEventWith(w.Event, w.Override, "", urlResolver)
 	if err != nil {
 		return nil, err
@@ -36,6 +53,10 @@ func marshalWebhookData(w *task.WebhookPayload, urlResolver asset.URLResolver) (
 		EventData: ed.Data,
 		Operator:  ed.Operator,
 	}
+	if w.Delivery != nil {
+		d.DeliveryID = w.Delivery.ID().String()
+		d.Attempt = w.Delivery.NextAttempt()
+	}
 
 	return json.Marshal(d)
 }
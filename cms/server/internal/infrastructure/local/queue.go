@@ -0,0 +1,117 @@
+package local
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+type TaskType string
+
+const (
+	TaskTypeDecompressAsset TaskType = "decompressAsset"
+	TaskTypeCompressAsset   TaskType = "compressAsset"
+	TaskTypeWebhook         TaskType = "webhook"
+)
+
+// Task is a pending task persisted in the queue. Data is the JSON encoded payload of the task.
+// A failed task is not run again until NextRunAt, and a claimed task is not claimed by others until LockedUntil.
+type Task struct {
+	ID          string
+	Type        TaskType
+	Data        []byte
+	Attempts    int
+	NextRunAt   time.Time
+	LockedUntil time.Time
+	CreatedAt   time.Time
+}
+
+// IsRunnable returns whether the task can be claimed at the time
+func (t *Task) IsRunnable(now time.Time) bool {
+	return !t.NextRunAt.After(now) && !t.LockedUntil.After(now)
+}
+
+type Queue interface {
+	FindAll(context.Context) ([]*Task, error)
+	// Claim atomically locks the oldest runnable task until now+lease and returns it. It returns nil when there is no runnable task.
+	Claim(ctx context.Context, now time.Time, lease time.Duration) (*Task, error)
+	// Extend extends the lock of the claimed task
+	Extend(ctx context.Context, id string, until time.Time) error
+	Save(context.Context, *Task) error
+	Remove(context.Context, string) error
+}
+
+type memoryQueue struct {
+	lock  sync.Mutex
+	tasks map[string]*Task
+}
+
+// NewMemoryQueue returns a Queue which keeps tasks only in memory
+func NewMemoryQueue() Queue {
+	return &memoryQueue{
+		tasks: map[string]*Task{},
+	}
+}
+
+func (q *memoryQueue) FindAll(_ context.Context) ([]*Task, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	res := lo.Map(lo.Values(q.tasks), func(t *Task, _ int) *Task {
+		t2 := *t
+		return &t2
+	})
+	slices.SortFunc(res, func(a, b *Task) bool {
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+	return res, nil
+}
+
+func (q *memoryQueue) Claim(_ context.Context, now time.Time, lease time.Duration) (*Task, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	var res *Task
+	for _, t := range q.tasks {
+		if t.IsRunnable(now) && (res == nil || t.CreatedAt.Before(res.CreatedAt)) {
+			res = t
+		}
+	}
+	if res == nil {
+		return nil, nil
+	}
+
+	res.LockedUntil = now.Add(lease)
+	t2 := *res
+	return &t2, nil
+}
+
+func (q *memoryQueue) Extend(_ context.Context, id string, until time.Time) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if t, ok := q.tasks[id]; ok {
+		t.LockedUntil = until
+	}
+	return nil
+}
+
+func (q *memoryQueue) Save(_ context.Context, t *Task) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	t2 := *t
+	q.tasks[t.ID] = &t2
+	return nil
+}
+
+func (q *memoryQueue) Remove(_ context.Context, id string) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	delete(q.tasks, id)
+	return nil
+}
@@ -0,0 +1,47 @@
+package local
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryQueue_Claim(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	q := NewMemoryQueue()
+	assert.NoError(t, q.Save(ctx, &Task{ID: "b", CreatedAt: now}))
+	assert.NoError(t, q.Save(ctx, &Task{ID: "a", CreatedAt: now.Add(-time.Second)}))
+	assert.NoError(t, q.Save(ctx, &Task{ID: "c", NextRunAt: now.Add(time.Minute), CreatedAt: now.Add(-time.Hour)}))
+
+	// the oldest runnable task is claimed first
+	got, err := q.Claim(ctx, now, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", got.ID)
+	assert.Equal(t, now.Add(time.Minute), got.LockedUntil)
+
+	got, err = q.Claim(ctx, now, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", got.ID)
+
+	// claimed tasks and tasks waiting for a retry are not claimed
+	got, err = q.Claim(ctx, now, time.Minute)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	// the lock is extended
+	assert.NoError(t, q.Extend(ctx, "a", now.Add(time.Hour)))
+
+	// the lease of b has expired and c is waiting no longer
+	got, err = q.Claim(ctx, now.Add(time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "c", got.ID)
+	got, err = q.Claim(ctx, now.Add(time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", got.ID)
+	got, err = q.Claim(ctx, now.Add(time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,451 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearth-cms/worker/pkg/compressor"
+	"github.com/reearth/reearth-cms/worker/pkg/decompressor"
+	"github.com/reearth/reearth-cms/worker/pkg/webhook"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+// progressInterval is the minimum interval between progress notifications of a decompression
+const progressInterval = 10 * time.Second
+
+// TaskConfig configures the local task runner. Running tasks are locked for Lease, which is extended while they run,
+// and failed tasks are retried after RetryInterval, which doubles on each failure up to MaxRetryInterval.
+type TaskConfig struct {
+	Workers          int           `default:"4"`
+	MaxAttempts      int           `default:"5"`
+	PollInterval     time.Duration `default:"30s"`
+	Lease            time.Duration `default:"5m"`
+	RetryInterval    time.Duration `default:"10s"`
+	MaxRetryInterval time.Duration `default:"10m"`
+}
+
+// CMS receives the results of tasks which have to be reflected to CMS data
+type CMS interface {
+	NotifyAssetDecompressed(ctx context.Context, assetID string, status *asset.ArchiveExtractionStatus) error
+	NotifyAssetDecompressProgress(ctx context.Context, assetID string, progress decompressor.Progress) error
+	NotifyAssetCompressed(ctx context.Context, assetID string, status *asset.ArchiveExtractionStatus) error
+	NotifyWebhookDelivered(ctx context.Context, result *webhook.Result) error
+}
+
+// TaskRunner runs tasks in a bounded worker pool inside the CMS process. Pending tasks are persisted to the queue so that they are resumed after restarts,
+// and workers claim tasks from the queue so that a task is not run twice even when multiple CMS instances share the queue.
+type TaskRunner struct {
+	conf  TaskConfig
+	file  gateway.File
+	cms   CMS
+	queue Queue
+	wake  chan struct{}
+}
+
+var _ gateway.TaskRunner = (*TaskRunner)(nil)
+
+func NewTaskRunner(conf TaskConfig, file gateway.File, queue Queue, cms CMS) *TaskRunner {
+	if conf.Workers <= 0 {
+		conf.Workers = 1
+	}
+	if conf.MaxAttempts <= 0 {
+		conf.MaxAttempts = 1
+	}
+	if conf.PollInterval <= 0 {
+		conf.PollInterval = 30 * time.Second
+	}
+	if conf.Lease <= 0 {
+		conf.Lease = 5 * time.Minute
+	}
+	if conf.RetryInterval <= 0 {
+		conf.RetryInterval = 10 * time.Second
+	}
+	if conf.MaxRetryInterval < conf.RetryInterval {
+		conf.MaxRetryInterval = conf.RetryInterval
+	}
+
+	return &TaskRunner{
+		conf:  conf,
+		file:  file,
+		cms:   cms,
+		queue: queue,
+		wake:  make(chan struct{}, conf.Workers),
+	}
+}
+
+// Start launches workers which claim and run tasks in the queue. Workers stop when ctx is done.
+func (t *TaskRunner) Start(ctx context.Context) {
+	for i := 0; i < t.conf.Workers; i++ {
+		go t.work(ctx)
+	}
+}
+
+// Run implements gateway.TaskRunner
+func (t *TaskRunner) Run(ctx context.Context, p task.Payload) error {
+	tk, err := t.taskFrom(p)
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	if tk == nil {
+		return nil
+	}
+
+	if err := t.queue.Save(ctx, tk); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+
+	t.notify()
+	log.Infof("local task: task has been queued: id=%s type=%s", tk.ID, tk.Type)
+	return nil
+}
+
+func (t *TaskRunner) taskFrom(p task.Payload) (*Task, error) {
+	var typ TaskType
+	var data any
+
+	switch {
+	case p.DecompressAsset != nil:
+		typ = TaskTypeDecompressAsset
+		data = p.DecompressAsset
+	case p.CompressAsset != nil:
+		typ = TaskTypeCompressAsset
+		data = p.CompressAsset
+	case p.Webhook != nil:
+		w, err := t.webhookFrom(p.Webhook)
+		if err != nil {
+			return nil, err
+		}
+		typ = TaskTypeWebhook
+		data = w
+	default:
+		return nil, nil
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Task{
+		ID:        uuid.NewString(),
+		Type:      typ,
+		Data:      b,
+		CreatedAt: util.Now(),
+	}, nil
+}
+
+func (t *TaskRunner) webhookFrom(p *task.WebhookPayload) (*webhook.Webhook, error) {
+	if d := p.Delivery; d != nil && d.Payload() != "" {
+		return &webhook.Webhook{
+			URL:        p.Webhook.URL().String(),
+			Secret:     p.Webhook.Secret(),
+			WebhookID:  p.Webhook.ID().String(),
+			EventID:    d.Event().String(),
+			EventType:  string(d.EventType()),
+			DeliveryID: d.ID().String(),
+			Attempt:    d.NextAttempt(),
+			Body:       json.RawMessage(d.Payload()),
+		}, nil
+	}
+
+	ed, err := integrationapi.NewEventWith(p.Event, p.Override, "", t.file.GetURL)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &webhook.Webhook{
+		URL:       p.Webhook.URL().String(),
+		Secret:    p.Webhook.Secret(),
+		Timestamp: ed.Timestamp,
+		WebhookID: p.Webhook.ID().String(),
+		EventID:   ed.ID,
+		EventType: ed.Type,
+		EventData: ed.Data,
+		Operator:  ed.Operator,
+	}
+	if p.Delivery != nil {
+		w.DeliveryID = p.Delivery.ID().String()
+		w.Attempt = p.Delivery.NextAttempt()
+	}
+	return w, nil
+}
+
+// notify wakes up an idle worker. When all workers are busy, the task is left in the queue and picked up when one of them becomes idle.
+func (t *TaskRunner) notify() {
+	select {
+	case t.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (t *TaskRunner) work(ctx context.Context) {
+	ticker := time.NewTicker(t.conf.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for t.runNext(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// runNext claims a runnable task and runs it. It returns false when there is no task to run.
+func (t *TaskRunner) runNext(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	tk, err := t.queue.Claim(ctx, util.Now(), t.conf.Lease)
+	if err != nil {
+		log.Errorf("local task: failed to claim a task: %v", err)
+		return false
+	}
+	if tk == nil {
+		return false
+	}
+
+	stop := t.keepLease(ctx, tk.ID)
+	err = t.exec(ctx, tk)
+	stop()
+	t.finish(ctx, tk, err)
+	return true
+}
+
+// keepLease extends the lock of the running task periodically until the returned function is called
+func (t *TaskRunner) keepLease(ctx context.Context, id string) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(t.conf.Lease / 2)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := t.queue.Extend(ctx, id, util.Now().Add(t.conf.Lease)); err != nil {
+					log.Warnf("local task: failed to extend the lease: id=%s err=%v", id, err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// finish removes the task from the queue when it has been done or has failed too many times. Otherwise, it is unlocked and retried after the backoff.
+func (t *TaskRunner) finish(ctx context.Context, tk *Task, err error) {
+	if err == nil {
+		if err := t.queue.Remove(ctx, tk.ID); err != nil {
+			log.Errorf("local task: failed to remove task: id=%s err=%v", tk.ID, err)
+		}
+		log.Infof("local task: task has been done: id=%s type=%s", tk.ID, tk.Type)
+		return
+	}
+
+	tk.Attempts++
+	if tk.Attempts >= t.conf.MaxAttempts {
+		log.Errorf("local task: task failed and gave up: id=%s type=%s attempts=%d err=%v", tk.ID, tk.Type, tk.Attempts, err)
+		if err := t.queue.Remove(ctx, tk.ID); err != nil {
+			log.Errorf("local task: failed to remove task: id=%s err=%v", tk.ID, err)
+		}
+		return
+	}
+
+	tk.NextRunAt = util.Now().Add(t.backoff(tk.Attempts))
+	tk.LockedUntil = time.Time{}
+	log.Warnf("local task: task failed and will be retried: id=%s type=%s attempts=%d next=%s err=%v", tk.ID, tk.Type, tk.Attempts, tk.NextRunAt, err)
+	if err := t.queue.Save(ctx, tk); err != nil {
+		log.Errorf("local task: failed to save task: id=%s err=%v", tk.ID, err)
+	}
+}
+
+// backoff returns the duration to wait after the n-th failure
+func (t *TaskRunner) backoff(n int) time.Duration {
+	b := t.conf.RetryInterval
+	for i := 1; i < n && b < t.conf.MaxRetryInterval; i++ {
+		b *= 2
+	}
+	if b > t.conf.MaxRetryInterval {
+		return t.conf.MaxRetryInterval
+	}
+	return b
+}
+
+func (t *TaskRunner) exec(ctx context.Context, tk *Task) error {
+	switch tk.Type {
+	case TaskTypeDecompressAsset:
+		var p task.DecompressAssetPayload
+		if err := json.Unmarshal(tk.Data, &p); err != nil {
+			return err
+		}
+		return t.decompress(ctx, p.AssetID, p.Path)
+	case TaskTypeCompressAsset:
+		var p task.CompressAssetPayload
+		if err := json.Unmarshal(tk.Data, &p); err != nil {
+			return err
+		}
+		return t.compress(ctx, p)
+	case TaskTypeWebhook:
+		var w webhook.Webhook
+		if err := json.Unmarshal(tk.Data, &w); err != nil {
+			return err
+		}
+		if w.DeliveryID != "" {
+			return t.deliver(ctx, &w)
+		}
+		return webhook.Send(ctx, &w)
+	}
+	return fmt.Errorf("unknown task type: %s", tk.Type)
+}
+
+// deliver sends a tracked webhook once and reports the result. Retries are scheduled by CMS, so the task fails only when the report fails.
+func (t *TaskRunner) deliver(ctx context.Context, w *webhook.Webhook) error {
+	r := webhook.Deliver(ctx, w)
+	if r.Error != "" {
+		log.Warnf("local task: webhook delivery failed: delivery=%s attempt=%d err=%s", r.DeliveryID, r.Attempt, r.Error)
+	}
+	return t.cms.NotifyWebhookDelivered(ctx, r)
+}
+
+func (t *TaskRunner) decompress(ctx context.Context, assetID, assetPath string) error {
+	status := asset.ArchiveExtractionStatusDone
+	if err := t.extract(ctx, assetID, assetPath); err != nil {
+		log.Errorf("local task: failed to decompress: asset=%s path=%s err=%v", assetID, assetPath, err)
+		status = asset.ArchiveExtractionStatusFailed
+	}
+	return t.cms.NotifyAssetDecompressed(ctx, assetID, lo.ToPtr(status))
+}
+
+func (t *TaskRunner) extract(ctx context.Context, assetID, assetPath string) error {
+	ext := decompressor.Ext(assetPath)
+	base := strings.TrimPrefix(strings.TrimSuffix(assetPath, "."+ext), "/")
+
+	r, size, err := t.file.Read(ctx, assetPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+
+	d, err := decompressor.New(r, size, ext, func(name string) (io.WriteCloser, error) {
+		return t.file.Upload(ctx, name)
+	})
+	if err != nil {
+		if errors.Is(err, decompressor.ErrUnsupportedExtention) {
+			log.Infof("local task: unsupported extension: decompression skipped: path=%s", assetPath)
+			return nil
+		}
+		return err
+	}
+
+	d.OnProgress(t.notifyProgress(ctx, assetID))
+	err = d.Decompress(base)
+
+	// the manifest is saved even when some files fail so that the extracted files are listed exactly
+	if merr := t.saveManifest(ctx, strings.TrimPrefix(assetPath, "/"), d.Manifest()); merr != nil && err == nil {
+		err = merr
+	}
+	return err
+}
+
+func (t *TaskRunner) compress(ctx context.Context, p task.CompressAssetPayload) error {
+	status := asset.ArchiveExtractionStatusDone
+	if err := t.pack(ctx, p.Path, p.Output); err != nil {
+		log.Errorf("local task: failed to compress: asset=%s path=%s err=%v", p.AssetID, p.Path, err)
+		status = asset.ArchiveExtractionStatusFailed
+	}
+	return t.cms.NotifyAssetCompressed(ctx, p.AssetID, lo.ToPtr(status))
+}
+
+// pack writes files under dir to output as a zip archive. The output, archives of other folders and manifests are not included.
+func (t *TaskRunner) pack(ctx context.Context, dir, output string) error {
+	files, err := t.file.List(ctx, dir)
+	if err != nil {
+		return err
+	}
+	files = lo.Filter(files, func(f string, _ int) bool {
+		return f != output && !strings.HasSuffix(f, asset.CompressedSuffix) && !strings.HasSuffix(f, decompressor.ManifestSuffix)
+	})
+
+	w, err := t.file.Upload(ctx, output)
+	if err != nil {
+		return err
+	}
+
+	err = compressor.Compress(w, dir, files, func(name string) (io.ReadCloser, error) {
+		r, size, err := t.file.Read(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return struct {
+			io.Reader
+			io.Closer
+		}{io.NewSectionReader(r, 0, size), r}, nil
+	})
+	if err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// notifyProgress returns a function which notifies the progress of a decompression at most once per progressInterval
+func (t *TaskRunner) notifyProgress(ctx context.Context, assetID string) func(decompressor.Progress) {
+	var lock sync.Mutex
+	var last time.Time
+	return func(p decompressor.Progress) {
+		if !lock.TryLock() {
+			return
+		}
+		defer lock.Unlock()
+
+		if time.Since(last) < progressInterval {
+			return
+		}
+		last = time.Now()
+		if err := t.cms.NotifyAssetDecompressProgress(ctx, assetID, p); err != nil {
+			log.Warnf("local task: failed to notify progress: asset=%s err=%v", assetID, err)
+		}
+	}
+}
+
+func (t *TaskRunner) saveManifest(ctx context.Context, assetPath string, m *decompressor.Manifest) error {
+	w, err := t.file.Upload(ctx, decompressor.ManifestPath(assetPath))
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(w).Encode(m.Rel(path.Dir(assetPath))); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
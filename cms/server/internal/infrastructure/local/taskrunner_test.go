@@ -0,0 +1,252 @@
+package local
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearth-cms/worker/pkg/decompressor"
+	"github.com/reearth/reearth-cms/worker/pkg/webhook"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskRunner_Decompress(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mfs := afero.NewMemMapFs()
+	zf := lo.Must(mfs.Create("assets/51/30c89f-8f67-4766-b127-49ee6796d464/test.zip"))
+	zw := zip.NewWriter(zf)
+	w := lo.Must(zw.Create("test1.txt"))
+	_ = lo.Must(w.Write([]byte("hello1")))
+	w = lo.Must(zw.Create("dir/test2.txt"))
+	_ = lo.Must(w.Write([]byte("hello2")))
+	require.NoError(t, zw.Close())
+	require.NoError(t, zf.Close())
+
+	f := lo.Must(fs.NewFile(mfs, "", nil))
+	cms := &cmsMock{notified: make(chan asset.ArchiveExtractionStatus, 1)}
+	q := NewMemoryQueue()
+	r := NewTaskRunner(TaskConfig{Workers: 2, MaxAttempts: 1, PollInterval: time.Hour}, f, q, cms)
+	r.Start(ctx)
+
+	assert.NoError(t, r.Run(ctx, (&task.DecompressAssetPayload{
+		AssetID: "aaa",
+		Path:    "51/30c89f-8f67-4766-b127-49ee6796d464/test.zip",
+	}).Payload()))
+
+	select {
+	case s := <-cms.notified:
+		assert.Equal(t, asset.ArchiveExtractionStatusDone, s)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout")
+	}
+
+	c := lo.Must(afero.ReadFile(mfs, "assets/51/30c89f-8f67-4766-b127-49ee6796d464/test/test1.txt"))
+	assert.Equal(t, "hello1", string(c))
+	c = lo.Must(afero.ReadFile(mfs, "assets/51/30c89f-8f67-4766-b127-49ee6796d464/test/dir/test2.txt"))
+	assert.Equal(t, "hello2", string(c))
+
+	var m decompressor.Manifest
+	require.NoError(t, json.Unmarshal(lo.Must(afero.ReadFile(mfs, "assets/51/30c89f-8f67-4766-b127-49ee6796d464/test.zip.manifest.json")), &m))
+	assert.Equal(t, []string{"test/dir/test2.txt", "test/test1.txt"}, lo.Map(m.Entries, func(e decompressor.Entry, _ int) string { return e.Path }))
+
+	assert.Eventually(t, func() bool {
+		return len(lo.Must(q.FindAll(ctx))) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestTaskRunner_Compress(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mfs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(mfs, "assets/51/30c89f-8f67-4766-b127-49ee6796d464/test/test1.txt", []byte("hello1"), 0644))
+	require.NoError(t, afero.WriteFile(mfs, "assets/51/30c89f-8f67-4766-b127-49ee6796d464/test/dir/test2.txt", []byte("hello2"), 0644))
+	// the archive of the sub folder is not included
+	require.NoError(t, afero.WriteFile(mfs, "assets/51/30c89f-8f67-4766-b127-49ee6796d464/test/dir.compressed.zip", []byte("zip"), 0644))
+
+	f := lo.Must(fs.NewFile(mfs, "", nil))
+	cms := &cmsMock{compressed: make(chan asset.ArchiveExtractionStatus, 1)}
+	q := NewMemoryQueue()
+	r := NewTaskRunner(TaskConfig{Workers: 1, MaxAttempts: 1, PollInterval: time.Hour}, f, q, cms)
+	r.Start(ctx)
+
+	assert.NoError(t, r.Run(ctx, (&task.CompressAssetPayload{
+		AssetID: "aaa",
+		Path:    "51/30c89f-8f67-4766-b127-49ee6796d464/test",
+		Output:  "51/30c89f-8f67-4766-b127-49ee6796d464/test.compressed.zip",
+	}).Payload()))
+
+	select {
+	case s := <-cms.compressed:
+		assert.Equal(t, asset.ArchiveExtractionStatusDone, s)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout")
+	}
+
+	b := lo.Must(afero.ReadFile(mfs, "assets/51/30c89f-8f67-4766-b127-49ee6796d464/test.compressed.zip"))
+	zr := lo.Must(zip.NewReader(bytes.NewReader(b), int64(len(b))))
+	assert.Equal(t, []string{"test/dir/test2.txt", "test/test1.txt"}, lo.Map(zr.File, func(f *zip.File, _ int) string { return f.Name }))
+}
+
+func TestTaskRunner_Webhook(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := make(chan map[string]any, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		received <- body
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	f := lo.Must(fs.NewFile(afero.NewMemMapFs(), "", nil))
+	q := NewMemoryQueue()
+	r := NewTaskRunner(TaskConfig{Workers: 1, MaxAttempts: 1, PollInterval: time.Hour}, f, q, &cmsMock{})
+	r.Start(ctx)
+
+	wh := integration.NewWebhookBuilder().NewID().Name("w").Url(lo.Must(url.Parse(ts.URL))).Active(true).Secret("secret").MustBuild()
+	a := asset.New().NewID().Project(project.NewID()).Thread(id.NewThreadID()).NewUUID().FileName("a.txt").Size(1).CreatedByUser(id.NewUserID()).MustBuild()
+	ev := event.New[any]().NewID().Type(event.AssetCreate).Timestamp(time.Now()).Operator(operator.OperatorFromMachine()).Object(a).MustBuild()
+
+	assert.NoError(t, r.Run(ctx, task.WebhookPayload{Webhook: wh, Event: ev}.Payload()))
+
+	select {
+	case body := <-received:
+		assert.Equal(t, ev.ID().String(), body["id"])
+		assert.Equal(t, string(event.AssetCreate), body["type"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout")
+	}
+
+	assert.Eventually(t, func() bool {
+		return len(lo.Must(q.FindAll(ctx))) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestTaskRunner_Retry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := make(chan struct{}, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		calls <- struct{}{}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	f := lo.Must(fs.NewFile(afero.NewMemMapFs(), "", nil))
+	q := NewMemoryQueue()
+	r := NewTaskRunner(TaskConfig{Workers: 1, MaxAttempts: 2, PollInterval: 10 * time.Millisecond, RetryInterval: 10 * time.Millisecond}, f, q, &cmsMock{})
+	r.Start(ctx)
+
+	wh := integration.NewWebhookBuilder().NewID().Name("w").Url(lo.Must(url.Parse(ts.URL))).Active(true).MustBuild()
+	a := asset.New().NewID().Project(project.NewID()).Thread(id.NewThreadID()).NewUUID().FileName("a.txt").Size(1).CreatedByUser(id.NewUserID()).MustBuild()
+	ev := event.New[any]().NewID().Type(event.AssetCreate).Timestamp(time.Now()).Operator(operator.OperatorFromMachine()).Object(a).MustBuild()
+
+	assert.NoError(t, r.Run(ctx, task.WebhookPayload{Webhook: wh, Event: ev}.Payload()))
+
+	assert.Eventually(t, func() bool {
+		return len(calls) == 2 && len(lo.Must(q.FindAll(ctx))) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestTaskRunner_backoff(t *testing.T) {
+	r := NewTaskRunner(TaskConfig{RetryInterval: time.Second, MaxRetryInterval: 5 * time.Second}, nil, nil, nil)
+	assert.Equal(t, time.Second, r.backoff(1))
+	assert.Equal(t, 2*time.Second, r.backoff(2))
+	assert.Equal(t, 4*time.Second, r.backoff(3))
+	assert.Equal(t, 5*time.Second, r.backoff(4))
+	assert.Equal(t, 5*time.Second, r.backoff(10))
+}
+
+func TestTaskRunner_WebhookDelivery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	f := lo.Must(fs.NewFile(afero.NewMemMapFs(), "", nil))
+	q := NewMemoryQueue()
+	cms := &cmsMock{delivered: make(chan *webhook.Result, 1)}
+	r := NewTaskRunner(TaskConfig{Workers: 1, MaxAttempts: 3, PollInterval: time.Hour}, f, q, cms)
+	r.Start(ctx)
+
+	wh := integration.NewWebhookBuilder().NewID().Name("w").Url(lo.Must(url.Parse(ts.URL))).Active(true).MustBuild()
+	d := integration.NewDelivery().NewID().Integration(integration.NewID()).Webhook(wh.ID()).Event(id.NewEventID()).EventType(event.AssetCreate).Payload(`{"a":1}`).MustBuild()
+
+	assert.NoError(t, r.Run(ctx, task.WebhookPayload{Webhook: wh, Delivery: d}.Payload()))
+
+	select {
+	case res := <-cms.delivered:
+		assert.Equal(t, d.ID().String(), res.DeliveryID)
+		assert.Equal(t, 1, res.Attempt)
+		assert.Equal(t, `{"a":1}`, res.Body)
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.NotEmpty(t, res.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout")
+	}
+
+	// the task is not retried by the runner
+	assert.Eventually(t, func() bool {
+		return len(lo.Must(q.FindAll(ctx))) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+type cmsMock struct {
+	notified   chan asset.ArchiveExtractionStatus
+	compressed chan asset.ArchiveExtractionStatus
+	delivered  chan *webhook.Result
+}
+
+func (c *cmsMock) NotifyAssetCompressed(_ context.Context, _ string, status *asset.ArchiveExtractionStatus) error {
+	if c.compressed != nil {
+		c.compressed <- *status
+	}
+	return nil
+}
+
+func (c *cmsMock) NotifyWebhookDelivered(_ context.Context, r *webhook.Result) error {
+	if c.delivered != nil {
+		c.delivered <- r
+	}
+	return nil
+}
+
+func (c *cmsMock) NotifyAssetDecompressProgress(_ context.Context, _ string, _ decompressor.Progress) error {
+	return nil
+}
+
+func (c *cmsMock) NotifyAssetDecompressed(_ context.Context, _ string, status *asset.ArchiveExtractionStatus) error {
+	if c.notified != nil {
+		c.notified <- *status
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
@@ -52,6 +53,29 @@ func (r *Asset) FindByIDs(ctx context.Context, ids id.AssetIDList) ([]*asset.Ass
 	return res, nil
 }
 
+func (r *Asset) FindByUUID(ctx context.Context, uuid string) (*asset.Asset, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return rerror.ErrIfNil(r.data.Find(func(key asset.ID, value *asset.Asset) bool {
+		return uuid != "" && value.UUID() == uuid && r.f.CanRead(value.Project())
+	}), rerror.ErrNotFound)
+}
+
+func (r *Asset) FindByArea(ctx context.Context, pid id.ProjectID, area value.Geometry) ([]*asset.Asset, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if !r.f.CanRead(pid) {
+		return nil, nil
+	}
+
+	return asset.List(r.data.FindAll(func(_ asset.ID, v *asset.Asset) bool {
+		return v.Project() == pid && area.IntersectsBBox(v.BBox())
+	})).SortByID(), nil
+}
+
 func (r *Asset) FindByProject(ctx context.Context, id id.ProjectID, filter repo.AssetFilter) ([]*asset.Asset, *usecasex.PageInfo, error) {
 	if !r.f.CanRead(id) {
 		return nil, usecasex.EmptyPageInfo(), nil
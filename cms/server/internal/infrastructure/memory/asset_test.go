@@ -7,6 +7,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/samber/lo"
@@ -250,6 +251,49 @@ func TestAssetRepo_FindByIDs(t *testing.T) {
 	}
 }
 
+func TestAssetRepo_FindByArea(t *testing.T) {
+	ctx := context.Background()
+	pid := id.NewProjectID()
+	newAsset := func(pid id.ProjectID, bbox []float64) *asset.Asset {
+		return asset.New().NewID().Project(pid).CreatedByUser(id.NewUserID()).Size(1000).Thread(id.NewThreadID()).NewUUID().BBox(bbox).MustBuild()
+	}
+	a1 := newAsset(pid, []float64{139, 35, 140, 36})
+	a2 := newAsset(pid, []float64{0, 0, 1, 1})
+	a3 := newAsset(pid, nil)
+	a4 := newAsset(id.NewProjectID(), []float64{139, 35, 140, 36})
+
+	r := NewAsset()
+	for _, a := range []*asset.Asset{a1, a2, a3, a4} {
+		assert.NoError(t, r.Save(ctx, a))
+	}
+
+	area, _ := value.GeometryFromBBox([]float64{139.5, 35.5, 141, 37})
+	got, err := r.FindByArea(ctx, pid, area)
+	assert.NoError(t, err)
+	assert.Equal(t, []*asset.Asset{a1}, got)
+
+	got, err = r.Filtered(repo.ProjectFilter{Readable: id.ProjectIDList{}, Writable: id.ProjectIDList{}}).FindByArea(ctx, pid, area)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestAssetRepo_FindByUUID(t *testing.T) {
+	ctx := context.Background()
+	a := asset.New().NewID().Project(id.NewProjectID()).CreatedByUser(id.NewUserID()).Size(1000).Thread(id.NewThreadID()).NewUUID().MustBuild()
+	r := NewAsset()
+	assert.NoError(t, r.Save(ctx, a))
+
+	got, err := r.FindByUUID(ctx, a.UUID())
+	assert.NoError(t, err)
+	assert.Equal(t, a, got)
+
+	_, err = r.FindByUUID(ctx, "")
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	_, err = r.Filtered(repo.ProjectFilter{Readable: id.ProjectIDList{}, Writable: id.ProjectIDList{}}).FindByUUID(ctx, a.UUID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+}
+
 func TestAssetRepo_FindByProject(t *testing.T) {
 	pid1 := id.NewProjectID()
 	uid1 := id.NewUserID()
@@ -9,20 +9,22 @@ import (
 
 func New() *repo.Container {
 	return &repo.Container{
-		Asset:       NewAsset(),
-		AssetFile:   NewAssetFile(),
-		Lock:        NewLock(),
-		User:        NewUser(),
-		Request:     NewRequest(),
-		Workspace:   NewWorkspace(),
-		Project:     NewProject(),
-		Model:       NewModel(),
-		Item:        NewItem(),
-		Schema:      NewSchema(),
-		Integration: NewIntegration(),
-		Thread:      NewThread(),
-		Event:       NewEvent(),
-		Transaction: &usecasex.NopTransaction{},
+		Asset:           NewAsset(),
+		AssetFile:       NewAssetFile(),
+		Lock:            NewLock(),
+		User:            NewUser(),
+		Request:         NewRequest(),
+		Workspace:       NewWorkspace(),
+		Project:         NewProject(),
+		Model:           NewModel(),
+		Item:            NewItem(),
+		Schema:          NewSchema(),
+		Integration:     NewIntegration(),
+		Thread:          NewThread(),
+		Event:           NewEvent(),
+		WebhookDelivery: NewWebhookDelivery(),
+		Task:            NewTask(),
+		Transaction:     &usecasex.NopTransaction{},
 	}
 }
 
@@ -7,7 +7,10 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
 )
 
 type Event struct {
@@ -36,6 +39,33 @@ func (r *Event) FindByID(_ context.Context, iId id.EventID) (*event.Event[any],
 	return nil, rerror.ErrNotFound
 }
 
+func (r *Event) Search(_ context.Context, f repo.EventFilter, _ *usecasex.Pagination) ([]*event.Event[any], *usecasex.PageInfo, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	result := r.data.FindAll(func(_ id.EventID, ev *event.Event[any]) bool {
+		return matchEvent(ev, f)
+	})
+	slices.SortFunc(result, func(a, b *event.Event[any]) bool {
+		return a.ID().Compare(b.ID()) > 0
+	})
+
+	var startCursor, endCursor *usecasex.Cursor
+	if len(result) > 0 {
+		startCursor = lo.ToPtr(usecasex.Cursor(result[0].ID().String()))
+		endCursor = lo.ToPtr(usecasex.Cursor(result[len(result)-1].ID().String()))
+	}
+
+	return result, usecasex.NewPageInfo(
+		int64(len(result)),
+		startCursor,
+		endCursor,
+		true,
+		true,
+	), nil
+}
+
 func (r *Event) Save(ctx context.Context, ev *event.Event[any]) error {
 	if r.err != nil {
 		return r.err
@@ -44,3 +74,38 @@ func (r *Event) Save(ctx context.Context, ev *event.Event[any]) error {
 	r.data.Store(ev.ID(), ev)
 	return nil
 }
+
+func matchEvent(ev *event.Event[any], f repo.EventFilter) bool {
+	if f.Workspace != nil && !equalRef(ev.Workspace(), f.Workspace) {
+		return false
+	}
+	if f.Project != nil && (ev.Project() == nil || ev.Project().ID != f.Project.String()) {
+		return false
+	}
+	if f.Model != nil && !equalRef(ev.Model(), f.Model) {
+		return false
+	}
+	if f.Item != nil && !equalRef(ev.Item(), f.Item) {
+		return false
+	}
+	if f.User != nil && !equalRef(ev.Operator().User(), f.User) {
+		return false
+	}
+	if f.Integration != nil && !equalRef(ev.Operator().Integration(), f.Integration) {
+		return false
+	}
+	if len(f.Types) > 0 && !slices.Contains(f.Types, ev.Type()) {
+		return false
+	}
+	if f.From != nil && ev.Timestamp().Before(*f.From) {
+		return false
+	}
+	if f.To != nil && !ev.Timestamp().Before(*f.To) {
+		return false
+	}
+	return true
+}
+
+func equalRef[T comparable](a, b *T) bool {
+	return a != nil && b != nil && *a == *b
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
@@ -12,6 +13,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -59,3 +61,52 @@ func TestEvent_Save(t *testing.T) {
 	_ = r.Save(ctx, ev)
 	assert.Equal(t, 1, len(r.(*Event).data.Values()))
 }
+
+func TestEvent_Search(t *testing.T) {
+	now := time.Now()
+	wid := id.NewWorkspaceID()
+	pid := id.NewProjectID()
+	mid := id.NewModelID()
+	iid := id.NewItemID()
+	uid := id.NewUserID()
+	prj := &event.Project{ID: pid.String(), Alias: "prj"}
+
+	ev1 := event.New[any]().NewID().Timestamp(now.Add(-time.Hour)).Type(event.ItemCreate).Operator(operator.OperatorFromUser(uid)).
+		Workspace(&wid).Project(prj).Model(&mid).Item(&iid).MustBuild()
+	ev2 := event.New[any]().NewID().Timestamp(now).Type(event.ModelUpdate).Operator(operator.OperatorFromIntegration(id.NewIntegrationID())).
+		Workspace(&wid).Project(prj).Model(&mid).MustBuild()
+	ev3 := event.New[any]().NewID().Timestamp(now).Type(event.MemberUpdate).Operator(operator.OperatorFromUser(uid)).
+		Workspace(&wid).Member(&event.Member{User: id.NewUserID().Ref(), Role: "reader"}).MustBuild()
+
+	r := NewEvent()
+	ctx := context.Background()
+	for _, ev := range []*event.Event[any]{ev1, ev2, ev3} {
+		assert.NoError(t, r.Save(ctx, ev))
+	}
+
+	got, pi, err := r.Search(ctx, repo.EventFilter{Workspace: &wid}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*event.Event[any]{ev3, ev2, ev1}, got)
+	assert.Equal(t, int64(3), pi.TotalCount)
+
+	got, _, _ = r.Search(ctx, repo.EventFilter{Project: &pid}, nil)
+	assert.Equal(t, []*event.Event[any]{ev2, ev1}, got)
+
+	got, _, _ = r.Search(ctx, repo.EventFilter{Item: &iid}, nil)
+	assert.Equal(t, []*event.Event[any]{ev1}, got)
+
+	got, _, _ = r.Search(ctx, repo.EventFilter{Workspace: &wid, User: &uid}, nil)
+	assert.Equal(t, []*event.Event[any]{ev3, ev1}, got)
+
+	got, _, _ = r.Search(ctx, repo.EventFilter{Workspace: &wid, Types: []event.Type{event.ModelUpdate, event.MemberUpdate}}, nil)
+	assert.Equal(t, []*event.Event[any]{ev3, ev2}, got)
+
+	got, _, _ = r.Search(ctx, repo.EventFilter{Model: &mid, From: lo.ToPtr(now.Add(-time.Minute))}, nil)
+	assert.Equal(t, []*event.Event[any]{ev2}, got)
+
+	got, _, _ = r.Search(ctx, repo.EventFilter{Model: &mid, To: lo.ToPtr(now.Add(-time.Minute))}, nil)
+	assert.Equal(t, []*event.Event[any]{ev1}, got)
+
+	got, _, _ = r.Search(ctx, repo.EventFilter{Workspace: id.NewWorkspaceID().Ref()}, nil)
+	assert.Empty(t, got)
+}
@@ -28,6 +28,36 @@ func (r *Item) FindByAssets(ctx context.Context, assetID id.AssetIDList, ref *ve
 	panic("implement me")
 }
 
+func (r *Item) FindByReferences(_ context.Context, list id.ItemIDList, ref *version.Ref) (item.VersionedList, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.findByReferences(list, ref, r.readable), nil
+}
+
+func (r *Item) FindAllByReferences(_ context.Context, list id.ItemIDList) (item.VersionedList, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.findByReferences(list, nil, func(*item.Item) bool { return true }), nil
+}
+
+func (r *Item) findByReferences(list id.ItemIDList, ref *version.Ref, filter func(*item.Item) bool) item.VersionedList {
+	var res item.VersionedList
+	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
+		itv := v.Get(ref.OrLatest().OrVersion())
+		if itv == nil {
+			return true
+		}
+		it := itv.Value()
+		if filter(it) && list.Has(it.References()...) {
+			res = append(res, itv)
+		}
+		return true
+	})
+	return res.Sort(nil)
+}
+
 func NewItem() repo.Item {
 	return &Item{
 		data: memorygit.NewVersionedSyncMap[item.ID, *item.Item](),
@@ -47,7 +77,7 @@ func (r *Item) FindByID(_ context.Context, itemID id.ItemID, ref *version.Ref) (
 	}
 
 	item, ok := r.data.Load(itemID, ref.OrLatest().OrVersion())
-	if !ok {
+	if !ok || !r.visible(item.Value()) {
 		return nil, rerror.ErrNotFound
 	}
 	return item, nil
@@ -62,7 +92,7 @@ func (r *Item) FindBySchema(_ context.Context, schemaID id.SchemaID, ref *versio
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
 		itv := v.Get(ref.OrLatest().OrVersion())
 		it := itv.Value()
-		if it.Schema() == schemaID && r.f.CanRead(it.Project()) {
+		if it.Schema() == schemaID && r.readable(it) {
 			res = append(res, itv)
 		}
 		return true
@@ -79,7 +109,7 @@ func (r *Item) FindByProject(_ context.Context, projectID id.ProjectID, ref *ver
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
 		itv := v.Get(ref.OrLatest().OrVersion())
 		it := itv.Value()
-		if it.Project() == projectID {
+		if it.Project() == projectID && r.visible(it) {
 			res = append(res, itv)
 		}
 		return true
@@ -97,7 +127,7 @@ func (r *Item) FindByModel(_ context.Context, modelID id.ModelID, ref *version.R
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
 		itv := v.Get(ref.OrLatest().OrVersion())
 		it := itv.Value()
-		if it.Model() == modelID {
+		if it.Model() == modelID && r.visible(it) {
 			res = append(res, itv)
 		}
 		return true
@@ -111,7 +141,10 @@ func (r *Item) FindByIDs(_ context.Context, list id.ItemIDList, ref *version.Ref
 		return nil, r.err
 	}
 
-	return item.VersionedList(r.data.LoadAll(list, lo.ToPtr(ref.OrLatest().OrVersion()))).Sort(nil), nil
+	res := lo.Filter(r.data.LoadAll(list, lo.ToPtr(ref.OrLatest().OrVersion())), func(i *version.Value[*item.Item], _ int) bool {
+		return r.visible(i.Value())
+	})
+	return item.VersionedList(res).Sort(nil), nil
 }
 
 func (r *Item) FindAllVersionsByID(_ context.Context, id id.ItemID) (item.VersionedList, error) {
@@ -122,7 +155,7 @@ func (r *Item) FindAllVersionsByID(_ context.Context, id id.ItemID) (item.Versio
 	res := r.data.LoadAllVersions(id).All()
 	sortItems(res)
 	return lo.Filter(res, func(i *version.Value[*item.Item], _ int) bool {
-		return r.f.CanRead(i.Value().Project())
+		return r.readable(i.Value())
 	}), nil
 }
 
@@ -134,7 +167,7 @@ func (r *Item) FindAllVersionsByIDs(ctx context.Context, ids id.ItemIDList) (ite
 	res := r.data.LoadAll(ids, nil)
 	sortItems(res)
 	return lo.Filter(res, func(i *version.Value[*item.Item], _ int) bool {
-		return r.f.CanRead(i.Value().Project())
+		return r.readable(i.Value())
 	}), nil
 }
 
@@ -155,12 +188,29 @@ func (r *Item) LastModifiedByModel(ctx context.Context, modelID id.ModelID) (tim
 	return res.Latest().Time(), nil
 }
 
+func (r *Item) FindDueSchedule(_ context.Context, now time.Time) (item.VersionedList, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	var res item.VersionedList
+	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
+		itv := v.Get(version.Latest.OrVersion())
+		it := itv.Value()
+		if publish, unpublish := it.ScheduleDue(now); (publish || unpublish) && r.readable(it) {
+			res = append(res, itv)
+		}
+		return true
+	})
+	return res.Sort(nil), nil
+}
+
 func (r *Item) Save(_ context.Context, t *item.Item) error {
 	if r.err != nil {
 		return r.err
 	}
 
-	if !r.f.CanWrite(t.Project()) {
+	if !r.f.CanWrite(t.Project()) || !r.accessible(t) {
 		return repo.ErrOperationDenied
 	}
 
@@ -177,6 +227,30 @@ func (r *Item) UpdateRef(ctx context.Context, item id.ItemID, ref version.Ref, v
 	return nil
 }
 
+func (r *Item) UpdateSchedule(_ context.Context, itemID id.ItemID, publishAt, unpublishAt *time.Time, publishVersion *version.Version) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	itv, _ := r.data.Load(itemID, version.Latest.OrVersion())
+	if itv == nil {
+		return rerror.ErrNotFound
+	}
+	it := itv.Value()
+	if !r.f.CanWrite(it.Project()) || !r.accessible(it) {
+		return repo.ErrOperationDenied
+	}
+
+	// the item is updated in place since the schedule is not a part of the versioned content
+	if err := it.SetSchedule(publishAt, unpublishAt); err != nil {
+		return err
+	}
+	if publishAt != nil {
+		it.SetPublishVersion(publishVersion)
+	}
+	return nil
+}
+
 func (r *Item) Remove(_ context.Context, itemID id.ItemID) error {
 	if r.err != nil {
 		return r.err
@@ -186,7 +260,7 @@ func (r *Item) Remove(_ context.Context, itemID id.ItemID) error {
 	if item == nil {
 		return rerror.ErrNotFound
 	}
-	if !r.f.CanWrite(item.Value().Project()) {
+	if !r.f.CanWrite(item.Value().Project()) || !r.accessible(item.Value()) {
 		return repo.ErrOperationDenied
 	}
 
@@ -200,7 +274,7 @@ func (r *Item) IsArchived(_ context.Context, itemID id.ItemID) (bool, error) {
 	}
 
 	i, _ := r.data.Load(itemID, version.Latest.OrVersion())
-	if i == nil || !r.f.CanRead(i.Value().Project()) {
+	if i == nil || !r.readable(i.Value()) {
 		return false, nil
 	}
 
@@ -218,7 +292,7 @@ func (r *Item) Archive(_ context.Context, itemID id.ItemID, projectID id.Project
 	}
 	i := iv.Value()
 
-	if !r.f.CanWrite(i.Project()) {
+	if !r.f.CanWrite(i.Project()) || !r.accessible(i) {
 		return repo.ErrOperationDenied
 	}
 
@@ -226,6 +300,20 @@ func (r *Item) Archive(_ context.Context, itemID id.ItemID, projectID id.Project
 	return nil
 }
 
+func (r *Item) readable(i *item.Item) bool {
+	return r.f.CanRead(i.Project()) && r.visible(i)
+}
+
+// visible returns whether the model and the group of the item can be read
+func (r *Item) visible(i *item.Item) bool {
+	return r.f.CanReadModel(i.Project(), i.Model()) && r.accessible(i)
+}
+
+// accessible returns whether the group of the item can be accessed
+func (r *Item) accessible(i *item.Item) bool {
+	return r.f.CanAccessItemGroup(i.Project(), i.Group())
+}
+
 func SetItemError(r repo.Item, err error) {
 	r.(*Item).err = err
 }
@@ -244,13 +332,27 @@ func (r *Item) Search(_ context.Context, q *item.Query, sort *usecasex.Sort, pag
 	if r.err != nil {
 		return nil, nil, r.err
 	}
+	if q.Sort() != nil && pagination != nil && pagination.Cursor != nil {
+		return nil, nil, repo.ErrUnsupportedPagination
+	}
 
 	var res item.VersionedList
 	qq := q.Q()
+	filters := q.Filters()
 
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
-		it := v.Get(version.Latest.OrVersion())
+		it := v.Get(q.Ref().OrLatest().OrVersion())
+		if it == nil {
+			return true
+		}
 		itv := it.Value()
+		if itv.Project() != q.Project() || !r.visible(itv) || q.Schema() != nil && itv.Schema() != *q.Schema() || !filters.Match(itv) || !q.SpatialFilter().Match(itv) {
+			return true
+		}
+		if qq == "" {
+			res = append(res, it)
+			return true
+		}
 		if _, ok := lo.Find(itv.Fields(), func(f *item.Field) bool {
 			return lo.SomeBy(f.Value().Values(), func(v *value.Value) bool {
 				if s, ok := v.ValueString(); ok {
@@ -265,7 +367,24 @@ func (r *Item) Search(_ context.Context, q *item.Query, sort *usecasex.Sort, pag
 		}
 		return true
 	})
-	return res, nil, nil
+
+	res = q.Sort().Sort(res.SortByCreationDate(item.AscDirection))
+	total := int64(len(res))
+	hasNext := false
+	if pagination != nil && pagination.Offset != nil {
+		start := lo.Clamp(pagination.Offset.Offset, 0, total)
+		end := lo.Clamp(start+pagination.Offset.Limit, 0, total)
+		res = res[start:end]
+		hasNext = end < total
+	}
+
+	return res, usecasex.NewPageInfo(
+		total,
+		nil,
+		nil,
+		hasNext,
+		false,
+	), nil
 }
 
 func (r *Item) FindByModelAndValue(_ context.Context, modelID id.ModelID, fields []repo.FieldAndValue, ref *version.Ref) (item.VersionedList, error) {
@@ -277,10 +396,10 @@ func (r *Item) FindByModelAndValue(_ context.Context, modelID id.ModelID, fields
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
 		itv := v.Get(ref.OrLatest().OrVersion())
 		it := itv.Value()
-		if it.Model() == modelID {
+		if it.Model() == modelID && r.visible(it) {
 			for _, f := range fields {
 				for _, ff := range it.Fields() {
-					if f.Field == ff.FieldID() && f.Value.Equal(f.Value) {
+					if f.Field == ff.FieldID() && f.Value.Equal(ff.Value()) {
 						res = append(res, itv)
 					}
 				}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/id"
@@ -11,7 +12,9 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -195,6 +198,29 @@ func TestItem_FindByProject(t *testing.T) {
 	assert.Equal(t, 2, len(got.Unwrap()))
 }
 
+func TestItem_FindByProject_ReadableModels(t *testing.T) {
+	ctx := context.Background()
+	pid := id.NewProjectID()
+	mid1, mid2 := id.NewModelID(), id.NewModelID()
+	i1 := item.New().NewID().Schema(id.NewSchemaID()).Model(mid1).Project(pid).Thread(id.NewThreadID()).MustBuild()
+	i2 := item.New().NewID().Schema(id.NewSchemaID()).Model(mid2).Project(pid).Thread(id.NewThreadID()).MustBuild()
+	r := NewItem()
+	_ = r.Save(ctx, i1)
+	_ = r.Save(ctx, i2)
+
+	r2 := r.Filtered(repo.ProjectFilter{
+		Readable:       []id.ProjectID{pid},
+		Writable:       []id.ProjectID{pid},
+		ReadableModels: map[id.ProjectID][]id.ModelID{pid: {mid1}},
+	})
+	got, _, err := r2.FindByProject(ctx, pid, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []id.ItemID{i1.ID()}, lo.Map(got, func(i item.Versioned, _ int) id.ItemID { return i.Value().ID() }))
+
+	_, err = r2.FindByID(ctx, i2.ID(), nil)
+	assert.Equal(t, rerror.ErrNotFound, err)
+}
+
 func TestItem_FindByFieldValue(t *testing.T) {
 	ctx := context.Background()
 	sid := id.NewSchemaID()
@@ -220,6 +246,71 @@ func TestItem_FindByFieldValue(t *testing.T) {
 	assert.Same(t, wantErr, r.Save(ctx, i))
 }
 
+func TestItem_Search(t *testing.T) {
+	ctx := context.Background()
+	sid := id.NewSchemaID()
+	sf := id.NewFieldID()
+	pid := id.NewProjectID()
+	newItem := func(v int) *item.Item {
+		return item.New().NewID().Schema(sid).Model(id.NewModelID()).Fields([]*item.Field{
+			item.NewField(sf, value.TypeInteger.Value(v).AsMultiple()),
+		}).Project(pid).Thread(id.NewThreadID()).MustBuild()
+	}
+	i1, i2, i3 := newItem(3), newItem(1), newItem(2)
+	i4 := item.New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(pid).Thread(id.NewThreadID()).MustBuild()
+
+	r := NewItem()
+	_ = r.Save(ctx, i1)
+	_ = r.Save(ctx, i2)
+	_ = r.Save(ctx, i3)
+	_ = r.Save(ctx, i4)
+
+	f := lo.Must(item.NewFieldFilter(sf, item.FilterOperatorGte, value.TypeInteger.Value(2)))
+	q := item.NewQuery(pid, sid.Ref(), "", nil).WithFilters(f).WithSort(item.NewFieldSort(sf, item.DescDirection))
+	got, pi, err := r.Search(ctx, q, nil, usecasex.OffsetPagination{Offset: 0, Limit: 1}.Wrap())
+	assert.NoError(t, err)
+	assert.Equal(t, item.List{i1}, got.Unwrap())
+	assert.Equal(t, int64(2), pi.TotalCount)
+	assert.True(t, pi.HasNextPage)
+
+	got, _, err = r.Search(ctx, q, nil, nil)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, item.List{i1, i3}, got.Unwrap())
+
+	_, _, err = r.Search(ctx, q, nil, usecasex.CursorPagination{First: lo.ToPtr(int64(1))}.Wrap())
+	assert.Equal(t, repo.ErrUnsupportedPagination, err)
+}
+
+func TestItem_Search_Spatial(t *testing.T) {
+	ctx := context.Background()
+	sid := id.NewSchemaID()
+	sf := id.NewFieldID()
+	pid := id.NewProjectID()
+	aid := id.NewAssetID()
+	newItem := func(v *value.Value) *item.Item {
+		return item.New().NewID().Schema(sid).Model(id.NewModelID()).Fields([]*item.Field{
+			item.NewField(sf, v.AsMultiple()),
+		}).Project(pid).Thread(id.NewThreadID()).MustBuild()
+	}
+	i1 := newItem(value.TypeGeometry.Value(value.Geometry{Type: value.GeometryTypePoint, Coordinates: value.Position{139.5, 35.5}}))
+	i2 := newItem(value.TypeGeometry.Value(value.Geometry{Type: value.GeometryTypePoint, Coordinates: value.Position{0, 0}}))
+	i3 := newItem(value.TypeAsset.Value(aid))
+
+	r := NewItem()
+	_ = r.Save(ctx, i1)
+	_ = r.Save(ctx, i2)
+	_ = r.Save(ctx, i3)
+
+	sp := lo.Must(item.ParseSpatialFilter("139,35,140,36", ""))
+	got, _, err := r.Search(ctx, item.NewQuery(pid, sid.Ref(), "", nil).WithSpatialFilter(sp), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, item.List{i1}, got.Unwrap())
+
+	got, _, err = r.Search(ctx, item.NewQuery(pid, sid.Ref(), "", nil).WithSpatialFilter(sp.WithAssets(id.AssetIDList{aid})), nil, nil)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, item.List{i1, i3}, got.Unwrap())
+}
+
 func TestItem_FindByModelAndValue(t *testing.T) {
 	ctx := context.Background()
 	sid := id.NewSchemaID()
@@ -264,3 +355,42 @@ func TestItem_UpdateRef(t *testing.T) {
 	SetItemError(r, wantErr)
 	assert.Same(t, wantErr, r.UpdateRef(ctx, i.ID(), vx, v.Version().OrRef().Ref()))
 }
+
+func TestItem_Schedule(t *testing.T) {
+	now := util.Now()
+	defer util.MockNow(now)()
+
+	ctx := context.Background()
+	pid := id.NewProjectID()
+	i1 := item.New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(pid).Thread(id.NewThreadID()).MustBuild()
+	i2 := item.New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(pid).Thread(id.NewThreadID()).MustBuild()
+	r := NewItem()
+	_ = r.Save(ctx, i1)
+	_ = r.Save(ctx, i2)
+
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+	assert.NoError(t, r.UpdateSchedule(ctx, i1.ID(), &past, nil, nil))
+	assert.NoError(t, r.UpdateSchedule(ctx, i2.ID(), nil, &future, nil))
+	assert.Same(t, item.ErrInvalidSchedule, r.UpdateSchedule(ctx, i2.ID(), &future, &past, nil))
+	assert.Same(t, rerror.ErrNotFound, r.UpdateSchedule(ctx, id.NewItemID(), nil, nil, nil))
+
+	got, err := r.FindDueSchedule(ctx, now)
+	assert.NoError(t, err)
+	assert.Equal(t, []id.ItemID{i1.ID()}, lo.Map(got.Unwrap(), func(i *item.Item, _ int) id.ItemID { return i.ID() }))
+	assert.Equal(t, &past, got[0].Value().PublishAt())
+
+	got, err = r.FindDueSchedule(ctx, future)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []id.ItemID{i1.ID(), i2.ID()}, lo.Map(got.Unwrap(), func(i *item.Item, _ int) id.ItemID { return i.ID() }))
+
+	r2 := r.Filtered(repo.ProjectFilter{Readable: id.ProjectIDList{}, Writable: id.ProjectIDList{}})
+	assert.Same(t, repo.ErrOperationDenied, r2.UpdateSchedule(ctx, i1.ID(), nil, nil, nil))
+	got, _ = r2.FindDueSchedule(ctx, future)
+	assert.Empty(t, got)
+
+	wantErr := errors.New("test")
+	SetItemError(r, wantErr)
+	assert.Same(t, wantErr, r.UpdateSchedule(ctx, i1.ID(), nil, nil, nil))
+	_, err = r.FindDueSchedule(ctx, now)
+	assert.Same(t, wantErr, err)
+}
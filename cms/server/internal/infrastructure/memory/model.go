@@ -98,6 +98,21 @@ func (r *Model) FindByKey(_ context.Context, pid id.ProjectID, key string) (*mod
 	return m, nil
 }
 
+func (r *Model) FindBySchema(_ context.Context, sid id.SchemaID) (*model.Model, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	m := r.data.Find(func(_ id.ModelID, m *model.Model) bool {
+		return m.Schema() == sid && r.f.CanRead(m.Project())
+	})
+	if m == nil {
+		return nil, rerror.ErrNotFound
+	}
+
+	return m, nil
+}
+
 func (r *Model) FindByIDOrKey(ctx context.Context, projectID id.ProjectID, q model.IDOrKey) (*model.Model, error) {
 	if r.err != nil {
 		return nil, r.err
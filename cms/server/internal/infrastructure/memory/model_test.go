@@ -383,3 +383,21 @@ func TestModelRepo_FindByProject(t *testing.T) {
 		})
 	}
 }
+
+func TestModelRepo_FindBySchema(t *testing.T) {
+	ctx := context.Background()
+	pid := id.NewProjectID()
+	m := model.New().NewID().Project(pid).Schema(id.NewSchemaID()).Key(key.New("T123456")).MustBuild()
+	r := NewModel()
+	assert.NoError(t, r.Save(ctx, m))
+
+	got, err := r.FindBySchema(ctx, m.Schema())
+	assert.NoError(t, err)
+	assert.Equal(t, m, got)
+
+	_, err = r.FindBySchema(ctx, id.NewSchemaID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	_, err = r.Filtered(repo.ProjectFilter{Readable: id.ProjectIDList{}, Writable: id.ProjectIDList{}}).FindBySchema(ctx, m.Schema())
+	assert.Equal(t, rerror.ErrNotFound, err)
+}
@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+type Task struct {
+	data *util.SyncMap[id.TaskID, *task.Task]
+	err  error
+}
+
+func NewTask() repo.Task {
+	return &Task{
+		data: &util.SyncMap[id.TaskID, *task.Task]{},
+	}
+}
+
+func (r *Task) FindByID(_ context.Context, tid id.TaskID) (*task.Task, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return rerror.ErrIfNil(r.data.Find(func(k id.TaskID, _ *task.Task) bool {
+		return k == tid
+	}), rerror.ErrNotFound)
+}
+
+func (r *Task) FindByProject(_ context.Context, pid id.ProjectID, _ *usecasex.Pagination) (task.List, *usecasex.PageInfo, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	result := r.data.FindAll(func(_ id.TaskID, t *task.Task) bool {
+		return t.Project() == pid
+	})
+	slices.SortFunc(result, func(a, b *task.Task) bool {
+		return a.ID().Compare(b.ID()) > 0
+	})
+
+	var startCursor, endCursor *usecasex.Cursor
+	if len(result) > 0 {
+		startCursor = lo.ToPtr(usecasex.Cursor(result[0].ID().String()))
+		endCursor = lo.ToPtr(usecasex.Cursor(result[len(result)-1].ID().String()))
+	}
+
+	return result, usecasex.NewPageInfo(
+		int64(len(result)),
+		startCursor,
+		endCursor,
+		true,
+		true,
+	), nil
+}
+
+func (r *Task) FindRunnable(_ context.Context, staleBefore time.Time) (task.List, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	result := r.data.FindAll(func(_ id.TaskID, t *task.Task) bool {
+		return t.Status() == task.StatusPending || t.IsStale(staleBefore)
+	})
+	slices.SortFunc(result, func(a, b *task.Task) bool {
+		return a.ID().Compare(b.ID()) < 0
+	})
+	return result, nil
+}
+
+func (r *Task) Save(_ context.Context, t *task.Task) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.Store(t.ID(), t)
+	return nil
+}
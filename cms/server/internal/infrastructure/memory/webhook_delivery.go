@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+type WebhookDelivery struct {
+	data *util.SyncMap[id.WebhookDeliveryID, *integration.Delivery]
+	err  error
+}
+
+func NewWebhookDelivery() repo.WebhookDelivery {
+	return &WebhookDelivery{
+		data: &util.SyncMap[id.WebhookDeliveryID, *integration.Delivery]{},
+	}
+}
+
+func (r *WebhookDelivery) FindByID(_ context.Context, did id.WebhookDeliveryID) (*integration.Delivery, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return rerror.ErrIfNil(r.data.Find(func(k id.WebhookDeliveryID, _ *integration.Delivery) bool {
+		return k == did
+	}), rerror.ErrNotFound)
+}
+
+func (r *WebhookDelivery) FindByWebhook(_ context.Context, wid id.WebhookID, _ *usecasex.Pagination) (integration.DeliveryList, *usecasex.PageInfo, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	result := r.data.FindAll(func(_ id.WebhookDeliveryID, d *integration.Delivery) bool {
+		return d.Webhook() == wid
+	})
+	slices.SortFunc(result, func(a, b *integration.Delivery) bool {
+		return a.ID().Compare(b.ID()) > 0
+	})
+
+	var startCursor, endCursor *usecasex.Cursor
+	if len(result) > 0 {
+		startCursor = lo.ToPtr(usecasex.Cursor(result[0].ID().String()))
+		endCursor = lo.ToPtr(usecasex.Cursor(result[len(result)-1].ID().String()))
+	}
+
+	return result, usecasex.NewPageInfo(
+		int64(len(result)),
+		startCursor,
+		endCursor,
+		true,
+		true,
+	), nil
+}
+
+func (r *WebhookDelivery) FindDue(_ context.Context, t time.Time) (integration.DeliveryList, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.data.FindAll(func(_ id.WebhookDeliveryID, d *integration.Delivery) bool {
+		return d.IsDue(t)
+	}), nil
+}
+
+func (r *WebhookDelivery) Save(_ context.Context, d *integration.Delivery) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.Store(d.ID(), d)
+	return nil
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookDelivery(t *testing.T) {
+	now := time.Now()
+	wid := id.NewWebhookID()
+	d1 := integration.NewDelivery().NewID().Integration(id.NewIntegrationID()).Webhook(wid).MustBuild()
+	d2 := integration.NewDelivery().NewID().Integration(id.NewIntegrationID()).Webhook(wid).
+		Status(integration.DeliveryStatusRetrying).NextAttemptAt(&now).MustBuild()
+	d3 := integration.NewDelivery().NewID().Integration(id.NewIntegrationID()).Webhook(id.NewWebhookID()).MustBuild()
+	d4 := integration.NewDelivery().NewID().Integration(id.NewIntegrationID()).Webhook(id.NewWebhookID()).
+		Status(integration.DeliveryStatusPending).NextAttemptAt(&now).MustBuild()
+
+	r := NewWebhookDelivery()
+	ctx := context.Background()
+	assert.NoError(t, r.Save(ctx, d1))
+	assert.NoError(t, r.Save(ctx, d2))
+	assert.NoError(t, r.Save(ctx, d3))
+	assert.NoError(t, r.Save(ctx, d4))
+
+	got, err := r.FindByID(ctx, d1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, d1, got)
+
+	_, err = r.FindByID(ctx, id.NewWebhookDeliveryID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	list, pi, err := r.FindByWebhook(ctx, wid, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, integration.DeliveryList{d2, d1}, list)
+	assert.Equal(t, int64(2), pi.TotalCount)
+
+	// pending deliveries whose results are not reported by the deadline are also due
+	due, err := r.FindDue(ctx, now)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, integration.DeliveryList{d2, d4}, due)
+
+	due, err = r.FindDue(ctx, now.Add(-time.Second))
+	assert.NoError(t, err)
+	assert.Empty(t, due)
+}
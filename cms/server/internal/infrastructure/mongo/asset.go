@@ -9,6 +9,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/mongox"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
@@ -18,7 +19,7 @@ import (
 )
 
 var (
-	assetIndexes       = []string{"project", "!createdat,!id"}
+	assetIndexes       = []string{"project", "!createdat,!id", "uuid"}
 	assetUniqueIndexes = []string{"id"}
 )
 
@@ -36,8 +37,11 @@ func (r *Asset) Init() error {
 		context.Background(),
 		r.client,
 		append(
-			mongox.IndexFromKeys(assetUniqueIndexes, true),
-			mongox.IndexFromKeys(assetIndexes, false)...,
+			append(
+				mongox.IndexFromKeys(assetUniqueIndexes, true),
+				mongox.IndexFromKeys(assetIndexes, false)...,
+			),
+			geoIndex,
 		)...,
 	)
 }
@@ -70,6 +74,26 @@ func (r *Asset) FindByIDs(ctx context.Context, ids id.AssetIDList) ([]*asset.Ass
 	return filterAssets(ids, res), nil
 }
 
+func (r *Asset) FindByUUID(ctx context.Context, uuid string) (*asset.Asset, error) {
+	if uuid == "" {
+		return nil, rerror.ErrNotFound
+	}
+	return r.findOne(ctx, bson.M{
+		"uuid": uuid,
+	})
+}
+
+func (r *Asset) FindByArea(ctx context.Context, pid id.ProjectID, area value.Geometry) ([]*asset.Asset, error) {
+	if !r.f.CanRead(pid) {
+		return nil, nil
+	}
+
+	return r.find(ctx, bson.M{
+		"project": pid.String(),
+		"geo":     geoIntersects(area),
+	})
+}
+
 func (r *Asset) FindByProject(ctx context.Context, id id.ProjectID, uFilter repo.AssetFilter) ([]*asset.Asset, *usecasex.PageInfo, error) {
 	if !r.f.CanRead(id) {
 		return nil, usecasex.EmptyPageInfo(), nil
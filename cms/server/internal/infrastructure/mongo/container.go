@@ -28,20 +28,22 @@ func New(ctx context.Context, mc *mongo.Client, databaseName string, useTransact
 	}
 
 	c := &repo.Container{
-		Asset:       NewAsset(client),
-		AssetFile:   NewAssetFile(client),
-		Workspace:   NewWorkspace(client),
-		User:        NewUser(client),
-		Transaction: client.Transaction(),
-		Lock:        lock,
-		Project:     NewProject(client),
-		Request:     NewRequest(client),
-		Item:        NewItem(client),
-		Model:       NewModel(client),
-		Schema:      NewSchema(client),
-		Thread:      NewThread(client),
-		Integration: NewIntegration(client),
-		Event:       NewEvent(client),
+		Asset:           NewAsset(client),
+		AssetFile:       NewAssetFile(client),
+		Workspace:       NewWorkspace(client),
+		User:            NewUser(client),
+		Transaction:     client.Transaction(),
+		Lock:            lock,
+		Project:         NewProject(client),
+		Request:         NewRequest(client),
+		Item:            NewItem(client),
+		Model:           NewModel(client),
+		Schema:          NewSchema(client),
+		Thread:          NewThread(client),
+		Integration:     NewIntegration(client),
+		Event:           NewEvent(client),
+		WebhookDelivery: NewWebhookDelivery(client),
+		Task:            NewTask(client),
 	}
 
 	// init
@@ -73,6 +75,8 @@ func Init(r *repo.Container) error {
 		r.Thread.(*ThreadRepo).Init,
 		r.Integration.(*Integration).Init,
 		r.Event.(*Event).Init,
+		r.WebhookDelivery.(*WebhookDelivery).Init,
+		r.Task.(*Task).Init,
 	)
 }
 
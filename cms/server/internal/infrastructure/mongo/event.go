@@ -8,11 +8,15 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 var (
-	eventIndexes       = []string{"user", "integration"}
+	eventIndexes       = []string{"user", "integration", "workspace,id", "project.id,id", "model,id", "item,id", "type"}
 	eventUniqueIndexes = []string{"id"}
 )
 
@@ -34,6 +38,26 @@ func (r *Event) FindByID(ctx context.Context, eventID id.EventID) (*event.Event[
 	})
 }
 
+func (r *Event) Search(ctx context.Context, f repo.EventFilter, pagination *usecasex.Pagination) ([]*event.Event[any], *usecasex.PageInfo, error) {
+	filter := eventFilter(f)
+	// newer events first
+	sort := &usecasex.Sort{Key: "id", Reverted: true}
+
+	c := mongodoc.NewEventConsumer()
+	if pagination == nil {
+		if err := r.client.Find(ctx, filter, c, options.Find().SetSort(bson.M{"id": -1})); err != nil {
+			return nil, nil, rerror.ErrInternalBy(err)
+		}
+		return c.Result, nil, nil
+	}
+
+	pageInfo, err := r.client.Paginate(ctx, filter, sort, pagination, c)
+	if err != nil {
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, pageInfo, nil
+}
+
 func (r *Event) Save(ctx context.Context, ev *event.Event[any]) error {
 	doc, eID, err := mongodoc.NewEvent(ev)
 	if err != nil {
@@ -49,3 +73,39 @@ func (r *Event) findOne(ctx context.Context, filter any) (*event.Event[any], err
 	}
 	return c.Result[0], nil
 }
+
+func eventFilter(f repo.EventFilter) bson.M {
+	filter := bson.M{}
+	if f.Workspace != nil {
+		filter["workspace"] = f.Workspace.String()
+	}
+	if f.Project != nil {
+		filter["project.id"] = f.Project.String()
+	}
+	if f.Model != nil {
+		filter["model"] = f.Model.String()
+	}
+	if f.Item != nil {
+		filter["item"] = f.Item.String()
+	}
+	if f.User != nil {
+		filter["user"] = f.User.String()
+	}
+	if f.Integration != nil {
+		filter["integration"] = f.Integration.String()
+	}
+	if len(f.Types) > 0 {
+		filter["type"] = bson.M{"$in": lo.Map(f.Types, func(t event.Type, _ int) string { return string(t) })}
+	}
+	if f.From != nil || f.To != nil {
+		ts := bson.M{}
+		if f.From != nil {
+			ts["$gte"] = *f.From
+		}
+		if f.To != nil {
+			ts["$lt"] = *f.To
+		}
+		filter["timestamp"] = ts
+	}
+	return filter
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
@@ -13,6 +14,8 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/mongox"
 	"github.com/reearth/reearthx/mongox/mongotest"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,3 +40,41 @@ func TestEvent_Save(t *testing.T) {
 	assert.Equal(t, ev, got)
 	assert.NoError(t, err)
 }
+
+func TestEvent_Search(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond).UTC()
+	wid := id.NewWorkspaceID()
+	pid := id.NewProjectID()
+	mid := id.NewModelID()
+	uid := id.NewUserID()
+	a := asset.New().NewID().Thread(id.NewThreadID()).NewUUID().
+		Project(pid).Size(100).CreatedAt(now).CreatedByUser(uid).MustBuild()
+	prj := &event.Project{ID: pid.String(), Alias: "prj"}
+
+	ev1 := event.New[any]().NewID().Timestamp(now.Add(-time.Hour)).Type(event.AssetCreate).Operator(operator.OperatorFromUser(uid)).
+		Workspace(&wid).Project(prj).Object(a).MustBuild()
+	ev2 := event.New[any]().NewID().Timestamp(now).Type(event.AssetDelete).Operator(operator.OperatorFromUser(uid)).
+		Workspace(&wid).Project(prj).Model(&mid).Object(a).MustBuild()
+	ev3 := event.New[any]().NewID().Timestamp(now).Type(event.MemberAdd).Operator(operator.OperatorFromUser(uid)).
+		Workspace(&wid).Member(&event.Member{Integration: id.NewIntegrationID().Ref(), Role: "writer"}).Object(a).MustBuild()
+
+	initDB := mongotest.Connect(t)
+	client := mongox.NewClientWithDatabase(initDB(t))
+	r := NewEvent(client)
+	ctx := context.Background()
+	for _, ev := range []*event.Event[any]{ev1, ev2, ev3} {
+		assert.NoError(t, r.Save(ctx, ev))
+	}
+
+	got, _, err := r.Search(ctx, repo.EventFilter{Workspace: &wid}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*event.Event[any]{ev3, ev2, ev1}, got)
+
+	got, _, err = r.Search(ctx, repo.EventFilter{Project: &pid, Types: []event.Type{event.AssetCreate}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*event.Event[any]{ev1}, got)
+
+	got, _, err = r.Search(ctx, repo.EventFilter{Workspace: &wid, From: lo.ToPtr(now)}, usecasex.CursorPagination{First: lo.ToPtr(int64(1))}.Wrap())
+	assert.NoError(t, err)
+	assert.Equal(t, []*event.Event[any]{ev3}, got)
+}
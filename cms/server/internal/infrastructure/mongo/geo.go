@@ -0,0 +1,22 @@
+package mongo
+
+import (
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/mongox"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// geoIndex is a 2dsphere index on the "geo" field which holds GeoJSON geometries
+var geoIndex = mongox.Index{
+	Name: "geo",
+	Key:  bson.D{{Key: "geo", Value: "2dsphere"}},
+}
+
+func geoIntersects(area value.Geometry) bson.M {
+	return bson.M{
+		"$geoIntersects": bson.M{
+			"$geometry": mongodoc.NewGeometry(area),
+		},
+	}
+}
@@ -11,10 +11,12 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/mongox"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -22,6 +24,7 @@ import (
 var (
 	itemIndexes = []string{
 		"assets",
+		"refs",
 		"modelid",
 		"project",
 		"schema",
@@ -31,6 +34,8 @@ var (
 		// "__r,assets,project,__", // cannot index parallel arrays
 		"__r,project,__",
 		"schema,id,__r,project",
+		"publishat",
+		"unpublishat",
 	}
 )
 
@@ -55,8 +60,11 @@ func (r *Item) Init() error {
 		context.Background(),
 		r.client.Client(),
 		append(
-			r.client.Indexes(),
-			mongox.IndexFromKeys(itemIndexes, false)...,
+			append(
+				r.client.Indexes(),
+				mongox.IndexFromKeys(itemIndexes, false)...,
+			),
+			geoIndex,
 		)...,
 	)
 }
@@ -183,6 +191,46 @@ func (r *Item) FindByAssets(ctx context.Context, al id.AssetIDList, ref *version
 	return r.find(ctx, bson.M{"$or": filters}, ref)
 }
 
+func (r *Item) FindByReferences(ctx context.Context, il id.ItemIDList, ref *version.Ref) (item.VersionedList, error) {
+	if il.Len() == 0 {
+		return nil, nil
+	}
+	return r.find(ctx, referencesFilter(il), ref)
+}
+
+// FindAllByReferences ignores the filter of the repository to find items which would be affected by deleting the items
+func (r *Item) FindAllByReferences(ctx context.Context, il id.ItemIDList) (item.VersionedList, error) {
+	if il.Len() == 0 {
+		return nil, nil
+	}
+	c := mongodoc.NewVersionedItemConsumer()
+	if err := r.client.Find(ctx, referencesFilter(il), version.Eq(version.Latest.OrVersion()), c); err != nil {
+		return nil, err
+	}
+	return c.Result, nil
+}
+
+func referencesFilter(il id.ItemIDList) bson.M {
+	filters := make([]bson.M, 0, len(il)+1)
+	filters = append(filters, bson.M{
+		"refs": bson.M{"$in": il.Strings()},
+	})
+
+	// compat: items saved before refs was introduced
+	for _, itemID := range il {
+		filters = append(filters, bson.M{
+			"fields": bson.M{
+				"$elemMatch": bson.M{
+					"v.t": "reference",
+					"v.v": itemID.String(),
+				},
+			},
+		})
+	}
+
+	return bson.M{"$or": filters}
+}
+
 func (i *Item) Search(ctx context.Context, query *item.Query, sort *usecasex.Sort, pagination *usecasex.Pagination) (item.VersionedList, *usecasex.PageInfo, error) {
 	filter := bson.M{
 		"project": query.Project().String(),
@@ -198,6 +246,19 @@ func (i *Item) Search(ctx context.Context, query *item.Query, sort *usecasex.Sor
 	if query.Schema() != nil {
 		filter["schema"] = query.Schema().String()
 	}
+	conds := lo.Map(query.Filters(), func(f *item.FieldFilter, _ int) any {
+		return fieldFilter(f)
+	})
+	if sf := query.SpatialFilter(); sf != nil {
+		conds = append(conds, spatialFilter(sf))
+	}
+	if len(conds) > 0 {
+		// the base filter is nested in $and because mongox.And drops other keys when $and is at the top level
+		filter = bson.M{"$and": append([]any{filter}, conds...)}
+	}
+	if s := query.Sort(); s != nil {
+		return i.paginateByField(ctx, filter, query.Ref(), s, pagination)
+	}
 	res, pi, err := i.paginate(ctx, filter, query.Ref(), sort, pagination)
 	return res, pi, err
 }
@@ -232,12 +293,22 @@ func (r *Item) LastModifiedByModel(ctx context.Context, modelID id.ModelID) (tim
 	}, version.Eq(version.Latest.OrVersion()))
 }
 
+// FindDueSchedule returns the latest versions of items whose scheduled publication or unpublication has come
+func (r *Item) FindDueSchedule(ctx context.Context, now time.Time) (item.VersionedList, error) {
+	return r.find(ctx, bson.M{
+		"$or": []bson.M{
+			{"publishat": bson.M{"$lte": now}},
+			{"unpublishat": bson.M{"$lte": now}},
+		},
+	}, nil)
+}
+
 func (r *Item) IsArchived(ctx context.Context, id id.ItemID) (bool, error) {
 	return r.client.IsArchived(ctx, r.readFilter(bson.M{"id": id.String()}))
 }
 
 func (r *Item) Save(ctx context.Context, item *item.Item) error {
-	if !r.f.CanWrite(item.Project()) {
+	if !r.f.CanWrite(item.Project()) || !r.f.CanAccessItemGroup(item.Project(), item.Group()) {
 		return repo.ErrOperationDenied
 	}
 	doc, id := mongodoc.NewItem(item)
@@ -248,6 +319,21 @@ func (r *Item) UpdateRef(ctx context.Context, item id.ItemID, ref version.Ref, v
 	return r.client.UpdateRef(ctx, item.String(), ref, vr)
 }
 
+// UpdateSchedule updates the schedule of the latest version of the item without creating a new version
+func (r *Item) UpdateSchedule(ctx context.Context, item id.ItemID, publishAt, unpublishAt *time.Time, publishVersion *version.Version) error {
+	var pv *string
+	if publishAt != nil && publishVersion != nil {
+		pv = lo.ToPtr(publishVersion.String())
+	}
+	return r.client.Set(ctx, r.writeFilter(bson.M{
+		"id": item.String(),
+	}), version.Eq(version.Latest.OrVersion()), bson.M{
+		"publishat":      publishAt,
+		"unpublishat":    unpublishAt,
+		"publishversion": pv,
+	})
+}
+
 func (r *Item) Remove(ctx context.Context, id id.ItemID) error {
 	return r.client.RemoveOne(ctx, r.writeFilter(bson.M{"id": id.String()}))
 }
@@ -271,6 +357,76 @@ func (r *Item) paginate(ctx context.Context, filter bson.M, ref *version.Ref, so
 	return c.Result, pageInfo, nil
 }
 
+// paginateByField sorts items by the first value of the field. Only offset pagination is supported as the sort key is not unique.
+// Fields saved in the compat layout have the field ID in "schemafield" and the raw value in "value".
+func (r *Item) paginateByField(ctx context.Context, filter bson.M, ref *version.Ref, s *item.FieldSort, pagination *usecasex.Pagination) (item.VersionedList, *usecasex.PageInfo, error) {
+	if pagination != nil && pagination.Cursor != nil {
+		return nil, nil, repo.ErrUnsupportedPagination
+	}
+
+	dir := 1
+	if s.Direction() == item.DescDirection {
+		dir = -1
+	}
+
+	pipeline := []any{
+		bson.M{"$addFields": bson.M{
+			itemSortValueKey: bson.M{"$let": bson.M{
+				"vars": bson.M{
+					"f": bson.M{"$arrayElemAt": []any{
+						bson.M{"$filter": bson.M{
+							"input": "$fields",
+							"cond": bson.M{"$or": []any{
+								bson.M{"$eq": []any{"$$this.f", s.Field().String()}},
+								bson.M{"$eq": []any{"$$this.schemafield", s.Field().String()}}, // compat
+							}},
+						}},
+						0,
+					}},
+				},
+				"in": bson.M{"$ifNull": []any{
+					bson.M{"$arrayElemAt": []any{"$$f.v.v", 0}},
+					// compat
+					bson.M{"$cond": []any{
+						bson.M{"$isArray": "$$f.value"},
+						bson.M{"$arrayElemAt": []any{"$$f.value", 0}},
+						"$$f.value",
+					}},
+				}},
+			}},
+		}},
+		bson.M{"$sort": bson.D{{Key: itemSortValueKey, Value: dir}, {Key: "id", Value: 1}}},
+	}
+
+	var limit int64
+	if pagination != nil && pagination.Offset != nil {
+		limit = pagination.Offset.Limit
+		pipeline = append(pipeline, bson.M{"$skip": pagination.Offset.Offset}, bson.M{"$limit": limit + 1})
+	}
+
+	q := version.Eq(ref.OrLatest().OrVersion())
+	c := mongodoc.NewVersionedItemConsumer()
+	if err := r.client.Aggregate(ctx, r.readFilter(filter), q, pipeline, c); err != nil {
+		return nil, nil, err
+	}
+
+	if pagination == nil || pagination.Offset == nil {
+		return c.Result, nil, nil
+	}
+
+	count, err := r.client.Count(ctx, r.readFilter(filter), q)
+	if err != nil {
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+
+	res := item.VersionedList(c.Result)
+	hasNext := int64(len(res)) > limit
+	if hasNext {
+		res = res[:limit]
+	}
+	return res, usecasex.NewPageInfo(count, nil, nil, hasNext, false), nil
+}
+
 func (r *Item) find(ctx context.Context, filter any, ref *version.Ref) (item.VersionedList, error) {
 	c := mongodoc.NewVersionedItemConsumer()
 	if err := r.client.Find(ctx, r.readFilter(filter), version.Eq(ref.OrLatest().OrVersion()), c); err != nil {
@@ -287,6 +443,49 @@ func (r *Item) findOne(ctx context.Context, filter any, ref *version.Ref) (item.
 	return c.Result[0], nil
 }
 
+const itemSortValueKey = "__sortvalue"
+
+// fieldFilter converts the filter into a condition on elements of the fields array
+func fieldFilter(f *item.FieldFilter) bson.M {
+	values := lo.Map(f.Values(), func(v *value.Value, _ int) any {
+		return mongodoc.NewValue(v).V
+	})
+
+	var cond any
+	negate := false
+	switch op := f.Operator(); op {
+	case item.FilterOperatorExists:
+		negate = !f.Exists()
+	case item.FilterOperatorNe:
+		cond, negate = values[0], true
+	case item.FilterOperatorIn:
+		cond = bson.M{"$in": values}
+	case item.FilterOperatorEq:
+		cond = values[0]
+	default:
+		cond = bson.M{"$" + string(op): values[0]}
+	}
+
+	m := bson.M{"f": f.Field().String()}
+	compat := bson.M{"schemafield": f.Field().String()}
+	if f.Operator() == item.FilterOperatorExists {
+		m["v.v.0"] = bson.M{"$exists": true}
+		compat["value"] = bson.M{"$exists": true, "$ne": nil}
+	} else {
+		m["v.v"] = cond
+		compat["value"] = cond
+	}
+
+	conds := []bson.M{
+		{"fields": bson.M{"$elemMatch": m}},
+		{"fields": bson.M{"$elemMatch": compat}}, // compat
+	}
+	if negate {
+		return bson.M{"$nor": conds}
+	}
+	return bson.M{"$or": conds}
+}
+
 func filterItems(ids []id.ItemID, rows item.VersionedList) item.VersionedList {
 	res := make(item.VersionedList, 0, len(ids))
 	for _, id := range ids {
@@ -301,9 +500,58 @@ func filterItems(ids []id.ItemID, rows item.VersionedList) item.VersionedList {
 }
 
 func (r *Item) readFilter(filter any) any {
-	return applyProjectFilter(filter, r.f.Readable)
+	return applyReadableModelFilter(applyItemGroupFilter(applyProjectFilter(filter, r.f.Readable), r.f.ItemGroups), r.f.ReadableModels)
 }
 
 func (r *Item) writeFilter(filter any) any {
-	return applyProjectFilter(filter, r.f.Writable)
+	return applyItemGroupFilter(applyProjectFilter(filter, r.f.Writable), r.f.ItemGroups)
+}
+
+// applyItemGroupFilter limits items of the projects to ones of the groups or without a group
+func applyItemGroupFilter(filter any, groups map[id.ProjectID][]string) any {
+	if len(groups) == 0 {
+		return filter
+	}
+
+	projects := make([]string, 0, len(groups))
+	conds := make([]bson.M, 0, len(groups)+1)
+	for p, g := range groups {
+		projects = append(projects, p.String())
+		conds = append(conds, bson.M{
+			"project": p.String(),
+			"group":   bson.M{"$in": append([]any{"", nil}, lo.ToAnySlice(g)...)},
+		})
+	}
+	conds = append(conds, bson.M{"project": bson.M{"$nin": projects}})
+	return mongox.And(filter, "", bson.M{"$or": conds})
+}
+
+// applyReadableModelFilter limits items of the projects to ones of the models
+func applyReadableModelFilter(filter any, models map[id.ProjectID][]id.ModelID) any {
+	if len(models) == 0 {
+		return filter
+	}
+
+	projects := make([]string, 0, len(models))
+	conds := make([]bson.M, 0, len(models)+1)
+	for p, m := range models {
+		projects = append(projects, p.String())
+		conds = append(conds, bson.M{
+			"project": p.String(),
+			"modelid": bson.M{"$in": id.ModelIDList(m).Strings()},
+		})
+	}
+	conds = append(conds, bson.M{"project": bson.M{"$nin": projects}})
+	return mongox.And(filter, "", bson.M{"$or": conds})
+}
+
+// spatialFilter matches items which have geometries or assets overlapping the area
+func spatialFilter(f *item.SpatialFilter) bson.M {
+	conds := []bson.M{
+		{"geo": geoIntersects(f.Area())},
+	}
+	if assets := f.Assets(); len(assets) > 0 {
+		conds = append(conds, bson.M{"assets": bson.M{"$in": assets.Strings()}})
+	}
+	return bson.M{"$or": conds}
 }
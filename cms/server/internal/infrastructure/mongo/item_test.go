@@ -471,6 +471,75 @@ func TestItem_Search(t *testing.T) {
 	}
 }
 
+func TestItem_SearchByField(t *testing.T) {
+	sid := id.NewSchemaID()
+	sf := id.NewFieldID()
+	sf2 := id.NewFieldID()
+	pid := id.NewProjectID()
+	newItem := func(v int, s string) *item.Item {
+		return item.New().NewID().Schema(sid).Model(id.NewModelID()).Fields([]*item.Field{
+			item.NewField(sf, value.TypeInteger.Value(v).AsMultiple()),
+			item.NewField(sf2, value.TypeSelect.Value(s).AsMultiple()),
+		}).Project(pid).Thread(id.NewThreadID()).MustBuild()
+	}
+	i1, i2, i3 := newItem(3, "a"), newItem(1, "b"), newItem(2, "c")
+	i4 := item.New().NewID().Schema(sid).Model(id.NewModelID()).Project(pid).Thread(id.NewThreadID()).MustBuild()
+
+	init := mongotest.Connect(t)
+	client := mongox.NewClientWithDatabase(init(t))
+	r := NewItem(client)
+	ctx := context.Background()
+	for _, i := range (item.List{i1, i2, i3, i4}) {
+		assert.NoError(t, r.Save(ctx, i))
+	}
+
+	tests := []struct {
+		name    string
+		filters []*item.FieldFilter
+		want    item.List
+	}{
+		{"eq", []*item.FieldFilter{lo.Must(item.NewFieldFilter(sf, item.FilterOperatorEq, value.TypeInteger.Value(1)))}, item.List{i2}},
+		{"ne", []*item.FieldFilter{lo.Must(item.NewFieldFilter(sf2, item.FilterOperatorNe, value.TypeSelect.Value("a")))}, item.List{i2, i3, i4}},
+		{"range", []*item.FieldFilter{
+			lo.Must(item.NewFieldFilter(sf, item.FilterOperatorGt, value.TypeInteger.Value(1))),
+			lo.Must(item.NewFieldFilter(sf, item.FilterOperatorLte, value.TypeInteger.Value(2))),
+		}, item.List{i3}},
+		{"in", []*item.FieldFilter{lo.Must(item.NewFieldFilter(sf2, item.FilterOperatorIn, value.TypeSelect.Value("a"), value.TypeSelect.Value("c")))}, item.List{i1, i3}},
+		{"exists", []*item.FieldFilter{item.NewExistsFieldFilter(sf, false)}, item.List{i4}},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, _, err := r.Search(ctx, item.NewQuery(pid, sid.Ref(), "", nil).WithFilters(tc.filters...), nil, nil)
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tc.want, got.Unwrap())
+		})
+	}
+
+	// sort
+	q := item.NewQuery(pid, sid.Ref(), "", nil).WithSort(item.NewFieldSort(sf, item.DescDirection))
+	got, pi, err := r.Search(ctx, q, nil, usecasex.OffsetPagination{Offset: 1, Limit: 2}.Wrap())
+	assert.NoError(t, err)
+	assert.Equal(t, item.List{i3, i2}, got.Unwrap())
+	assert.Equal(t, int64(4), pi.TotalCount)
+	assert.True(t, pi.HasNextPage)
+
+	_, _, err = r.Search(ctx, q, nil, usecasex.CursorPagination{First: lo.ToPtr(int64(1))}.Wrap())
+	assert.Equal(t, repo.ErrUnsupportedPagination, err)
+
+	// items saved in the compat layout are sorted by their values too
+	i5 := newItem(5, "d")
+	assert.NoError(t, r.Save(ctx, i5))
+	_, err = client.Database().Collection("item").UpdateMany(ctx, bson.M{"id": i5.ID().String()}, bson.M{"$set": bson.M{
+		"fields": []bson.M{{"schemafield": sf.String(), "valuetype": "integer", "value": 5}},
+	}})
+	assert.NoError(t, err)
+	got, _, err = r.Search(ctx, q, nil, usecasex.OffsetPagination{Offset: 0, Limit: 2}.Wrap())
+	assert.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{i5.ID(), i1.ID()}, util.Map(got.Unwrap(), func(i *item.Item) id.ItemID { return i.ID() }))
+}
+
 func TestItem_FindByModelAndValue(t *testing.T) {
 	init := mongotest.Connect(t)
 	sid := id.NewSchemaID()
@@ -612,3 +681,151 @@ func TestItem_FindByAssets(t *testing.T) {
 		})
 	}
 }
+
+func TestItem_FindByReferences(t *testing.T) {
+	init := mongotest.Connect(t)
+	sid := id.NewSchemaID()
+	rid1 := id.NewItemID()
+	rid2 := id.NewItemID()
+	sf1 := id.NewFieldID()
+	sf2 := id.NewFieldID()
+	f1 := item.NewField(sf1, value.NewMultiple(value.TypeGroup, []any{
+		value.Group{sf2: value.TypeReference.Value(rid1).AsMultiple()},
+	}))
+	pid := id.NewProjectID()
+	mid := id.NewModelID()
+	i1 := item.New().NewID().Schema(sid).Model(mid).Fields([]*item.Field{f1}).Project(pid).Thread(id.NewThreadID()).MustBuild()
+
+	tests := []struct {
+		Name     string
+		Input    id.ItemIDList
+		Seeds    item.List
+		Expected int
+		WantErr  error
+	}{
+		{
+			Name:     "must find 1 item",
+			Input:    id.ItemIDList{rid1, rid2},
+			Seeds:    item.List{i1},
+			Expected: 1,
+		},
+		{
+			Name:     "must not find any item",
+			Input:    id.ItemIDList{},
+			Seeds:    item.List{i1},
+			Expected: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(tt *testing.T) {
+			tt.Parallel()
+
+			client := mongox.NewClientWithDatabase(init(t))
+
+			repo := NewItem(client)
+			ctx := context.Background()
+			for _, i := range tc.Seeds {
+				err := repo.Save(ctx, i)
+				assert.NoError(tt, err)
+			}
+
+			got, err := repo.FindByReferences(ctx, tc.Input, nil)
+			assert.Equal(tt, tc.WantErr, err)
+			assert.Equal(tt, tc.Expected, len(got))
+		})
+	}
+}
+
+func TestItem_FindAllByReferences(t *testing.T) {
+	init := mongotest.Connect(t)
+	sid := id.NewSchemaID()
+	mid := id.NewModelID()
+	pid := id.NewProjectID()
+	rid := id.NewItemID()
+	sf := id.NewFieldID()
+	f := item.NewField(sf, value.TypeReference.Value(rid).AsMultiple())
+	i1 := item.New().NewID().Schema(sid).Model(mid).Fields([]*item.Field{f}).Project(pid).Thread(id.NewThreadID()).Group("a").MustBuild()
+	i2 := item.New().NewID().Schema(sid).Model(mid).Fields([]*item.Field{f}).Project(pid).Thread(id.NewThreadID()).Group("b").MustBuild()
+
+	client := mongox.NewClientWithDatabase(init(t))
+	ctx := context.Background()
+	r := NewItem(client)
+	lo.Must0(r.Save(ctx, i1))
+	lo.Must0(r.Save(ctx, i2))
+
+	filtered := r.Filtered(repo.ProjectFilter{ItemGroups: map[id.ProjectID][]string{pid: {"a"}}})
+	got, err := filtered.FindByReferences(ctx, id.ItemIDList{rid}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+
+	// items hidden by the filter are also returned
+	got, err = filtered.FindAllByReferences(ctx, id.ItemIDList{rid})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(got))
+}
+
+func TestItem_FilteredByItemGroups(t *testing.T) {
+	init := mongotest.Connect(t)
+	sid := id.NewSchemaID()
+	mid := id.NewModelID()
+	pid := id.NewProjectID()
+	pid2 := id.NewProjectID()
+	i1 := item.New().NewID().Schema(sid).Model(mid).Project(pid).Thread(id.NewThreadID()).Group("13101").MustBuild()
+	i2 := item.New().NewID().Schema(sid).Model(mid).Project(pid).Thread(id.NewThreadID()).Group("13102").MustBuild()
+	i3 := item.New().NewID().Schema(sid).Model(mid).Project(pid).Thread(id.NewThreadID()).MustBuild()
+	i4 := item.New().NewID().Schema(sid).Model(mid).Project(pid2).Thread(id.NewThreadID()).Group("13102").MustBuild()
+
+	client := mongox.NewClientWithDatabase(init(t))
+	r := NewItem(client)
+	ctx := context.Background()
+	for _, i := range (item.List{i1, i2, i3, i4}) {
+		assert.NoError(t, r.Save(ctx, i))
+	}
+
+	r2 := r.Filtered(repo.ProjectFilter{
+		ItemGroups: map[id.ProjectID][]string{pid: {"13101"}},
+	})
+
+	got, err := r2.FindByIDs(ctx, id.ItemIDList{i1.ID(), i2.ID(), i3.ID(), i4.ID()}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []id.ItemID{i1.ID(), i3.ID(), i4.ID()}, lo.Map(got, func(i item.Versioned, _ int) id.ItemID { return i.Value().ID() }))
+
+	_, err = r2.FindByID(ctx, i2.ID(), nil)
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	_, pi, err := r2.FindByProject(ctx, pid, nil, usecasex.CursorPagination{First: lo.ToPtr(int64(10))}.Wrap())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), pi.TotalCount)
+}
+
+func TestItem_FilteredByReadableModels(t *testing.T) {
+	init := mongotest.Connect(t)
+	sid := id.NewSchemaID()
+	mid, mid2 := id.NewModelID(), id.NewModelID()
+	pid := id.NewProjectID()
+	pid2 := id.NewProjectID()
+	i1 := item.New().NewID().Schema(sid).Model(mid).Project(pid).Thread(id.NewThreadID()).MustBuild()
+	i2 := item.New().NewID().Schema(sid).Model(mid2).Project(pid).Thread(id.NewThreadID()).MustBuild()
+	i3 := item.New().NewID().Schema(sid).Model(mid2).Project(pid2).Thread(id.NewThreadID()).MustBuild()
+
+	client := mongox.NewClientWithDatabase(init(t))
+	r := NewItem(client)
+	ctx := context.Background()
+	for _, i := range (item.List{i1, i2, i3}) {
+		assert.NoError(t, r.Save(ctx, i))
+	}
+
+	r2 := r.Filtered(repo.ProjectFilter{
+		ReadableModels: map[id.ProjectID][]id.ModelID{pid: {mid}},
+	})
+
+	got, err := r2.FindByIDs(ctx, id.ItemIDList{i1.ID(), i2.ID(), i3.ID()}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []id.ItemID{i1.ID(), i3.ID()}, lo.Map(got, func(i item.Versioned, _ int) id.ItemID { return i.Value().ID() }))
+
+	_, pi, err := r2.FindByProject(ctx, pid, nil, usecasex.CursorPagination{First: lo.ToPtr(int64(10))}.Wrap())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), pi.TotalCount)
+}
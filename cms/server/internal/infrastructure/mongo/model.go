@@ -14,7 +14,7 @@ import (
 )
 
 var (
-	modelIndexes       = []string{"project", "workspace", "key"}
+	modelIndexes       = []string{"project", "workspace", "key", "schema"}
 	modelUniqueIndexes = []string{"id"}
 )
 
@@ -84,6 +84,12 @@ func (r *Model) FindByKey(ctx context.Context, projectID id.ProjectID, key strin
 	})
 }
 
+func (r *Model) FindBySchema(ctx context.Context, schemaID id.SchemaID) (*model.Model, error) {
+	return r.findOne(ctx, bson.M{
+		"schema": schemaID.String(),
+	})
+}
+
 func (r *Model) FindByIDOrKey(ctx context.Context, projectID id.ProjectID, q model.IDOrKey) (*model.Model, error) {
 	mid := q.ID()
 	key := q.Key()
@@ -392,3 +392,24 @@ func TestModelRepo_FindByProject(t *testing.T) {
 		})
 	}
 }
+
+func TestModelRepo_FindBySchema(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond).UTC()
+	m := model.New().NewID().Project(id.NewProjectID()).Schema(id.NewSchemaID()).Key(key.New("T123456")).UpdatedAt(now).MustBuild()
+
+	init := mongotest.Connect(t)
+	client := mongox.NewClientWithDatabase(init(t))
+	r := NewModel(client)
+	ctx := context.Background()
+	assert.NoError(t, r.Save(ctx, m))
+
+	got, err := r.FindBySchema(ctx, m.Schema())
+	assert.NoError(t, err)
+	assert.Equal(t, m, got)
+
+	_, err = r.FindBySchema(ctx, id.NewSchemaID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	_, err = r.Filtered(repo.ProjectFilter{Readable: id.ProjectIDList{}, Writable: id.ProjectIDList{}}).FindBySchema(ctx, m.Schema())
+	assert.Equal(t, rerror.ErrNotFound, err)
+}
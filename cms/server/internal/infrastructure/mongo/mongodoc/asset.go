@@ -21,6 +21,27 @@ type AssetDocument struct {
 	UUID                    string
 	Thread                  string
 	ArchiveExtractionStatus string
+	BBox                    []float64
+	// Geo is the polygon of the bounding box for spatial queries
+	Geo *GeometryDocument
+
+	ArchiveExtractionProgress *AssetExtractionProgressDocument
+	ArchiveExtractionFailures []AssetExtractionFailureDocument
+	CompressionStatus         string
+	CompressedPath            string
+}
+
+type AssetExtractionProgressDocument struct {
+	Files      int
+	TotalFiles int
+	Failed     int
+	Bytes      int64
+	TotalBytes int64
+}
+
+type AssetExtractionFailureDocument struct {
+	Path  string
+	Error string
 }
 
 type AssetAndFileDocument struct {
@@ -33,6 +54,7 @@ type AssetFileDocument struct {
 	Size        uint64
 	ContentType string
 	Path        string
+	CRC32       uint32
 	Children    []*AssetFileDocument
 }
 
@@ -76,8 +98,28 @@ func NewAsset(a *asset.Asset) (*AssetDocument, string) {
 		UUID:                    a.UUID(),
 		Thread:                  a.Thread().String(),
 		ArchiveExtractionStatus: archiveExtractionStatus,
+		BBox:                    a.BBox(),
+		Geo:                     NewBBoxGeometry(a.BBox()),
+		CompressedPath:          a.CompressedPath(),
 	}, aid
 
+	if s := a.CompressionStatus(); s != nil {
+		ad.CompressionStatus = s.String()
+	}
+
+	if p := a.ArchiveExtractionProgress(); p != nil {
+		ad.ArchiveExtractionProgress = &AssetExtractionProgressDocument{
+			Files:      p.Files,
+			TotalFiles: p.TotalFiles,
+			Failed:     p.Failed,
+			Bytes:      p.Bytes,
+			TotalBytes: p.TotalBytes,
+		}
+	}
+	ad.ArchiveExtractionFailures = lo.Map(a.ArchiveExtractionFailures(), func(f asset.ArchiveExtractionFailure, _ int) AssetExtractionFailureDocument {
+		return AssetExtractionFailureDocument{Path: f.Path, Error: f.Error}
+	})
+
 	return ad, id
 }
 
@@ -104,7 +146,23 @@ func (d *AssetDocument) Model() (*asset.Asset, error) {
 		Type(asset.PreviewTypeFromRef(lo.ToPtr(d.PreviewType))).
 		UUID(d.UUID).
 		Thread(thid).
-		ArchiveExtractionStatus(asset.ArchiveExtractionStatusFromRef(lo.ToPtr(d.ArchiveExtractionStatus)))
+		ArchiveExtractionStatus(asset.ArchiveExtractionStatusFromRef(lo.ToPtr(d.ArchiveExtractionStatus))).
+		BBox(d.BBox).
+		CompressionStatus(asset.ArchiveExtractionStatusFromRef(lo.ToPtr(d.CompressionStatus))).
+		CompressedPath(d.CompressedPath).
+		ArchiveExtractionFailures(lo.Map(d.ArchiveExtractionFailures, func(f AssetExtractionFailureDocument, _ int) asset.ArchiveExtractionFailure {
+			return asset.ArchiveExtractionFailure{Path: f.Path, Error: f.Error}
+		}))
+
+	if p := d.ArchiveExtractionProgress; p != nil {
+		ab = ab.ArchiveExtractionProgress(&asset.ArchiveExtractionProgress{
+			Files:      p.Files,
+			TotalFiles: p.TotalFiles,
+			Failed:     p.Failed,
+			Bytes:      p.Bytes,
+			TotalBytes: p.TotalBytes,
+		})
+	}
 
 	if d.User != nil {
 		uid, err := id.UserIDFrom(*d.User)
@@ -142,6 +200,7 @@ func NewFile(f *asset.File) *AssetFileDocument {
 		Size:        f.Size(),
 		ContentType: f.ContentType(),
 		Path:        f.Path(),
+		CRC32:       f.CRC32(),
 		Children:    c,
 	}
 }
@@ -164,6 +223,7 @@ func (f *AssetFileDocument) Model() *asset.File {
 		Size(f.Size).
 		ContentType(f.ContentType).
 		Path(f.Path).
+		CRC32(f.CRC32).
 		Children(c).
 		Build()
 
@@ -7,6 +7,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/reearth/reearth-cms/server/pkg/user"
@@ -52,6 +53,9 @@ func NewDocument(obj any) (doc Document, id string, err error) {
 	case *model.Model:
 		ty = "model"
 		res, id = NewModel(m)
+	case *request.Request:
+		ty = "request"
+		res, id = NewRequest(m)
 	case *thread.Thread:
 		ty = "thread"
 		res, id = NewThread(m)
@@ -103,6 +107,11 @@ func ModelFrom(obj Document) (res any, err error) {
 		if err = bson.Unmarshal(obj.Object, &d); err == nil {
 			res, err = d.Model()
 		}
+	case "request":
+		var d *RequestDocument
+		if err = bson.Unmarshal(obj.Object, &d); err == nil {
+			res, err = d.Model()
+		}
 	case "thread":
 		var d *ThreadDocument
 		if err = bson.Unmarshal(obj.Object, &d); err == nil {
@@ -17,6 +17,23 @@ type EventDocument struct {
 	Machine     bool
 	Type        string
 	Object      Document
+	// the following fields are used to filter audit logs
+	Project   *EventProjectDocument `bson:"project,omitempty"`
+	Workspace *string               `bson:"workspace,omitempty"`
+	ModelID   *string               `bson:"model,omitempty"`
+	Item      *string               `bson:"item,omitempty"`
+	Member    *EventMemberDocument  `bson:"member,omitempty"`
+}
+
+type EventProjectDocument struct {
+	ID    string
+	Alias string
+}
+
+type EventMemberDocument struct {
+	User        *string
+	Integration *string
+	Role        string
 }
 
 func NewEvent(e *event.Event[any]) (*EventDocument, string, error) {
@@ -33,9 +50,35 @@ func NewEvent(e *event.Event[any]) (*EventDocument, string, error) {
 		Machine:     e.Operator().Machine(),
 		Type:        string(e.Type()),
 		Object:      objDoc,
+		Project:     newEventProject(e.Project()),
+		Workspace:   e.Workspace().StringRef(),
+		ModelID:     e.Model().StringRef(),
+		Item:        e.Item().StringRef(),
+		Member:      newEventMember(e.Member()),
 	}, eId, nil
 }
 
+func newEventProject(p *event.Project) *EventProjectDocument {
+	if p == nil {
+		return nil
+	}
+	return &EventProjectDocument{
+		ID:    p.ID,
+		Alias: p.Alias,
+	}
+}
+
+func newEventMember(m *event.Member) *EventMemberDocument {
+	if m == nil {
+		return nil
+	}
+	return &EventMemberDocument{
+		User:        m.User.StringRef(),
+		Integration: m.Integration.StringRef(),
+		Role:        m.Role,
+	}
+}
+
 func (d *EventDocument) Model() (*event.Event[any], error) {
 	eID, err := event.IDFrom(d.ID)
 	if err != nil {
@@ -66,6 +109,11 @@ func (d *EventDocument) Model() (*event.Event[any], error) {
 		Timestamp(d.Timestamp).
 		Operator(o).
 		Object(m).
+		Project(d.Project.model()).
+		Workspace(id.WorkspaceIDFromRef(d.Workspace)).
+		Model(id.ModelIDFromRef(d.ModelID)).
+		Item(id.ItemIDFromRef(d.Item)).
+		Member(d.Member.model()).
 		Build()
 	if err != nil {
 		return nil, err
@@ -74,6 +122,27 @@ func (d *EventDocument) Model() (*event.Event[any], error) {
 	return e, nil
 }
 
+func (d *EventProjectDocument) model() *event.Project {
+	if d == nil {
+		return nil
+	}
+	return &event.Project{
+		ID:    d.ID,
+		Alias: d.Alias,
+	}
+}
+
+func (d *EventMemberDocument) model() *event.Member {
+	if d == nil {
+		return nil
+	}
+	return &event.Member{
+		User:        id.UserIDFromRef(d.User),
+		Integration: id.IntegrationIDFromRef(d.Integration),
+		Role:        d.Role,
+	}
+}
+
 type EventConsumer = mongox.SliceFuncConsumer[*EventDocument, *event.Event[any]]
 
 func NewEventConsumer() *EventConsumer {
@@ -0,0 +1,53 @@
+package mongodoc
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+)
+
+// GeometryDocument is a GeoJSON geometry object which can be indexed by a 2dsphere index
+type GeometryDocument struct {
+	Type        string `bson:"type"`
+	Coordinates any    `bson:"coordinates"`
+}
+
+func NewGeometry(g value.Geometry) *GeometryDocument {
+	// altitudes are dropped as they are not used for spatial queries
+	var c any
+	switch v := g.Coordinates.(type) {
+	case value.Position:
+		c = to2D(v)
+	case []value.Position:
+		c = lo.Map(v, func(p value.Position, _ int) value.Position { return to2D(p) })
+	case [][]value.Position:
+		c = lo.Map(v, func(l []value.Position, _ int) []value.Position {
+			return lo.Map(l, func(p value.Position, _ int) value.Position { return to2D(p) })
+		})
+	case [][][]value.Position:
+		c = lo.Map(v, func(ll [][]value.Position, _ int) [][]value.Position {
+			return lo.Map(ll, func(l []value.Position, _ int) []value.Position {
+				return lo.Map(l, func(p value.Position, _ int) value.Position { return to2D(p) })
+			})
+		})
+	default:
+		return nil
+	}
+
+	return &GeometryDocument{
+		Type:        string(g.Type),
+		Coordinates: c,
+	}
+}
+
+// NewBBoxGeometry returns a polygon of the bounding box of [west, south, east, north]
+func NewBBoxGeometry(b []float64) *GeometryDocument {
+	g, ok := value.GeometryFromBBox(b)
+	if !ok {
+		return nil
+	}
+	return NewGeometry(g)
+}
+
+func to2D(p value.Position) value.Position {
+	return value.Position{p[0], p[1]}
+}
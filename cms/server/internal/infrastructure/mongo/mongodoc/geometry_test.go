@@ -0,0 +1,27 @@
+package mongodoc
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGeometry(t *testing.T) {
+	assert.Equal(t, &GeometryDocument{
+		Type:        "LineString",
+		Coordinates: []value.Position{{139, 35}, {140, 36}},
+	}, NewGeometry(value.Geometry{
+		Type:        value.GeometryTypeLineString,
+		Coordinates: []value.Position{{139, 35, 10}, {140, 36, 20}},
+	}))
+	assert.Nil(t, NewGeometry(value.Geometry{}))
+}
+
+func TestNewBBoxGeometry(t *testing.T) {
+	assert.Equal(t, &GeometryDocument{
+		Type:        "Polygon",
+		Coordinates: [][]value.Position{{{139, 35}, {140, 35}, {140, 36}, {139, 36}, {139, 35}}},
+	}, NewBBoxGeometry([]float64{139, 35, 140, 36}))
+	assert.Nil(t, NewBBoxGeometry(nil))
+}
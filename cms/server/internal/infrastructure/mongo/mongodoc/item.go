@@ -6,6 +6,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongogit"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/mongox"
 	"github.com/reearth/reearthx/util"
@@ -23,14 +24,26 @@ type ItemDocument struct {
 	User        *string
 	Integration *string
 	Assets      []string `bson:"assets,omitempty"`
+	// Refs is the list of items referenced by the item for back-reference queries
+	Refs []string `bson:"refs,omitempty"`
+	// Geo is the list of geometries of the item for spatial queries
+	Geo []*GeometryDocument `bson:"geo,omitempty"`
+	// PublishAt and UnpublishAt are the times when the item is published or unpublished by the scheduler
+	PublishAt   *time.Time `bson:"publishat,omitempty"`
+	UnpublishAt *time.Time `bson:"unpublishat,omitempty"`
+	// PublishVersion is the version published by the scheduler instead of the latest one, such as the version approved in a request
+	PublishVersion *string `bson:"publishversion,omitempty"`
+	// Group is the group which owns the item for restricting access to the item
+	Group string `bson:"group,omitempty"`
 }
 
 type ItemFieldDocument struct {
-	F         string        `bson:"f,omitempty"`
-	V         ValueDocument `bson:"v,omitempty"`
-	Field     string        `bson:"schemafield,omitempty"` // compat
-	ValueType string        `bson:"valuetype,omitempty"`   // compat
-	Value     any           `bson:"value,omitempty"`       // compat
+	F         string                   `bson:"f,omitempty"`
+	V         ValueDocument            `bson:"v,omitempty"`
+	L         map[string]ValueDocument `bson:"l,omitempty"`           // values of a localized field keyed by locales
+	Field     string                   `bson:"schemafield,omitempty"` // compat
+	ValueType string                   `bson:"valuetype,omitempty"`   // compat
+	Value     any                      `bson:"value,omitempty"`       // compat
 }
 
 type ItemConsumer = mongox.SliceFuncConsumer[*ItemDocument, *item.Item]
@@ -55,6 +68,10 @@ func NewVersionedItemConsumer() *VersionedItemConsumer {
 
 func NewItem(i *item.Item) (*ItemDocument, string) {
 	itmId := i.ID().String()
+	var publishVersion *string
+	if v := i.PublishVersion(); v != nil {
+		publishVersion = lo.ToPtr(v.String())
+	}
 	return &ItemDocument{
 		ID:      itmId,
 		Schema:  i.Schema().String(),
@@ -67,15 +84,35 @@ func NewItem(i *item.Item) (*ItemDocument, string) {
 				return ItemFieldDocument{}, false
 			}
 
+			var l map[string]ValueDocument
+			if loc := f.Localized(); len(loc) > 0 {
+				l = make(map[string]ValueDocument, len(loc))
+				for k, m := range loc {
+					if lv := NewMultipleValue(m); lv != nil {
+						l[k] = *lv
+					}
+				}
+			}
+
 			return ItemFieldDocument{
 				F: f.FieldID().String(),
 				V: *v,
+				L: l,
 			}, true
 		}),
 		Timestamp:   i.Timestamp(),
 		User:        i.User().StringRef(),
 		Integration: i.Integration().StringRef(),
 		Assets:      i.AssetIDs().Strings(),
+		Refs:        i.References().Strings(),
+		Geo: lo.FilterMap(i.Geometries(), func(g value.Geometry, _ int) (*GeometryDocument, bool) {
+			d := NewGeometry(g)
+			return d, d != nil
+		}),
+		PublishAt:      i.PublishAt(),
+		UnpublishAt:    i.UnpublishAt(),
+		PublishVersion: publishVersion,
+		Group:          i.Group(),
 	}, itmId
 }
 
@@ -124,7 +161,15 @@ func (d *ItemDocument) Model() (*item.Item, error) {
 			}
 		}
 
-		return item.NewField(sf, f.V.MultipleValue()), nil
+		var l map[string]*value.Multiple
+		if len(f.L) > 0 {
+			l = make(map[string]*value.Multiple, len(f.L))
+			for k, v := range f.L {
+				l[k] = v.MultipleValue()
+			}
+		}
+
+		return item.NewLocalizedField(sf, f.V.MultipleValue(), l), nil
 	})
 	if err != nil {
 		return nil, err
@@ -137,7 +182,18 @@ func (d *ItemDocument) Model() (*item.Item, error) {
 		Model(mid).
 		Thread(tid).
 		Fields(fields).
-		Timestamp(d.Timestamp)
+		Timestamp(d.Timestamp).
+		PublishAt(d.PublishAt).
+		UnpublishAt(d.UnpublishAt).
+		Group(d.Group)
+
+	if d.PublishVersion != nil {
+		v, err := version.Parse(*d.PublishVersion)
+		if err != nil {
+			return nil, err
+		}
+		ib = ib.PublishVersion(&v)
+	}
 
 	if uId := id.UserIDFromRef(d.User); uId != nil {
 		ib = ib.User(*uId)
@@ -6,7 +6,10 @@ import (
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 )
 
 type ProjectDocument struct {
@@ -18,11 +21,36 @@ type ProjectDocument struct {
 	ImageURL    string
 	Workspace   string
 	Publication *ProjectPublicationDocument
+	ReqPolicy   *ProjectRequestPolicyDocument   `bson:"reqpolicy,omitempty"`
+	ItemGroups  *ProjectItemGroupPolicyDocument `bson:"itemgroups,omitempty"`
 }
 
 type ProjectPublicationDocument struct {
 	AssetPublic bool
 	Scope       string
+	APIKeys     []ProjectAPIKeyDocument `bson:"apikeys,omitempty"`
+}
+
+type ProjectAPIKeyDocument struct {
+	ID   string
+	Name string
+	// Key is the raw key stored by old versions. It is hashed when it is loaded and is not written anymore.
+	Key       string   `bson:",omitempty"`
+	Hash      string   `bson:",omitempty"`
+	Models    []string `bson:",omitempty"`
+	RateLimit int      `bson:",omitempty"`
+}
+
+type ProjectRequestPolicyDocument struct {
+	RequiredApprovals int
+	RequiredRoles     []string
+	// ModelReviewers is keyed by model IDs
+	ModelReviewers map[string][]string
+}
+
+type ProjectItemGroupPolicyDocument struct {
+	Field      *string `bson:",omitempty"`
+	Restricted bool
 }
 
 func NewProject(project *project.Project) (*ProjectDocument, string) {
@@ -42,6 +70,8 @@ func NewProject(project *project.Project) (*ProjectDocument, string) {
 		ImageURL:    imageURL,
 		Workspace:   project.Workspace().String(),
 		Publication: NewProjectPublication(project.Publication()),
+		ReqPolicy:   NewProjectRequestPolicy(project.RequestPolicy()),
+		ItemGroups:  NewProjectItemGroupPolicy(project.ItemGroupPolicy()),
 	}, pid
 }
 
@@ -53,6 +83,48 @@ func NewProjectPublication(p *project.Publication) *ProjectPublicationDocument {
 	return &ProjectPublicationDocument{
 		AssetPublic: p.AssetPublic(),
 		Scope:       string(p.Scope()),
+		APIKeys: lo.Map(p.APIKeys(), func(k *project.APIKey, _ int) ProjectAPIKeyDocument {
+			return ProjectAPIKeyDocument{
+				ID:        k.ID().String(),
+				Name:      k.Name(),
+				Hash:      k.Hash(),
+				Models:    k.Models().Strings(),
+				RateLimit: k.RateLimit(),
+			}
+		}),
+	}
+}
+
+func NewProjectRequestPolicy(p *project.RequestPolicy) *ProjectRequestPolicyDocument {
+	if p == nil {
+		return nil
+	}
+
+	var reviewers map[string][]string
+	if mr := p.ModelReviewers(); len(mr) > 0 {
+		reviewers = make(map[string][]string, len(mr))
+		for m, l := range mr {
+			reviewers[m.String()] = l.Strings()
+		}
+	}
+
+	return &ProjectRequestPolicyDocument{
+		RequiredApprovals: p.RequiredApprovals(),
+		RequiredRoles: lo.Map(p.RequiredRoles(), func(r user.Role, _ int) string {
+			return string(r)
+		}),
+		ModelReviewers: reviewers,
+	}
+}
+
+func NewProjectItemGroupPolicy(p *project.ItemGroupPolicy) *ProjectItemGroupPolicyDocument {
+	if p == nil {
+		return nil
+	}
+
+	return &ProjectItemGroupPolicyDocument{
+		Field:      p.Field().StringRef(),
+		Restricted: p.Restricted(),
 	}
 }
 
@@ -73,6 +145,21 @@ func (d *ProjectDocument) Model() (*project.Project, error) {
 		}
 	}
 
+	reqPolicy, err := d.ReqPolicy.Model()
+	if err != nil {
+		return nil, err
+	}
+
+	publication, err := d.Publication.Model()
+	if err != nil {
+		return nil, err
+	}
+
+	itemGroups, err := d.ItemGroups.Model()
+	if err != nil {
+		return nil, err
+	}
+
 	return project.New().
 		ID(pid).
 		UpdatedAt(d.UpdatedAt).
@@ -81,15 +168,66 @@ func (d *ProjectDocument) Model() (*project.Project, error) {
 		Alias(d.Alias).
 		Workspace(tid).
 		ImageURL(imageURL).
-		Publication(d.Publication.Model()).
+		Publication(publication).
+		RequestPolicy(reqPolicy).
+		ItemGroupPolicy(itemGroups).
 		Build()
 }
 
-func (d *ProjectPublicationDocument) Model() *project.Publication {
+func (d *ProjectPublicationDocument) Model() (*project.Publication, error) {
 	if d == nil {
-		return nil
+		return nil, nil
+	}
+
+	keys, err := util.TryMap(d.APIKeys, func(k ProjectAPIKeyDocument) (*project.APIKey, error) {
+		kid, err := id.APIKeyIDFrom(k.ID)
+		if err != nil {
+			return nil, err
+		}
+		models, err := id.ModelIDListFrom(k.Models)
+		if err != nil {
+			return nil, err
+		}
+		hash := k.Hash
+		if hash == "" && k.Key != "" {
+			hash = project.HashAPIKey(k.Key)
+		}
+		return project.APIKeyFrom(kid, k.Name, hash, models, k.RateLimit), nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return project.NewPublication(project.PublicationScope(d.Scope), d.AssetPublic)
+
+	p := project.NewPublication(project.PublicationScope(d.Scope), d.AssetPublic)
+	p.SetAPIKeys(keys)
+	return p, nil
+}
+
+func (d *ProjectRequestPolicyDocument) Model() (*project.RequestPolicy, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	roles, err := util.TryMap(d.RequiredRoles, user.RoleFromString)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviewers map[id.ModelID]id.UserIDList
+	if len(d.ModelReviewers) > 0 {
+		reviewers = make(map[id.ModelID]id.UserIDList, len(d.ModelReviewers))
+		for k, v := range d.ModelReviewers {
+			mid, err := id.ModelIDFrom(k)
+			if err != nil {
+				return nil, err
+			}
+			if reviewers[mid], err = id.UserIDListFrom(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return project.NewRequestPolicy(d.RequiredApprovals, roles, reviewers)
 }
 
 type ProjectConsumer = mongox.SliceFuncConsumer[*ProjectDocument, *project.Project]
@@ -97,3 +235,15 @@ type ProjectConsumer = mongox.SliceFuncConsumer[*ProjectDocument, *project.Proje
 func NewProjectConsumer() *ProjectConsumer {
 	return NewComsumer[*ProjectDocument, *project.Project]()
 }
+
+func (d *ProjectItemGroupPolicyDocument) Model() (*project.ItemGroupPolicy, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	var field *id.Key
+	if d.Field != nil {
+		field = id.NewKey(*d.Field).Ref()
+	}
+	return project.NewItemGroupPolicy(field, d.Restricted)
+}
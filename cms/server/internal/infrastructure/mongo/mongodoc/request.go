@@ -21,11 +21,14 @@ type RequestDocument struct {
 	Description string
 	CreatedBy   string
 	Reviewers   []string
+	ApprovedBy  []string `bson:"approvedby,omitempty"`
 	State       string
 	UpdatedAt   time.Time
 	ApprovedAt  *time.Time
 	ClosedAt    *time.Time
 	Thread      string
+	PublishAt   *time.Time `bson:"publishat,omitempty"`
+	UnpublishAt *time.Time `bson:"unpublishat,omitempty"`
 }
 
 type RequestItem struct {
@@ -71,11 +74,13 @@ func NewRequest(r *request.Request) (*RequestDocument, string) {
 		Reviewers: lo.Map(r.Reviewers(), func(u id.UserID, i int) string {
 			return u.String()
 		}),
-		State:      r.State().String(),
-		UpdatedAt:  r.UpdatedAt(),
-		ApprovedAt: r.ApprovedAt(),
-		ClosedAt:   r.ClosedAt(),
-		Thread:     r.Thread().String(),
+		State:       r.State().String(),
+		UpdatedAt:   r.UpdatedAt(),
+		ApprovedAt:  r.ApprovedAt(),
+		ClosedAt:    r.ClosedAt(),
+		Thread:      r.Thread().String(),
+		PublishAt:   r.PublishAt(),
+		UnpublishAt: r.UnpublishAt(),
 	}, rid
 
 	return doc, id
@@ -116,6 +121,10 @@ func (d *RequestDocument) Model() (*request.Request, error) {
 	if err != nil {
 		return nil, err
 	}
+	approvedBy, err := id.UserIDListFrom(d.ApprovedBy)
+	if err != nil {
+		return nil, err
+	}
 	items, err := util.TryMap(d.Items, func(ri RequestItem) (*request.Item, error) {
 		iid, err := id.ItemIDFrom(ri.Item)
 		if err != nil {
@@ -155,7 +164,10 @@ func (d *RequestDocument) Model() (*request.Request, error) {
 		ClosedAt(d.ClosedAt).
 		ApprovedAt(d.ApprovedAt).
 		Reviewers(reviewers).
-		Thread(tid)
+		ApprovedBy(approvedBy).
+		Thread(tid).
+		PublishAt(d.PublishAt).
+		UnpublishAt(d.UnpublishAt)
 
 	return builder.Build()
 }
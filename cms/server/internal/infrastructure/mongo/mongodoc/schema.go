@@ -30,6 +30,15 @@ type FieldDocument struct {
 	UpdatedAt    time.Time
 	DefaultValue *ValueDocument
 	TypeProperty TypePropertyDocument
+	MinItems     *int                     `bson:",omitempty"`
+	MaxItems     *int                     `bson:",omitempty"`
+	RequiredIf   *FieldRequiredIfDocument `bson:",omitempty"`
+	Localized    bool                     `bson:",omitempty"`
+}
+
+type FieldRequiredIfDocument struct {
+	Field  string
+	Values []string
 }
 
 type TypePropertyDocument struct {
@@ -42,10 +51,32 @@ type TypePropertyDocument struct {
 	Number    *FieldNumberPropertyDocument    `bson:",omitempty"`
 	Integer   *FieldIntegerPropertyDocument   `bson:",omitempty"`
 	Reference *FieldReferencePropertyDocument `bson:",omitempty"`
+	DateTime  *FieldDateTimePropertyDocument  `bson:",omitempty"`
+	URL       *FieldURLPropertyDocument       `bson:",omitempty"`
+	Geometry  *FieldGeometryPropertyDocument  `bson:",omitempty"`
+	Group     *FieldGroupPropertyDocument     `bson:",omitempty"`
 }
 
 type FieldTextPropertyDocument struct {
 	MaxLength *int
+	Pattern   *string `bson:",omitempty"`
+}
+
+type FieldDateTimePropertyDocument struct {
+	Min *time.Time
+	Max *time.Time
+}
+
+type FieldURLPropertyDocument struct {
+	Pattern *string `bson:",omitempty"`
+}
+
+type FieldGeometryPropertyDocument struct {
+	SupportedTypes []string
+}
+
+type FieldGroupPropertyDocument struct {
+	Fields []FieldDocument
 }
 type FieldSelectPropertyDocument struct {
 	Values []string
@@ -62,86 +93,122 @@ type FieldIntegerPropertyDocument struct {
 }
 
 type FieldReferencePropertyDocument struct {
-	Model string
+	Model    string
+	OnDelete string `bson:"ondelete,omitempty"`
 }
 
 func NewSchema(s *schema.Schema) (*SchemaDocument, string) {
 	sId := s.ID().String()
-	fieldsDoc := util.Map(s.Fields(), func(f *schema.Field) FieldDocument {
-		fd := FieldDocument{
-			ID:           f.ID().String(),
-			Name:         f.Name(),
-			Description:  f.Description(),
-			Order:        f.Order(),
-			Key:          f.Key().String(),
-			Unique:       f.Unique(),
-			Multiple:     f.Multiple(),
-			Required:     f.Required(),
-			UpdatedAt:    f.UpdatedAt(),
-			DefaultValue: NewMultipleValue(f.DefaultValue()),
-			TypeProperty: TypePropertyDocument{
-				Type: string(f.Type()),
-			},
-		}
-
-		f.TypeProperty().Match(schema.TypePropertyMatch{
-			Text: func(fp *schema.FieldText) {
-				fd.TypeProperty.Text = &FieldTextPropertyDocument{
-					MaxLength: fp.MaxLength(),
-				}
-			},
-			TextArea: func(fp *schema.FieldTextArea) {
-				fd.TypeProperty.TextArea = &FieldTextPropertyDocument{
-					MaxLength: fp.MaxLength(),
-				}
-			},
-			RichText: func(fp *schema.FieldRichText) {
-				fd.TypeProperty.RichText = &FieldTextPropertyDocument{
-					MaxLength: fp.MaxLength(),
-				}
-			},
-			Markdown: func(fp *schema.FieldMarkdown) {
-				fd.TypeProperty.Markdown = &FieldTextPropertyDocument{
-					MaxLength: fp.MaxLength(),
-				}
-			},
-			Asset:    func(fp *schema.FieldAsset) {},
-			DateTime: func(fp *schema.FieldDateTime) {},
-			Bool:     func(fp *schema.FieldBool) {},
-			Select: func(fp *schema.FieldSelect) {
-				fd.TypeProperty.Select = &FieldSelectPropertyDocument{
-					Values: fp.Values(),
-				}
-			},
-			Number: func(fp *schema.FieldNumber) {
-				fd.TypeProperty.Number = &FieldNumberPropertyDocument{
-					Min: fp.Min(),
-					Max: fp.Max(),
-				}
-			},
-			Integer: func(fp *schema.FieldInteger) {
-				fd.TypeProperty.Integer = &FieldIntegerPropertyDocument{
-					Min: fp.Min(),
-					Max: fp.Max(),
-				}
-			},
-			Reference: func(fp *schema.FieldReference) {
-				fd.TypeProperty.Reference = &FieldReferencePropertyDocument{
-					Model: fp.Model().String(),
-				}
-			},
-			URL: func(fp *schema.FieldURL) {},
-		})
-		return fd
-	})
 	return &SchemaDocument{
 		ID:        sId,
 		Workspace: s.Workspace().String(),
 		Project:   s.Project().String(),
-		Fields:    fieldsDoc,
+		Fields:    util.Map(s.Fields(), newFieldDocument),
 	}, sId
 }
 
+func newFieldDocument(f *schema.Field) FieldDocument {
+	fd := FieldDocument{
+		ID:           f.ID().String(),
+		Name:         f.Name(),
+		Description:  f.Description(),
+		Order:        f.Order(),
+		Key:          f.Key().String(),
+		Unique:       f.Unique(),
+		Multiple:     f.Multiple(),
+		Required:     f.Required(),
+		Localized:    f.Localized(),
+		UpdatedAt:    f.UpdatedAt(),
+		DefaultValue: NewMultipleValue(f.DefaultValue()),
+		TypeProperty: TypePropertyDocument{
+			Type: string(f.Type()),
+		},
+		MinItems: f.MinItems(),
+		MaxItems: f.MaxItems(),
+	}
+
+	if c := f.RequiredIf(); c != nil {
+		fd.RequiredIf = &FieldRequiredIfDocument{
+			Field:  c.Field().String(),
+			Values: c.Values(),
+		}
+	}
+
+	f.TypeProperty().Match(schema.TypePropertyMatch{
+		Text: func(fp *schema.FieldText) {
+			fd.TypeProperty.Text = &FieldTextPropertyDocument{
+				MaxLength: fp.MaxLength(),
+				Pattern:   fp.Pattern(),
+			}
+		},
+		TextArea: func(fp *schema.FieldTextArea) {
+			fd.TypeProperty.TextArea = &FieldTextPropertyDocument{
+				MaxLength: fp.MaxLength(),
+				Pattern:   fp.Pattern(),
+			}
+		},
+		RichText: func(fp *schema.FieldRichText) {
+			fd.TypeProperty.RichText = &FieldTextPropertyDocument{
+				MaxLength: fp.MaxLength(),
+				Pattern:   fp.Pattern(),
+			}
+		},
+		Markdown: func(fp *schema.FieldMarkdown) {
+			fd.TypeProperty.Markdown = &FieldTextPropertyDocument{
+				MaxLength: fp.MaxLength(),
+				Pattern:   fp.Pattern(),
+			}
+		},
+		Asset: func(fp *schema.FieldAsset) {},
+		DateTime: func(fp *schema.FieldDateTime) {
+			fd.TypeProperty.DateTime = &FieldDateTimePropertyDocument{
+				Min: fp.Min(),
+				Max: fp.Max(),
+			}
+		},
+		Bool: func(fp *schema.FieldBool) {},
+		Select: func(fp *schema.FieldSelect) {
+			fd.TypeProperty.Select = &FieldSelectPropertyDocument{
+				Values: fp.Values(),
+			}
+		},
+		Number: func(fp *schema.FieldNumber) {
+			fd.TypeProperty.Number = &FieldNumberPropertyDocument{
+				Min: fp.Min(),
+				Max: fp.Max(),
+			}
+		},
+		Integer: func(fp *schema.FieldInteger) {
+			fd.TypeProperty.Integer = &FieldIntegerPropertyDocument{
+				Min: fp.Min(),
+				Max: fp.Max(),
+			}
+		},
+		Reference: func(fp *schema.FieldReference) {
+			fd.TypeProperty.Reference = &FieldReferencePropertyDocument{
+				Model:    fp.Model().String(),
+				OnDelete: string(fp.OnDelete()),
+			}
+		},
+		URL: func(fp *schema.FieldURL) {
+			fd.TypeProperty.URL = &FieldURLPropertyDocument{
+				Pattern: fp.Pattern(),
+			}
+		},
+		Geometry: func(fp *schema.FieldGeometry) {
+			fd.TypeProperty.Geometry = &FieldGeometryPropertyDocument{
+				SupportedTypes: util.Map(fp.SupportedTypes(), func(t value.GeometryType) string { return string(t) }),
+			}
+		},
+		Group: func(fp *schema.FieldGroup) {
+			fd.TypeProperty.Group = &FieldGroupPropertyDocument{
+				Fields: util.Map(fp.Fields(), newFieldDocument),
+			}
+		},
+	})
+	return fd
+}
+
 func (d *SchemaDocument) Model() (*schema.Schema, error) {
 	sId, err := id.SchemaIDFrom(d.ID)
 	if err != nil {
@@ -156,66 +223,7 @@ func (d *SchemaDocument) Model() (*schema.Schema, error) {
 		return nil, err
 	}
 
-	f, err := util.TryMap(d.Fields, func(fd FieldDocument) (*schema.Field, error) {
-		tpd := fd.TypeProperty
-		var tp *schema.TypeProperty
-		switch value.Type(tpd.Type) {
-		case value.TypeText:
-			tp = schema.NewText(tpd.Text.MaxLength).TypeProperty()
-		case value.TypeTextArea:
-			tp = schema.NewTextArea(tpd.TextArea.MaxLength).TypeProperty()
-		case value.TypeRichText:
-			tp = schema.NewRichText(tpd.RichText.MaxLength).TypeProperty()
-		case value.TypeMarkdown:
-			tp = schema.NewMarkdown(tpd.Markdown.MaxLength).TypeProperty()
-		case value.TypeAsset:
-			tp = schema.NewAsset().TypeProperty()
-		case value.TypeDateTime:
-			tp = schema.NewDateTime().TypeProperty()
-		case value.TypeBool:
-			tp = schema.NewBool().TypeProperty()
-		case value.TypeSelect:
-			tp = schema.NewSelect(tpd.Select.Values).TypeProperty()
-		case value.TypeNumber:
-			tpi, err := schema.NewNumber(tpd.Number.Min, tpd.Number.Max)
-			if err != nil {
-				return nil, err
-			}
-			tp = tpi.TypeProperty()
-		case value.TypeInteger:
-			tpi, err := schema.NewInteger(tpd.Integer.Min, tpd.Integer.Max)
-			if err != nil {
-				return nil, err
-			}
-			tp = tpi.TypeProperty()
-		case value.TypeReference:
-			mid, err := id.ModelIDFrom(tpd.Reference.Model)
-			if err != nil {
-				return nil, err
-			}
-			tp = schema.NewReference(mid).TypeProperty()
-		case value.TypeURL:
-			tp = schema.NewURL().TypeProperty()
-		}
-
-		fid, err := id.FieldIDFrom(fd.ID)
-		if err != nil {
-			return nil, err
-		}
-
-		return schema.NewField(tp).
-			ID(fid).
-			Name(fd.Name).
-			Unique(fd.Unique).
-			Multiple(fd.Multiple).
-			Order(fd.Order).
-			Required(fd.Required).
-			Description(fd.Description).
-			Key(key.New(fd.Key)).
-			UpdatedAt(fd.UpdatedAt).
-			DefaultValue(fd.DefaultValue.MultipleValue()).
-			Build()
-	})
+	f, err := util.TryMap(d.Fields, FieldDocument.model)
 	if err != nil {
 		return nil, err
 	}
@@ -228,6 +236,113 @@ func (d *SchemaDocument) Model() (*schema.Schema, error) {
 		Build()
 }
 
+func (fd FieldDocument) model() (*schema.Field, error) {
+	tpd := fd.TypeProperty
+	var tp *schema.TypeProperty
+	switch value.Type(tpd.Type) {
+	case value.TypeText:
+		tpi := schema.NewText(tpd.Text.MaxLength)
+		if err := tpi.SetPattern(tpd.Text.Pattern); err != nil {
+			return nil, err
+		}
+		tp = tpi.TypeProperty()
+	case value.TypeTextArea:
+		tpi := schema.NewTextArea(tpd.TextArea.MaxLength)
+		if err := tpi.SetPattern(tpd.TextArea.Pattern); err != nil {
+			return nil, err
+		}
+		tp = tpi.TypeProperty()
+	case value.TypeRichText:
+		tpi := schema.NewRichText(tpd.RichText.MaxLength)
+		if err := tpi.SetPattern(tpd.RichText.Pattern); err != nil {
+			return nil, err
+		}
+		tp = tpi.TypeProperty()
+	case value.TypeMarkdown:
+		tpi := schema.NewMarkdown(tpd.Markdown.MaxLength)
+		if err := tpi.SetPattern(tpd.Markdown.Pattern); err != nil {
+			return nil, err
+		}
+		tp = tpi.TypeProperty()
+	case value.TypeAsset:
+		tp = schema.NewAsset().TypeProperty()
+	case value.TypeDateTime:
+		var min, max *time.Time
+		if tpd.DateTime != nil {
+			min, max = tpd.DateTime.Min, tpd.DateTime.Max
+		}
+		tpi, err := schema.NewDateTime(min, max)
+		if err != nil {
+			return nil, err
+		}
+		tp = tpi.TypeProperty()
+	case value.TypeBool:
+		tp = schema.NewBool().TypeProperty()
+	case value.TypeSelect:
+		tp = schema.NewSelect(tpd.Select.Values).TypeProperty()
+	case value.TypeNumber:
+		tpi, err := schema.NewNumber(tpd.Number.Min, tpd.Number.Max)
+		if err != nil {
+			return nil, err
+		}
+		tp = tpi.TypeProperty()
+	case value.TypeInteger:
+		tpi, err := schema.NewInteger(tpd.Integer.Min, tpd.Integer.Max)
+		if err != nil {
+			return nil, err
+		}
+		tp = tpi.TypeProperty()
+	case value.TypeReference:
+		mid, err := id.ModelIDFrom(tpd.Reference.Model)
+		if err != nil {
+			return nil, err
+		}
+		r := schema.NewReference(mid)
+		if err := r.SetOnDelete(schema.ReferenceOnDelete(tpd.Reference.OnDelete)); err != nil {
+			return nil, err
+		}
+		tp = r.TypeProperty()
+	case value.TypeURL:
+		tpi := schema.NewURL()
+		if tpd.URL != nil {
+			if err := tpi.SetPattern(tpd.URL.Pattern); err != nil {
+				return nil, err
+			}
+		}
+		tp = tpi.TypeProperty()
+	}
+
+	fid, err := id.FieldIDFrom(fd.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var requiredIf *schema.RequiredCondition
+	if fd.RequiredIf != nil {
+		cfid, err := id.FieldIDFrom(fd.RequiredIf.Field)
+		if err != nil {
+			return nil, err
+		}
+		requiredIf = schema.NewRequiredCondition(cfid, fd.RequiredIf.Values)
+	}
+
+	return schema.NewField(tp).
+		ID(fid).
+		Name(fd.Name).
+		Unique(fd.Unique).
+		Multiple(fd.Multiple).
+		Order(fd.Order).
+		Required(fd.Required).
+		Localized(fd.Localized).
+		Description(fd.Description).
+		Key(key.New(fd.Key)).
+		UpdatedAt(fd.UpdatedAt).
+		DefaultValue(fd.DefaultValue.MultipleValue()).
+		ItemCount(fd.MinItems, fd.MaxItems).
+		RequiredIf(requiredIf).
+		Build()
+}
+
 type SchemaConsumer = mongox.SliceFuncConsumer[*SchemaDocument, *schema.Schema]
 
 func NewSchemaConsumer() *SchemaConsumer {
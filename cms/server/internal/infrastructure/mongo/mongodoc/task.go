@@ -0,0 +1,259 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/samber/lo"
+)
+
+type TaskDocument struct {
+	ID          string
+	Workspace   string
+	Project     string
+	User        *string
+	Integration *string
+	Type        string
+	Status      string
+	Progress    TaskProgressDocument
+	Errors      []TaskErrorDocument
+	Message     string
+	ImportItems *TaskImportItemsDocument
+	ExportItems *TaskExportItemsDocument
+	Migrate     *TaskMigrateFieldsDocument
+	Asset       *string
+	UpdatedAt   time.Time
+}
+
+type TaskProgressDocument struct {
+	Total     int
+	Processed int
+	Created   int
+	Updated   int
+	Failed    int
+}
+
+type TaskErrorDocument struct {
+	Row     int
+	Item    *string
+	Field   string
+	Message string
+}
+
+type TaskImportItemsDocument struct {
+	Model    string
+	Asset    string
+	Format   string
+	Fields   map[string]string
+	KeyField string
+	DryRun   bool
+}
+
+type TaskExportItemsDocument struct {
+	Model      string
+	Format     string
+	Keyword    string
+	Filters    []TaskExportFilterDocument
+	BBox       string
+	Intersects string
+}
+
+type TaskExportFilterDocument struct {
+	Key      string
+	Operator string
+	Value    string
+}
+
+type TaskMigrateFieldsDocument struct {
+	Model     string
+	Type      string
+	Sources   []string
+	Targets   []FieldDocument
+	Separator string
+	DryRun    bool
+}
+
+func NewTask(t *task.Task) (*TaskDocument, string) {
+	tid := t.ID().String()
+	p := t.Progress()
+
+	var importItems *TaskImportItemsDocument
+	if c := t.ImportItems(); c != nil {
+		importItems = &TaskImportItemsDocument{
+			Model:    c.ModelID.String(),
+			Asset:    c.AssetID.String(),
+			Format:   c.Format,
+			Fields:   c.Fields,
+			KeyField: c.KeyField,
+			DryRun:   c.DryRun,
+		}
+	}
+
+	var exportItems *TaskExportItemsDocument
+	if c := t.ExportItems(); c != nil {
+		exportItems = &TaskExportItemsDocument{
+			Model:   c.ModelID.String(),
+			Format:  c.Format,
+			Keyword: c.Keyword,
+			Filters: lo.Map(c.Filters, func(f task.ExportFilter, _ int) TaskExportFilterDocument {
+				return TaskExportFilterDocument{Key: f.Key, Operator: f.Operator, Value: f.Value}
+			}),
+			BBox:       c.BBox,
+			Intersects: c.Intersects,
+		}
+	}
+
+	var migrate *TaskMigrateFieldsDocument
+	if c := t.MigrateFields(); c != nil {
+		migrate = &TaskMigrateFieldsDocument{
+			Model:     c.ModelID.String(),
+			Type:      c.Type,
+			Sources:   c.Sources.Strings(),
+			Targets:   lo.Map(c.Targets, func(f *schema.Field, _ int) FieldDocument { return newFieldDocument(f) }),
+			Separator: c.Separator,
+			DryRun:    c.DryRun,
+		}
+	}
+
+	return &TaskDocument{
+		ID:          tid,
+		Workspace:   t.Workspace().String(),
+		Project:     t.Project().String(),
+		User:        t.User().StringRef(),
+		Integration: t.Integration().StringRef(),
+		Type:        string(t.Type()),
+		Status:      string(t.Status()),
+		Progress: TaskProgressDocument{
+			Total:     p.Total,
+			Processed: p.Processed,
+			Created:   p.Created,
+			Updated:   p.Updated,
+			Failed:    p.Failed,
+		},
+		Errors: lo.Map(t.Errors(), func(e task.Error, _ int) TaskErrorDocument {
+			return TaskErrorDocument{Row: e.Row, Item: e.Item.StringRef(), Field: e.Field, Message: e.Message}
+		}),
+		Message:     t.Message(),
+		ImportItems: importItems,
+		ExportItems: exportItems,
+		Migrate:     migrate,
+		Asset:       t.Asset().StringRef(),
+		UpdatedAt:   t.UpdatedAt(),
+	}, tid
+}
+
+func (d *TaskDocument) Model() (*task.Task, error) {
+	tid, err := id.TaskIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	wid, err := id.WorkspaceIDFrom(d.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	pid, err := id.ProjectIDFrom(d.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	var importItems *task.ImportItemsConfig
+	if d.ImportItems != nil {
+		mid, err := id.ModelIDFrom(d.ImportItems.Model)
+		if err != nil {
+			return nil, err
+		}
+		aid, err := id.AssetIDFrom(d.ImportItems.Asset)
+		if err != nil {
+			return nil, err
+		}
+		importItems = &task.ImportItemsConfig{
+			ModelID:  mid,
+			AssetID:  aid,
+			Format:   d.ImportItems.Format,
+			Fields:   d.ImportItems.Fields,
+			KeyField: d.ImportItems.KeyField,
+			DryRun:   d.ImportItems.DryRun,
+		}
+	}
+
+	var exportItems *task.ExportItemsConfig
+	if d.ExportItems != nil {
+		mid, err := id.ModelIDFrom(d.ExportItems.Model)
+		if err != nil {
+			return nil, err
+		}
+		exportItems = &task.ExportItemsConfig{
+			ModelID: mid,
+			Format:  d.ExportItems.Format,
+			Keyword: d.ExportItems.Keyword,
+			Filters: lo.Map(d.ExportItems.Filters, func(f TaskExportFilterDocument, _ int) task.ExportFilter {
+				return task.ExportFilter{Key: f.Key, Operator: f.Operator, Value: f.Value}
+			}),
+			BBox:       d.ExportItems.BBox,
+			Intersects: d.ExportItems.Intersects,
+		}
+	}
+
+	var migrate *task.MigrateFieldsConfig
+	if d.Migrate != nil {
+		mid, err := id.ModelIDFrom(d.Migrate.Model)
+		if err != nil {
+			return nil, err
+		}
+		sources, err := id.FieldIDListFrom(d.Migrate.Sources)
+		if err != nil {
+			return nil, err
+		}
+		targets := make(schema.FieldList, 0, len(d.Migrate.Targets))
+		for _, fd := range d.Migrate.Targets {
+			f, err := fd.model()
+			if err != nil {
+				return nil, err
+			}
+			targets = append(targets, f)
+		}
+		migrate = &task.MigrateFieldsConfig{
+			ModelID:   mid,
+			Type:      d.Migrate.Type,
+			Sources:   sources,
+			Targets:   targets,
+			Separator: d.Migrate.Separator,
+			DryRun:    d.Migrate.DryRun,
+		}
+	}
+
+	return task.New().
+		ID(tid).
+		Workspace(wid).
+		Project(pid).
+		User(id.UserIDFromRef(d.User)).
+		Integration(id.IntegrationIDFromRef(d.Integration)).
+		Type(task.Type(d.Type)).
+		Status(task.Status(d.Status)).
+		Progress(task.Progress{
+			Total:     d.Progress.Total,
+			Processed: d.Progress.Processed,
+			Created:   d.Progress.Created,
+			Updated:   d.Progress.Updated,
+			Failed:    d.Progress.Failed,
+		}).
+		Errors(lo.Map(d.Errors, func(e TaskErrorDocument, _ int) task.Error {
+			return task.Error{Row: e.Row, Item: id.ItemIDFromRef(e.Item), Field: e.Field, Message: e.Message}
+		})).
+		Message(d.Message).
+		ImportItems(importItems).
+		ExportItems(exportItems).
+		MigrateFields(migrate).
+		Asset(id.AssetIDFromRef(d.Asset)).
+		UpdatedAt(d.UpdatedAt).
+		Build()
+}
+
+type TaskConsumer = mongox.SliceFuncConsumer[*TaskDocument, *task.Task]
+
+func NewTaskConsumer() *TaskConsumer {
+	return NewComsumer[*TaskDocument, *task.Task]()
+}
@@ -12,6 +12,7 @@ type ThreadDocument struct {
 	ID        string
 	Workspace string
 	Comments  []*Comment
+	Resolved  bool
 }
 
 type Comment struct {
@@ -19,6 +20,12 @@ type Comment struct {
 	User        *string
 	Integration *string
 	Content     string
+	Mentions    *CommentMentions `bson:",omitempty"`
+}
+
+type CommentMentions struct {
+	Users        []string
+	Integrations []string
 }
 
 type ThreadConsumer = mongox.SliceFuncConsumer[*ThreadDocument, *thread.Thread]
@@ -34,6 +41,7 @@ func NewThread(a *thread.Thread) (*ThreadDocument, string) {
 		ID:        thid,
 		Workspace: a.Workspace().String(),
 		Comments:  comments,
+		Resolved:  a.Resolved(),
 	}, thid
 
 	return thd, id
@@ -58,6 +66,7 @@ func (d *ThreadDocument) Model() (*thread.Thread, error) {
 		ID(thid).
 		Workspace(wid).
 		Comments(comments).
+		Resolved(d.Resolved).
 		Build()
 }
 
@@ -71,6 +80,17 @@ func NewComment(c *thread.Comment) *Comment {
 		User:        c.Author().User().StringRef(),
 		Integration: c.Author().Integration().StringRef(),
 		Content:     c.Content(),
+		Mentions:    newCommentMentions(c.Mentions()),
+	}
+}
+
+func newCommentMentions(m *thread.Mentions) *CommentMentions {
+	if m.IsEmpty() {
+		return nil
+	}
+	return &CommentMentions{
+		Users:        m.Users.Strings(),
+		Integrations: m.Integrations.Strings(),
 	}
 }
 
@@ -95,5 +115,19 @@ func (c *Comment) Model() *thread.Comment {
 		}
 	}
 
-	return thread.NewComment(cid, author, c.Content)
+	res := thread.NewComment(cid, author, c.Content)
+	res.SetMentions(c.Mentions.model())
+	return res
+}
+
+func (m *CommentMentions) model() *thread.Mentions {
+	if m == nil {
+		return nil
+	}
+	users, _ := id.UserIDListFrom(m.Users)
+	integrations, _ := id.IntegrationIDListFrom(m.Integrations)
+	return &thread.Mentions{
+		Users:        users,
+		Integrations: integrations,
+	}
 }
@@ -4,6 +4,8 @@ import (
 	"reflect"
 
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type ValueDocument struct {
@@ -51,7 +53,7 @@ func (d *ValueDocument) Value() *value.Value {
 		d.T = string(value.TypeDateTime)
 	}
 
-	return value.New(value.Type(d.T), d.V)
+	return value.New(value.Type(d.T), normalizeValue(d.V))
 }
 
 func (d *ValueDocument) OptionalValue() *value.Optional {
@@ -71,7 +73,32 @@ func (d *ValueDocument) MultipleValue() *value.Multiple {
 	}
 
 	t := value.Type(d.T)
-	return value.NewMultiple(t, unpackArray(d.V))
+	return value.NewMultiple(t, unpackArray(normalizeValue(d.V)))
+}
+
+// normalizeValue converts BSON documents and arrays decoded into interfaces into plain maps and slices
+func normalizeValue(v any) any {
+	switch w := v.(type) {
+	case primitive.D:
+		m := make(map[string]any, len(w))
+		for _, e := range w {
+			m[e.Key] = normalizeValue(e.Value)
+		}
+		return m
+	case primitive.M:
+		return normalizeValue(map[string]any(w))
+	case map[string]any:
+		m := make(map[string]any, len(w))
+		for k, e := range w {
+			m[k] = normalizeValue(e)
+		}
+		return m
+	case primitive.A:
+		return normalizeValue([]any(w))
+	case []any:
+		return lo.Map(w, func(e any, _ int) any { return normalizeValue(e) })
+	}
+	return v
 }
 
 func unpackArray(s any) []any {
@@ -3,8 +3,10 @@ package mongodoc
 import (
 	"testing"
 
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func TestNewValue(t *testing.T) {
@@ -37,3 +39,24 @@ func TestNewMultipleValue(t *testing.T) {
 		V: []any{true},
 	}, NewMultipleValue(value.MultipleFrom(value.TypeBool, []*value.Value{value.TypeBool.Value(true)})))
 }
+
+func TestValueDocument_MultipleValue_BSON(t *testing.T) {
+	fid := id.NewFieldID()
+	tests := []*value.Multiple{
+		value.TypeGeometry.Value(value.Geometry{
+			Type:        value.GeometryTypePolygon,
+			Coordinates: [][]value.Position{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+		}).AsMultiple(),
+		value.TypeGroup.Value(value.Group{
+			fid: value.NewMultiple(value.TypeText, []any{"a", "b"}),
+		}).AsMultiple(),
+	}
+
+	for _, m := range tests {
+		b, err := bson.Marshal(NewMultipleValue(m))
+		assert.NoError(t, err)
+		var d ValueDocument
+		assert.NoError(t, bson.Unmarshal(b, &d))
+		assert.Equal(t, m, d.MultipleValue())
+	}
+}
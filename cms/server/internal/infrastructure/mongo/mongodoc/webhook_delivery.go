@@ -0,0 +1,97 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/samber/lo"
+)
+
+type WebhookDeliveryDocument struct {
+	ID            string
+	Integration   string
+	Webhook       string
+	Event         string
+	EventType     string
+	URL           string
+	Payload       string
+	Status        string
+	Attempts      []WebhookDeliveryAttemptDocument
+	NextAttemptAt *time.Time
+	UpdatedAt     time.Time
+}
+
+type WebhookDeliveryAttemptDocument struct {
+	At         time.Time
+	StatusCode int
+	Latency    int64
+	Error      string
+}
+
+func NewWebhookDelivery(d *integration.Delivery) (*WebhookDeliveryDocument, string) {
+	did := d.ID().String()
+	return &WebhookDeliveryDocument{
+		ID:          did,
+		Integration: d.Integration().String(),
+		Webhook:     d.Webhook().String(),
+		Event:       d.Event().String(),
+		EventType:   string(d.EventType()),
+		URL:         d.URL(),
+		Payload:     d.Payload(),
+		Status:      string(d.Status()),
+		Attempts: lo.Map(d.Attempts(), func(a *integration.DeliveryAttempt, _ int) WebhookDeliveryAttemptDocument {
+			return WebhookDeliveryAttemptDocument{
+				At:         a.At(),
+				StatusCode: a.StatusCode(),
+				Latency:    a.Latency().Milliseconds(),
+				Error:      a.Error(),
+			}
+		}),
+		NextAttemptAt: d.NextAttemptAt(),
+		UpdatedAt:     d.UpdatedAt(),
+	}, did
+}
+
+func (d *WebhookDeliveryDocument) Model() (*integration.Delivery, error) {
+	did, err := id.WebhookDeliveryIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	iid, err := id.IntegrationIDFrom(d.Integration)
+	if err != nil {
+		return nil, err
+	}
+	wid, err := id.WebhookIDFrom(d.Webhook)
+	if err != nil {
+		return nil, err
+	}
+	eid, err := id.EventIDFrom(d.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	return integration.NewDelivery().
+		ID(did).
+		Integration(iid).
+		Webhook(wid).
+		Event(eid).
+		EventType(event.Type(d.EventType)).
+		URL(d.URL).
+		Payload(d.Payload).
+		Status(integration.DeliveryStatus(d.Status)).
+		Attempts(lo.Map(d.Attempts, func(a WebhookDeliveryAttemptDocument, _ int) *integration.DeliveryAttempt {
+			return integration.NewDeliveryAttempt(a.At, a.StatusCode, time.Duration(a.Latency)*time.Millisecond, a.Error)
+		})).
+		NextAttemptAt(d.NextAttemptAt).
+		UpdatedAt(d.UpdatedAt).
+		Build()
+}
+
+type WebhookDeliveryConsumer = mongox.SliceFuncConsumer[*WebhookDeliveryDocument, *integration.Delivery]
+
+func NewWebhookDeliveryConsumer() *WebhookDeliveryConsumer {
+	return NewComsumer[*WebhookDeliveryDocument, *integration.Delivery]()
+}
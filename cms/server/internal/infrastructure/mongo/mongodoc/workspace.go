@@ -4,12 +4,15 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/util"
 )
 
 type WorkspaceMemberDocument struct {
-	Role      string
-	InvitedBy string
-	Disabled  bool
+	Role       string
+	CustomRole *string  `bson:",omitempty"`
+	Groups     []string `bson:",omitempty"`
+	InvitedBy  string
+	Disabled   bool
 }
 
 type WorkspaceDocument struct {
@@ -17,24 +20,41 @@ type WorkspaceDocument struct {
 	Name         string
 	Members      map[string]WorkspaceMemberDocument
 	Integrations map[string]WorkspaceMemberDocument
+	Roles        []WorkspaceRoleDocument `bson:",omitempty"`
 	Personal     bool
 }
 
+type WorkspaceRoleDocument struct {
+	ID          string
+	Name        string
+	Permissions []WorkspaceRolePermissionDocument
+}
+
+type WorkspaceRolePermissionDocument struct {
+	Project *string
+	Model   *string
+	Actions []string
+}
+
 func NewWorkspace(ws *user.Workspace) (*WorkspaceDocument, string) {
 	membersDoc := map[string]WorkspaceMemberDocument{}
 	for uId, m := range ws.Members().Users() {
 		membersDoc[uId.String()] = WorkspaceMemberDocument{
-			Role:      string(m.Role),
-			Disabled:  m.Disabled,
-			InvitedBy: m.InvitedBy.String(),
+			Role:       string(m.Role),
+			CustomRole: m.CustomRole.StringRef(),
+			Groups:     m.Groups,
+			Disabled:   m.Disabled,
+			InvitedBy:  m.InvitedBy.String(),
 		}
 	}
 	integrationsDoc := map[string]WorkspaceMemberDocument{}
 	for iId, m := range ws.Members().Integrations() {
 		integrationsDoc[iId.String()] = WorkspaceMemberDocument{
-			Role:      string(m.Role),
-			Disabled:  m.Disabled,
-			InvitedBy: m.InvitedBy.String(),
+			Role:       string(m.Role),
+			CustomRole: m.CustomRole.StringRef(),
+			Groups:     m.Groups,
+			Disabled:   m.Disabled,
+			InvitedBy:  m.InvitedBy.String(),
 		}
 	}
 	wId := ws.ID().String()
@@ -43,10 +63,41 @@ func NewWorkspace(ws *user.Workspace) (*WorkspaceDocument, string) {
 		Name:         ws.Name(),
 		Members:      membersDoc,
 		Integrations: integrationsDoc,
+		Roles:        util.Map(ws.Roles(), newWorkspaceRole),
 		Personal:     ws.IsPersonal(),
 	}, wId
 }
 
+func newWorkspaceRole(r *user.CustomRole) WorkspaceRoleDocument {
+	return WorkspaceRoleDocument{
+		ID:   r.ID().String(),
+		Name: r.Name(),
+		Permissions: util.Map(r.Permissions(), func(p user.Permission) WorkspaceRolePermissionDocument {
+			return WorkspaceRolePermissionDocument{
+				Project: p.Project.StringRef(),
+				Model:   p.Model.StringRef(),
+				Actions: util.Map(p.Actions, func(a user.Action) string { return string(a) }),
+			}
+		}),
+	}
+}
+
+func (d WorkspaceRoleDocument) model() (*user.CustomRole, error) {
+	rid, err := id.RoleIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	permissions := make([]user.Permission, 0, len(d.Permissions))
+	for _, p := range d.Permissions {
+		permissions = append(permissions, user.Permission{
+			Project: id.ProjectIDFromRef(p.Project),
+			Model:   id.ModelIDFromRef(p.Model),
+			Actions: util.Map(p.Actions, func(a string) user.Action { return user.Action(a) }),
+		})
+	}
+	return user.NewCustomRole(rid, d.Name, permissions)
+}
+
 func (d *WorkspaceDocument) Model() (*user.Workspace, error) {
 	tid, err := id.WorkspaceIDFrom(d.ID)
 	if err != nil {
@@ -65,9 +116,11 @@ func (d *WorkspaceDocument) Model() (*user.Workspace, error) {
 				inviterID = uid
 			}
 			members[uid] = user.Member{
-				Role:      user.Role(member.Role),
-				Disabled:  member.Disabled,
-				InvitedBy: inviterID,
+				Role:       user.Role(member.Role),
+				CustomRole: id.RoleIDFromRef(member.CustomRole),
+				Groups:     member.Groups,
+				Disabled:   member.Disabled,
+				InvitedBy:  inviterID,
 			}
 		}
 	}
@@ -79,17 +132,28 @@ func (d *WorkspaceDocument) Model() (*user.Workspace, error) {
 				return nil, err
 			}
 			integrations[iId] = user.Member{
-				Role:      user.Role(integrationDoc.Role),
-				Disabled:  integrationDoc.Disabled,
-				InvitedBy: id.MustUserID(integrationDoc.InvitedBy),
+				Role:       user.Role(integrationDoc.Role),
+				CustomRole: id.RoleIDFromRef(integrationDoc.CustomRole),
+				Groups:     integrationDoc.Groups,
+				Disabled:   integrationDoc.Disabled,
+				InvitedBy:  id.MustUserID(integrationDoc.InvitedBy),
 			}
 		}
 	}
+	roles := make([]*user.CustomRole, 0, len(d.Roles))
+	for _, rd := range d.Roles {
+		r, err := rd.model()
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, r)
+	}
 	return user.NewWorkspace().
 		ID(tid).
 		Name(d.Name).
 		Members(members).
 		Integrations(integrations).
+		Roles(roles).
 		Personal(d.Personal).
 		Build()
 }
@@ -41,6 +41,28 @@ func (c *Collection) Paginate(ctx context.Context, filter any, q version.Query,
 	return c.client.Paginate(ctx, apply(q, filter), s, p, consumer)
 }
 
+// Aggregate runs the pipeline on the documents which match the filter and the version query
+func (c *Collection) Aggregate(ctx context.Context, filter any, q version.Query, pipeline []any, consumer mongox.Consumer) error {
+	stages := append([]any{bson.M{"$match": apply(q, filter)}}, pipeline...)
+	cursor, err := c.client.Client().Aggregate(ctx, stages)
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	for cursor.Next(ctx) {
+		if err := consumer.Consume(cursor.Current); err != nil {
+			return err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
+
 func (c *Collection) Count(ctx context.Context, filter any, q version.Query) (int64, error) {
 	return c.client.Count(ctx, apply(q, filter))
 }
@@ -116,6 +138,16 @@ func (c *Collection) UpdateRef(ctx context.Context, id string, ref version.Ref,
 	return nil
 }
 
+// Set updates fields of the documents matched by the filter and the query in place without creating a new version
+func (c *Collection) Set(ctx context.Context, filter any, q version.Query, fields bson.M) error {
+	if _, err := c.client.Client().UpdateMany(ctx, apply(q, filter), bson.M{
+		"$set": fields,
+	}); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
+
 func (c *Collection) IsArchived(ctx context.Context, filter any) (bool, error) {
 	cons := mongox.SliceConsumer[MetadataDocument]{}
 	q := mongox.And(filter, "", bson.M{
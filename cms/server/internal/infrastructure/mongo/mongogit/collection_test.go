@@ -453,6 +453,28 @@ func TestCollection_UpdateRef(t *testing.T) {
 	assert.Equal(t, Meta{ObjectID: meta.ObjectID, Version: v3, Refs: []version.Ref{}}, meta)
 }
 
+func TestCollection_Set(t *testing.T) {
+	ctx := context.Background()
+	col := initCollection(t)
+	c := col.Client().Client()
+
+	v1, v2 := version.New(), version.New()
+	_, _ = c.InsertMany(ctx, []any{
+		bson.M{"id": "x", versionKey: v1, "a": "a"},
+		bson.M{"id": "x", versionKey: v2, refsKey: []string{"latest"}, "a": "a"},
+	})
+
+	assert.NoError(t, col.Set(ctx, bson.M{"id": "x"}, version.Eq(version.Latest.OrVersion()), bson.M{"a": "b"}))
+
+	var got bson.M
+	assert.NoError(t, c.FindOne(ctx, bson.M{"id": "x", versionKey: v1}).Decode(&got))
+	assert.Equal(t, "a", got["a"])
+	assert.NoError(t, c.FindOne(ctx, bson.M{"id": "x", versionKey: v2}).Decode(&got))
+	assert.Equal(t, "b", got["a"])
+	n, _ := c.CountDocuments(ctx, bson.M{"id": "x"})
+	assert.Equal(t, int64(2), n)
+}
+
 func TestCollection_IsArchived(t *testing.T) {
 	ctx := context.Background()
 	col := initCollection(t)
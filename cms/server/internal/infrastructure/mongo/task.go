@@ -0,0 +1,79 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	taskIndexes       = []string{"project", "status,updatedat"}
+	taskUniqueIndexes = []string{"id"}
+)
+
+type Task struct {
+	client *mongox.Collection
+}
+
+func NewTask(client *mongox.Client) repo.Task {
+	return &Task{client: client.WithCollection("task")}
+}
+
+func (r *Task) Init() error {
+	return createIndexes(context.Background(), r.client, taskIndexes, taskUniqueIndexes)
+}
+
+func (r *Task) FindByID(ctx context.Context, tid id.TaskID) (*task.Task, error) {
+	c := mongodoc.NewTaskConsumer()
+	if err := r.client.FindOne(ctx, bson.M{"id": tid.String()}, c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *Task) FindByProject(ctx context.Context, pid id.ProjectID, pagination *usecasex.Pagination) (task.List, *usecasex.PageInfo, error) {
+	filter := bson.M{"project": pid.String()}
+	// newer tasks first
+	sort := &usecasex.Sort{Key: "id", Reverted: true}
+
+	c := mongodoc.NewTaskConsumer()
+	if pagination == nil {
+		if err := r.client.Find(ctx, filter, c, options.Find().SetSort(bson.M{"id": -1})); err != nil {
+			return nil, nil, rerror.ErrInternalBy(err)
+		}
+		return c.Result, nil, nil
+	}
+
+	pageInfo, err := r.client.Paginate(ctx, filter, sort, pagination, c)
+	if err != nil {
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, pageInfo, nil
+}
+
+func (r *Task) FindRunnable(ctx context.Context, staleBefore time.Time) (task.List, error) {
+	c := mongodoc.NewTaskConsumer()
+	if err := r.client.Find(ctx, bson.M{
+		"$or": []bson.M{
+			{"status": string(task.StatusPending)},
+			{"status": string(task.StatusRunning), "updatedat": bson.M{"$lt": staleBefore}},
+		},
+	}, c, options.Find().SetSort(bson.M{"id": 1})); err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, nil
+}
+
+func (r *Task) Save(ctx context.Context, t *task.Task) error {
+	doc, tid := mongodoc.NewTask(t)
+	return r.client.SaveOne(ctx, tid, doc)
+}
@@ -0,0 +1,109 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/local"
+	"github.com/reearth/reearthx/mongox"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	taskQueueIndexes       = []string{"createdat", "nextrunat,lockeduntil"}
+	taskQueueUniqueIndexes = []string{"id"}
+)
+
+type TaskQueue struct {
+	client *mongox.Collection
+}
+
+func NewTaskQueue(client *mongox.Client) *TaskQueue {
+	return &TaskQueue{client: client.WithCollection("task_queue")}
+}
+
+func (r *TaskQueue) Init() error {
+	return createIndexes(context.Background(), r.client, taskQueueIndexes, taskQueueUniqueIndexes)
+}
+
+func (r *TaskQueue) FindAll(ctx context.Context) ([]*local.Task, error) {
+	c := mongox.NewSliceFuncConsumer(func(d *taskDocument) (*local.Task, error) {
+		return d.Model(), nil
+	})
+	if err := r.client.Find(ctx, bson.M{}, c, options.Find().SetSort(bson.M{"createdat": 1})); err != nil {
+		return nil, err
+	}
+	return c.Result, nil
+}
+
+// Claim locks the oldest runnable task with findOneAndUpdate so that a task is claimed by only one worker even across processes.
+// Fields missing in tasks saved by older versions are regarded as runnable.
+func (r *TaskQueue) Claim(ctx context.Context, now time.Time, lease time.Duration) (*local.Task, error) {
+	var d taskDocument
+	err := r.client.Client().FindOneAndUpdate(
+		ctx,
+		bson.M{
+			"nextrunat":   bson.M{"$not": bson.M{"$gt": now}},
+			"lockeduntil": bson.M{"$not": bson.M{"$gt": now}},
+		},
+		bson.M{"$set": bson.M{"lockeduntil": now.Add(lease)}},
+		options.FindOneAndUpdate().SetSort(bson.M{"createdat": 1}).SetReturnDocument(options.After),
+	).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return d.Model(), nil
+}
+
+func (r *TaskQueue) Extend(ctx context.Context, id string, until time.Time) error {
+	_, err := r.client.Client().UpdateOne(ctx, bson.M{"id": id}, bson.M{"$set": bson.M{"lockeduntil": until}})
+	return err
+}
+
+func (r *TaskQueue) Save(ctx context.Context, t *local.Task) error {
+	return r.client.SaveOne(ctx, t.ID, newTaskDocument(t))
+}
+
+func (r *TaskQueue) Remove(ctx context.Context, id string) error {
+	return r.client.RemoveAll(ctx, bson.M{"id": id})
+}
+
+type taskDocument struct {
+	ID          string
+	Type        string
+	Data        string
+	Attempts    int
+	NextRunAt   time.Time
+	LockedUntil time.Time
+	CreatedAt   time.Time
+}
+
+func newTaskDocument(t *local.Task) *taskDocument {
+	return &taskDocument{
+		ID:          t.ID,
+		Type:        string(t.Type),
+		Data:        string(t.Data),
+		Attempts:    t.Attempts,
+		NextRunAt:   t.NextRunAt,
+		LockedUntil: t.LockedUntil,
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+func (d *taskDocument) Model() *local.Task {
+	return &local.Task{
+		ID:          d.ID,
+		Type:        local.TaskType(d.Type),
+		Data:        []byte(d.Data),
+		Attempts:    d.Attempts,
+		NextRunAt:   d.NextRunAt,
+		LockedUntil: d.LockedUntil,
+		CreatedAt:   d.CreatedAt,
+	}
+}
@@ -0,0 +1,48 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/local"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/mongox/mongotest"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskQueue(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond).UTC()
+	init := mongotest.Connect(t)
+	q := NewTaskQueue(mongox.NewClientWithDatabase(init(t)))
+	ctx := context.Background()
+	assert.NoError(t, q.Init())
+
+	t1 := &local.Task{ID: "a", Type: local.TaskTypeWebhook, Data: []byte("{}"), CreatedAt: now.Add(-time.Second)}
+	t2 := &local.Task{ID: "b", Type: local.TaskTypeWebhook, Data: []byte("{}"), NextRunAt: now.Add(time.Minute), CreatedAt: now.Add(-time.Hour)}
+	assert.NoError(t, q.Save(ctx, t1))
+	assert.NoError(t, q.Save(ctx, t2))
+
+	got, err := q.Claim(ctx, now, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", got.ID)
+	assert.Equal(t, now.Add(time.Minute), got.LockedUntil)
+
+	got, err = q.Claim(ctx, now, time.Minute)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	assert.NoError(t, q.Extend(ctx, "a", now.Add(time.Hour)))
+	got, err = q.Claim(ctx, now.Add(time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", got.ID)
+	got, err = q.Claim(ctx, now.Add(time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	assert.NoError(t, q.Remove(ctx, "a"))
+	all, err := q.FindAll(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b"}, lo.Map(all, func(t *local.Task, _ int) string { return t.ID }))
+}
@@ -0,0 +1,77 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	webhookDeliveryIndexes       = []string{"webhook", "integration", "event", "status,nextattemptat"}
+	webhookDeliveryUniqueIndexes = []string{"id"}
+)
+
+type WebhookDelivery struct {
+	client *mongox.Collection
+}
+
+func NewWebhookDelivery(client *mongox.Client) repo.WebhookDelivery {
+	return &WebhookDelivery{client: client.WithCollection("webhook_delivery")}
+}
+
+func (r *WebhookDelivery) Init() error {
+	return createIndexes(context.Background(), r.client, webhookDeliveryIndexes, webhookDeliveryUniqueIndexes)
+}
+
+func (r *WebhookDelivery) FindByID(ctx context.Context, did id.WebhookDeliveryID) (*integration.Delivery, error) {
+	c := mongodoc.NewWebhookDeliveryConsumer()
+	if err := r.client.FindOne(ctx, bson.M{"id": did.String()}, c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *WebhookDelivery) FindByWebhook(ctx context.Context, wid id.WebhookID, pagination *usecasex.Pagination) (integration.DeliveryList, *usecasex.PageInfo, error) {
+	filter := bson.M{"webhook": wid.String()}
+	// newer deliveries first
+	sort := &usecasex.Sort{Key: "id", Reverted: true}
+
+	c := mongodoc.NewWebhookDeliveryConsumer()
+	if pagination == nil {
+		if err := r.client.Find(ctx, filter, c, options.Find().SetSort(bson.M{"id": -1})); err != nil {
+			return nil, nil, rerror.ErrInternalBy(err)
+		}
+		return c.Result, nil, nil
+	}
+
+	pageInfo, err := r.client.Paginate(ctx, filter, sort, pagination, c)
+	if err != nil {
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, pageInfo, nil
+}
+
+func (r *WebhookDelivery) FindDue(ctx context.Context, t time.Time) (integration.DeliveryList, error) {
+	c := mongodoc.NewWebhookDeliveryConsumer()
+	if err := r.client.Find(ctx, bson.M{
+		"status":        bson.M{"$in": []string{string(integration.DeliveryStatusRetrying), string(integration.DeliveryStatusPending)}},
+		"nextattemptat": bson.M{"$lte": t},
+	}, c); err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, nil
+}
+
+func (r *WebhookDelivery) Save(ctx context.Context, d *integration.Delivery) error {
+	doc, did := mongodoc.NewWebhookDelivery(d)
+	return r.client.SaveOne(ctx, did, doc)
+}
@@ -0,0 +1,50 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/mongox/mongotest"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookDelivery(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond).UTC()
+	wid := id.NewWebhookID()
+	d1 := integration.NewDelivery().NewID().Integration(id.NewIntegrationID()).Webhook(wid).
+		Event(id.NewEventID()).EventType(event.ItemCreate).URL("https://example.com").Payload("{}").
+		Attempts([]*integration.DeliveryAttempt{integration.NewDeliveryAttempt(now, 500, time.Second, "")}).
+		Status(integration.DeliveryStatusRetrying).NextAttemptAt(&now).UpdatedAt(now).MustBuild()
+	d2 := integration.NewDelivery().NewID().Integration(id.NewIntegrationID()).Webhook(wid).
+		Event(id.NewEventID()).EventType(event.ItemCreate).UpdatedAt(now).MustBuild()
+	d3 := integration.NewDelivery().NewID().Integration(id.NewIntegrationID()).Webhook(id.NewWebhookID()).
+		Event(id.NewEventID()).EventType(event.ItemCreate).Status(integration.DeliveryStatusPending).NextAttemptAt(&now).UpdatedAt(now).MustBuild()
+
+	init := mongotest.Connect(t)
+	client := mongox.NewClientWithDatabase(init(t))
+	r := NewWebhookDelivery(client)
+	ctx := context.Background()
+	assert.NoError(t, r.(*WebhookDelivery).Init())
+	assert.NoError(t, r.Save(ctx, d1))
+	assert.NoError(t, r.Save(ctx, d2))
+	assert.NoError(t, r.Save(ctx, d3))
+
+	got, err := r.FindByID(ctx, d1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, d1, got)
+
+	list, _, err := r.FindByWebhook(ctx, wid, usecasex.CursorPagination{First: lo.ToPtr(int64(10))}.Wrap())
+	assert.NoError(t, err)
+	assert.Equal(t, integration.DeliveryList{d2, d1}, list)
+
+	due, err := r.FindDue(ctx, now)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, integration.DeliveryList{d1, d3}, due)
+}
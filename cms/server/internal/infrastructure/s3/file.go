@@ -0,0 +1,460 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/uuid"
+	"github.com/kennygrant/sanitize"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+)
+
+const (
+	s3AssetBasePath string = "assets"
+	fileSizeLimit   int64  = 10 * 1024 * 1024 * 1024 // 10GB
+)
+
+// Config is the configuration of an S3-compatible storage. Endpoint and UsePathStyle are used to connect to services other than AWS such as MinIO.
+type Config struct {
+	BucketName string
+	Region     string `default:"us-east-1"`
+	Endpoint   string
+	// UsePathStyle puts the bucket name in the path of URLs instead of the host name. MinIO requires it.
+	UsePathStyle bool
+	// AccessKeyID and SecretAccessKey are optional. The default credential chain of AWS SDK is used when they are empty.
+	AccessKeyID             string
+	SecretAccessKey         string
+	PublicationCacheControl string
+	// SignedURLExpiry is the expiry of signed URLs of assets, which are issued when the base URL is not set as the bucket is private
+	SignedURLExpiry time.Duration `default:"24h"`
+}
+
+type fileRepo struct {
+	bucketName      string
+	base            *url.URL
+	cacheControl    string
+	signedURLExpiry time.Duration
+	client          *awss3.Client
+	presigner       *awss3.PresignClient
+	uploader        *manager.Uploader
+}
+
+// NewFile returns a file gateway backed by an S3-compatible storage.
+// When base is empty, URLs of assets are signed since the bucket is supposed to be private.
+func NewFile(conf Config, base string) (gateway.File, error) {
+	if conf.BucketName == "" {
+		return nil, rerror.NewE(i18n.T("bucket name is empty"))
+	}
+
+	var u *url.URL
+	if base != "" {
+		var err error
+		u, err = url.Parse(base)
+		if err != nil {
+			return nil, rerror.NewE(i18n.T("invalid base URL"))
+		}
+	}
+
+	region := conf.Region
+	if region == "" {
+		// S3-compatible services such as MinIO accept any region
+		region = "us-east-1"
+	}
+
+	opts := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(region)}
+	if conf.AccessKeyID != "" {
+		opts = append(opts, awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(conf.AccessKeyID, conf.SecretAccessKey, "")))
+	}
+
+	awsConf, err := awsconfig.LoadDefaultConfig(context.Background(), opts...)
+	if err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+
+	expiry := conf.SignedURLExpiry
+	if expiry <= 0 {
+		expiry = 24 * time.Hour
+	}
+
+	client := awss3.NewFromConfig(awsConf, func(o *awss3.Options) {
+		o.UsePathStyle = conf.UsePathStyle
+		if conf.Endpoint != "" {
+			o.BaseEndpoint = aws.String(conf.Endpoint)
+		}
+	})
+	return &fileRepo{
+		bucketName:      conf.BucketName,
+		base:            u,
+		cacheControl:    conf.PublicationCacheControl,
+		signedURLExpiry: expiry,
+		client:          client,
+		presigner:       awss3.NewPresignClient(client),
+		uploader:        manager.NewUploader(client),
+	}, nil
+}
+
+func (f *fileRepo) ReadAsset(ctx context.Context, u string, fn string) (io.ReadCloser, error) {
+	p := getS3ObjectPath(u, fn)
+	if p == "" {
+		return nil, rerror.ErrNotFound
+	}
+
+	return f.read(ctx, p)
+}
+
+func (f *fileRepo) GetAssetFiles(ctx context.Context, u string) ([]gateway.FileEntry, error) {
+	p := getS3ObjectPath(u, "")
+	if p == "" {
+		return nil, gateway.ErrFileNotFound
+	}
+
+	var fileEntries []gateway.FileEntry
+	err := f.list(ctx, p+"/", func(o types.Object) {
+		fileEntries = append(fileEntries, gateway.FileEntry{
+			// assets/22/2232222233333/hoge/tileset.json -> hoge/tileset.json
+			Name: strings.TrimPrefix(strings.TrimPrefix(aws.ToString(o.Key), p), "/"),
+			Size: aws.ToInt64(o.Size),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fileEntries) == 0 {
+		return nil, gateway.ErrFileNotFound
+	}
+
+	return fileEntries, nil
+}
+
+func (f *fileRepo) UploadAsset(ctx context.Context, file *file.File) (string, int64, error) {
+	if file == nil {
+		return "", 0, gateway.ErrInvalidFile
+	}
+	if file.Size >= fileSizeLimit {
+		return "", 0, gateway.ErrFileTooLarge
+	}
+
+	uuid := newUUID()
+
+	p := getS3ObjectPath(uuid, file.Path)
+	if p == "" {
+		return "", 0, gateway.ErrInvalidFile
+	}
+
+	size, err := f.upload(ctx, p, file.Content)
+	if err != nil {
+		return "", 0, err
+	}
+	return uuid, size, nil
+}
+
+func (f *fileRepo) DeleteAsset(ctx context.Context, u string, fn string) error {
+	p := getS3ObjectPath(u, fn)
+	if p == "" {
+		return gateway.ErrInvalidFile
+	}
+
+	sn := sanitize.Path(p)
+	if sn == "" {
+		return gateway.ErrInvalidFile
+	}
+	return f.delete(ctx, sn)
+}
+
+// GetURL returns the URL of the asset. The URL is signed and expires when the base URL is not set.
+func (f *fileRepo) GetURL(a *asset.Asset) string {
+	if f.base != nil {
+		return getURL(f.base, a.UUID(), a.FileName())
+	}
+
+	u, err := f.presign(context.Background(), a, "", f.signedURLExpiry)
+	if err != nil {
+		log.Errorf("s3: presign err: %+v\n", err)
+		return ""
+	}
+	return u
+}
+
+// GetSignedURL implements gateway.File
+func (f *fileRepo) GetSignedURL(ctx context.Context, a *asset.Asset, fp string, expiry time.Duration) (string, error) {
+	u, err := f.presign(ctx, a, fp, expiry)
+	if err != nil {
+		log.Errorf("s3: presign err: %+v\n", err)
+		return "", rerror.ErrInternalBy(err)
+	}
+	return u, nil
+}
+
+// presign returns a presigned URL of the file of the asset. The asset itself is presigned when fp is empty.
+func (f *fileRepo) presign(ctx context.Context, a *asset.Asset, fp string, expiry time.Duration) (string, error) {
+	if fp == "" {
+		fp = a.FileName()
+	}
+	p := getS3ObjectPath(a.UUID(), fp)
+	if p == "" {
+		return "", gateway.ErrInvalidFile
+	}
+
+	req, err := f.presigner.PresignGetObject(ctx, &awss3.GetObjectInput{
+		Bucket: aws.String(f.bucketName),
+		Key:    aws.String(p),
+	}, awss3.WithPresignExpires(expiry))
+	if err != nil {
+		return "", err
+	}
+	return req.URL, nil
+}
+
+// Read implements gateway.File
+func (f *fileRepo) Read(ctx context.Context, p string) (gateway.ReadAtCloser, int64, error) {
+	if p == "" {
+		return nil, 0, rerror.ErrNotFound
+	}
+
+	key := path.Join(s3AssetBasePath, p)
+	head, err := f.client.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket: aws.String(f.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, 0, rerror.ErrNotFound
+		}
+		log.Errorf("s3: read head err: %+v\n", err)
+		return nil, 0, rerror.ErrInternalBy(err)
+	}
+
+	return &objectReaderAt{ctx: ctx, f: f, key: key}, aws.ToInt64(head.ContentLength), nil
+}
+
+// Upload implements gateway.File. Large files are uploaded in multiple parts while they are written.
+func (f *fileRepo) Upload(ctx context.Context, name string) (io.WriteCloser, error) {
+	if name == "" {
+		return nil, gateway.ErrInvalidFile
+	}
+
+	pr, pw := io.Pipe()
+	w := &objectWriter{pw: pw, done: make(chan error, 1)}
+	key := path.Join(s3AssetBasePath, name)
+	go func() {
+		_, err := f.uploader.Upload(ctx, f.uploadInput(key, pr))
+		// unblock the writer when the upload fails in the middle
+		_ = pr.CloseWithError(err)
+		w.done <- err
+	}()
+	return w, nil
+}
+
+// List implements gateway.File
+func (f *fileRepo) List(ctx context.Context, dir string) ([]string, error) {
+	if dir == "" {
+		return nil, rerror.ErrNotFound
+	}
+
+	var files []string
+	err := f.list(ctx, path.Join(s3AssetBasePath, dir)+"/", func(o types.Object) {
+		files = append(files, strings.TrimPrefix(aws.ToString(o.Key), s3AssetBasePath+"/"))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func (f *fileRepo) read(ctx context.Context, key string) (io.ReadCloser, error) {
+	if key == "" {
+		return nil, rerror.ErrNotFound
+	}
+
+	res, err := f.client.GetObject(ctx, &awss3.GetObjectInput{
+		Bucket: aws.String(f.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, rerror.ErrNotFound
+		}
+		log.Errorf("s3: read err: %+v\n", err)
+		return nil, rerror.ErrInternalBy(err)
+	}
+
+	return res.Body, nil
+}
+
+func (f *fileRepo) upload(ctx context.Context, key string, content io.Reader) (int64, error) {
+	if key == "" {
+		return 0, gateway.ErrInvalidFile
+	}
+
+	if _, err := f.uploader.Upload(ctx, f.uploadInput(key, content)); err != nil {
+		log.Errorf("s3: upload err: %+v\n", err)
+		return 0, gateway.ErrFailedToUploadFile
+	}
+
+	head, err := f.client.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket: aws.String(f.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return 0, rerror.ErrInternalBy(err)
+	}
+
+	return aws.ToInt64(head.ContentLength), nil
+}
+
+func (f *fileRepo) uploadInput(key string, body io.Reader) *awss3.PutObjectInput {
+	in := &awss3.PutObjectInput{
+		Bucket: aws.String(f.bucketName),
+		Key:    aws.String(key),
+		Body:   body,
+	}
+	if f.cacheControl != "" {
+		in.CacheControl = aws.String(f.cacheControl)
+	}
+	if ct := mime.TypeByExtension(path.Ext(key)); ct != "" {
+		in.ContentType = aws.String(ct)
+	}
+	return in
+}
+
+func (f *fileRepo) delete(ctx context.Context, key string) error {
+	if key == "" {
+		return gateway.ErrInvalidFile
+	}
+
+	if _, err := f.client.DeleteObject(ctx, &awss3.DeleteObjectInput{
+		Bucket: aws.String(f.bucketName),
+		Key:    aws.String(key),
+	}); err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+
+		log.Errorf("s3: delete err: %+v\n", err)
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
+
+func (f *fileRepo) list(ctx context.Context, prefix string, fn func(types.Object)) error {
+	p := awss3.NewListObjectsV2Paginator(f.client, &awss3.ListObjectsV2Input{
+		Bucket: aws.String(f.bucketName),
+		Prefix: aws.String(prefix),
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			log.Errorf("s3: list err: %+v\n", err)
+			return rerror.ErrInternalBy(err)
+		}
+		for _, o := range page.Contents {
+			fn(o)
+		}
+	}
+	return nil
+}
+
+// objectReaderAt implements io.ReaderAt with range requests to an S3 object
+type objectReaderAt struct {
+	ctx context.Context
+	f   *fileRepo
+	key string
+}
+
+func (r *objectReaderAt) ReadAt(b []byte, off int64) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	res, err := r.f.client.GetObject(r.ctx, &awss3.GetObjectInput{
+		Bucket: aws.String(r.f.bucketName),
+		Key:    aws.String(r.key),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", off, off+int64(len(b))-1)),
+	})
+	if err != nil {
+		var aerr smithy.APIError
+		if errors.As(err, &aerr) && aerr.ErrorCode() == "InvalidRange" {
+			return 0, io.EOF
+		}
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	n, err := io.ReadFull(res.Body, b)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (r *objectReaderAt) Close() error {
+	return nil
+}
+
+// objectWriter streams written data to the uploader. Close waits for the upload to be completed.
+type objectWriter struct {
+	pw   *io.PipeWriter
+	done chan error
+}
+
+func (w *objectWriter) Write(b []byte) (int, error) {
+	return w.pw.Write(b)
+}
+
+func (w *objectWriter) Close() error {
+	if err := w.pw.Close(); err != nil {
+		return err
+	}
+	if err := <-w.done; err != nil {
+		log.Errorf("s3: upload err: %+v\n", err)
+		return gateway.ErrFailedToUploadFile
+	}
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var nsk *types.NoSuchKey
+	var nf *types.NotFound
+	return errors.As(err, &nsk) || errors.As(err, &nf)
+}
+
+func getS3ObjectPath(uuid, objectName string) string {
+	if uuid == "" || !IsValidUUID(uuid) {
+		return ""
+	}
+
+	return path.Join(s3AssetBasePath, uuid[:2], uuid[2:], objectName)
+}
+
+func newUUID() string {
+	return uuid.New().String()
+}
+
+func IsValidUUID(u string) bool {
+	_, err := uuid.Parse(u)
+	return err == nil
+}
+
+func getURL(host *url.URL, uuid, fName string) string {
+	return host.JoinPath(s3AssetBasePath, uuid[:2], uuid[2:], fName).String()
+}
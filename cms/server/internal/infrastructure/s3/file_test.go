@@ -0,0 +1,225 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_GetURL(t *testing.T) {
+	host := "https://localhost:8080"
+	r, err := NewFile(Config{BucketName: "asset.cms.test", Region: "us-east-1"}, host)
+	assert.NoError(t, err)
+
+	u := newUUID()
+	n := "xxx.yyy"
+	a := asset.New().NewID().
+		Project(id.NewProjectID()).
+		CreatedByUser(id.NewUserID()).
+		Size(1000).
+		FileName(n).
+		UUID(u).
+		Thread(id.NewThreadID()).
+		MustBuild()
+
+	expected, err := url.JoinPath(host, s3AssetBasePath, u[:2], u[2:], n)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, r.GetURL(a))
+
+	// signed URL
+	r, err = NewFile(Config{
+		BucketName:      "asset.cms.test",
+		Region:          "us-east-1",
+		Endpoint:        "http://localhost:9000",
+		UsePathStyle:    true,
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+		SignedURLExpiry: time.Hour,
+	}, "")
+	assert.NoError(t, err)
+
+	su := lo.Must(url.Parse(r.GetURL(a)))
+	assert.Equal(t, "localhost:9000", su.Host)
+	assert.Equal(t, "/asset.cms.test/"+path.Join(s3AssetBasePath, u[:2], u[2:], n), su.Path)
+	assert.Equal(t, "3600", su.Query().Get("X-Amz-Expires"))
+	assert.NotEmpty(t, su.Query().Get("X-Amz-Signature"))
+
+	su = lo.Must(url.Parse(lo.Must(r.GetSignedURL(context.Background(), a, "", time.Minute))))
+	assert.Equal(t, "60", su.Query().Get("X-Amz-Expires"))
+	assert.NotEmpty(t, su.Query().Get("X-Amz-Signature"))
+
+	su = lo.Must(url.Parse(lo.Must(r.GetSignedURL(context.Background(), a, "xxx/yyy.txt", time.Minute))))
+	assert.Equal(t, "/asset.cms.test/"+path.Join(s3AssetBasePath, u[:2], u[2:], "xxx/yyy.txt"), su.Path)
+	assert.NotEmpty(t, su.Query().Get("X-Amz-Signature"))
+}
+
+func TestFile_Storage(t *testing.T) {
+	ctx := context.Background()
+	s := newFakeS3()
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	r, err := NewFile(Config{
+		BucketName:              "bucket",
+		Endpoint:                ts.URL,
+		UsePathStyle:            true,
+		AccessKeyID:             "key",
+		SecretAccessKey:         "secret",
+		PublicationCacheControl: "no-cache",
+	}, ts.URL)
+	require.NoError(t, err)
+
+	// asset
+	u, size, err := r.UploadAsset(ctx, &file.File{Path: "aaa.txt", Content: io.NopCloser(strings.NewReader("hello")), Size: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), size)
+	assert.Equal(t, "no-cache", s.header(path.Join("assets", u[:2], u[2:], "aaa.txt")).Get("Cache-Control"))
+	assert.Equal(t, "text/plain; charset=utf-8", s.header(path.Join("assets", u[:2], u[2:], "aaa.txt")).Get("Content-Type"))
+
+	rc, err := r.ReadAsset(ctx, u, "aaa.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(lo.Must(io.ReadAll(rc))))
+	_ = rc.Close()
+
+	_, err = r.ReadAsset(ctx, u, "bbb.txt")
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	// raw files
+	w, err := r.Upload(ctx, path.Join(u[:2], u[2:], "aaa/b.txt"))
+	assert.NoError(t, err)
+	_, _ = w.Write([]byte("world"))
+	assert.NoError(t, w.Close())
+
+	ra, size, err := r.Read(ctx, path.Join(u[:2], u[2:], "aaa/b.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), size)
+	b := make([]byte, 3)
+	n, err := ra.ReadAt(b, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "rld", string(b[:n]))
+
+	_, _, err = r.Read(ctx, path.Join(u[:2], u[2:], "none"))
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	files, err := r.List(ctx, path.Join(u[:2], u[2:], "aaa"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{path.Join(u[:2], u[2:], "aaa/b.txt")}, files)
+
+	entries, err := r.GetAssetFiles(ctx, u)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"aaa.txt", "aaa/b.txt"}, lo.Map(entries, func(e gateway.FileEntry, _ int) string { return e.Name }))
+
+	// delete
+	assert.NoError(t, r.DeleteAsset(ctx, u, "aaa.txt"))
+	_, err = r.ReadAsset(ctx, u, "aaa.txt")
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+}
+
+func TestFile_GetS3ObjectPath(t *testing.T) {
+	u := newUUID()
+	assert.Equal(t, path.Join(s3AssetBasePath, u[:2], u[2:], "xxx.yyy"), getS3ObjectPath(u, "xxx.yyy"))
+	assert.Equal(t, "", getS3ObjectPath("", ""))
+	assert.Equal(t, "", getS3ObjectPath("xxxxxx", "xxx.yyy"))
+}
+
+// fakeS3 is an in-memory S3-compatible server which supports path-style requests of single-part uploads, reads, deletes and listing
+type fakeS3 struct {
+	lock    sync.Mutex
+	objects map[string][]byte
+	headers map[string]http.Header
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: map[string][]byte{}, headers: map[string]http.Header{}}
+}
+
+func (s *fakeS3) header(key string) http.Header {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.headers[key]
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// /bucket/key
+	_, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+	switch {
+	case r.Method == http.MethodPut:
+		b, _ := io.ReadAll(r.Body)
+		s.objects[key] = b
+		s.headers[key] = r.Header.Clone()
+	case r.Method == http.MethodGet && key == "" && r.URL.Query().Get("list-type") == "2":
+		s.list(w, r.URL.Query().Get("prefix"))
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		b, ok := s.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>not found</Message></Error>`))
+			}
+			return
+		}
+		if rg := r.Header.Get("Range"); rg != "" {
+			var start, end int
+			_, _ = fmt.Sscanf(rg, "bytes=%d-%d", &start, &end)
+			if end >= len(b) {
+				end = len(b) - 1
+			}
+			b = b[start : end+1]
+			w.Header().Set("Content-Length", strconv.Itoa(len(b)))
+			w.WriteHeader(http.StatusPartialContent)
+		} else {
+			w.Header().Set("Content-Length", strconv.Itoa(len(b)))
+		}
+		if r.Method == http.MethodGet {
+			_, _ = io.Copy(w, bytes.NewReader(b))
+		}
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *fakeS3) list(w http.ResponseWriter, prefix string) {
+	type content struct {
+		Key  string
+		Size int
+	}
+	res := struct {
+		XMLName  xml.Name `xml:"ListBucketResult"`
+		Contents []content
+	}{}
+	for k, b := range s.objects {
+		if strings.HasPrefix(k, prefix) {
+			res.Contents = append(res.Contents, content{Key: k, Size: len(b)})
+		}
+	}
+	sort.Slice(res.Contents, func(i, j int) bool { return res.Contents[i].Key < res.Contents[j].Key })
+	_ = xml.NewEncoder(w).Encode(res)
+}
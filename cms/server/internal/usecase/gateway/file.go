@@ -3,6 +3,7 @@ package gateway
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/file"
@@ -16,8 +17,14 @@ var (
 	ErrFileTooLarge       error = rerror.NewE(i18n.T("file too large"))
 	ErrFailedToDeleteFile error = rerror.NewE(i18n.T("failed to delete file"))
 	ErrFileNotFound       error = rerror.NewE(i18n.T("file not found"))
+	ErrSignedURLDisabled  error = rerror.NewE(i18n.T("signed URL is disabled"))
 )
 
+type ReadAtCloser interface {
+	io.ReaderAt
+	io.Closer
+}
+
 type FileEntry struct {
 	Name string
 	Size int64
@@ -29,4 +36,12 @@ type File interface {
 	UploadAsset(context.Context, *file.File) (string, int64, error)
 	DeleteAsset(context.Context, string, string) error
 	GetURL(*asset.Asset) string
+	// GetSignedURL returns a URL of a file of the asset which can be accessed until it expires even if the storage is not public.
+	// The file is specified by its path in the directory of the asset, such as a file extracted from an archive. The asset itself is signed when the path is empty.
+	GetSignedURL(context.Context, *asset.Asset, string, time.Duration) (string, error)
+	// Read, Upload and List access raw files by a path relative to the asset directory. They are used by task runners to extract and compress archives.
+	Read(context.Context, string) (ReadAtCloser, int64, error)
+	Upload(context.Context, string) (io.WriteCloser, error)
+	// List returns paths of all files under the directory recursively
+	List(context.Context, string) ([]string, error)
 }
@@ -2,10 +2,16 @@ package interactor
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
@@ -17,21 +23,35 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/reearth/reearth-cms/worker/pkg/decompressor"
+	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/samber/lo"
 )
 
+const defaultSignedURLExpiry = time.Hour
+
 type Asset struct {
-	repos       *repo.Container
-	gateways    *gateway.Container
-	ignoreEvent bool
+	repos           *repo.Container
+	gateways        *gateway.Container
+	ignoreEvent     bool
+	signedURLExpiry time.Duration
 }
 
 func NewAsset(r *repo.Container, g *gateway.Container) interfaces.Asset {
+	return newAsset(r, g, 0)
+}
+
+func newAsset(r *repo.Container, g *gateway.Container, signedURLExpiry time.Duration) *Asset {
+	if signedURLExpiry <= 0 {
+		signedURLExpiry = defaultSignedURLExpiry
+	}
 	return &Asset{
-		repos:    r,
-		gateways: g,
+		repos:           r,
+		gateways:        g,
+		signedURLExpiry: signedURLExpiry,
 	}
 }
 
@@ -69,6 +89,63 @@ func (i *Asset) GetURL(a *asset.Asset) string {
 	return i.gateways.File.GetURL(a)
 }
 
+func (i *Asset) GetSignedURL(ctx context.Context, a *asset.Asset, p string) (string, error) {
+	return i.gateways.File.GetSignedURL(ctx, a, p, i.signedURLExpiry)
+}
+
+func (i *Asset) URLResolver(ctx context.Context, assets asset.List, _ *usecase.Operator) asset.URLResolver {
+	var lock sync.Mutex
+	public := map[id.ProjectID]bool{}
+	fetch := func(pids id.ProjectIDList) {
+		pids = lo.Filter(lo.Uniq(pids), func(p id.ProjectID, _ int) bool {
+			_, ok := public[p]
+			return !ok
+		})
+		if len(pids) == 0 {
+			return
+		}
+
+		prjs, err := i.repos.Project.FindByIDs(ctx, pids)
+		if err != nil {
+			log.Errorf("asset: failed to fetch projects of assets: %v", err)
+		}
+		for _, p := range pids {
+			public[p] = false
+		}
+		for _, p := range prjs {
+			if p != nil && p.Publication() != nil {
+				public[p.ID()] = p.Publication().AssetPublic()
+			}
+		}
+	}
+
+	fetch(lo.FilterMap(assets, func(a *asset.Asset, _ int) (id.ProjectID, bool) {
+		if a == nil {
+			return id.ProjectID{}, false
+		}
+		return a.Project(), true
+	}))
+
+	return func(a *asset.Asset) string {
+		lock.Lock()
+		fetch(id.ProjectIDList{a.Project()})
+		p := public[a.Project()]
+		lock.Unlock()
+
+		if p {
+			return i.GetURL(a)
+		}
+		u, err := i.GetSignedURL(ctx, a, "")
+		if err != nil {
+			if !errors.Is(err, gateway.ErrSignedURLDisabled) {
+				log.Errorf("asset: failed to sign asset url: %v", err)
+			}
+			return i.GetURL(a)
+		}
+		return u
+	}
+}
+
 func (i *Asset) Create(ctx context.Context, inp interfaces.CreateAssetParam, op *usecase.Operator) (result *asset.Asset, afile *asset.File, err error) {
 	if op.User == nil && op.Integration == nil {
 		return nil, nil, interfaces.ErrInvalidOperator
@@ -83,7 +160,7 @@ func (i *Asset) Create(ctx context.Context, inp interfaces.CreateAssetParam, op
 		return nil, nil, err
 	}
 
-	if !op.IsWritableWorkspace(prj.Workspace()) {
+	if !op.CanAny(user.ActionCreate, prj.ID(), op.IsWritableWorkspace(prj.Workspace())) {
 		return nil, nil, interfaces.ErrOperationDenied
 	}
 
@@ -96,24 +173,25 @@ func (i *Asset) Create(ctx context.Context, inp interfaces.CreateAssetParam, op
 		if err != nil {
 			return nil, nil, err
 		}
+	} else {
+		file, err = getExternalFile(ctx, inp.URL)
+		if err != nil {
+			return nil, nil, err
+		}
+		uuid, size, err = i.gateways.File.UploadAsset(ctx, file)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
+	file.Size = int64(size)
+
+	// the bounding box of an uploaded GeoJSON file is computed here as it is not decompressed
+	bbox := i.detectBBox(ctx, uuid, []gateway.FileEntry{{Name: file.Path, Size: size}})
 
 	return Run2(
 		ctx, op, i.repos,
 		Usecase().Transaction(),
 		func(ctx context.Context) (*asset.Asset, *asset.File, error) {
-			if inp.URL != "" {
-				file, err = getExternalFile(ctx, inp.URL)
-				if err != nil {
-					return nil, nil, err
-				}
-				uuid, size, err = i.gateways.File.UploadAsset(ctx, file)
-				if err != nil {
-					return nil, nil, err
-				}
-			}
-			file.Size = int64(size)
-
 			th, err := thread.New().NewID().Workspace(prj.Workspace()).Build()
 
 			if err != nil {
@@ -136,7 +214,8 @@ func (i *Asset) Create(ctx context.Context, inp interfaces.CreateAssetParam, op
 				Type(asset.PreviewTypeFromContentType(file.ContentType)).
 				UUID(uuid).
 				Thread(th.ID()).
-				ArchiveExtractionStatus(es)
+				ArchiveExtractionStatus(es).
+				BBox(bbox)
 
 			if op.User != nil {
 				ab.CreatedByUser(*op.User)
@@ -235,7 +314,8 @@ func (i *Asset) triggerDecompressEvent(ctx context.Context, a *asset.Asset, f *a
 	return nil
 }
 
-func (i *Asset) Update(ctx context.Context, inp interfaces.UpdateAssetParam, operator *usecase.Operator) (result *asset.Asset, err error) {
+// Compress requests the task runner to pack the extracted files of the asset, or a folder in them, into a zip archive stored alongside the asset
+func (i *Asset) Compress(ctx context.Context, inp interfaces.CompressAssetParam, operator *usecase.Operator) (*asset.Asset, error) {
 	if operator.User == nil && operator.Integration == nil {
 		return nil, interfaces.ErrInvalidOperator
 	}
@@ -253,10 +333,37 @@ func (i *Asset) Update(ctx context.Context, inp interfaces.UpdateAssetParam, ope
 				return nil, interfaces.ErrOperationDenied
 			}
 
-			if inp.PreviewType != nil {
-				a.UpdatePreviewType(inp.PreviewType)
+			if s := a.ArchiveExtractionStatus(); s == nil || *s != asset.ArchiveExtractionStatusDone {
+				return nil, interfaces.ErrAssetNotExtracted
+			}
+
+			// only one archive of the asset is generated at a time as the asset records a single compression status
+			if s := a.CompressionStatus(); s != nil && *s == asset.ArchiveExtractionStatusInProgress {
+				return nil, interfaces.ErrAssetCompressionInProgress
+			}
+
+			f, err := i.repos.AssetFile.FindByID(ctx, inp.AssetID)
+			if err != nil {
+				return nil, err
 			}
 
+			dir := compressionDir(f, inp.Path)
+			if dir == "" {
+				return nil, interfaces.ErrAssetDirNotFound
+			}
+
+			output := asset.CompressedPath(dir)
+			taskPayload := task.CompressAssetPayload{
+				AssetID: a.ID().String(),
+				Path:    path.Join(a.UUID()[:2], a.UUID()[2:], dir),
+				Output:  path.Join(a.UUID()[:2], a.UUID()[2:], output),
+			}
+			if err := i.gateways.TaskRunner.Run(ctx, taskPayload.Payload()); err != nil {
+				return nil, err
+			}
+
+			a.UpdateCompressionStatus(lo.ToPtr(asset.ArchiveExtractionStatusInProgress))
+			a.UpdateCompressedPath(output)
 			if err := i.repos.Asset.Save(ctx, a); err != nil {
 				return nil, err
 			}
@@ -266,7 +373,30 @@ func (i *Asset) Update(ctx context.Context, inp interfaces.UpdateAssetParam, ope
 	)
 }
 
-func (i *Asset) UpdateFiles(ctx context.Context, aid id.AssetID, s *asset.ArchiveExtractionStatus, op *usecase.Operator) (*asset.Asset, error) {
+// compressionDir returns the folder to compress relative to the asset directory. The folder extracted from the archive is used when p is nil.
+// It returns an empty string when the folder does not contain any extracted files.
+func compressionDir(f *asset.File, p *string) string {
+	var dir string
+	if p != nil {
+		dir = strings.TrimPrefix(path.Clean("/"+*p), "/")
+	} else {
+		src := strings.TrimPrefix(f.Path(), "/")
+		dir = strings.TrimSuffix(src, "."+decompressor.Ext(src))
+	}
+	if dir == "" {
+		return ""
+	}
+
+	if !lo.SomeBy(f.Files(), func(c *asset.File) bool {
+		return strings.HasPrefix(strings.TrimPrefix(c.Path(), "/"), dir+"/")
+	}) {
+		return ""
+	}
+	return dir
+}
+
+// UpdateCompression records the result of the compression reported by the task runner
+func (i *Asset) UpdateCompression(ctx context.Context, aid id.AssetID, s *asset.ArchiveExtractionStatus, op *usecase.Operator) (*asset.Asset, error) {
 	if op.User == nil && op.Integration == nil && !op.Machine {
 		return nil, interfaces.ErrInvalidOperator
 	}
@@ -280,36 +410,123 @@ func (i *Asset) UpdateFiles(ctx context.Context, aid id.AssetID, s *asset.Archiv
 				return nil, err
 			}
 
-			srcfile, err := i.repos.AssetFile.FindByID(ctx, aid)
-			if err != nil {
+			if !op.CanUpdate(a) {
+				return nil, interfaces.ErrOperationDenied
+			}
+
+			a.UpdateCompressionStatus(s)
+			if err := i.repos.Asset.Save(ctx, a); err != nil {
 				return nil, err
 			}
 
-			if a.ArchiveExtractionStatus().String() == asset.ArchiveExtractionStatusDone.String() || a.ArchiveExtractionStatus().String() == asset.ArchiveExtractionStatusFailed.String() {
-				return a, nil
+			return a, nil
+		},
+	)
+}
+
+func (i *Asset) Update(ctx context.Context, inp interfaces.UpdateAssetParam, operator *usecase.Operator) (result *asset.Asset, err error) {
+	if operator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(
+		ctx, operator, i.repos,
+		Usecase().Transaction(),
+		func(ctx context.Context) (*asset.Asset, error) {
+			a, err := i.repos.Asset.FindByID(ctx, inp.AssetID)
+			if err != nil {
+				return nil, err
 			}
 
-			if !op.CanUpdate(a) {
+			if !operator.CanUpdate(a) {
 				return nil, interfaces.ErrOperationDenied
 			}
 
-			files, err := i.gateways.File.GetAssetFiles(ctx, a.UUID())
+			if inp.PreviewType != nil {
+				a.UpdatePreviewType(inp.PreviewType)
+			}
+
+			if err := i.repos.Asset.Save(ctx, a); err != nil {
+				return nil, err
+			}
+
+			return a, nil
+		},
+	)
+}
+
+func (i *Asset) UpdateFiles(ctx context.Context, aid id.AssetID, s *asset.ArchiveExtractionStatus, op *usecase.Operator) (*asset.Asset, error) {
+	if op.User == nil && op.Integration == nil && !op.Machine {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	// the extracted files are listed and read before the transaction begins, since reading large GeoJSON files to detect the bounding box takes long
+	a, err := i.repos.Asset.FindByID(ctx, aid)
+	if err != nil {
+		return nil, err
+	}
+
+	srcfile, err := i.repos.AssetFile.FindByID(ctx, aid)
+	if err != nil {
+		return nil, err
+	}
+
+	if isArchiveExtractionFinished(a) {
+		return a, nil
+	}
+
+	if !op.CanUpdate(a) {
+		return nil, interfaces.ErrOperationDenied
+	}
+
+	var files []gateway.FileEntry
+	m := i.readManifest(ctx, a, srcfile)
+	if m != nil {
+		files = lo.Map(m.Entries, func(e decompressor.Entry, _ int) gateway.FileEntry {
+			return gateway.FileEntry{Name: e.Path, Size: e.Size}
+		})
+	} else {
+		files, err = i.gateways.File.GetAssetFiles(ctx, a.UUID())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bbox := i.detectBBox(ctx, a.UUID(), files)
+
+	return Run1(
+		ctx, op, i.repos,
+		Usecase().Transaction(),
+		func(ctx context.Context) (*asset.Asset, error) {
+			a, err := i.repos.Asset.FindByID(ctx, aid)
 			if err != nil {
 				return nil, err
 			}
 
-			assetFiles := lo.Filter(lo.Map(files, func(f gateway.FileEntry, _ int) *asset.File {
-				return asset.NewFile().
-					Name(path.Base(f.Name)).
-					Path(f.Name).
-					GuessContentType().
-					Build()
-			}), func(f *asset.File, _ int) bool {
-				return srcfile.Path() != f.Path()
-			})
+			if isArchiveExtractionFinished(a) {
+				return a, nil
+			}
+
+			var assetFiles []*asset.File
+			if m != nil {
+				assetFiles = manifestFiles(a, m)
+			} else {
+				manifestPath := decompressor.ManifestPath(srcfile.Path())
+				assetFiles = lo.Filter(lo.Map(files, func(f gateway.FileEntry, _ int) *asset.File {
+					return asset.NewFile().
+						Name(path.Base(f.Name)).
+						Path(f.Name).
+						Size(uint64(f.Size)).
+						GuessContentType().
+						Build()
+				}), func(f *asset.File, _ int) bool {
+					return srcfile.Path() != f.Path() && manifestPath != f.Path() && !strings.HasSuffix(f.Path(), asset.CompressedSuffix)
+				})
+			}
 
 			a.UpdateArchiveExtractionStatus(s)
 			a.UpdatePreviewType(detectPreviewType(files))
+			a.UpdateBBox(bbox)
 
 			f := asset.FoldFiles(assetFiles, srcfile)
 
@@ -341,6 +558,91 @@ func (i *Asset) UpdateFiles(ctx context.Context, aid id.AssetID, s *asset.Archiv
 	)
 }
 
+func isArchiveExtractionFinished(a *asset.Asset) bool {
+	s := a.ArchiveExtractionStatus()
+	return s != nil && (*s == asset.ArchiveExtractionStatusDone || *s == asset.ArchiveExtractionStatusFailed)
+}
+
+// UpdateExtractionProgress records the progress of the extraction reported by the worker
+func (i *Asset) UpdateExtractionProgress(ctx context.Context, aid id.AssetID, p asset.ArchiveExtractionProgress, op *usecase.Operator) (*asset.Asset, error) {
+	if op.User == nil && op.Integration == nil && !op.Machine {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(
+		ctx, op, i.repos,
+		Usecase().Transaction(),
+		func(ctx context.Context) (*asset.Asset, error) {
+			a, err := i.repos.Asset.FindByID(ctx, aid)
+			if err != nil {
+				return nil, err
+			}
+
+			// progress which arrives after the extraction finished is stale
+			if isArchiveExtractionFinished(a) {
+				return a, nil
+			}
+
+			if !op.CanUpdate(a) {
+				return nil, interfaces.ErrOperationDenied
+			}
+
+			a.UpdateArchiveExtractionProgress(&p)
+			if err := i.repos.Asset.Save(ctx, a); err != nil {
+				return nil, err
+			}
+
+			return a, nil
+		},
+	)
+}
+
+// readManifest reads the manifest which the worker stores next to the archive. It returns nil when there is no manifest.
+func (i *Asset) readManifest(ctx context.Context, a *asset.Asset, srcfile *asset.File) *decompressor.Manifest {
+	r, err := i.gateways.File.ReadAsset(ctx, a.UUID(), decompressor.ManifestPath(srcfile.Path()))
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = r.Close() }()
+
+	var m decompressor.Manifest
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		log.Warnf("asset: invalid manifest: asset=%s err=%v", a.ID(), err)
+		return nil
+	}
+	return &m
+}
+
+// manifestFiles returns the extracted files listed in the manifest, and records failed files and the final progress to the asset
+func manifestFiles(a *asset.Asset, m *decompressor.Manifest) []*asset.File {
+	files := make([]*asset.File, 0, len(m.Entries))
+	p := asset.ArchiveExtractionProgress{
+		Files:      len(m.Entries),
+		TotalFiles: len(m.Entries) + len(m.Failed),
+		Failed:     len(m.Failed),
+	}
+	for _, e := range m.Entries {
+		files = append(files, asset.NewFile().
+			Name(path.Base(e.Path)).
+			Path(e.Path).
+			Size(uint64(e.Size)).
+			ContentType(e.ContentType).
+			CRC32(e.CRC32).
+			Build())
+		p.Bytes += e.Size
+	}
+	p.TotalBytes = p.Bytes
+	if prev := a.ArchiveExtractionProgress(); prev != nil && prev.TotalBytes > p.TotalBytes {
+		p.TotalBytes = prev.TotalBytes
+	}
+
+	a.UpdateArchiveExtractionProgress(&p)
+	a.UpdateArchiveExtractionFailures(lo.Map(m.Failed, func(f decompressor.FailedEntry, _ int) asset.ArchiveExtractionFailure {
+		return asset.ArchiveExtractionFailure{Path: f.Path, Error: f.Error}
+	}))
+	return files
+}
+
 func detectPreviewType(files []gateway.FileEntry) *asset.PreviewType {
 	for _, entry := range files {
 		if path.Base(entry.Name) == "tileset.json" {
@@ -353,6 +655,52 @@ func detectPreviewType(files []gateway.FileEntry) *asset.PreviewType {
 	return nil
 }
 
+// detectBBox computes the bounding box from GeoJSON files, the root tileset.json of 3D Tiles and metadata.json of vector tiles.
+// Files which cannot be read or parsed are ignored as the bounding box is optional.
+func (i *Asset) detectBBox(ctx context.Context, uuid string, files []gateway.FileEntry) []float64 {
+	var tileset string
+	var b []float64
+	for _, entry := range files {
+		var parse func(io.Reader) ([]float64, error)
+		switch base := path.Base(entry.Name); {
+		case base == "tileset.json":
+			// only the root tileset, which is the nearest to the top directory, has the extent of the whole data
+			if tileset == "" || strings.Count(entry.Name, "/") < strings.Count(tileset, "/") {
+				tileset = entry.Name
+			}
+			continue
+		case base == "metadata.json":
+			parse = asset.BBoxFromMVTMetadata
+		case strings.EqualFold(path.Ext(base), ".geojson"):
+			parse = asset.BBoxFromGeoJSON
+		default:
+			continue
+		}
+		b = asset.MergeBBox(b, i.readBBox(ctx, uuid, entry.Name, parse))
+	}
+
+	if tileset != "" {
+		b = asset.MergeBBox(b, i.readBBox(ctx, uuid, tileset, asset.BBoxFromTileset))
+	}
+	return b
+}
+
+func (i *Asset) readBBox(ctx context.Context, uuid, name string, parse func(io.Reader) ([]float64, error)) []float64 {
+	r, err := i.gateways.File.ReadAsset(ctx, uuid, name)
+	if err != nil {
+		log.Warnf("asset: failed to read a file to detect bbox: uuid=%s name=%s err=%v", uuid, name, err)
+		return nil
+	}
+	defer func() { _ = r.Close() }()
+
+	b, err := parse(r)
+	if err != nil {
+		log.Warnf("asset: failed to detect bbox: uuid=%s name=%s err=%v", uuid, name, err)
+		return nil
+	}
+	return b
+}
+
 func (i *Asset) Delete(ctx context.Context, aId id.AssetID, operator *usecase.Operator) (result id.AssetID, err error) {
 	if operator.User == nil && operator.Integration == nil {
 		return aId, interfaces.ErrInvalidOperator
@@ -367,7 +715,7 @@ func (i *Asset) Delete(ctx context.Context, aId id.AssetID, operator *usecase.Op
 				return aId, err
 			}
 
-			if !operator.CanUpdate(a) {
+			if !operator.CanDo(user.ActionDelete, a) {
 				return aId, interfaces.ErrOperationDenied
 			}
 
@@ -12,11 +12,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/jarcoal/httpmock"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway/gatewaymock"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/file"
@@ -401,6 +403,7 @@ func TestAsset_Create(t *testing.T) {
 	buf2 := bytes.NewBufferString("Hello")
 	af := asset.NewFile().Name("aaa.txt").Size(uint64(buf.Len())).Path("aaa.txt").Build()
 	af2 := asset.NewFile().Name("aaa.txt").Size(uint64(buf2.Len())).Path("aaa.txt").Build()
+	geojson := `{"type": "LineString", "coordinates": [[135, 30], [140, 35]]}`
 
 	type args struct {
 		cpp      interfaces.CreateAssetParam
@@ -471,6 +474,36 @@ func TestAsset_Create(t *testing.T) {
 			wantFile: af2,
 			wantErr:  nil,
 		},
+		{
+			name:  "Create GeoJSON",
+			seeds: []*asset.Asset{},
+			args: args{
+				cpp: interfaces.CreateAssetParam{
+					ProjectID: p1.ID(),
+					File: &file.File{
+						Path:    "aaa.geojson",
+						Content: io.NopCloser(bytes.NewBufferString(geojson)),
+						Size:    int64(len(geojson)),
+					},
+					SkipDecompression: true,
+				},
+				operator: op,
+			},
+			want: asset.New().
+				NewID().
+				Project(p1.ID()).
+				CreatedByUser(u.ID()).
+				FileName("aaa.geojson").
+				Size(uint64(len(geojson))).
+				Type(asset.PreviewTypeUnknown.Ref()).
+				Thread(id.NewThreadID()).
+				NewUUID().
+				ArchiveExtractionStatus(lo.ToPtr(asset.ArchiveExtractionStatusSkipped)).
+				BBox([]float64{135, 30, 140, 35}).
+				MustBuild(),
+			wantFile: asset.NewFile().Name("aaa.geojson").Size(uint64(len(geojson))).Path("aaa.geojson").Build(),
+			wantErr:  nil,
+		},
 		{
 			name:  "Create invalid file size",
 			seeds: []*asset.Asset{},
@@ -513,7 +546,7 @@ func TestAsset_Create(t *testing.T) {
 			ctx := context.Background()
 			db := memory.New()
 			mfs := afero.NewMemMapFs()
-			f, _ := fs.NewFile(mfs, "")
+			f, _ := fs.NewFile(mfs, "", nil)
 			runnerGw := NewMockRunner()
 
 			err := db.User.Save(ctx, u)
@@ -552,12 +585,14 @@ func TestAsset_Create(t *testing.T) {
 			assert.Equal(t, tc.want.Project(), got.Project())
 			assert.Equal(t, tc.want.PreviewType(), got.PreviewType())
 			assert.Equal(t, tc.want.ArchiveExtractionStatus(), got.ArchiveExtractionStatus())
+			assert.Equal(t, tc.want.BBox(), got.BBox())
 
 			dbGot, err := db.Asset.FindByID(ctx, got.ID())
 			assert.NoError(t, err)
 			assert.Equal(t, tc.want.Project(), dbGot.Project())
 			assert.Equal(t, tc.want.PreviewType(), dbGot.PreviewType())
 			assert.Equal(t, tc.want.ArchiveExtractionStatus(), dbGot.ArchiveExtractionStatus())
+			assert.Equal(t, tc.want.BBox(), dbGot.BBox())
 
 			assert.Equal(t, gotFile, tc.wantFile)
 		})
@@ -771,7 +806,7 @@ func TestAsset_UpdateFiles(t *testing.T) {
 			ctx := context.Background()
 			db := memory.New()
 
-			fileGw := lo.Must(fs.NewFile(tc.prepareFileFunc(), ""))
+			fileGw := lo.Must(fs.NewFile(tc.prepareFileFunc(), "", nil))
 
 			err := db.Project.Save(ctx, proj)
 			assert.NoError(t, err)
@@ -812,6 +847,160 @@ func TestAsset_UpdateFiles(t *testing.T) {
 	}
 }
 
+func TestAsset_UpdateFiles_Manifest(t *testing.T) {
+	uid := id.NewUserID()
+	ws := user.NewWorkspace().NewID().MustBuild()
+	proj := project.New().NewID().Workspace(ws.ID()).MustBuild()
+	a := asset.New().NewID().Project(proj.ID()).CreatedByUser(uid).Size(1000).
+		UUID("5130c89f-8f67-4766-b127-49ee6796d464").Thread(id.NewThreadID()).
+		ArchiveExtractionStatus(lo.ToPtr(asset.ArchiveExtractionStatusInProgress)).
+		ArchiveExtractionProgress(&asset.ArchiveExtractionProgress{Files: 1, TotalFiles: 3, Bytes: 3, TotalBytes: 100}).
+		MustBuild()
+	af := asset.NewFile().Name("xxx.zip").Path("/xxx.zip").GuessContentType().Build()
+	op := &usecase.Operator{
+		User:             &uid,
+		OwningWorkspaces: []id.WorkspaceID{ws.ID()},
+		OwningProjects:   []id.ProjectID{proj.ID()},
+	}
+
+	// the manifest lists only zzz.txt although hello.txt exists in the storage
+	mfs := mockFs()
+	lo.Must0(afero.WriteFile(mfs, "assets/51/30c89f-8f67-4766-b127-49ee6796d464/xxx.zip.manifest.json", []byte(`{
+		"entries": [{"path": "xxx/zzz.txt", "size": 3, "crc32": 1234, "contentType": "text/plain"}],
+		"failed": [{"path": "xxx/yyy/hello.txt", "error": "broken"}]
+	}`), 0644))
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, proj))
+	lo.Must0(db.Asset.Save(ctx, a))
+	lo.Must0(db.AssetFile.Save(ctx, a.ID(), af.Clone()))
+
+	assetUC := Asset{
+		repos:       db,
+		gateways:    &gateway.Container{File: lo.Must(fs.NewFile(mfs, "", nil))},
+		ignoreEvent: true,
+	}
+
+	// progress is recorded while the extraction is in progress
+	got, err := assetUC.UpdateExtractionProgress(ctx, a.ID(), asset.ArchiveExtractionProgress{Files: 2, TotalFiles: 3, Bytes: 50, TotalBytes: 100}, &usecase.Operator{Machine: true})
+	assert.NoError(t, err)
+	assert.Equal(t, &asset.ArchiveExtractionProgress{Files: 2, TotalFiles: 3, Bytes: 50, TotalBytes: 100}, got.ArchiveExtractionProgress())
+
+	_, err = assetUC.UpdateExtractionProgress(ctx, a.ID(), asset.ArchiveExtractionProgress{}, &usecase.Operator{})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+
+	got, err = assetUC.UpdateFiles(ctx, a.ID(), lo.ToPtr(asset.ArchiveExtractionStatusDone), op)
+	assert.NoError(t, err)
+	assert.Equal(t, &asset.ArchiveExtractionProgress{Files: 1, TotalFiles: 2, Failed: 1, Bytes: 3, TotalBytes: 100}, got.ArchiveExtractionProgress())
+	assert.Equal(t, []asset.ArchiveExtractionFailure{{Path: "xxx/yyy/hello.txt", Error: "broken"}}, got.ArchiveExtractionFailures())
+
+	gotf, err := db.AssetFile.FindByID(ctx, a.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, asset.NewFile().Name("xxx.zip").Path("/xxx.zip").GuessContentType().Children([]*asset.File{
+		asset.NewFile().Name("xxx").Path("/xxx").Dir().Children([]*asset.File{
+			asset.NewFile().Name("zzz.txt").Path("/xxx/zzz.txt").Size(3).ContentType("text/plain").CRC32(1234).Build(),
+		}).Build(),
+	}).Build(), gotf)
+
+	// progress after the extraction finished is ignored
+	got, err = assetUC.UpdateExtractionProgress(ctx, a.ID(), asset.ArchiveExtractionProgress{Files: 3}, &usecase.Operator{Machine: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, got.ArchiveExtractionProgress().Files)
+}
+
+func TestAsset_Compress(t *testing.T) {
+	uid := id.NewUserID()
+	ws := user.NewWorkspace().NewID().MustBuild()
+	proj := project.New().NewID().Workspace(ws.ID()).MustBuild()
+	a := asset.New().NewID().Project(proj.ID()).CreatedByUser(uid).Size(1000).
+		UUID("5130c89f-8f67-4766-b127-49ee6796d464").Thread(id.NewThreadID()).
+		ArchiveExtractionStatus(lo.ToPtr(asset.ArchiveExtractionStatusDone)).
+		MustBuild()
+	a2 := asset.New().NewID().Project(proj.ID()).CreatedByUser(uid).Size(1000).
+		UUID("5130c89f-8f67-4766-b127-49ee6796d465").Thread(id.NewThreadID()).
+		ArchiveExtractionStatus(lo.ToPtr(asset.ArchiveExtractionStatusInProgress)).
+		MustBuild()
+	af := asset.NewFile().Name("xxx.zip").Path("/xxx.zip").Children([]*asset.File{
+		asset.NewFile().Name("xxx").Path("/xxx").Dir().Children([]*asset.File{
+			asset.NewFile().Name("yyy").Path("/xxx/yyy").Dir().Children([]*asset.File{
+				asset.NewFile().Name("hello.txt").Path("/xxx/yyy/hello.txt").Build(),
+			}).Build(),
+			asset.NewFile().Name("zzz.txt").Path("/xxx/zzz.txt").Build(),
+		}).Build(),
+	}).Build()
+	op := &usecase.Operator{
+		User:             &uid,
+		OwningWorkspaces: []id.WorkspaceID{ws.ID()},
+		OwningProjects:   []id.ProjectID{proj.ID()},
+	}
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, proj))
+	lo.Must0(db.Asset.Save(ctx, a))
+	lo.Must0(db.Asset.Save(ctx, a2))
+	lo.Must0(db.AssetFile.Save(ctx, a.ID(), af.Clone()))
+	lo.Must0(db.AssetFile.Save(ctx, a2.ID(), af.Clone()))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mRunner := gatewaymock.NewMockTaskRunner(mockCtrl)
+	assetUC := Asset{
+		repos:       db,
+		gateways:    &gateway.Container{TaskRunner: mRunner},
+		ignoreEvent: true,
+	}
+
+	// the folder extracted from the archive is compressed by default
+	mRunner.EXPECT().Run(ctx, (&task.CompressAssetPayload{
+		AssetID: a.ID().String(),
+		Path:    "51/30c89f-8f67-4766-b127-49ee6796d464/xxx",
+		Output:  "51/30c89f-8f67-4766-b127-49ee6796d464/xxx.compressed.zip",
+	}).Payload()).Times(1).Return(nil)
+	got, err := assetUC.Compress(ctx, interfaces.CompressAssetParam{AssetID: a.ID()}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, lo.ToPtr(asset.ArchiveExtractionStatusInProgress), got.CompressionStatus())
+	assert.Equal(t, "xxx.compressed.zip", got.CompressedPath())
+
+	// the asset cannot be compressed again until the previous result is reported
+	_, err = assetUC.Compress(ctx, interfaces.CompressAssetParam{AssetID: a.ID()}, op)
+	assert.Equal(t, interfaces.ErrAssetCompressionInProgress, err)
+
+	// the result is reported by the task runner
+	got, err = assetUC.UpdateCompression(ctx, a.ID(), lo.ToPtr(asset.ArchiveExtractionStatusDone), &usecase.Operator{Machine: true})
+	assert.NoError(t, err)
+	assert.Equal(t, lo.ToPtr(asset.ArchiveExtractionStatusDone), got.CompressionStatus())
+
+	_, err = assetUC.UpdateCompression(ctx, a.ID(), lo.ToPtr(asset.ArchiveExtractionStatusDone), &usecase.Operator{})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+
+	// sub folder
+	mRunner.EXPECT().Run(ctx, (&task.CompressAssetPayload{
+		AssetID: a.ID().String(),
+		Path:    "51/30c89f-8f67-4766-b127-49ee6796d464/xxx/yyy",
+		Output:  "51/30c89f-8f67-4766-b127-49ee6796d464/xxx/yyy.compressed.zip",
+	}).Payload()).Times(2).Return(nil)
+	got, err = assetUC.Compress(ctx, interfaces.CompressAssetParam{AssetID: a.ID(), Path: lo.ToPtr("/xxx/yyy/")}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "xxx/yyy.compressed.zip", got.CompressedPath())
+	_ = lo.Must(assetUC.UpdateCompression(ctx, a.ID(), lo.ToPtr(asset.ArchiveExtractionStatusFailed), &usecase.Operator{Machine: true}))
+
+	_, err = assetUC.Compress(ctx, interfaces.CompressAssetParam{AssetID: a.ID(), Path: lo.ToPtr("xxx/zzz.txt")}, op)
+	assert.Equal(t, interfaces.ErrAssetDirNotFound, err)
+
+	// paths cannot point outside of the asset directory
+	got, err = assetUC.Compress(ctx, interfaces.CompressAssetParam{AssetID: a.ID(), Path: lo.ToPtr("../../xxx/yyy")}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "xxx/yyy.compressed.zip", got.CompressedPath())
+
+	_, err = assetUC.Compress(ctx, interfaces.CompressAssetParam{AssetID: a2.ID()}, op)
+	assert.Equal(t, interfaces.ErrAssetNotExtracted, err)
+
+	_, err = assetUC.Compress(ctx, interfaces.CompressAssetParam{AssetID: a.ID()}, &usecase.Operator{User: &uid})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+}
+
 func TestAsset_Delete(t *testing.T) {
 	uid := id.NewUserID()
 
@@ -945,6 +1134,10 @@ func (f *file2) GetURL(*asset.Asset) string {
 	return "xxx"
 }
 
+func (f *file2) GetSignedURL(_ context.Context, a *asset.Asset, _ string, _ time.Duration) (string, error) {
+	return "xxx?signature=" + a.ID().String(), nil
+}
+
 func TestAsset_GetURL(t *testing.T) {
 	uc := &Asset{
 		gateways: &gateway.Container{
@@ -954,6 +1147,25 @@ func TestAsset_GetURL(t *testing.T) {
 	assert.Equal(t, "xxx", uc.GetURL(nil))
 }
 
+func TestAsset_URLResolver(t *testing.T) {
+	ctx := context.Background()
+	ws := id.NewWorkspaceID()
+	p1 := project.New().NewID().Workspace(ws).Publication(project.NewPublication(project.PublicationScopePublic, true)).MustBuild()
+	p2 := project.New().NewID().Workspace(ws).MustBuild()
+	a1 := asset.New().NewID().Project(p1.ID()).CreatedByUser(id.NewUserID()).Size(1).Thread(id.NewThreadID()).NewUUID().MustBuild()
+	a2 := asset.New().NewID().Project(p2.ID()).CreatedByUser(id.NewUserID()).Size(1).Thread(id.NewThreadID()).NewUUID().MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, p1))
+	lo.Must0(db.Project.Save(ctx, p2))
+	uc := newAsset(db, &gateway.Container{File: &file2{}}, 0)
+
+	r := uc.URLResolver(ctx, asset.List{a1}, nil)
+	assert.Equal(t, "xxx", r(a1))
+	// the project of the asset is fetched lazily
+	assert.Equal(t, "xxx?signature="+a2.ID().String(), r(a2))
+}
+
 func mockFs() afero.Fs {
 	files := map[string]string{
 		"assets/51/30c89f-8f67-4766-b127-49ee6796d464/xxx.zip":           "xxx",
@@ -1026,3 +1238,34 @@ func Test_detectPreviewType(t *testing.T) {
 		})
 	}
 }
+
+func TestAsset_detectBBox(t *testing.T) {
+	files := map[string]string{
+		"assets/51/30c89f-8f67-4766-b127-49ee6796d464/a/tileset.json":       `{"root": {"boundingVolume": {"region": [2.4, 0.6, 2.5, 0.7, 0, 100]}}}`,
+		"assets/51/30c89f-8f67-4766-b127-49ee6796d464/a/child/tileset.json": `{"root": {"boundingVolume": {"region": [0, 0, 3, 1.5, 0, 100]}}}`,
+		"assets/51/30c89f-8f67-4766-b127-49ee6796d464/b.geojson":            `{"type": "Point", "coordinates": [150, 30]}`,
+		"assets/51/30c89f-8f67-4766-b127-49ee6796d464/c.geojson":            `{`,
+	}
+	mfs := afero.NewMemMapFs()
+	for name, content := range files {
+		lo.Must0(afero.WriteFile(mfs, name, []byte(content), 0644))
+	}
+
+	assetUC := Asset{
+		gateways: &gateway.Container{
+			File: lo.Must(fs.NewFile(mfs, "", nil)),
+		},
+	}
+	got := assetUC.detectBBox(context.Background(), "5130c89f-8f67-4766-b127-49ee6796d464", []gateway.FileEntry{
+		{Name: "a/child/tileset.json"},
+		{Name: "a/tileset.json"},
+		{Name: "b.geojson"},
+		{Name: "c.geojson"},
+		{Name: "d.txt"},
+	})
+	assert.InDeltaSlice(t, []float64{137.5099, 30, 150, 40.1070}, got, 1e-4)
+
+	assert.Nil(t, assetUC.detectBBox(context.Background(), "5130c89f-8f67-4766-b127-49ee6796d464", []gateway.FileEntry{
+		{Name: "d.txt"},
+	}))
+}
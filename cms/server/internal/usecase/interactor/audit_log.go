@@ -0,0 +1,49 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type AuditLog struct {
+	repos    *repo.Container
+	gateways *gateway.Container
+}
+
+func NewAuditLog(r *repo.Container, g *gateway.Container) interfaces.AuditLog {
+	return &AuditLog{
+		repos:    r,
+		gateways: g,
+	}
+}
+
+func (i *AuditLog) Search(ctx context.Context, f interfaces.AuditLogFilter, p *usecasex.Pagination, operator *usecase.Operator) ([]*event.Event[any], *usecasex.PageInfo, error) {
+	if f.Workspace == nil && f.Project == nil {
+		return nil, nil, interfaces.ErrAuditLogScopeRequired
+	}
+	// audit logs include member changes, so only maintainers can see them
+	if f.Workspace != nil && !operator.IsMaintainingWorkspace(*f.Workspace) {
+		return nil, nil, interfaces.ErrOperationDenied
+	}
+	if f.Project != nil && !operator.IsMaintainingProject(*f.Project) {
+		return nil, nil, interfaces.ErrOperationDenied
+	}
+
+	return i.repos.Event.Search(ctx, repo.EventFilter{
+		Workspace:   f.Workspace,
+		Project:     f.Project,
+		Model:       f.Model,
+		Item:        f.Item,
+		User:        f.User,
+		Integration: f.Integration,
+		Types:       f.Types,
+		From:        f.From,
+		To:          f.To,
+	}, p)
+}
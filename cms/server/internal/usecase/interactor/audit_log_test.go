@@ -0,0 +1,104 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLog_Search(t *testing.T) {
+	u1 := user.New().NewID().Email("aaa@example.com").Name("aaa").MustBuild()
+	u2 := user.New().NewID().Email("bbb@example.com").Name("bbb").MustBuild()
+	ws := user.NewWorkspace().NewID().Members(map[id.UserID]user.Member{u1.ID(): {Role: user.RoleOwner}}).MustBuild()
+	prj := project.New().NewID().Workspace(ws.ID()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.User.Save(ctx, u1))
+	lo.Must0(db.User.Save(ctx, u2))
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	lo.Must0(db.Project.Save(ctx, prj))
+
+	op := &usecase.Operator{
+		User:                   u1.ID().Ref(),
+		ReadableWorkspaces:     []id.WorkspaceID{ws.ID()},
+		WritableWorkspaces:     []id.WorkspaceID{ws.ID()},
+		MaintainableWorkspaces: []id.WorkspaceID{ws.ID()},
+		OwningWorkspaces:       []id.WorkspaceID{ws.ID()},
+		ReadableProjects:       []id.ProjectID{prj.ID()},
+		WritableProjects:       []id.ProjectID{prj.ID()},
+		MaintainableProjects:   []id.ProjectID{prj.ID()},
+		OwningProjects:         []id.ProjectID{prj.ID()},
+	}
+
+	m, err := NewModel(db, nil).Create(ctx, interfaces.CreateModelParam{ProjectId: prj.ID(), Key: lo.ToPtr("model")}, op)
+	assert.NoError(t, err)
+	_, err = NewSchema(db, nil).CreateField(ctx, interfaces.CreateFieldParam{
+		SchemaId:     m.Schema(),
+		Name:         "title",
+		Key:          "title",
+		TypeProperty: schema.NewText(nil).TypeProperty(),
+	}, op)
+	assert.NoError(t, err)
+	wsUC := NewWorkspace(db, nil)
+	_, err = wsUC.AddUserMember(ctx, ws.ID(), map[id.UserID]user.Role{u2.ID(): user.RoleReader}, op)
+	assert.NoError(t, err)
+	_, err = wsUC.UpdateUser(ctx, ws.ID(), u2.ID(), user.RoleWriter, op)
+	assert.NoError(t, err)
+
+	uc := NewAuditLog(db, nil)
+
+	// workspace
+	got, _, err := uc.Search(ctx, interfaces.AuditLogFilter{Workspace: ws.ID().Ref()}, nil, op)
+	assert.NoError(t, err)
+	assert.Equal(t, []event.Type{event.MemberUpdate, event.MemberAdd, event.FieldCreate, event.ModelCreate}, lo.Map(got, func(e *event.Event[any], _ int) event.Type { return e.Type() }))
+	assert.Equal(t, &event.Member{User: u2.ID().Ref(), Role: string(user.RoleWriter)}, got[0].Member())
+	assert.Equal(t, &event.Member{User: u2.ID().Ref(), Role: string(user.RoleReader)}, got[1].Member())
+	assert.Equal(t, u1.ID().Ref(), got[0].Operator().User())
+
+	// model
+	got, _, err = uc.Search(ctx, interfaces.AuditLogFilter{Project: prj.ID().Ref(), Model: m.ID().Ref()}, nil, op)
+	assert.NoError(t, err)
+	assert.Equal(t, []event.Type{event.FieldCreate, event.ModelCreate}, lo.Map(got, func(e *event.Event[any], _ int) event.Type { return e.Type() }))
+
+	// events of members are not recorded when events are ignored
+	wsUC2 := NewWorkspace(db, nil).(*Workspace)
+	wsUC2.ignoreEvent = true
+	_, err = wsUC2.UpdateUser(ctx, ws.ID(), u2.ID(), user.RoleReader, op)
+	assert.NoError(t, err)
+	got, _, err = uc.Search(ctx, interfaces.AuditLogFilter{Workspace: ws.ID().Ref()}, nil, op)
+	assert.NoError(t, err)
+	assert.Len(t, got, 4)
+
+	// event types
+	got, _, err = uc.Search(ctx, interfaces.AuditLogFilter{Workspace: ws.ID().Ref(), Types: []event.Type{event.MemberAdd}}, nil, op)
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+
+	// scope is required
+	_, _, err = uc.Search(ctx, interfaces.AuditLogFilter{}, nil, op)
+	assert.ErrorIs(t, err, interfaces.ErrAuditLogScopeRequired)
+
+	// only maintainers can see audit logs
+	op2 := &usecase.Operator{
+		User:               u2.ID().Ref(),
+		ReadableWorkspaces: []id.WorkspaceID{ws.ID()},
+		WritableWorkspaces: []id.WorkspaceID{ws.ID()},
+		ReadableProjects:   []id.ProjectID{prj.ID()},
+		WritableProjects:   []id.ProjectID{prj.ID()},
+	}
+	_, _, err = uc.Search(ctx, interfaces.AuditLogFilter{Workspace: ws.ID().Ref()}, nil, op2)
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+	_, _, err = uc.Search(ctx, interfaces.AuditLogFilter{Project: prj.ID().Ref()}, nil, op2)
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+}
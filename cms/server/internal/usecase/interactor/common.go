@@ -2,26 +2,35 @@ package interactor
 
 import (
 	"context"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/task"
+	webhookpkg "github.com/reearth/reearth-cms/worker/pkg/webhook"
 	"github.com/reearth/reearthx/util"
 )
 
 type ContainerConfig struct {
 	SignupSecret    string
 	AuthSrvUIDomain string
+	// SignedURLExpiry is the lifetime of signed asset URLs. The default is used when it is zero.
+	SignedURLExpiry time.Duration
 }
 
 func New(r *repo.Container, g *gateway.Container, config ContainerConfig) interfaces.Container {
 	return interfaces.Container{
-		Asset:       NewAsset(r, g),
+		Asset:       newAsset(r, g, config.SignedURLExpiry),
 		Workspace:   NewWorkspace(r, g),
 		User:        NewUser(r, g, config.SignupSecret, config.AuthSrvUIDomain),
 		Project:     NewProject(r, g),
@@ -31,12 +40,18 @@ func New(r *repo.Container, g *gateway.Container, config ContainerConfig) interf
 		Schema:      NewSchema(r, g),
 		Integration: NewIntegration(r, g),
 		Thread:      NewThread(r, g),
+		Task:        NewTask(r, g),
+		AuditLog:    NewAuditLog(r, g),
 	}
 }
 
 type Event struct {
-	Project       *project.Project
-	Workspace     id.WorkspaceID
+	Project   *project.Project
+	Workspace id.WorkspaceID
+	// Model is the model which the event is about. It is derived from the object when it is nil.
+	Model *id.ModelID
+	// Member is the workspace member affected by member events
+	Member        *event.Member
 	Type          event.Type
 	Operator      operator.Operator
 	Object        any
@@ -53,8 +68,37 @@ func (e *Event) EventProject() *event.Project {
 	}
 }
 
+// eventTargets returns the model and the item which the object of an event belongs to
+func eventTargets(obj any) (*id.ModelID, *id.ItemID) {
+	switch o := obj.(type) {
+	case *item.Item:
+		return o.Model().Ref(), o.ID().Ref()
+	case item.Versioned:
+		return o.Value().Model().Ref(), o.Value().ID().Ref()
+	case *model.Model:
+		return o.ID().Ref(), nil
+	}
+	return nil, nil
+}
+
 func createEvent(ctx context.Context, r *repo.Container, g *gateway.Container, e Event) (*event.Event[any], error) {
-	ev, err := event.New[any]().NewID().Object(e.Object).Type(e.Type).Project(e.EventProject()).Timestamp(util.Now()).Operator(e.Operator).Build()
+	mid, iid := eventTargets(e.Object)
+	if e.Model != nil {
+		mid = e.Model
+	}
+
+	ev, err := event.New[any]().
+		NewID().
+		Object(e.Object).
+		Type(e.Type).
+		Project(e.EventProject()).
+		Workspace(e.Workspace.Ref()).
+		Model(mid).
+		Item(iid).
+		Member(e.Member).
+		Timestamp(util.Now()).
+		Operator(e.Operator).
+		Build()
 	if err != nil {
 		return nil, err
 	}
@@ -86,15 +130,61 @@ func webhook(ctx context.Context, r *repo.Container, g *gateway.Container, e Eve
 		return err
 	}
 
-	for _, w := range integrations.ActiveWebhooks(ev.Type()) {
-		if err := g.TaskRunner.Run(ctx, task.WebhookPayload{
-			Webhook:  w,
-			Event:    ev,
-			Override: e.WebhookObject,
-		}.Payload()); err != nil {
-			return err
+	var payload string
+	for _, in := range integrations {
+		for _, w := range in.ActiveWebhooks(ev.Type()) {
+			if payload == "" {
+				if payload, err = webhookPayload(ev, e.WebhookObject, g.File.GetURL); err != nil {
+					return err
+				}
+			}
+
+			d, err := integration.NewDelivery().
+				NewID().
+				Integration(in.ID()).
+				Webhook(w.ID()).
+				Event(ev.ID()).
+				EventType(ev.Type()).
+				URL(w.URL().String()).
+				Payload(payload).
+				Build()
+			if err != nil {
+				return err
+			}
+			d.Dispatch(integration.DefaultRetryPolicy)
+
+			if err := r.WebhookDelivery.Save(ctx, d); err != nil {
+				return err
+			}
+
+			if err := g.TaskRunner.Run(ctx, task.WebhookPayload{
+				Webhook:  w,
+				Delivery: d,
+			}.Payload()); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
+
+// webhookPayload renders the request body of the event. It is stored on deliveries so that retries and redeliveries send the same body.
+func webhookPayload(ev *event.Event[any], override any, urlResolver asset.URLResolver) (string, error) {
+	ed, err := integrationapi.NewEventWith(ev, override, "", urlResolver)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := webhookpkg.Webhook{
+		Timestamp: ed.Timestamp,
+		EventID:   ed.ID,
+		EventType: ed.Type,
+		EventData: ed.Data,
+		Operator:  ed.Operator,
+	}.RequestBody()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
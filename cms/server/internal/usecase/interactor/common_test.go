@@ -2,11 +2,13 @@ package interactor
 
 import (
 	"context"
+	"encoding/json"
 	"net/url"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway/gatewaymock"
@@ -19,6 +21,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,6 +44,7 @@ func TestCommon_createEvent(t *testing.T) {
 	mRunner := gatewaymock.NewMockTaskRunner(mockCtrl)
 	gw := &gateway.Container{
 		TaskRunner: mRunner,
+		File:       lo.Must(fs.NewFile(afero.NewMemMapFs(), "https://example.com/assets", nil)),
 	}
 
 	ctx := context.Background()
@@ -55,7 +59,8 @@ func TestCommon_createEvent(t *testing.T) {
 		Operator:  operator.OperatorFromUser(uID),
 	})
 	assert.NoError(t, err)
-	expectedEv := event.New[any]().ID(ev.ID()).Timestamp(now).Type(event.AssetCreate).Operator(operator.OperatorFromUser(uID)).Object(a).MustBuild()
+	expectedEv := event.New[any]().ID(ev.ID()).Timestamp(now).Type(event.AssetCreate).Operator(operator.OperatorFromUser(uID)).
+		Workspace(workspace.ID().Ref()).Object(a).MustBuild()
 	assert.Equal(t, expectedEv, ev)
 }
 
@@ -65,6 +70,9 @@ func TestCommon_webhook(t *testing.T) {
 	a := asset.New().NewID().Thread(asset.NewThreadID()).NewUUID().
 		Project(project.NewID()).Size(100).CreatedByUser(uID).
 		MustBuild()
+	a2 := asset.New().NewID().Thread(asset.NewThreadID()).NewUUID().
+		Project(project.NewID()).Size(100).CreatedByUser(uID).
+		MustBuild()
 	workspace := user.NewWorkspace().NewID().MustBuild()
 	wh := integration.NewWebhookBuilder().NewID().Name("aaa").
 		Url(lo.Must(url.Parse("https://example.com"))).Active(true).
@@ -81,6 +89,7 @@ func TestCommon_webhook(t *testing.T) {
 	mRunner := gatewaymock.NewMockTaskRunner(mockCtrl)
 	gw := &gateway.Container{
 		TaskRunner: mRunner,
+		File:       lo.Must(fs.NewFile(afero.NewMemMapFs(), "https://example.com/assets", nil)),
 	}
 
 	ctx := context.Background()
@@ -98,10 +107,29 @@ func TestCommon_webhook(t *testing.T) {
 	assert.NoError(t, err)
 
 	lo.Must0(db.Integration.Save(ctx, integration))
-	mRunner.EXPECT().Run(ctx, task.WebhookPayload{
-		Webhook: wh,
-		Event:   ev,
-	}.Payload()).Times(1).Return(nil)
-	err = webhook(ctx, db, gw, Event{Workspace: workspace.ID()}, ev)
+	var p task.Payload
+	mRunner.EXPECT().Run(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, p2 task.Payload) error {
+		p = p2
+		return nil
+	})
+	err = webhook(ctx, db, gw, Event{Workspace: workspace.ID(), WebhookObject: a2}, ev)
+	assert.NoError(t, err)
+	assert.Equal(t, wh, p.Webhook.Webhook)
+
+	// delivery is recorded
+	d := p.Webhook.Delivery
+	assert.Equal(t, integration.ID(), d.Integration())
+	assert.Equal(t, wh.ID(), d.Webhook())
+	assert.Equal(t, ev.ID(), d.Event())
+	assert.Equal(t, event.Type(event.AssetCreate), d.EventType())
+	assert.Equal(t, "https://example.com", d.URL())
+	assert.Equal(t, 1, d.NextAttempt())
+	// the body is rendered with the overridden object
+	var body map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(d.Payload()), &body))
+	assert.Equal(t, ev.ID().String(), body["id"])
+	assert.Equal(t, a2.ID().String(), body["data"].(map[string]any)["id"])
+	got, err := db.WebhookDelivery.FindByID(ctx, d.ID())
 	assert.NoError(t, err)
+	assert.Equal(t, d, got)
 }
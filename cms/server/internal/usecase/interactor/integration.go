@@ -11,7 +11,10 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
 
@@ -270,3 +273,177 @@ func (i Integration) DeleteWebhook(ctx context.Context, iId id.IntegrationID, wI
 			return nil
 		})
 }
+
+func (i Integration) FindWebhookDeliveries(ctx context.Context, iId id.IntegrationID, wId id.WebhookID, p *usecasex.Pagination, operator *usecase.Operator) (integration.DeliveryList, *usecasex.PageInfo, error) {
+	if operator.User == nil && operator.Integration == nil {
+		return nil, nil, interfaces.ErrInvalidOperator
+	}
+	return Run2(ctx, operator, i.repos, Usecase().Transaction(),
+		func(ctx context.Context) (integration.DeliveryList, *usecasex.PageInfo, error) {
+			in, err := i.findWebhookIntegration(ctx, iId, operator)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if _, ok := in.Webhook(wId); !ok {
+				return nil, nil, rerror.ErrNotFound
+			}
+
+			return i.repos.WebhookDelivery.FindByWebhook(ctx, wId, p)
+		})
+}
+
+func (i Integration) RedeliverWebhook(ctx context.Context, iId id.IntegrationID, dId id.WebhookDeliveryID, operator *usecase.Operator) (*integration.Delivery, error) {
+	if operator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(),
+		func(ctx context.Context) (*integration.Delivery, error) {
+			in, err := i.findWebhookIntegration(ctx, iId, operator)
+			if err != nil {
+				return nil, err
+			}
+
+			d, err := i.repos.WebhookDelivery.FindByID(ctx, dId)
+			if err != nil {
+				return nil, err
+			}
+			if d.Integration() != in.ID() {
+				return nil, rerror.ErrNotFound
+			}
+
+			w, ok := in.Webhook(d.Webhook())
+			if !ok {
+				return nil, rerror.ErrNotFound
+			}
+
+			d2 := d.Redeliver()
+			d2.Dispatch(integration.DefaultRetryPolicy)
+			if err := i.repos.WebhookDelivery.Save(ctx, d2); err != nil {
+				return nil, err
+			}
+
+			if err := i.dispatchWebhookDelivery(ctx, w, d2); err != nil {
+				return nil, err
+			}
+
+			return d2, nil
+		})
+}
+
+func (i Integration) RecordWebhookDelivery(ctx context.Context, param interfaces.RecordWebhookDeliveryParam, operator *usecase.Operator) error {
+	if !operator.Machine {
+		return interfaces.ErrInvalidOperator
+	}
+	return Run0(ctx, operator, i.repos, Usecase().Transaction(),
+		func(ctx context.Context) error {
+			d, err := i.repos.WebhookDelivery.FindByID(ctx, param.DeliveryID)
+			if err != nil {
+				return err
+			}
+
+			a := integration.NewDeliveryAttempt(param.At, param.StatusCode, param.Latency, param.Error)
+			if !d.Record(a, param.Attempt, param.Payload, integration.DefaultRetryPolicy) {
+				// duplicated or outdated report
+				return nil
+			}
+
+			return i.repos.WebhookDelivery.Save(ctx, d)
+		})
+}
+
+// RetryWebhookDeliveries dispatches failed deliveries whose next attempt time has come.
+// Pending deliveries whose results have not been reported in time are recorded as failed so that they are retried later or dead-lettered.
+func (i Integration) RetryWebhookDeliveries(ctx context.Context, operator *usecase.Operator) error {
+	if !operator.Machine {
+		return interfaces.ErrInvalidOperator
+	}
+	return Run0(ctx, operator, i.repos, Usecase().Transaction(),
+		func(ctx context.Context) error {
+			now := util.Now()
+			deliveries, err := i.repos.WebhookDelivery.FindDue(ctx, now)
+			if err != nil {
+				return err
+			}
+
+			for _, d := range deliveries {
+				if d.Status() == integration.DeliveryStatusPending {
+					if d.Timeout(now, integration.DefaultRetryPolicy) {
+						if err := i.repos.WebhookDelivery.Save(ctx, d); err != nil {
+							return err
+						}
+					}
+					continue
+				}
+
+				var w *integration.Webhook
+				in, err := i.repos.Integration.FindByID(ctx, d.Integration())
+				if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+					return err
+				}
+				if in != nil {
+					w, _ = in.Webhook(d.Webhook())
+				}
+
+				if w == nil || !w.Active() {
+					d.Kill()
+				} else {
+					d.Dispatch(integration.DefaultRetryPolicy)
+				}
+
+				if err := i.repos.WebhookDelivery.Save(ctx, d); err != nil {
+					return err
+				}
+
+				if d.Status() == integration.DeliveryStatusDead {
+					continue
+				}
+
+				if err := i.dispatchWebhookDelivery(ctx, w, d); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+}
+
+// findWebhookIntegration returns the integration when the operator is its developer or the integration itself
+func (i Integration) findWebhookIntegration(ctx context.Context, iId id.IntegrationID, operator *usecase.Operator) (*integration.Integration, error) {
+	in, err := i.repos.Integration.FindByID(ctx, iId)
+	if err != nil {
+		return nil, err
+	}
+
+	if operator.Integration != nil {
+		if *operator.Integration != in.ID() {
+			return nil, interfaces.ErrOperationDenied
+		}
+	} else if operator.User == nil || in.Developer() != *operator.User {
+		return nil, interfaces.ErrOperationDenied
+	}
+
+	return in, nil
+}
+
+func (i Integration) dispatchWebhookDelivery(ctx context.Context, w *integration.Webhook, d *integration.Delivery) error {
+	if i.gateways == nil || i.gateways.TaskRunner == nil {
+		return nil
+	}
+
+	p := task.WebhookPayload{
+		Webhook:  w,
+		Delivery: d,
+	}
+
+	// the stored payload is sent as is. only deliveries created before payloads were stored at dispatch need the event to be rendered again
+	if d.Payload() == "" {
+		ev, err := i.repos.Event.FindByID(ctx, d.Event())
+		if err != nil {
+			return err
+		}
+		p.Event = ev
+	}
+
+	return i.gateways.TaskRunner.Run(ctx, p.Payload())
+}
@@ -7,13 +7,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
 	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway/gatewaymock"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
@@ -599,3 +605,83 @@ func TestNewIntegration(t *testing.T) {
 	r := memory.New()
 	assert.Equal(t, &Integration{repos: r}, NewIntegration(r, nil))
 }
+
+func TestIntegration_WebhookDeliveries(t *testing.T) {
+	ts := testSuite()
+	ctx := context.Background()
+	db := memory.New()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mRunner := gatewaymock.NewMockTaskRunner(mockCtrl)
+	i := Integration{repos: db, gateways: &gateway.Container{TaskRunner: mRunner}}
+
+	w := integration.NewWebhookBuilder().NewID().Name("w").Url(ts.Uri).Active(true).MustBuild()
+	in := ts.I1.Clone()
+	in.AddWebhook(w)
+	lo.Must0(db.Integration.Save(ctx, in))
+	lo.Must0(db.Integration.Save(ctx, ts.I2.Clone()))
+
+	d := integration.NewDelivery().NewID().Integration(in.ID()).Webhook(w.ID()).Event(id.NewEventID()).EventType(event.ItemCreate).URL(ts.Uri.String()).MustBuild()
+	lo.Must0(db.WebhookDelivery.Save(ctx, d))
+	machine := &usecase.Operator{Machine: true}
+
+	// list
+	got, _, err := i.FindWebhookDeliveries(ctx, in.ID(), w.ID(), nil, ts.Op)
+	assert.NoError(t, err)
+	assert.Equal(t, integration.DeliveryList{d}, got)
+
+	got, _, err = i.FindWebhookDeliveries(ctx, in.ID(), w.ID(), nil, &usecase.Operator{Integration: lo.ToPtr(in.ID())})
+	assert.NoError(t, err)
+	assert.Equal(t, integration.DeliveryList{d}, got)
+
+	_, _, err = i.FindWebhookDeliveries(ctx, in.ID(), w.ID(), nil, &usecase.Operator{Integration: lo.ToPtr(ts.IId2)})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	_, _, err = i.FindWebhookDeliveries(ctx, in.ID(), id.NewWebhookID(), nil, ts.Op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	// record
+	assert.Equal(t, interfaces.ErrInvalidOperator, i.RecordWebhookDelivery(ctx, interfaces.RecordWebhookDeliveryParam{DeliveryID: d.ID()}, ts.Op))
+
+	assert.NoError(t, i.RecordWebhookDelivery(ctx, interfaces.RecordWebhookDeliveryParam{
+		DeliveryID: d.ID(),
+		Attempt:    1,
+		At:         ts.Now.Add(-time.Hour),
+		Payload:    `{"a":1}`,
+		StatusCode: 500,
+		Latency:    time.Second,
+	}, machine))
+	d2, _ := db.WebhookDelivery.FindByID(ctx, d.ID())
+	assert.Equal(t, integration.DeliveryStatusRetrying, d2.Status())
+	assert.Equal(t, `{"a":1}`, d2.Payload())
+	assert.Equal(t, 500, d2.LastAttempt().StatusCode())
+
+	// retry
+	mRunner.EXPECT().Run(ctx, task.WebhookPayload{Webhook: w, Delivery: d2}.Payload()).Times(1).Return(nil)
+	assert.NoError(t, i.RetryWebhookDeliveries(ctx, machine))
+	d2, _ = db.WebhookDelivery.FindByID(ctx, d.ID())
+	assert.Equal(t, integration.DeliveryStatusPending, d2.Status())
+	assert.Equal(t, 2, d2.NextAttempt())
+
+	// nothing to retry
+	assert.NoError(t, i.RetryWebhookDeliveries(ctx, machine))
+
+	// the result of the dispatched attempt is not reported by the deadline
+	unmock := util.MockNow(d2.NextAttemptAt().Add(time.Second))
+	assert.NoError(t, i.RetryWebhookDeliveries(ctx, machine))
+	unmock()
+	d2, _ = db.WebhookDelivery.FindByID(ctx, d.ID())
+	assert.Equal(t, integration.DeliveryStatusRetrying, d2.Status())
+	assert.Equal(t, 3, d2.NextAttempt())
+
+	// redeliver
+	mRunner.EXPECT().Run(ctx, gomock.Any()).Times(1).Return(nil)
+	d3, err := i.RedeliverWebhook(ctx, in.ID(), d.ID(), ts.Op)
+	assert.NoError(t, err)
+	assert.NotEqual(t, d.ID(), d3.ID())
+	assert.Equal(t, `{"a":1}`, d3.Payload())
+	assert.Equal(t, integration.DeliveryStatusPending, d3.Status())
+
+	_, err = i.RedeliverWebhook(ctx, ts.IId2, d.ID(), &usecase.Operator{Integration: lo.ToPtr(ts.IId2)})
+	assert.Equal(t, rerror.ErrNotFound, err)
+}
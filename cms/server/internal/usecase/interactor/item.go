@@ -2,21 +2,30 @@ package interactor
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
@@ -37,16 +46,24 @@ func NewItem(r *repo.Container, g *gateway.Container) *Item {
 	}
 }
 
-func (i Item) FindByID(ctx context.Context, itemID id.ItemID, _ *usecase.Operator) (item.Versioned, error) {
-	return i.repos.Item.FindByID(ctx, itemID, nil)
+func (i Item) FindByID(ctx context.Context, itemID id.ItemID, operator *usecase.Operator) (item.Versioned, error) {
+	itm, err := i.repos.Item.FindByID(ctx, itemID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !canReadItem(itm.Value(), operator) {
+		return nil, rerror.ErrNotFound
+	}
+	return itm, nil
 }
 
 func (i Item) FindPublicByID(ctx context.Context, itemID id.ItemID, _ *usecase.Operator) (item.Versioned, error) {
 	return i.repos.Item.FindByID(ctx, itemID, version.Public.Ref())
 }
 
-func (i Item) FindByIDs(ctx context.Context, ids id.ItemIDList, _ *usecase.Operator) (item.VersionedList, error) {
-	return i.repos.Item.FindByIDs(ctx, ids, nil)
+func (i Item) FindByIDs(ctx context.Context, ids id.ItemIDList, operator *usecase.Operator) (item.VersionedList, error) {
+	res, err := i.repos.Item.FindByIDs(ctx, ids, nil)
+	return filterReadableItems(res, operator), err
 }
 
 func (i Item) ItemStatus(ctx context.Context, itemsIds id.ItemIDList, _ *usecase.Operator) (map[id.ItemID]item.Status, error) {
@@ -102,7 +119,8 @@ func (i Item) FindByProject(ctx context.Context, projectID id.ProjectID, p *usec
 		return nil, nil, rerror.ErrNotFound
 	}
 	// TODO: check operation for projects that publication type is limited
-	return i.repos.Item.FindByProject(ctx, projectID, nil, p)
+	res, page, err := i.repos.Item.FindByProject(ctx, projectID, nil, p)
+	return filterReadableItems(res, operator), page, err
 }
 
 func (i Item) FindByModel(ctx context.Context, modelID id.ModelID, p *usecasex.Pagination, operator *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error) {
@@ -110,7 +128,7 @@ func (i Item) FindByModel(ctx context.Context, modelID id.ModelID, p *usecasex.P
 	if err != nil {
 		return nil, nil, err
 	}
-	if !operator.IsReadableProject(m.Project()) {
+	if !operator.Can(user.ActionRead, m.Project(), m.ID().Ref(), operator.IsReadableProject(m.Project())) {
 		return nil, nil, rerror.ErrNotFound
 	}
 	return i.repos.Item.FindByModel(ctx, m.ID(), nil, p)
@@ -125,22 +143,26 @@ func (i Item) FindPublicByModel(ctx context.Context, modelID id.ModelID, p *usec
 	return i.repos.Item.FindByModel(ctx, m.ID(), version.Public.Ref(), p)
 }
 
-func (i Item) FindBySchema(ctx context.Context, schemaID id.SchemaID, sort *usecasex.Sort, p *usecasex.Pagination, _ *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error) {
+func (i Item) FindBySchema(ctx context.Context, schemaID id.SchemaID, sort *usecasex.Sort, p *usecasex.Pagination, operator *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error) {
 	s, err := i.repos.Schema.FindByID(ctx, schemaID)
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := i.checkReadableSchema(ctx, s.Project(), s.ID(), operator); err != nil {
+		return nil, nil, err
+	}
 
 	sfIds := s.Fields().IDs()
 	res, page, err := i.repos.Item.FindBySchema(ctx, schemaID, nil, sort, p)
 	return res.FilterFields(sfIds), page, err
 }
 
-func (i Item) FindByAssets(ctx context.Context, list id.AssetIDList, _ *usecase.Operator) (map[id.AssetID]item.VersionedList, error) {
+func (i Item) FindByAssets(ctx context.Context, list id.AssetIDList, operator *usecase.Operator) (map[id.AssetID]item.VersionedList, error) {
 	itms, err := i.repos.Item.FindByAssets(ctx, list, nil)
 	if err != nil {
 		return nil, err
 	}
+	itms = filterReadableItems(itms, operator)
 	res := map[id.AssetID]item.VersionedList{}
 	for _, aid := range list {
 		for _, itm := range itms {
@@ -152,12 +174,62 @@ func (i Item) FindByAssets(ctx context.Context, list id.AssetIDList, _ *usecase.
 	return res, nil
 }
 
-func (i Item) FindAllVersionsByID(ctx context.Context, itemID id.ItemID, _ *usecase.Operator) (item.VersionedList, error) {
-	return i.repos.Item.FindAllVersionsByID(ctx, itemID)
+func (i Item) FindBackReferences(ctx context.Context, itemID id.ItemID, operator *usecase.Operator) (item.VersionedList, error) {
+	itm, err := i.FindByID(ctx, itemID, operator)
+	if err != nil {
+		return nil, err
+	}
+	res, err := i.repos.Item.FindByReferences(ctx, id.ItemIDList{itm.Value().ID()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	// items of other projects can reference the item, which the operator may not be able to read
+	res = lo.Filter(res, func(v item.Versioned, _ int) bool {
+		return operator.Machine || operator.IsReadableProject(v.Value().Project())
+	})
+	return filterReadableItems(res, operator), nil
+}
+
+func (i Item) FindAllVersionsByID(ctx context.Context, itemID id.ItemID, operator *usecase.Operator) (item.VersionedList, error) {
+	res, err := i.repos.Item.FindAllVersionsByID(ctx, itemID)
+	return filterReadableItems(res, operator), err
 }
 
-func (i Item) Search(ctx context.Context, q *item.Query, sort *usecasex.Sort, p *usecasex.Pagination, _ *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error) {
-	return i.repos.Item.Search(ctx, q, sort, p)
+// Diff returns the changes of fields between two versions of the item
+func (i Item) Diff(ctx context.Context, param interfaces.DiffItemParam, operator *usecase.Operator) ([]item.FieldDiff, error) {
+	versions, err := i.FindAllVersionsByID(ctx, param.ItemID, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	from := findItemVersion(versions, param.From.OrRef())
+	to := findItemVersion(versions, version.Latest.OrVersion())
+	if param.To != nil {
+		to = findItemVersion(versions, param.To.OrRef())
+	}
+	if from == nil || to == nil {
+		return nil, rerror.ErrNotFound
+	}
+
+	return from.Value().Diff(to.Value()), nil
+}
+
+func (i Item) Search(ctx context.Context, q *item.Query, sort *usecasex.Sort, p *usecasex.Pagination, operator *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error) {
+	if q.Schema() != nil {
+		if err := i.checkReadableSchema(ctx, q.Project(), *q.Schema(), operator); err != nil {
+			return nil, nil, err
+		}
+	}
+	if sf := q.SpatialFilter(); sf != nil {
+		// items also match when their assets overlap the area
+		assets, err := i.repos.Asset.FindByArea(ctx, q.Project(), sf.Area())
+		if err != nil {
+			return nil, nil, err
+		}
+		q = q.WithSpatialFilter(sf.WithAssets(asset.List(assets).IDs()))
+	}
+	res, page, err := i.repos.Item.Search(ctx, q, sort, p)
+	return filterReadableItems(res, operator), page, err
 }
 
 func (i Item) Create(ctx context.Context, param interfaces.CreateItemParam, operator *usecase.Operator) (item.Versioned, error) {
@@ -181,15 +253,19 @@ func (i Item) Create(ctx context.Context, param interfaces.CreateItemParam, oper
 			return nil, err
 		}
 
-		if !operator.IsWritableWorkspace(s.Workspace()) {
+		if !operator.Can(user.ActionCreate, m.Project(), m.ID().Ref(), operator.IsWritableWorkspace(s.Workspace())) {
 			return nil, interfaces.ErrOperationDenied
 		}
 
-		fields, err := itemFieldsFromParams(param.Fields, s)
+		fields, err := itemFieldsFromParams(param.Fields, s, nil)
 		if err != nil {
 			return nil, err
 		}
 
+		if err := validateRequiredConditions(fields, s); err != nil {
+			return nil, err
+		}
+
 		if err := i.checkUnique(ctx, fields, s, m.ID(), nil); err != nil {
 			return nil, err
 		}
@@ -223,6 +299,11 @@ func (i Item) Create(ctx context.Context, param interfaces.CreateItemParam, oper
 			return nil, err
 		}
 
+		applyItemGroup(it, param.Group, prj, s)
+		if !operator.CanAssignItemGroup(prj.ID(), it.Group()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
 		if err := i.repos.Item.Save(ctx, it); err != nil {
 			return nil, err
 		}
@@ -259,7 +340,7 @@ func (i Item) Update(ctx context.Context, param interfaces.UpdateItemParam, oper
 	if operator.User == nil && operator.Integration == nil {
 		return nil, interfaces.ErrInvalidOperator
 	}
-	if len(param.Fields) == 0 {
+	if len(param.Fields) == 0 && param.Group == nil {
 		return nil, interfaces.ErrItemFieldRequired
 	}
 
@@ -270,6 +351,9 @@ func (i Item) Update(ctx context.Context, param interfaces.UpdateItemParam, oper
 		}
 
 		itv := itm.Value()
+		if !operator.CanAccessItemGroup(itv.Project(), itv.Group()) {
+			return nil, rerror.ErrNotFound
+		}
 		if !operator.CanUpdate(itv) {
 			return nil, interfaces.ErrOperationDenied
 		}
@@ -289,7 +373,7 @@ func (i Item) Update(ctx context.Context, param interfaces.UpdateItemParam, oper
 			return nil, err
 		}
 
-		fields, err := itemFieldsFromParams(param.Fields, s)
+		fields, err := itemFieldsFromParams(param.Fields, s, itv)
 		if err != nil {
 			return nil, err
 		}
@@ -299,6 +383,15 @@ func (i Item) Update(ctx context.Context, param interfaces.UpdateItemParam, oper
 		}
 
 		itv.UpdateFields(fields)
+		if err := validateRequiredConditions(itv.Fields(), s); err != nil {
+			return nil, err
+		}
+
+		applyItemGroup(itv, param.Group, prj, s)
+		if !operator.CanAssignItemGroup(prj.ID(), itv.Group()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
 		if err := i.repos.Item.Save(ctx, itv); err != nil {
 			return nil, err
 		}
@@ -322,6 +415,102 @@ func (i Item) Update(ctx context.Context, param interfaces.UpdateItemParam, oper
 	})
 }
 
+// Rollback creates a new version of the item which has the values of the old version.
+// Fields which have been removed from the schema or whose type has been changed since the old version are dropped.
+func (i Item) Rollback(ctx context.Context, param interfaces.RollbackItemParam, operator *usecase.Operator) (item.Versioned, error) {
+	if operator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (item.Versioned, error) {
+		versions, err := i.repos.Item.FindAllVersionsByID(ctx, param.ItemID)
+		if err != nil {
+			return nil, err
+		}
+
+		old := findItemVersion(versions, param.Version.OrRef())
+		itm := findItemVersion(versions, version.Latest.OrVersion())
+		if old == nil || itm == nil {
+			return nil, rerror.ErrNotFound
+		}
+
+		itv := itm.Value()
+		if !operator.CanAccessItemGroup(itv.Project(), itv.Group()) {
+			return nil, rerror.ErrNotFound
+		}
+		if !operator.CanUpdate(itv) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		m, err := i.repos.Model.FindByID(ctx, itv.Model())
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := i.repos.Schema.FindByID(ctx, itv.Schema())
+		if err != nil {
+			return nil, err
+		}
+
+		prj, err := i.repos.Project.FindByID(ctx, s.Project())
+		if err != nil {
+			return nil, err
+		}
+
+		fields := lo.Filter(old.Value().Fields(), func(f *item.Field, _ int) bool {
+			sf := s.Field(f.FieldID())
+			return sf != nil && sf.Type() == f.Type()
+		})
+		// all fields of the current schema are validated so that fields which have become required since the old version are not restored empty
+		for _, sf := range s.Fields() {
+			f, _ := lo.Find(fields, func(f *item.Field) bool { return f.FieldID() == sf.ID() })
+			if err := validateRestoredField(sf, f); err != nil {
+				return nil, rerror.FmtE(i18n.T("field %s: %w"), sf.Name(), err)
+			}
+		}
+
+		if err := i.checkUnique(ctx, fields, s, itv.Model(), itv); err != nil {
+			return nil, err
+		}
+
+		itv.RestoreFields(fields)
+		if err := validateRequiredConditions(itv.Fields(), s); err != nil {
+			return nil, err
+		}
+
+		applyItemGroup(itv, nil, prj, s)
+		if !operator.CanAssignItemGroup(prj.ID(), itv.Group()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		if err := i.repos.Item.Save(ctx, itv); err != nil {
+			return nil, err
+		}
+
+		res, err := i.repos.Item.FindByID(ctx, param.ItemID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := i.event(ctx, Event{
+			Project:   prj,
+			Workspace: s.Workspace(),
+			Type:      event.ItemUpdate,
+			Object:    res,
+			WebhookObject: item.ItemModelSchema{
+				Item:   res.Value(),
+				Model:  m,
+				Schema: s,
+			},
+			Operator: operator.Operator(),
+		}); err != nil {
+			return nil, err
+		}
+
+		return res, nil
+	})
+}
+
 func (i Item) Delete(ctx context.Context, itemID id.ItemID, operator *usecase.Operator) error {
 	if operator.User == nil && operator.Integration == nil {
 		return interfaces.ErrInvalidOperator
@@ -333,14 +522,192 @@ func (i Item) Delete(ctx context.Context, itemID id.ItemID, operator *usecase.Op
 			return err
 		}
 
-		if !operator.CanUpdate(itm.Value()) {
+		if !operator.CanAccessItemGroup(itm.Value().Project(), itm.Value().Group()) {
+			return rerror.ErrNotFound
+		}
+		if !operator.CanDo(user.ActionDelete, itm.Value()) {
 			return interfaces.ErrOperationDenied
 		}
 
-		return i.repos.Item.Remove(ctx, itemID)
+		return i.deleteWithReferences(ctx, []*item.Item{itm.Value()}, operator)
 	})
 }
 
+// deleteWithReferences deletes the items after applying the on-delete behaviors of reference fields of items which reference them.
+// Items referencing by cascade fields are deleted recursively, and the deletion fails if any remaining item references a deleted item by a restrict field.
+// The operator has to be able to delete the items deleted by cascade and to update the items whose references are removed.
+func (i Item) deleteWithReferences(ctx context.Context, targets []*item.Item, operator *usecase.Operator) error {
+	deleted := slices.Clone(targets)
+	deletedIDs := id.ItemIDList(util.Map(targets, func(t *item.Item) id.ItemID { return t.ID() }))
+	items := map[id.ItemID]*item.Item{}
+	schemas := map[id.SchemaID]*schema.Schema{}
+	// nullified maps items to the deleted items whose references are removed from them
+	nullified := map[id.ItemID]id.ItemIDList{}
+	var restricted id.ItemIDList
+
+	for queue := slices.Clone(targets); len(queue) > 0; queue = queue[1:] {
+		cur := queue[0]
+		// items hidden from the operator are also looked up since their references would be broken
+		refs, err := i.repos.Item.FindAllByReferences(ctx, id.ItemIDList{cur.ID()})
+		if err != nil {
+			return err
+		}
+
+		for _, r := range refs {
+			if deletedIDs.Has(r.Value().ID()) {
+				continue
+			}
+			if !canReadItem(r.Value(), operator) {
+				return interfaces.ErrOperationDenied
+			}
+			ri, ok := items[r.Value().ID()]
+			if !ok {
+				ri = r.Value()
+				items[ri.ID()] = ri
+			}
+
+			s, ok := schemas[ri.Schema()]
+			if !ok {
+				if s, err = i.repos.Schema.FindByID(ctx, ri.Schema()); err != nil {
+					return err
+				}
+				schemas[ri.Schema()] = s
+			}
+
+			switch referenceOnDelete(ri, cur.ID(), s) {
+			case schema.ReferenceOnDeleteRestrict:
+				restricted = append(restricted, ri.ID())
+			case schema.ReferenceOnDeleteCascade:
+				if !operator.CanAccessItemGroup(ri.Project(), ri.Group()) || !operator.CanDo(user.ActionDelete, ri) {
+					return interfaces.ErrOperationDenied
+				}
+				deleted = append(deleted, ri)
+				deletedIDs = append(deletedIDs, ri.ID())
+				queue = append(queue, ri)
+			default:
+				nullified[ri.ID()] = append(nullified[ri.ID()], cur.ID())
+			}
+		}
+	}
+
+	// restricting items can be deleted by cascade of other references
+	if lo.SomeBy(restricted, func(rid id.ItemID) bool { return !deletedIDs.Has(rid) }) {
+		return interfaces.ErrItemReferenced
+	}
+
+	var updated []*item.Item
+	for nid, targets := range nullified {
+		if deletedIDs.Has(nid) {
+			continue
+		}
+		itm := items[nid]
+		if !operator.CanAccessItemGroup(itm.Project(), itm.Group()) || !operator.CanUpdate(itm) {
+			return interfaces.ErrOperationDenied
+		}
+		for _, t := range targets {
+			itm.RemoveReference(t)
+		}
+		if err := i.repos.Item.Save(ctx, itm); err != nil {
+			return err
+		}
+		updated = append(updated, itm)
+	}
+
+	for _, d := range deleted {
+		if err := i.repos.Item.Remove(ctx, d.ID()); err != nil {
+			return err
+		}
+	}
+
+	if i.ignoreEvent {
+		return nil
+	}
+	projects := map[id.ProjectID]*project.Project{}
+	findProject := func(pid id.ProjectID) (*project.Project, error) {
+		p, ok := projects[pid]
+		if !ok {
+			var err error
+			if p, err = i.repos.Project.FindByID(ctx, pid); err != nil {
+				return nil, err
+			}
+			projects[pid] = p
+		}
+		return p, nil
+	}
+
+	for _, u := range updated {
+		p, err := findProject(u.Project())
+		if err != nil {
+			return err
+		}
+		res, err := i.repos.Item.FindByID(ctx, u.ID(), nil)
+		if err != nil {
+			return err
+		}
+		m, err := i.repos.Model.FindByID(ctx, u.Model())
+		if err != nil {
+			return err
+		}
+
+		if err := i.event(ctx, Event{
+			Project:   p,
+			Workspace: p.Workspace(),
+			Type:      event.ItemUpdate,
+			Object:    res,
+			WebhookObject: item.ItemModelSchema{
+				Item:   res.Value(),
+				Model:  m,
+				Schema: schemas[u.Schema()],
+			},
+			Operator: operator.Operator(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, d := range deleted {
+		p, err := findProject(d.Project())
+		if err != nil {
+			return err
+		}
+
+		if err := i.event(ctx, Event{
+			Project:   p,
+			Workspace: p.Workspace(),
+			Type:      event.ItemDelete,
+			Object:    d,
+			Operator:  operator.Operator(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// referenceOnDelete returns the strictest on-delete behavior of the fields of the item which reference the target item
+func referenceOnDelete(itm *item.Item, target id.ItemID, s *schema.Schema) schema.ReferenceOnDelete {
+	res := schema.ReferenceOnDeleteNullify
+	for _, fid := range itm.ReferencingFields(target) {
+		f := s.FieldOrSubField(fid)
+		if f == nil {
+			continue
+		}
+		f.TypeProperty().Match(schema.TypePropertyMatch{
+			Reference: func(fr *schema.FieldReference) {
+				switch fr.OnDelete() {
+				case schema.ReferenceOnDeleteRestrict:
+					res = schema.ReferenceOnDeleteRestrict
+				case schema.ReferenceOnDeleteCascade:
+					if res != schema.ReferenceOnDeleteRestrict {
+						res = schema.ReferenceOnDeleteCascade
+					}
+				}
+			},
+		})
+	}
+	return res
+}
+
 func (i Item) Unpublish(ctx context.Context, itemIDs id.ItemIDList, operator *usecase.Operator) (item.VersionedList, error) {
 	if operator.User == nil && operator.Integration == nil {
 		return nil, interfaces.ErrInvalidOperator
@@ -352,7 +719,7 @@ func (i Item) Unpublish(ctx context.Context, itemIDs id.ItemIDList, operator *us
 		}
 
 		// check all items were found
-		if len(items) != len(itemIDs) {
+		if len(items) != len(itemIDs) || len(filterAccessibleItems(items, operator)) != len(items) {
 			return nil, interfaces.ErrItemMissing
 		}
 
@@ -379,7 +746,7 @@ func (i Item) Unpublish(ctx context.Context, itemIDs id.ItemIDList, operator *us
 			return nil, err
 		}
 
-		if !operator.IsMaintainingWorkspace(prj.Workspace()) {
+		if !operator.Can(user.ActionPublish, prj.ID(), m.ID().Ref(), operator.IsMaintainingWorkspace(prj.Workspace())) {
 			return nil, interfaces.ErrInvalidOperator
 		}
 
@@ -411,6 +778,280 @@ func (i Item) Unpublish(ctx context.Context, itemIDs id.ItemIDList, operator *us
 	})
 }
 
+func (i Item) Schedule(ctx context.Context, param interfaces.ScheduleItemParam, operator *usecase.Operator) (item.Versioned, error) {
+	if operator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	if err := item.ValidateSchedule(param.PublishAt, param.UnpublishAt); err != nil {
+		return nil, err
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (item.Versioned, error) {
+		itm, err := i.repos.Item.FindByID(ctx, param.ItemID, nil)
+		if err != nil {
+			return nil, err
+		}
+		if !operator.CanAccessItemGroup(itm.Value().Project(), itm.Value().Group()) {
+			return nil, rerror.ErrNotFound
+		}
+
+		prj, err := i.repos.Project.FindByID(ctx, itm.Value().Project())
+		if err != nil {
+			return nil, err
+		}
+
+		// same as unpublishing, only maintainers can change the publication of items
+		if !operator.Can(user.ActionPublish, prj.ID(), itm.Value().Model().Ref(), operator.IsMaintainingWorkspace(prj.Workspace())) {
+			return nil, interfaces.ErrInvalidOperator
+		}
+
+		// the version approved in a request is kept to be published
+		if err := i.repos.Item.UpdateSchedule(ctx, param.ItemID, param.PublishAt, param.UnpublishAt, itm.Value().PublishVersion()); err != nil {
+			return nil, err
+		}
+
+		if err := itm.Value().SetSchedule(param.PublishAt, param.UnpublishAt); err != nil {
+			return nil, err
+		}
+		return itm, nil
+	})
+}
+
+// RunSchedule publishes and unpublishes the items whose scheduled time has come
+func (i Item) RunSchedule(ctx context.Context, operator *usecase.Operator) error {
+	if !operator.Machine {
+		return interfaces.ErrInvalidOperator
+	}
+
+	now := util.Now()
+	items, err := i.repos.Item.FindDueSchedule(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	// each item is processed in its own transaction so that a failing item does not block the others
+	var errs []error
+	for _, itm := range items {
+		if err := Run0(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) error {
+			return i.runSchedule(ctx, itm, now, operator)
+		}); err != nil {
+			log.Errorf("item: failed to run schedule: id=%s err=%v", itm.Value().ID(), err)
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (i Item) runSchedule(ctx context.Context, itm item.Versioned, now time.Time, operator *usecase.Operator) error {
+	itv := itm.Value()
+	publish, unpublish := itv.ScheduleDue(now)
+	publishAt, unpublishAt, publishVersion := itv.PublishAt(), itv.UnpublishAt(), itv.PublishVersion()
+
+	var events []event.Type
+	pinned := false
+	if publish {
+		// the version approved in a request is published even if the item has got newer versions since then
+		vr := version.Latest.OrVersion()
+		if publishVersion != nil {
+			vr, pinned = publishVersion.OrRef(), true
+		}
+		if err := i.repos.Item.UpdateRef(ctx, itv.ID(), version.Public, vr.Ref()); err != nil {
+			return err
+		}
+		publishAt, publishVersion = nil, nil
+		events = append(events, event.ItemPublish)
+	}
+	// unpublishAt is always after publishAt, so unpublishing follows publishing when both are due
+	if unpublish {
+		if err := i.repos.Item.UpdateRef(ctx, itv.ID(), version.Public, nil); err != nil {
+			return err
+		}
+		unpublishAt = nil
+		events = append(events, event.ItemUnpublish)
+	}
+
+	if err := i.repos.Item.UpdateSchedule(ctx, itv.ID(), publishAt, unpublishAt, publishVersion); err != nil {
+		return err
+	}
+	if err := itv.SetSchedule(publishAt, unpublishAt); err != nil {
+		return err
+	}
+
+	// events are about the published version
+	if pinned && !unpublish {
+		published, err := i.repos.Item.FindByID(ctx, itv.ID(), version.Public.Ref())
+		if err != nil {
+			return err
+		}
+		itm, itv = published, published.Value()
+	}
+
+	m, err := i.repos.Model.FindByID(ctx, itv.Model())
+	if err != nil {
+		return err
+	}
+
+	prj, err := i.repos.Project.FindByID(ctx, itv.Project())
+	if err != nil {
+		return err
+	}
+
+	sch, err := i.repos.Schema.FindByID(ctx, itv.Schema())
+	if err != nil {
+		return err
+	}
+
+	for _, t := range events {
+		if err := i.event(ctx, Event{
+			Project:   prj,
+			Workspace: prj.Workspace(),
+			Type:      t,
+			Object:    itm,
+			WebhookObject: item.ItemModelSchema{
+				Item:   itv,
+				Model:  m,
+				Schema: sch,
+			},
+			Operator: operator.Operator(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Import creates a task which imports items from the asset. The task is executed in background.
+func (i Item) Import(ctx context.Context, param interfaces.ImportItemsParam, operator *usecase.Operator) (*task.Task, error) {
+	if operator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	if _, ok := item.ImportFormatFrom(string(param.Format)); !ok {
+		return nil, interfaces.ErrInvalidImportFormat
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (*task.Task, error) {
+		m, err := i.repos.Model.FindByID(ctx, param.ModelID)
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := i.repos.Schema.FindByID(ctx, m.Schema())
+		if err != nil {
+			return nil, err
+		}
+
+		// existing items are checked again one by one in the task
+		if !operator.Can(user.ActionCreate, m.Project(), m.ID().Ref(), operator.IsWritableWorkspace(s.Workspace())) &&
+			!operator.Can(user.ActionUpdate, m.Project(), m.ID().Ref(), operator.IsWritableWorkspace(s.Workspace())) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		a, err := i.repos.Asset.FindByID(ctx, param.AssetID)
+		if err != nil {
+			return nil, err
+		}
+		if a.Project() != m.Project() {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		for _, k := range param.Fields {
+			if s.FieldByIDOrKey(nil, lo.ToPtr(key.New(k))) == nil {
+				return nil, interfaces.ErrImportFieldNotFound
+			}
+		}
+		var keyField string
+		if param.KeyField != nil {
+			if s.FieldByIDOrKey(nil, param.KeyField) == nil {
+				return nil, interfaces.ErrImportFieldNotFound
+			}
+			keyField = param.KeyField.String()
+		}
+
+		t, err := task.New().
+			NewID().
+			Workspace(s.Workspace()).
+			Project(m.Project()).
+			User(operator.User).
+			Integration(operator.Integration).
+			Type(task.TypeImportItems).
+			ImportItems(&task.ImportItemsConfig{
+				ModelID:  m.ID(),
+				AssetID:  a.ID(),
+				Format:   string(param.Format),
+				Fields:   param.Fields,
+				KeyField: keyField,
+				DryRun:   param.DryRun,
+			}).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := i.repos.Task.Save(ctx, t); err != nil {
+			return nil, err
+		}
+		return t, nil
+	})
+}
+
+// Export creates a task which exports items of the model to an asset. The task is executed in background.
+func (i Item) Export(ctx context.Context, param interfaces.ExportItemsParam, operator *usecase.Operator) (*task.Task, error) {
+	if operator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	if _, ok := item.ExportFormatFrom(string(param.Format)); !ok {
+		return nil, interfaces.ErrInvalidExportFormat
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (*task.Task, error) {
+		m, err := i.repos.Model.FindByID(ctx, param.ModelID)
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := i.repos.Schema.FindByID(ctx, m.Schema())
+		if err != nil {
+			return nil, err
+		}
+
+		// the generated asset is created in the project
+		if !operator.IsWritableWorkspace(s.Workspace()) || !operator.CanRead(m.Project(), m.ID().Ref()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		cfg := &task.ExportItemsConfig{
+			ModelID:    m.ID(),
+			Format:     string(param.Format),
+			Keyword:    param.Keyword,
+			Filters:    param.Filters,
+			BBox:       param.BBox,
+			Intersects: param.Intersects,
+		}
+		// validate the conditions before running the task
+		if _, err := exportQuery(s, cfg); err != nil {
+			return nil, err
+		}
+
+		t, err := task.New().
+			NewID().
+			Workspace(s.Workspace()).
+			Project(m.Project()).
+			User(operator.User).
+			Integration(operator.Integration).
+			Type(task.TypeExportItems).
+			ExportItems(cfg).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := i.repos.Task.Save(ctx, t); err != nil {
+			return nil, err
+		}
+		return t, nil
+	})
+}
+
 func (i Item) checkUnique(ctx context.Context, itemFields []*item.Field, s *schema.Schema, mid id.ModelID, itm *item.Item) error {
 	var fieldsArg []repo.FieldAndValue
 	for _, f := range itemFields {
@@ -449,31 +1090,168 @@ func (i Item) checkUnique(ctx context.Context, itemFields []*item.Field, s *sche
 	return nil
 }
 
-func itemFieldsFromParams(fields []interfaces.ItemFieldParam, s *schema.Schema) ([]*item.Field, error) {
+func findItemVersion(versions item.VersionedList, vr version.VersionOrRef) item.Versioned {
+	res, _ := lo.Find(versions, func(v item.Versioned) bool {
+		return version.MatchVersionOrRef(vr, func(w version.Version) bool {
+			return v.Version() == w
+		}, func(r version.Ref) bool {
+			return v.Refs().Has(r)
+		})
+	})
+	return res
+}
+
+// itemFieldsFromParams converts the params into fields of the schema. Localized values of the old item are kept when they are not specified.
+func itemFieldsFromParams(fields []interfaces.ItemFieldParam, s *schema.Schema, old *item.Item) ([]*item.Field, error) {
 	return util.TryMap(fields, func(f interfaces.ItemFieldParam) (*item.Field, error) {
 		sf := s.FieldByIDOrKey(f.Field, f.Key)
 		if sf == nil {
 			return nil, interfaces.ErrFieldNotFound
 		}
 
-		if !sf.Multiple() {
-			f.Value = []any{f.Value}
+		m, err := fieldValueFromParam(sf, f.Value)
+		if err != nil {
+			return nil, err
+		}
+		if err := sf.Validate(m); err != nil {
+			return nil, rerror.FmtE(i18n.T("field %s: %w"), sf.Name(), err)
+		}
+
+		if f.Localized == nil {
+			if old != nil && sf.Localized() {
+				if of := old.Field(sf.ID()); of != nil {
+					return item.NewLocalizedField(sf.ID(), m, of.Localized()), nil
+				}
+			}
+			return item.NewField(sf.ID(), m), nil
 		}
 
-		as, ok := f.Value.([]any)
-		if !ok {
-			return nil, interfaces.ErrInvalidValue
+		if !sf.Localized() && len(f.Localized) > 0 {
+			return nil, rerror.FmtE(i18n.T("field %s: %w"), sf.Name(), interfaces.ErrFieldNotLocalized)
 		}
 
-		m := value.NewMultiple(sf.Type(), as)
-		if err := sf.Validate(m); err != nil {
-			return nil, fmt.Errorf("field %s: %w", sf.Name(), err)
+		localized := make(map[string]*value.Multiple, len(f.Localized))
+		for l, v := range f.Localized {
+			locale, err := item.NormalizeLocale(l)
+			if err != nil {
+				return nil, err
+			}
+			lm, err := fieldValueFromParam(sf, v)
+			if err != nil {
+				return nil, err
+			}
+			// values of locales other than the default one are optional
+			if lm.IsEmpty() {
+				continue
+			}
+			if err := sf.ValidateValue(lm); err != nil {
+				return nil, rerror.FmtE(i18n.T("field %s: %w"), sf.Name(), err)
+			}
+			localized[locale] = lm
 		}
 
-		return item.NewField(sf.ID(), m), nil
+		return item.NewLocalizedField(sf.ID(), m, localized), nil
 	})
 }
 
+func fieldValueFromParam(sf *schema.Field, v any) (*value.Multiple, error) {
+	if !sf.Multiple() {
+		v = []any{v}
+	}
+
+	as, ok := v.([]any)
+	if !ok {
+		return nil, interfaces.ErrInvalidValue
+	}
+
+	m := sf.NewValue(as)
+	// invalid geometries, such as polygons whose rings intersect themselves, are rejected instead of being dropped
+	if sf.Type() == value.TypeGeometry && m.Len() != len(lo.Filter(as, func(v any, _ int) bool { return v != nil && v != "" })) {
+		return nil, rerror.FmtE(i18n.T("field %s: %w"), sf.Name(), schema.ErrInvalidValue)
+	}
+	return m, nil
+}
+
+// validateRestoredField validates the value of a field restored from an old version. f is nil when the old version has no value for the field.
+func validateRestoredField(sf *schema.Field, f *item.Field) error {
+	if f == nil {
+		return sf.Validate(nil)
+	}
+	if err := sf.Validate(f.Value()); err != nil {
+		return err
+	}
+	for _, m := range f.Localized() {
+		if err := sf.ValidateValue(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateRequiredConditions(fields []*item.Field, s *schema.Schema) error {
+	values := make(map[id.FieldID]*value.Multiple, len(fields))
+	for _, f := range fields {
+		values[f.FieldID()] = f.Value()
+	}
+	return s.ValidateRequiredConditions(values)
+}
+
+// checkReadableSchema returns ErrNotFound when the custom role of the operator does not allow reading items of the model of the schema
+func (i Item) checkReadableSchema(ctx context.Context, pid id.ProjectID, sid id.SchemaID, operator *usecase.Operator) error {
+	if operator.CustomRole(pid) == nil {
+		return nil
+	}
+	models, _, err := i.repos.Model.FindByProject(ctx, pid, nil)
+	if err != nil {
+		return err
+	}
+	var mid *id.ModelID
+	if m, ok := lo.Find(models, func(m *model.Model) bool { return m.Schema() == sid }); ok {
+		mid = m.ID().Ref()
+	}
+	if !operator.CanRead(pid, mid) {
+		return rerror.ErrNotFound
+	}
+	return nil
+}
+
+func canReadItem(itm *item.Item, operator *usecase.Operator) bool {
+	return itm == nil || operator.CanRead(itm.Project(), itm.Model().Ref()) && operator.CanAccessItemGroup(itm.Project(), itm.Group())
+}
+
+// filterReadableItems removes items which the custom role of the operator does not allow to read and items of groups which the operator cannot access
+func filterReadableItems(l item.VersionedList, operator *usecase.Operator) item.VersionedList {
+	if l == nil {
+		return nil
+	}
+	return lo.Filter(l, func(v item.Versioned, _ int) bool { return v == nil || canReadItem(v.Value(), operator) })
+}
+
+// filterAccessibleItems removes items of groups which the operator cannot access
+func filterAccessibleItems(l item.VersionedList, operator *usecase.Operator) item.VersionedList {
+	return lo.Filter(l, func(v item.Versioned, _ int) bool {
+		return v == nil || operator.CanAccessItemGroup(v.Value().Project(), v.Value().Group())
+	})
+}
+
+// applyItemGroup sets the group of the item explicitly, or derives it from the value of the field of the item group policy of the project.
+// The group is left unchanged when neither is given.
+func applyItemGroup(itm *item.Item, group *string, prj *project.Project, s *schema.Schema) {
+	if group != nil {
+		itm.SetGroup(strings.TrimSpace(*group))
+		return
+	}
+	k := prj.ItemGroupPolicy().Field()
+	if k == nil {
+		return
+	}
+	if f := s.FieldByIDOrKey(nil, k); f != nil {
+		if g, ok := itm.GroupOfField(f.ID()); ok {
+			itm.SetGroup(strings.TrimSpace(g))
+		}
+	}
+}
+
 func (i Item) event(ctx context.Context, e Event) error {
 	if i.ignoreEvent {
 		return nil
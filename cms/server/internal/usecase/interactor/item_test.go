@@ -10,6 +10,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/key"
@@ -797,6 +798,353 @@ func TestItem_Update(t *testing.T) {
 	assert.Nil(t, item)
 }
 
+func TestItem_CreateAndUpdate_ValidationRules(t *testing.T) {
+	prj := project.New().NewID().MustBuild()
+	sf1 := schema.NewField(schema.NewSelect([]string{"city", "ward"}).TypeProperty()).NewID().Name("type").Key(key.Random()).MustBuild()
+	tp := schema.NewText(nil)
+	lo.Must0(tp.SetPattern(lo.ToPtr(`^\d{5}$`)))
+	sf2 := schema.NewField(tp.TypeProperty()).NewID().Name("code").Key(key.Random()).
+		RequiredIf(schema.NewRequiredCondition(sf1.ID(), []string{"city"})).MustBuild()
+	s := schema.New().NewID().Workspace(id.NewWorkspaceID()).Project(prj.ID()).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(key.Random()).Project(s.Project()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+
+	op := &usecase.Operator{
+		User:               id.NewUserID().Ref(),
+		ReadableProjects:   []id.ProjectID{s.Project()},
+		WritableProjects:   []id.ProjectID{s.Project()},
+		ReadableWorkspaces: []id.WorkspaceID{s.Workspace()},
+		WritableWorkspaces: []id.WorkspaceID{s.Workspace()},
+	}
+
+	// pattern
+	_, err := itemUC.Create(ctx, interfaces.CreateItemParam{
+		SchemaID: s.ID(),
+		ModelID:  m.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf2.ID().Ref(), Type: value.TypeText, Value: "1310"},
+		},
+	}, op)
+	assert.EqualError(t, err, `field code: value does not match the pattern ^\d{5}$`)
+
+	// required condition
+	_, err = itemUC.Create(ctx, interfaces.CreateItemParam{
+		SchemaID: s.ID(),
+		ModelID:  m.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf1.ID().Ref(), Type: value.TypeSelect, Value: "city"},
+		},
+	}, op)
+	assert.EqualError(t, err, "field code is required when field type is city")
+
+	it, err := itemUC.Create(ctx, interfaces.CreateItemParam{
+		SchemaID: s.ID(),
+		ModelID:  m.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf1.ID().Ref(), Type: value.TypeSelect, Value: "ward"},
+		},
+	}, op)
+	assert.NoError(t, err)
+
+	// the condition is checked against the merged fields
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: it.Value().ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf1.ID().Ref(), Type: value.TypeSelect, Value: "city"},
+		},
+	}, op)
+	assert.EqualError(t, err, "field code is required when field type is city")
+
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: it.Value().ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf2.ID().Ref(), Type: value.TypeText, Value: "13101"},
+		},
+	}, op)
+	assert.NoError(t, err)
+
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: it.Value().ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf1.ID().Ref(), Type: value.TypeSelect, Value: "city"},
+		},
+	}, op)
+	assert.NoError(t, err)
+}
+
+func TestItem_Create_GroupAndGeometry(t *testing.T) {
+	prj := project.New().NewID().MustBuild()
+	gsf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("name").Key(key.New("name")).Required(true).MustBuild()
+	gsf2 := schema.NewField(lo.Must(schema.NewInteger(nil, nil)).TypeProperty()).NewID().Name("floors").Key(key.New("floors")).MustBuild()
+	sf1 := schema.NewField(lo.Must(schema.NewGroup(schema.FieldList{gsf1, gsf2})).TypeProperty()).NewID().Name("building").Key(key.New("building")).Multiple(true).MustBuild()
+	sf2 := schema.NewField(schema.NewGeometry([]value.GeometryType{value.GeometryTypePoint}).TypeProperty()).NewID().Name("location").Key(key.New("location")).MustBuild()
+	s := schema.New().NewID().Workspace(id.NewWorkspaceID()).Project(prj.ID()).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(key.Random()).Project(s.Project()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+
+	op := &usecase.Operator{
+		User:               id.NewUserID().Ref(),
+		ReadableProjects:   []id.ProjectID{s.Project()},
+		WritableProjects:   []id.ProjectID{s.Project()},
+		ReadableWorkspaces: []id.WorkspaceID{s.Workspace()},
+		WritableWorkspaces: []id.WorkspaceID{s.Workspace()},
+	}
+
+	// a required sub-field is missing
+	_, err := itemUC.Create(ctx, interfaces.CreateItemParam{
+		SchemaID: s.ID(),
+		ModelID:  m.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf1.ID().Ref(), Type: value.TypeGroup, Value: []any{map[string]any{"floors": 3}}},
+		},
+	}, op)
+	assert.EqualError(t, err, "field building: field name: value is required")
+
+	// unsupported geometry type
+	_, err = itemUC.Create(ctx, interfaces.CreateItemParam{
+		SchemaID: s.ID(),
+		ModelID:  m.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf2.ID().Ref(), Type: value.TypeGeometry, Value: `{"type":"LineString","coordinates":[[139,35],[140,36]]}`},
+		},
+	}, op)
+	assert.EqualError(t, err, "field location: unsupported geometry type")
+
+	// self-intersecting polygon
+	_, err = itemUC.Create(ctx, interfaces.CreateItemParam{
+		SchemaID: s.ID(),
+		ModelID:  m.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf2.ID().Ref(), Type: value.TypeGeometry, Value: `{"type":"Polygon","coordinates":[[[0,0],[1,1],[1,0],[0,1],[0,0]]]}`},
+		},
+	}, op)
+	assert.EqualError(t, err, "field location: invalid value")
+
+	it, err := itemUC.Create(ctx, interfaces.CreateItemParam{
+		SchemaID: s.ID(),
+		ModelID:  m.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf1.ID().Ref(), Type: value.TypeGroup, Value: []any{
+				map[string]any{"name": "a", "floors": 3},
+				map[string]any{gsf1.ID().String(): "b"},
+			}},
+			{Field: sf2.ID().Ref(), Type: value.TypeGeometry, Value: map[string]any{"type": "Point", "coordinates": []any{139.7, 35.6}}},
+		},
+	}, op)
+	assert.NoError(t, err)
+
+	f := it.Value().Field(sf1.ID())
+	assert.Equal(t, []any{
+		map[string]any{"name": "a", "floors": int64(3)},
+		map[string]any{"name": "b"},
+	}, sf1.ValueInterface(f.Value(), true))
+
+	g, ok := it.Value().Field(sf2.ID()).Value().First().ValueGeometry()
+	assert.True(t, ok)
+	assert.Equal(t, value.Geometry{Type: value.GeometryTypePoint, Coordinates: value.Position{139.7, 35.6}}, g)
+}
+
+func TestItem_CreateAndUpdate_Localized(t *testing.T) {
+	prj := project.New().NewID().MustBuild()
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("title").Key(key.New("title")).Localized(true).MustBuild()
+	sf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("code").Key(key.New("code")).MustBuild()
+	s := schema.New().NewID().Workspace(id.NewWorkspaceID()).Project(prj.ID()).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(key.Random()).Project(s.Project()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+
+	op := &usecase.Operator{
+		User:               id.NewUserID().Ref(),
+		ReadableProjects:   []id.ProjectID{s.Project()},
+		WritableProjects:   []id.ProjectID{s.Project()},
+		ReadableWorkspaces: []id.WorkspaceID{s.Workspace()},
+		WritableWorkspaces: []id.WorkspaceID{s.Workspace()},
+	}
+
+	// the field is not localized
+	_, err := itemUC.Create(ctx, interfaces.CreateItemParam{
+		SchemaID: s.ID(),
+		ModelID:  m.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf2.ID().Ref(), Type: value.TypeText, Value: "a", Localized: map[string]any{"en": "b"}},
+		},
+	}, op)
+	assert.ErrorIs(t, err, interfaces.ErrFieldNotLocalized)
+
+	// invalid locale
+	_, err = itemUC.Create(ctx, interfaces.CreateItemParam{
+		SchemaID: s.ID(),
+		ModelID:  m.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf1.ID().Ref(), Type: value.TypeText, Value: "a", Localized: map[string]any{"!!": "b"}},
+		},
+	}, op)
+	assert.ErrorIs(t, err, item.ErrInvalidLocale)
+
+	it, err := itemUC.Create(ctx, interfaces.CreateItemParam{
+		SchemaID: s.ID(),
+		ModelID:  m.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf1.ID().Ref(), Type: value.TypeText, Value: "タイトル", Localized: map[string]any{"EN": "title", "fr": nil}},
+		},
+	}, op)
+	assert.NoError(t, err)
+	f := it.Value().Field(sf1.ID())
+	assert.Equal(t, []string{"en"}, f.Locales())
+	assert.Equal(t, value.TypeText.Value("title").AsMultiple(), f.ValueIn("en-US"))
+	assert.Equal(t, value.TypeText.Value("タイトル").AsMultiple(), f.ValueIn("fr"))
+
+	// localized values are kept when they are not specified
+	it, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: it.Value().ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf1.ID().Ref(), Type: value.TypeText, Value: "題名"},
+		},
+	}, op)
+	assert.NoError(t, err)
+	f = it.Value().Field(sf1.ID())
+	assert.Equal(t, value.TypeText.Value("題名").AsMultiple(), f.Value())
+	assert.Equal(t, value.TypeText.Value("title").AsMultiple(), f.ValueIn("en"))
+
+	// localized values are replaced when they are specified
+	it, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: it.Value().ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: sf1.ID().Ref(), Type: value.TypeText, Value: "題名", Localized: map[string]any{}},
+		},
+	}, op)
+	assert.NoError(t, err)
+	assert.Empty(t, it.Value().Field(sf1.ID()).Locales())
+}
+
+func TestItem_Search_Spatial(t *testing.T) {
+	prj := project.New().NewID().MustBuild()
+	sf1 := schema.NewField(schema.NewGeometry(nil).TypeProperty()).NewID().Key(key.Random()).MustBuild()
+	sf2 := schema.NewField(schema.NewAsset().TypeProperty()).NewID().Key(key.Random()).MustBuild()
+	s := schema.New().NewID().Workspace(id.NewWorkspaceID()).Project(prj.ID()).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	a := asset.New().NewID().Project(prj.ID()).CreatedByUser(id.NewUserID()).Size(1).NewUUID().Thread(id.NewThreadID()).
+		BBox([]float64{139, 35, 140, 36}).MustBuild()
+	newItem := func(fields ...*item.Field) *item.Item {
+		return item.New().NewID().Schema(s.ID()).Model(id.NewModelID()).Project(prj.ID()).Thread(id.NewThreadID()).Fields(fields).MustBuild()
+	}
+	i1 := newItem(item.NewField(sf1.ID(), value.TypeGeometry.Value(value.Geometry{Type: value.GeometryTypePoint, Coordinates: value.Position{139.1, 35.1}}).AsMultiple()))
+	i2 := newItem(item.NewField(sf2.ID(), value.TypeAsset.Value(a.ID()).AsMultiple()))
+	i3 := newItem(item.NewField(sf1.ID(), value.TypeGeometry.Value(value.Geometry{Type: value.GeometryTypePoint, Coordinates: value.Position{0, 0}}).AsMultiple()))
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Asset.Save(ctx, a))
+	for _, i := range []*item.Item{i1, i2, i3} {
+		lo.Must0(db.Item.Save(ctx, i))
+	}
+	itemUC := NewItem(db, nil)
+
+	sp := lo.Must(item.ParseSpatialFilter("139.5,35.5,141,37", ""))
+	got, _, err := itemUC.Search(ctx, item.NewQuery(prj.ID(), s.ID().Ref(), "", nil).WithSpatialFilter(sp), nil, nil, &usecase.Operator{})
+	assert.NoError(t, err)
+	assert.Equal(t, item.List{i2}, got.Unwrap())
+
+	sp = lo.Must(item.ParseSpatialFilter("139,35,139.2,35.2", ""))
+	got, _, err = itemUC.Search(ctx, item.NewQuery(prj.ID(), s.ID().Ref(), "", nil).WithSpatialFilter(sp), nil, nil, &usecase.Operator{})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, item.List{i1, i2}, got.Unwrap())
+}
+
+func TestItem_DiffAndRollback(t *testing.T) {
+	prj := project.New().NewID().MustBuild()
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("name").Key(key.New("name")).MustBuild()
+	sf2 := schema.NewField(schema.NewAsset().TypeProperty()).NewID().Name("file").Key(key.New("file")).MustBuild()
+	s := schema.New().NewID().Workspace(id.NewWorkspaceID()).Project(prj.ID()).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(key.Random()).Project(s.Project()).MustBuild()
+	a1, a2 := id.NewAssetID(), id.NewAssetID()
+	iid, tid := id.NewItemID(), id.NewThreadID()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+
+	op := &usecase.Operator{
+		User:               id.NewUserID().Ref(),
+		ReadableProjects:   []id.ProjectID{s.Project()},
+		WritableProjects:   []id.ProjectID{s.Project()},
+		ReadableWorkspaces: []id.WorkspaceID{s.Workspace()},
+		WritableWorkspaces: []id.WorkspaceID{s.Workspace()},
+	}
+
+	// the memory repository shares items between versions, so each version is saved as a different object
+	newItem := func(a id.AssetID) *item.Item {
+		return item.New().ID(iid).Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(tid).User(*op.User).Fields([]*item.Field{
+			item.NewField(sf1.ID(), value.TypeText.Value("a").AsMultiple()),
+			item.NewField(sf2.ID(), value.TypeAsset.Value(a).AsMultiple()),
+		}).MustBuild()
+	}
+	lo.Must0(db.Item.Save(ctx, newItem(a1)))
+	it := lo.Must(db.Item.FindByID(ctx, iid, nil))
+	v1 := it.Version()
+	lo.Must0(db.Item.Save(ctx, newItem(a2)))
+
+	diffs, err := itemUC.Diff(ctx, interfaces.DiffItemParam{ItemID: it.Value().ID(), From: v1}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(diffs))
+	assert.Equal(t, sf2.ID(), diffs[0].Field)
+	added, removed := diffs[0].Assets()
+	assert.Equal(t, id.AssetIDList{a2}, added)
+	assert.Equal(t, id.AssetIDList{a1}, removed)
+
+	_, err = itemUC.Diff(ctx, interfaces.DiffItemParam{ItemID: it.Value().ID(), From: version.New()}, op)
+	assert.Same(t, rerror.ErrNotFound, err)
+
+	_, err = itemUC.Rollback(ctx, interfaces.RollbackItemParam{ItemID: it.Value().ID(), Version: v1}, &usecase.Operator{
+		User:               id.NewUserID().Ref(),
+		ReadableWorkspaces: []id.WorkspaceID{s.Workspace()},
+	})
+	assert.Same(t, interfaces.ErrOperationDenied, err)
+
+	got, err := itemUC.Rollback(ctx, interfaces.RollbackItemParam{ItemID: it.Value().ID(), Version: v1}, op)
+	assert.NoError(t, err)
+	assert.NotEqual(t, v1, got.Version())
+	assert.Equal(t, value.TypeAsset.Value(a1).AsMultiple(), got.Value().Field(sf2.ID()).Value())
+
+	versions, err := itemUC.FindAllVersionsByID(ctx, it.Value().ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(versions))
+
+	diffs, err = itemUC.Diff(ctx, interfaces.DiffItemParam{ItemID: it.Value().ID(), From: v1}, op)
+	assert.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	// a field which has become required since the old version
+	sf3 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("note").Key(key.New("note")).Required(true).MustBuild()
+	s.AddField(sf3)
+	lo.Must0(db.Schema.Save(ctx, s))
+	_, err = itemUC.Rollback(ctx, interfaces.RollbackItemParam{ItemID: it.Value().ID(), Version: v1}, op)
+	assert.EqualError(t, err, "field note: value is required")
+}
+
 func TestItem_Delete(t *testing.T) {
 	wid := id.NewWorkspaceID()
 	u := user.New().Name("aaa").NewID().Email("aaa@bbb.com").Workspace(wid).MustBuild()
@@ -828,6 +1176,217 @@ func TestItem_Delete(t *testing.T) {
 	assert.Equal(t, wantErr, err)
 }
 
+func TestItem_Delete_References(t *testing.T) {
+	wid := id.NewWorkspaceID()
+	pid := id.NewProjectID()
+	mid := id.NewModelID()
+	ctx := context.Background()
+	db := memory.New()
+
+	refField := func(onDelete schema.ReferenceOnDelete) *schema.Field {
+		r := schema.NewReference(mid)
+		lo.Must0(r.SetOnDelete(onDelete))
+		return schema.NewField(r.TypeProperty()).NewID().RandomKey().Multiple(true).MustBuild()
+	}
+	fn := refField(schema.ReferenceOnDeleteNullify)
+	fc := refField(schema.ReferenceOnDeleteCascade)
+	fr := refField(schema.ReferenceOnDeleteRestrict)
+	s := schema.New().NewID().Workspace(wid).Project(pid).Fields(schema.FieldList{fn, fc, fr}).MustBuild()
+	lo.Must0(db.Schema.Save(ctx, s))
+
+	newItem := func(fields ...*item.Field) *item.Item {
+		i := item.New().NewID().Schema(s.ID()).Model(mid).Project(pid).Thread(id.NewThreadID()).Fields(fields).MustBuild()
+		lo.Must0(db.Item.Save(ctx, i))
+		return i
+	}
+	refs := func(f *schema.Field, ids ...id.ItemID) *item.Field {
+		return item.NewField(f.ID(), value.NewMultiple(value.TypeReference, lo.ToAnySlice(ids)))
+	}
+
+	c1, c2 := newItem(), newItem()
+	n := newItem(refs(fn, c1.ID(), c2.ID()))
+	k1 := newItem(refs(fc, c1.ID()))
+	k2 := newItem(refs(fc, k1.ID()))
+	r := newItem(refs(fr, c2.ID()))
+	// an item of another project is not a back-reference the operator can read
+	lo.Must0(db.Item.Save(ctx, item.New().NewID().Schema(s.ID()).Model(mid).Project(id.NewProjectID()).Thread(id.NewThreadID()).Fields([]*item.Field{refs(fn, c2.ID())}).MustBuild()))
+
+	op := &usecase.Operator{
+		User:                 lo.ToPtr(id.NewUserID()),
+		ReadableProjects:     id.ProjectIDList{pid},
+		MaintainableProjects: id.ProjectIDList{pid},
+	}
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+
+	got, err := itemUC.FindBackReferences(ctx, c1.ID(), op)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, id.ItemIDList{n.ID(), k1.ID()}, lo.Map(got, func(v item.Versioned, _ int) id.ItemID { return v.Value().ID() }))
+
+	// restrict
+	assert.Equal(t, interfaces.ErrItemReferenced, itemUC.Delete(ctx, c2.ID(), op))
+	_, err = itemUC.FindByID(ctx, c2.ID(), op)
+	assert.NoError(t, err)
+
+	// a writer has to be able to delete items deleted by cascade and to update items whose references are removed
+	uid := id.NewUserID()
+	w := &usecase.Operator{
+		User:             &uid,
+		ReadableProjects: id.ProjectIDList{pid},
+		WritableProjects: id.ProjectIDList{pid},
+	}
+	newOwnItem := func() *item.Item {
+		i := item.New().NewID().Schema(s.ID()).Model(mid).Project(pid).Thread(id.NewThreadID()).User(uid).MustBuild()
+		lo.Must0(db.Item.Save(ctx, i))
+		return i
+	}
+	c3, c4 := newOwnItem(), newOwnItem()
+	_ = newItem(refs(fc, c3.ID()))
+	n4 := newItem(refs(fn, c4.ID()))
+	assert.Equal(t, interfaces.ErrOperationDenied, itemUC.Delete(ctx, c3.ID(), w))
+	assert.Equal(t, interfaces.ErrOperationDenied, itemUC.Delete(ctx, c4.ID(), w))
+	gotn4, err := itemUC.FindByID(ctx, n4.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{c4.ID()}, gotn4.Value().References())
+
+	// nullify and cascade
+	assert.NoError(t, itemUC.Delete(ctx, c1.ID(), op))
+	for _, d := range []*item.Item{c1, k1, k2} {
+		_, err = itemUC.FindByID(ctx, d.ID(), op)
+		assert.ErrorIs(t, err, rerror.ErrNotFound)
+	}
+	gotn, err := itemUC.FindByID(ctx, n.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{c2.ID()}, gotn.Value().References())
+
+	got, err = itemUC.FindBackReferences(ctx, c2.ID(), op)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, id.ItemIDList{n.ID(), r.ID()}, lo.Map(got, func(v item.Versioned, _ int) id.ItemID { return v.Value().ID() }))
+
+	got, err = itemUC.FindBackReferences(ctx, c1.ID(), op)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	assert.Nil(t, got)
+
+	// items can't be deleted while items hidden from the operator reference them
+	c5, c6 := newItem(), newItem()
+	c5.SetGroup("a")
+	lo.Must0(db.Item.Save(ctx, c5))
+	hidden := item.New().NewID().Schema(s.ID()).Model(mid).Project(pid).Thread(id.NewThreadID()).Group("b").Fields([]*item.Field{refs(fn, c5.ID())}).MustBuild()
+	lo.Must0(db.Item.Save(ctx, hidden))
+	lo.Must0(db.Item.Save(ctx, item.New().NewID().Schema(s.ID()).Model(mid).Project(id.NewProjectID()).Thread(id.NewThreadID()).Fields([]*item.Field{refs(fn, c6.ID())}).MustBuild()))
+	g := &usecase.Operator{
+		User:                 lo.ToPtr(id.NewUserID()),
+		ReadableProjects:     id.ProjectIDList{pid},
+		MaintainableProjects: id.ProjectIDList{pid},
+		ItemGroups:           map[id.ProjectID][]string{pid: {"a"}},
+	}
+	gUC, opUC := NewItem(db.Filtered(repo.WorkspaceFilter{}, repo.ProjectFilterFromOperator(g)), nil), NewItem(db.Filtered(repo.WorkspaceFilter{}, repo.ProjectFilterFromOperator(op)), nil)
+	gUC.ignoreEvent, opUC.ignoreEvent = true, true
+	assert.Equal(t, interfaces.ErrOperationDenied, gUC.Delete(ctx, c5.ID(), g))
+	assert.Equal(t, interfaces.ErrOperationDenied, opUC.Delete(ctx, c6.ID(), op))
+	assert.NoError(t, gUC.Delete(ctx, newItem().ID(), g))
+	goth, err := db.Item.FindByID(ctx, hidden.ID(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{c5.ID()}, goth.Value().References())
+}
+
+func TestItem_Schedule(t *testing.T) {
+	now := util.Now()
+	defer util.MockNow(now)()
+
+	wid := id.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	i1 := item.New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(prj.ID()).Thread(id.NewThreadID()).MustBuild()
+	publishAt, unpublishAt := now.Add(time.Hour), now.Add(2*time.Hour)
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Item.Save(ctx, i1))
+	itemUC := NewItem(db, nil)
+
+	param := interfaces.ScheduleItemParam{ItemID: i1.ID(), PublishAt: &publishAt, UnpublishAt: &unpublishAt}
+	_, err := itemUC.Schedule(ctx, param, &usecase.Operator{User: lo.ToPtr(id.NewUserID()), WritableWorkspaces: id.WorkspaceIDList{wid}})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+
+	op := &usecase.Operator{User: lo.ToPtr(id.NewUserID()), MaintainableWorkspaces: id.WorkspaceIDList{wid}}
+	_, err = itemUC.Schedule(ctx, interfaces.ScheduleItemParam{ItemID: i1.ID(), PublishAt: &unpublishAt, UnpublishAt: &publishAt}, op)
+	assert.Same(t, item.ErrInvalidSchedule, err)
+
+	got, err := itemUC.Schedule(ctx, param, op)
+	assert.NoError(t, err)
+	assert.Equal(t, &publishAt, got.Value().PublishAt())
+	assert.Equal(t, &unpublishAt, got.Value().UnpublishAt())
+
+	got, err = itemUC.FindByID(ctx, i1.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, &publishAt, got.Value().PublishAt())
+}
+
+func TestItem_RunSchedule(t *testing.T) {
+	now := util.Now()
+	defer util.MockNow(now)()
+
+	wid := id.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).RandomKey().MustBuild()
+	newItem := func() *item.Item {
+		return item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID()).MustBuild()
+	}
+	i1, i2, i3 := newItem(), newItem(), newItem()
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	for _, i := range []*item.Item{i1, i2, i3} {
+		lo.Must0(db.Item.Save(ctx, i))
+	}
+	// i1 will be published and then unpublished in the future
+	lo.Must0(db.Item.UpdateSchedule(ctx, i1.ID(), &past, &future, nil))
+	// i2 is public and will be unpublished
+	lo.Must0(db.Item.UpdateRef(ctx, i2.ID(), version.Public, version.Latest.OrVersion().Ref()))
+	lo.Must0(db.Item.UpdateSchedule(ctx, i2.ID(), nil, &past, nil))
+	// i3 is not due yet
+	lo.Must0(db.Item.UpdateSchedule(ctx, i3.ID(), &future, nil, nil))
+
+	itemUC := NewItem(db, nil)
+	assert.Equal(t, interfaces.ErrInvalidOperator, itemUC.RunSchedule(ctx, &usecase.Operator{User: lo.ToPtr(id.NewUserID())}))
+	assert.NoError(t, itemUC.RunSchedule(ctx, &usecase.Operator{Machine: true}))
+
+	status, err := itemUC.ItemStatus(ctx, id.ItemIDList{i1.ID(), i2.ID(), i3.ID()}, &usecase.Operator{})
+	assert.NoError(t, err)
+	assert.Equal(t, item.StatusPublic, status[i1.ID()])
+	assert.Equal(t, item.StatusDraft, status[i2.ID()])
+	assert.Equal(t, item.StatusDraft, status[i3.ID()])
+
+	got, err := db.Item.FindByIDs(ctx, id.ItemIDList{i1.ID(), i2.ID(), i3.ID()}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, got.Item(i1.ID()).Value().PublishAt())
+	assert.Equal(t, &future, got.Item(i1.ID()).Value().UnpublishAt())
+	assert.Nil(t, got.Item(i2.ID()).Value().UnpublishAt())
+	assert.Equal(t, &future, got.Item(i3.ID()).Value().PublishAt())
+
+	due, err := db.Item.FindDueSchedule(ctx, now)
+	assert.NoError(t, err)
+	assert.Empty(t, due)
+
+	// an item which fails does not block the others
+	i4 := item.New().NewID().Schema(s.ID()).Model(id.NewModelID()).Project(prj.ID()).Thread(id.NewThreadID()).MustBuild()
+	i5 := newItem()
+	for _, i := range []*item.Item{i4, i5} {
+		lo.Must0(db.Item.Save(ctx, i))
+		lo.Must0(db.Item.UpdateSchedule(ctx, i.ID(), &past, nil, nil))
+	}
+	assert.Error(t, itemUC.RunSchedule(ctx, &usecase.Operator{Machine: true}))
+	status, err = itemUC.ItemStatus(ctx, id.ItemIDList{i5.ID()}, &usecase.Operator{})
+	assert.NoError(t, err)
+	assert.Equal(t, item.StatusPublic, status[i5.ID()])
+}
+
 func TestWorkFlow(t *testing.T) {
 	now := util.Now()
 	defer util.MockNow(now)()
@@ -893,3 +1452,164 @@ func TestWorkFlow(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, map[id.ItemID]item.Status{i.ID(): item.StatusDraft}, status)
 }
+
+func TestItem_CustomRole(t *testing.T) {
+	wid := id.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	newModel := func() (*model.Model, *schema.Schema, *schema.Field) {
+		sf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(key.Random()).MustBuild()
+		s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf}).MustBuild()
+		m := model.New().NewID().Schema(s.ID()).Key(key.Random()).Project(prj.ID()).MustBuild()
+		return m, s, sf
+	}
+	m1, s1, sf1 := newModel()
+	m2, s2, sf2 := newModel()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s1))
+	lo.Must0(db.Schema.Save(ctx, s2))
+	lo.Must0(db.Model.Save(ctx, m1))
+	lo.Must0(db.Model.Save(ctx, m2))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+
+	i1 := item.New().NewID().Schema(s1.ID()).Model(m1.ID()).Project(prj.ID()).Thread(id.NewThreadID()).User(id.NewUserID()).MustBuild()
+	i2 := item.New().NewID().Schema(s2.ID()).Model(m2.ID()).Project(prj.ID()).Thread(id.NewThreadID()).User(id.NewUserID()).MustBuild()
+	lo.Must0(db.Item.Save(ctx, i1))
+	lo.Must0(db.Item.Save(ctx, i2))
+
+	// a reader who can edit only items of the first model
+	r := lo.Must(user.NewCustomRole(id.NewRoleID(), "staff", []user.Permission{
+		{Project: prj.ID().Ref(), Model: m1.ID().Ref(), Actions: []user.Action{user.ActionRead, user.ActionCreate, user.ActionUpdate}},
+	}))
+	op := &usecase.Operator{
+		User:               id.NewUserID().Ref(),
+		ReadableProjects:   []id.ProjectID{prj.ID()},
+		ReadableWorkspaces: []id.WorkspaceID{wid},
+		CustomRoles:        map[id.ProjectID]*user.CustomRole{prj.ID(): r},
+	}
+	field := func(sf *schema.Field) []interfaces.ItemFieldParam {
+		return []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Type: value.TypeText, Value: "xxx"}}
+	}
+
+	_, err := itemUC.Create(ctx, interfaces.CreateItemParam{SchemaID: s1.ID(), ModelID: m1.ID(), Fields: field(sf1)}, op)
+	assert.NoError(t, err)
+	_, err = itemUC.Create(ctx, interfaces.CreateItemParam{SchemaID: s2.ID(), ModelID: m2.ID(), Fields: field(sf2)}, op)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	// items of other users can be updated by the role
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{ItemID: i1.ID(), Fields: field(sf1)}, op)
+	assert.NoError(t, err)
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{ItemID: i2.ID(), Fields: field(sf2)}, op)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+	assert.Equal(t, interfaces.ErrOperationDenied, itemUC.Delete(ctx, i1.ID(), op))
+
+	// items of the other model cannot be read
+	_, err = itemUC.FindByID(ctx, i1.ID(), op)
+	assert.NoError(t, err)
+	_, err = itemUC.FindByID(ctx, i2.ID(), op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+	got, err := itemUC.FindByIDs(ctx, id.ItemIDList{i1.ID(), i2.ID()}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, []id.ItemID{i1.ID()}, util.Map(got, func(v item.Versioned) id.ItemID { return v.Value().ID() }))
+	_, _, err = itemUC.FindByModel(ctx, m2.ID(), nil, op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+	_, _, err = itemUC.FindBySchema(ctx, s2.ID(), nil, nil, op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+	_, _, err = itemUC.Search(ctx, item.NewQuery(prj.ID(), s2.ID().Ref(), "", nil), nil, nil, op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	// publishing needs the action
+	_, err = itemUC.Unpublish(ctx, id.ItemIDList{i1.ID()}, op)
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+}
+
+func TestItem_ItemGroups(t *testing.T) {
+	wid := id.NewWorkspaceID()
+	policy := lo.Must(project.NewItemGroupPolicy(key.New("city_code").Ref(), true))
+	prj := project.New().NewID().Workspace(wid).ItemGroupPolicy(policy).MustBuild()
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(key.New("city_code")).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(key.Random()).Project(prj.ID()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+
+	uid := id.NewUserID()
+	op := &usecase.Operator{
+		User:               &uid,
+		WritableWorkspaces: []id.WorkspaceID{wid},
+		WritableProjects:   []id.ProjectID{prj.ID()},
+		ItemGroups:         map[id.ProjectID][]string{prj.ID(): {"13101"}},
+	}
+	cityCode := func(v string) []interfaces.ItemFieldParam {
+		return []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Type: value.TypeText, Value: v}}
+	}
+
+	i1 := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID()).User(uid).Group("13102").MustBuild()
+	i2 := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID()).User(uid).MustBuild()
+	lo.Must0(db.Item.Save(ctx, i1))
+	lo.Must0(db.Item.Save(ctx, i2))
+
+	// the group is derived from the field
+	created, err := itemUC.Create(ctx, interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: cityCode("13101")}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "13101", created.Value().Group())
+	_, err = itemUC.Create(ctx, interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: cityCode("13102")}, op)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+	_, err = itemUC.Create(ctx, interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: cityCode("13101"), Group: lo.ToPtr("13102")}, op)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+	// items without a group would be visible to the whole project
+	_, err = itemUC.Create(ctx, interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: cityCode("13101"), Group: lo.ToPtr(" ")}, op)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+	_, err = itemUC.Create(ctx, interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: cityCode("")}, op)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	// items of other groups cannot be found
+	_, err = itemUC.FindByID(ctx, i1.ID(), op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+	_, err = itemUC.FindByID(ctx, i2.ID(), op)
+	assert.NoError(t, err)
+	got, err := itemUC.FindByIDs(ctx, id.ItemIDList{i1.ID(), i2.ID(), created.Value().ID()}, op)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []id.ItemID{i2.ID(), created.Value().ID()}, util.Map(got, func(v item.Versioned) id.ItemID { return v.Value().ID() }))
+	got, _, err = itemUC.Search(ctx, item.NewQuery(prj.ID(), s.ID().Ref(), "", nil), nil, nil, op)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(got))
+	got, err = itemUC.FindAllVersionsByID(ctx, i1.ID(), op)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+
+	// items of other groups cannot be changed
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{ItemID: i1.ID(), Fields: cityCode("13101")}, op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+	assert.Equal(t, rerror.ErrNotFound, itemUC.Delete(ctx, i1.ID(), op))
+
+	// items cannot be moved to other groups
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{ItemID: created.Value().ID(), Fields: cityCode("13102")}, op)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+	updated, err := itemUC.Update(ctx, interfaces.UpdateItemParam{ItemID: i2.ID(), Fields: cityCode("13101")}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "13101", updated.Value().Group())
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{ItemID: i2.ID(), Group: lo.ToPtr("")}, op)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	// maintainers are not restricted
+	op2 := &usecase.Operator{
+		User:                   id.NewUserID().Ref(),
+		MaintainableWorkspaces: []id.WorkspaceID{wid},
+		MaintainableProjects:   []id.ProjectID{prj.ID()},
+	}
+	_, err = itemUC.FindByID(ctx, i1.ID(), op2)
+	assert.NoError(t, err)
+	updated, err = itemUC.Update(ctx, interfaces.UpdateItemParam{ItemID: i1.ID(), Group: lo.ToPtr("13103")}, op2)
+	assert.NoError(t, err)
+	assert.Equal(t, "13103", updated.Value().Group())
+}
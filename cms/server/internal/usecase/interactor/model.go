@@ -8,17 +8,20 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/key"
 	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
 )
 
 type Model struct {
-	repos    *repo.Container
-	gateways *gateway.Container
+	repos       *repo.Container
+	gateways    *gateway.Container
+	ignoreEvent bool
 }
 
 func NewModel(r *repo.Container, g *gateway.Container) interfaces.Model {
@@ -104,6 +107,10 @@ func (i Model) Create(ctx context.Context, param interfaces.CreateModelParam, op
 			if err != nil {
 				return nil, err
 			}
+
+			if err := i.event(ctx, p, event.ModelCreate, m, operator); err != nil {
+				return nil, err
+			}
 			return m, nil
 		})
 }
@@ -138,6 +145,10 @@ func (i Model) Update(ctx context.Context, param interfaces.UpdateModelParam, op
 			if err := i.repos.Model.Save(ctx, m); err != nil {
 				return nil, err
 			}
+
+			if err := i.projectEvent(ctx, event.ModelUpdate, m, operator); err != nil {
+				return nil, err
+			}
 			return m, nil
 		})
 }
@@ -172,7 +183,7 @@ func (i Model) Delete(ctx context.Context, modelID id.ModelID, operator *usecase
 			if err := i.repos.Model.Remove(ctx, modelID); err != nil {
 				return err
 			}
-			return nil
+			return i.projectEvent(ctx, event.ModelDelete, m, operator)
 		})
 }
 
@@ -192,6 +203,37 @@ func (i Model) Publish(ctx context.Context, modelID id.ModelID, b bool, operator
 			if err := i.repos.Model.Save(ctx, m); err != nil {
 				return false, err
 			}
+
+			if err := i.projectEvent(ctx, event.ModelUpdate, m, operator); err != nil {
+				return false, err
+			}
 			return b, nil
 		})
 }
+
+func (i Model) projectEvent(ctx context.Context, ty event.Type, m *model.Model, operator *usecase.Operator) error {
+	if i.ignoreEvent {
+		return nil
+	}
+
+	p, err := i.repos.Project.FindByID(ctx, m.Project())
+	if err != nil {
+		return err
+	}
+	return i.event(ctx, p, ty, m, operator)
+}
+
+func (i Model) event(ctx context.Context, p *project.Project, ty event.Type, m *model.Model, operator *usecase.Operator) error {
+	if i.ignoreEvent {
+		return nil
+	}
+
+	_, err := createEvent(ctx, i.repos, i.gateways, Event{
+		Project:   p,
+		Workspace: p.Workspace(),
+		Type:      ty,
+		Object:    m,
+		Operator:  operator.Operator(),
+	})
+	return err
+}
@@ -0,0 +1,211 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/definition"
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+func (i Model) Export(ctx context.Context, pid id.ProjectID, operator *usecase.Operator) (*definition.Definition, error) {
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(),
+		func(ctx context.Context) (*definition.Definition, error) {
+			if !operator.IsReadableProject(pid) {
+				return nil, interfaces.ErrOperationDenied
+			}
+
+			models, schemas, err := i.projectModels(ctx, pid)
+			if err != nil {
+				return nil, err
+			}
+			return definition.New(models, schemas), nil
+		})
+}
+
+// Import makes the models and the schemas of the project the same as the definition.
+// Models and fields are matched by their keys, and existing fields of the same type are kept with their values.
+// Models which are not in the definition are removed with their schemas and items, and types of fields cannot be changed.
+func (i Model) Import(ctx context.Context, param interfaces.ImportModelsParam, operator *usecase.Operator) (definition.Changes, error) {
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(),
+		func(ctx context.Context) (definition.Changes, error) {
+			if !operator.IsMaintainingProject(param.ProjectID) {
+				return nil, interfaces.ErrOperationDenied
+			}
+
+			if err := param.Definition.Validate(); err != nil {
+				return nil, err
+			}
+
+			p, err := i.repos.Project.FindByID(ctx, param.ProjectID)
+			if err != nil {
+				return nil, err
+			}
+
+			models, schemas, err := i.projectModels(ctx, p.ID())
+			if err != nil {
+				return nil, err
+			}
+
+			changes := definition.Diff(definition.New(models, schemas), param.Definition)
+			if param.DryRun || len(changes) == 0 {
+				return changes, nil
+			}
+			if len(changes.TypeChanges()) > 0 {
+				return changes, interfaces.ErrFieldTypeChanged
+			}
+			if changes.HasDestructive() && !param.AllowDestructive {
+				return changes, interfaces.ErrDestructiveChanges
+			}
+
+			// build all models and fields first so that nothing is saved when the definition is invalid
+			modelIDs := lo.SliceToMap(models, func(m *model.Model) (string, id.ModelID) { return m.Key().String(), m.ID() })
+			newModels := map[string]*model.Model{}
+			for _, dm := range param.Definition.Models {
+				if _, ok := modelIDs[dm.Key]; ok {
+					continue
+				}
+				m, err := model.New().NewID().Project(p.ID()).Schema(id.NewSchemaID()).Key(key.New(dm.Key)).
+					Name(dm.Name).Description(dm.Description).Public(dm.Public).Build()
+				if err != nil {
+					return nil, err
+				}
+				newModels[dm.Key] = m
+				modelIDs[dm.Key] = m.ID()
+			}
+
+			fields := map[string]schema.FieldList{}
+			for _, dm := range param.Definition.Models {
+				var existing schema.FieldList
+				if m, ok := lo.Find(models, func(m *model.Model) bool { return m.Key().String() == dm.Key }); ok {
+					if s, ok := lo.Find(schemas, func(s *schema.Schema) bool { return s.ID() == m.Schema() }); ok {
+						existing = s.Fields()
+					}
+				}
+				f, err := dm.SchemaFields(existing, modelIDs)
+				if err != nil {
+					return nil, err
+				}
+				fields[dm.Key] = f
+			}
+
+			for _, dm := range param.Definition.Models {
+				if m, ok := newModels[dm.Key]; ok {
+					if err := i.importNewModel(ctx, p, m, fields[dm.Key], operator); err != nil {
+						return nil, err
+					}
+					continue
+				}
+
+				m, _ := lo.Find(models, func(m *model.Model) bool { return m.Key().String() == dm.Key })
+				s, ok := lo.Find(schemas, func(s *schema.Schema) bool { return s.ID() == m.Schema() })
+				if !ok {
+					return nil, rerror.ErrNotFound
+				}
+				if err := i.importModel(ctx, p, m, s, dm, fields[dm.Key], changes, operator); err != nil {
+					return nil, err
+				}
+			}
+
+			for _, m := range models {
+				if param.Definition.Model(m.Key().String()) != nil {
+					continue
+				}
+				if err := i.removeModel(ctx, m, operator); err != nil {
+					return nil, err
+				}
+				if err := i.event(ctx, p, event.ModelDelete, m, operator); err != nil {
+					return nil, err
+				}
+			}
+
+			return changes, nil
+		})
+}
+
+func (i Model) importNewModel(ctx context.Context, p *project.Project, m *model.Model, fields schema.FieldList, operator *usecase.Operator) error {
+	s, err := schema.New().ID(m.Schema()).Workspace(p.Workspace()).Project(p.ID()).Build()
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		s.AddField(f)
+	}
+	if err := i.repos.Schema.Save(ctx, s); err != nil {
+		return err
+	}
+	if err := i.repos.Model.Save(ctx, m); err != nil {
+		return err
+	}
+	return i.event(ctx, p, event.ModelCreate, m, operator)
+}
+
+func (i Model) importModel(ctx context.Context, p *project.Project, m *model.Model, s *schema.Schema, dm *definition.Model, fields schema.FieldList, changes definition.Changes, operator *usecase.Operator) error {
+	if lo.SomeBy(changes, func(c definition.Change) bool { return c.Model == dm.Key && c.Field != "" }) {
+		for _, f := range s.Fields() {
+			s.RemoveField(f.ID())
+		}
+		for _, f := range fields {
+			s.AddField(f)
+		}
+		if err := i.repos.Schema.Save(ctx, s); err != nil {
+			return err
+		}
+	}
+
+	if !lo.SomeBy(changes, func(c definition.Change) bool { return c.Model == dm.Key }) {
+		return nil
+	}
+
+	m.SetName(dm.Name)
+	m.SetDescription(dm.Description)
+	m.SetPublic(dm.Public)
+	if err := i.repos.Model.Save(ctx, m); err != nil {
+		return err
+	}
+	return i.event(ctx, p, event.ModelUpdate, m, operator)
+}
+
+// removeModel removes the model with its schema and items so that nothing of the model is left behind.
+// Items are deleted in the same way as deleting them one by one, so references to them from other models follow their on-delete behaviors.
+func (i Model) removeModel(ctx context.Context, m *model.Model, operator *usecase.Operator) error {
+	items, _, err := i.repos.Item.FindByModel(ctx, m.ID(), nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(items) > 0 {
+		itemUC := Item{repos: i.repos, gateways: i.gateways, ignoreEvent: i.ignoreEvent}
+		if err := itemUC.deleteWithReferences(ctx, items.Unwrap(), operator); err != nil {
+			return err
+		}
+	}
+	if err := i.repos.Schema.Remove(ctx, m.Schema()); err != nil && !errors.Is(err, rerror.ErrNotFound) {
+		return err
+	}
+	return i.repos.Model.Remove(ctx, m.ID())
+}
+
+// projectModels returns all models of the project in order of creation and their schemas
+func (i Model) projectModels(ctx context.Context, pid id.ProjectID) (model.List, schema.List, error) {
+	models, _, err := i.repos.Model.FindByProject(ctx, pid, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	models = models.SortByID()
+
+	schemas, err := i.repos.Schema.FindByIDs(ctx, util.Map(models, func(m *model.Model) id.SchemaID { return m.Schema() }))
+	if err != nil {
+		return nil, nil, err
+	}
+	return models, schemas, nil
+}
@@ -0,0 +1,168 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/definition"
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModel_ExportAndImport(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+
+	wid := id.NewWorkspaceID()
+	p1 := project.New().NewID().Workspace(wid).MustBuild()
+	p2 := project.New().NewID().Workspace(wid).MustBuild()
+	s1 := schema.New().NewID().Workspace(wid).Project(p1.ID()).MustBuild()
+	s2 := schema.New().NewID().Workspace(wid).Project(p1.ID()).MustBuild()
+	m1 := model.New().NewID().Project(p1.ID()).Schema(s1.ID()).Key(key.New("city")).Name("City").MustBuild()
+	m2 := model.New().NewID().Project(p1.ID()).Schema(s2.ID()).Key(key.New("building")).Name("Building").MustBuild()
+	s1.AddField(schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(key.New("name")).Name("Name").Required(true).MustBuild())
+	s2.AddField(schema.NewField(schema.NewReference(m1.ID()).TypeProperty()).NewID().Key(key.New("city")).Name("City").MustBuild())
+	s2.AddField(schema.NewField(schema.NewBool().TypeProperty()).NewID().Key(key.New("old")).Name("Old").MustBuild())
+	assert.NoError(t, db.Project.Save(ctx, p1))
+	assert.NoError(t, db.Project.Save(ctx, p2))
+	assert.NoError(t, db.Schema.Save(ctx, s1))
+	assert.NoError(t, db.Schema.Save(ctx, s2))
+	assert.NoError(t, db.Model.Save(ctx, m1))
+	assert.NoError(t, db.Model.Save(ctx, m2))
+
+	uc := NewModel(db, nil)
+	op := &usecase.Operator{
+		User:                 id.NewUserID().Ref(),
+		ReadableProjects:     []id.ProjectID{p1.ID(), p2.ID()},
+		MaintainableProjects: []id.ProjectID{p2.ID()},
+	}
+
+	// export
+	_, err := uc.Export(ctx, p1.ID(), &usecase.Operator{})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	d, err := uc.Export(ctx, p1.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"city", "building"}, lo.Map(d.Models, func(m *definition.Model, _ int) string { return m.Key }))
+	assert.Equal(t, "city", d.Model("building").Field("city").Reference.Model)
+
+	// import into a project which cannot be maintained by the operator
+	_, err = uc.Import(ctx, interfaces.ImportModelsParam{ProjectID: p1.ID(), Definition: d}, op)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	// preview
+	changes, err := uc.Import(ctx, interfaces.ImportModelsParam{ProjectID: p2.ID(), Definition: d, DryRun: true}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, definition.Changes{
+		{Type: definition.ChangeTypeAdd, Model: "city"},
+		{Type: definition.ChangeTypeAdd, Model: "city", Field: "name"},
+		{Type: definition.ChangeTypeAdd, Model: "building"},
+		{Type: definition.ChangeTypeAdd, Model: "building", Field: "city"},
+		{Type: definition.ChangeTypeAdd, Model: "building", Field: "old"},
+	}, changes)
+	models, _, _ := db.Model.FindByProject(ctx, p2.ID(), nil)
+	assert.Empty(t, models)
+
+	// import into an empty project
+	_, err = uc.Import(ctx, interfaces.ImportModelsParam{ProjectID: p2.ID(), Definition: d}, op)
+	assert.NoError(t, err)
+	d2, err := uc.Export(ctx, p2.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, d, d2)
+
+	city, err := db.Model.FindByKey(ctx, p2.ID(), "city")
+	assert.NoError(t, err)
+	building, err := db.Model.FindByKey(ctx, p2.ID(), "building")
+	assert.NoError(t, err)
+	bs, err := db.Schema.FindByID(ctx, building.Schema())
+	assert.NoError(t, err)
+	ref := bs.FieldByIDOrKey(nil, lo.ToPtr(key.New("city")))
+	ref.TypeProperty().Match(schema.TypePropertyMatch{
+		Reference: func(f *schema.FieldReference) {
+			assert.Equal(t, city.ID(), f.Model())
+		},
+	})
+
+	// destructive changes need to be allowed
+	d.Model("building").Fields = []*definition.Field{
+		d.Model("building").Field("city"),
+		{Key: "height", Name: "Height", Type: value.TypeNumber},
+	}
+	changes, err = uc.Import(ctx, interfaces.ImportModelsParam{ProjectID: p2.ID(), Definition: d}, op)
+	assert.Equal(t, interfaces.ErrDestructiveChanges, err)
+	assert.Equal(t, definition.Changes{
+		{Type: definition.ChangeTypeAdd, Model: "building", Field: "height"},
+		{Type: definition.ChangeTypeRemove, Model: "building", Field: "old", Destructive: true},
+	}, changes)
+
+	_, err = uc.Import(ctx, interfaces.ImportModelsParam{ProjectID: p2.ID(), Definition: d, AllowDestructive: true}, op)
+	assert.NoError(t, err)
+	bs2, err := db.Schema.FindByID(ctx, building.Schema())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"city", "height"}, lo.Map(bs2.Fields(), func(f *schema.Field, _ int) string { return f.Key().String() }))
+	// the field which has not been changed is kept
+	assert.Equal(t, ref.ID(), bs2.Fields()[0].ID())
+
+	// types of fields cannot be changed even if destructive changes are allowed
+	d.Model("building").Field("height").Type = value.TypeText
+	changes, err = uc.Import(ctx, interfaces.ImportModelsParam{ProjectID: p2.ID(), Definition: d, AllowDestructive: true}, op)
+	assert.Equal(t, interfaces.ErrFieldTypeChanged, err)
+	assert.Equal(t, definition.Changes{
+		{Type: definition.ChangeTypeUpdate, Model: "building", Field: "height", Destructive: true},
+	}, changes)
+	bs3, err := db.Schema.FindByID(ctx, building.Schema())
+	assert.NoError(t, err)
+	assert.Equal(t, bs2.Fields()[1].ID(), bs3.Fields()[1].ID())
+
+	// removed models are removed with their schemas and items
+	i := item.New().NewID().Schema(building.Schema()).Model(building.ID()).Project(p2.ID()).Thread(id.NewThreadID()).MustBuild()
+	assert.NoError(t, db.Item.Save(ctx, i))
+	d.Models = d.Models[:1]
+	changes, err = uc.Import(ctx, interfaces.ImportModelsParam{ProjectID: p2.ID(), Definition: d}, op)
+	assert.Equal(t, interfaces.ErrDestructiveChanges, err)
+	assert.Equal(t, definition.Changes{
+		{Type: definition.ChangeTypeRemove, Model: "building", Destructive: true},
+	}, changes)
+
+	// items of the removed model follow the on-delete behaviors of references to them
+	rf := schema.NewReference(building.ID())
+	lo.Must0(rf.SetOnDelete(schema.ReferenceOnDeleteRestrict))
+	s3 := schema.New().NewID().Workspace(wid).Project(p1.ID()).Fields(schema.FieldList{
+		schema.NewField(rf.TypeProperty()).NewID().Key(key.New("building")).MustBuild(),
+	}).MustBuild()
+	assert.NoError(t, db.Schema.Save(ctx, s3))
+	referencing := item.New().NewID().Schema(s3.ID()).Model(m1.ID()).Project(p1.ID()).Thread(id.NewThreadID()).Fields([]*item.Field{
+		item.NewField(s3.Fields()[0].ID(), value.TypeReference.Value(i.ID()).AsMultiple()),
+	}).MustBuild()
+	assert.NoError(t, db.Item.Save(ctx, referencing))
+	_, err = uc.Import(ctx, interfaces.ImportModelsParam{ProjectID: p2.ID(), Definition: d, AllowDestructive: true}, op)
+	assert.Equal(t, interfaces.ErrItemReferenced, err)
+	_, err = db.Model.FindByID(ctx, building.ID())
+	assert.NoError(t, err)
+	assert.NoError(t, db.Item.Remove(ctx, referencing.ID()))
+
+	_, err = uc.Import(ctx, interfaces.ImportModelsParam{ProjectID: p2.ID(), Definition: d, AllowDestructive: true}, op)
+	assert.NoError(t, err)
+	_, err = db.Model.FindByID(ctx, building.ID())
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	_, err = db.Schema.FindByID(ctx, building.Schema())
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	_, err = db.Item.FindByID(ctx, i.ID(), nil)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	events, _, err := db.Event.Search(ctx, repo.EventFilter{Item: i.ID().Ref(), Types: []event.Type{event.ItemDelete}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(events))
+}
@@ -9,9 +9,12 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/key"
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
 )
 
 type Project struct {
@@ -113,6 +116,22 @@ func (i *Project) Update(ctx context.Context, p interfaces.UpdateProjectParam, o
 				proj.SetPublication(pub)
 			}
 
+			if p.RequestPolicy != nil {
+				policy, err := i.requestPolicy(ctx, proj, *p.RequestPolicy)
+				if err != nil {
+					return nil, err
+				}
+				proj.SetRequestPolicy(policy)
+			}
+
+			if p.ItemGroupPolicy != nil {
+				policy, err := itemGroupPolicy(proj.ItemGroupPolicy(), *p.ItemGroupPolicy)
+				if err != nil {
+					return nil, err
+				}
+				proj.SetItemGroupPolicy(policy)
+			}
+
 			if err := i.repos.Project.Save(ctx, proj); err != nil {
 				return nil, err
 			}
@@ -121,6 +140,46 @@ func (i *Project) Update(ctx context.Context, p interfaces.UpdateProjectParam, o
 		})
 }
 
+func (i *Project) requestPolicy(ctx context.Context, proj *project.Project, p interfaces.UpdateProjectRequestPolicyParam) (*project.RequestPolicy, error) {
+	cur := proj.RequestPolicy()
+	requiredApprovals, requiredRoles, modelReviewers := cur.RequiredApprovals(), cur.RequiredRoles(), cur.ModelReviewers()
+	if p.RequiredApprovals != nil {
+		requiredApprovals = *p.RequiredApprovals
+	}
+	if p.RequiredRoles != nil {
+		requiredRoles = p.RequiredRoles
+	}
+	if p.ModelReviewers != nil {
+		ws, err := i.repos.Workspace.FindByID(ctx, proj.Workspace())
+		if err != nil {
+			return nil, err
+		}
+		for _, reviewers := range p.ModelReviewers {
+			for _, r := range reviewers {
+				if !ws.Members().IsOwnerOrMaintainer(r) {
+					return nil, rerror.NewE(i18n.T("reviewer should be owner or maintainer"))
+				}
+			}
+		}
+		modelReviewers = p.ModelReviewers
+	}
+	return project.NewRequestPolicy(requiredApprovals, requiredRoles, modelReviewers)
+}
+
+func itemGroupPolicy(cur *project.ItemGroupPolicy, p interfaces.UpdateProjectItemGroupPolicyParam) (*project.ItemGroupPolicy, error) {
+	field, restricted := cur.Field(), cur.Restricted()
+	if p.Field != nil {
+		field = nil
+		if *p.Field != "" {
+			field = key.New(*p.Field).Ref()
+		}
+	}
+	if p.Restricted != nil {
+		restricted = *p.Restricted
+	}
+	return project.NewItemGroupPolicy(field, restricted)
+}
+
 func (i *Project) CheckAlias(ctx context.Context, alias string) (bool, error) {
 	return Run1(ctx, nil, i.repos, Usecase().Transaction(),
 		func(ctx context.Context) (bool, error) {
@@ -153,3 +212,138 @@ func (i *Project) Delete(ctx context.Context, projectID id.ProjectID, operator *
 			return nil
 		})
 }
+
+func (i *Project) CreateAPIKey(ctx context.Context, p interfaces.CreateAPIKeyParam, operator *usecase.Operator) (*project.Project, *project.APIKey, error) {
+	proj, err := i.repos.Project.FindByID(ctx, p.ProjectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return Run2(ctx, operator, i.repos, Usecase().WithMaintainableWorkspaces(proj.Workspace()).Transaction(),
+		func(ctx context.Context) (*project.Project, *project.APIKey, error) {
+			if err := i.checkAPIKeyModels(ctx, proj, p.Models); err != nil {
+				return nil, nil, err
+			}
+
+			k, err := project.NewAPIKey(p.Name, p.Models, p.RateLimit)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			pub := proj.Publication()
+			if pub == nil {
+				pub = project.NewPublication(project.PublicationScopePrivate, false)
+			}
+			pub.AddAPIKey(k)
+			proj.SetPublication(pub)
+
+			if err := i.repos.Project.Save(ctx, proj); err != nil {
+				return nil, nil, err
+			}
+			return proj, k, nil
+		})
+}
+
+func (i *Project) UpdateAPIKey(ctx context.Context, p interfaces.UpdateAPIKeyParam, operator *usecase.Operator) (*project.Project, *project.APIKey, error) {
+	proj, err := i.repos.Project.FindByID(ctx, p.ProjectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return Run2(ctx, operator, i.repos, Usecase().WithMaintainableWorkspaces(proj.Workspace()).Transaction(),
+		func(ctx context.Context) (*project.Project, *project.APIKey, error) {
+			k := findAPIKey(proj, p.KeyID)
+			if k == nil {
+				return nil, nil, project.ErrAPIKeyNotFound
+			}
+
+			if p.Name != nil {
+				if err := k.SetName(*p.Name); err != nil {
+					return nil, nil, err
+				}
+			}
+			if p.Models != nil {
+				if err := i.checkAPIKeyModels(ctx, proj, p.Models); err != nil {
+					return nil, nil, err
+				}
+				k.SetModels(p.Models)
+			}
+			if p.RateLimit != nil {
+				if err := k.SetRateLimit(*p.RateLimit); err != nil {
+					return nil, nil, err
+				}
+			}
+
+			if err := i.repos.Project.Save(ctx, proj); err != nil {
+				return nil, nil, err
+			}
+			return proj, k, nil
+		})
+}
+
+func (i *Project) RegenerateAPIKey(ctx context.Context, pid id.ProjectID, kid id.APIKeyID, operator *usecase.Operator) (*project.Project, *project.APIKey, error) {
+	proj, err := i.repos.Project.FindByID(ctx, pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return Run2(ctx, operator, i.repos, Usecase().WithMaintainableWorkspaces(proj.Workspace()).Transaction(),
+		func(ctx context.Context) (*project.Project, *project.APIKey, error) {
+			k := findAPIKey(proj, kid)
+			if k == nil {
+				return nil, nil, project.ErrAPIKeyNotFound
+			}
+
+			if err := k.Regenerate(); err != nil {
+				return nil, nil, err
+			}
+
+			if err := i.repos.Project.Save(ctx, proj); err != nil {
+				return nil, nil, err
+			}
+			return proj, k, nil
+		})
+}
+
+func (i *Project) DeleteAPIKey(ctx context.Context, pid id.ProjectID, kid id.APIKeyID, operator *usecase.Operator) (*project.Project, error) {
+	proj, err := i.repos.Project.FindByID(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	return Run1(ctx, operator, i.repos, Usecase().WithMaintainableWorkspaces(proj.Workspace()).Transaction(),
+		func(ctx context.Context) (*project.Project, error) {
+			if proj.Publication() == nil || !proj.Publication().RemoveAPIKey(kid) {
+				return nil, project.ErrAPIKeyNotFound
+			}
+
+			if err := i.repos.Project.Save(ctx, proj); err != nil {
+				return nil, err
+			}
+			return proj, nil
+		})
+}
+
+// checkAPIKeyModels checks that all models of the allow-list belong to the project
+func (i *Project) checkAPIKeyModels(ctx context.Context, proj *project.Project, models id.ModelIDList) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	ml, err := i.repos.Model.FindByIDs(ctx, models)
+	if err != nil {
+		return err
+	}
+	if len(ml) != len(lo.Uniq(models)) {
+		return interfaces.ErrInvalidAPIKeyModels
+	}
+	for _, m := range ml {
+		if m.Project() != proj.ID() {
+			return interfaces.ErrInvalidAPIKeyModels
+		}
+	}
+	return nil
+}
+
+func findAPIKey(proj *project.Project, kid id.APIKeyID) *project.APIKey {
+	if proj.Publication() == nil {
+		return nil
+	}
+	return proj.Publication().APIKey(kid)
+}
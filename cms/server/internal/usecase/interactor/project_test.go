@@ -13,6 +13,8 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/rerror"
@@ -671,3 +673,74 @@ func TestProject_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestProject_APIKey(t *testing.T) {
+	ctx := context.Background()
+	wid := id.NewWorkspaceID()
+	p := project.New().NewID().Workspace(wid).MustBuild()
+	m := model.New().NewID().Schema(id.NewSchemaID()).Key(key.Random()).Project(p.ID()).MustBuild()
+	m2 := model.New().NewID().Schema(id.NewSchemaID()).Key(key.Random()).Project(id.NewProjectID()).MustBuild()
+
+	op := &usecase.Operator{
+		User:                   lo.ToPtr(id.NewUserID()),
+		ReadableWorkspaces:     []id.WorkspaceID{wid},
+		WritableWorkspaces:     []id.WorkspaceID{wid},
+		MaintainableWorkspaces: []id.WorkspaceID{wid},
+	}
+	writer := &usecase.Operator{
+		User:               lo.ToPtr(id.NewUserID()),
+		ReadableWorkspaces: []id.WorkspaceID{wid},
+		WritableWorkspaces: []id.WorkspaceID{wid},
+	}
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, p))
+	lo.Must0(db.Model.Save(ctx, m))
+	lo.Must0(db.Model.Save(ctx, m2))
+	uc := NewProject(db, nil)
+
+	// create
+	_, _, err := uc.CreateAPIKey(ctx, interfaces.CreateAPIKeyParam{ProjectID: p.ID(), Name: "key"}, writer)
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+	_, _, err = uc.CreateAPIKey(ctx, interfaces.CreateAPIKeyParam{ProjectID: p.ID(), Name: "key", Models: id.ModelIDList{m2.ID()}}, op)
+	assert.ErrorIs(t, err, interfaces.ErrInvalidAPIKeyModels)
+	_, _, err = uc.CreateAPIKey(ctx, interfaces.CreateAPIKeyParam{ProjectID: p.ID(), Name: ""}, op)
+	assert.ErrorIs(t, err, project.ErrInvalidAPIKey)
+
+	got, k, err := uc.CreateAPIKey(ctx, interfaces.CreateAPIKeyParam{ProjectID: p.ID(), Name: "key", Models: id.ModelIDList{m.ID()}, RateLimit: 10}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "key", k.Name())
+	assert.Equal(t, id.ModelIDList{m.ID()}, k.Models())
+	assert.Equal(t, 10, k.RateLimit())
+	assert.Equal(t, project.PublicationScopePrivate, got.Publication().Scope())
+	saved := lo.Must(db.Project.FindByID(ctx, p.ID()))
+	assert.Equal(t, k, saved.Publication().APIKey(k.ID()))
+
+	// update
+	_, k2, err := uc.UpdateAPIKey(ctx, interfaces.UpdateAPIKeyParam{ProjectID: p.ID(), KeyID: k.ID(), Name: lo.ToPtr("key2"), Models: id.ModelIDList{}, RateLimit: lo.ToPtr(0)}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "key2", k2.Name())
+	assert.Empty(t, k2.Models())
+	assert.Equal(t, 0, k2.RateLimit())
+	assert.Equal(t, k.Key(), k2.Key())
+	_, _, err = uc.UpdateAPIKey(ctx, interfaces.UpdateAPIKeyParam{ProjectID: p.ID(), KeyID: id.NewAPIKeyID(), Name: lo.ToPtr("key2")}, op)
+	assert.ErrorIs(t, err, project.ErrAPIKeyNotFound)
+
+	// regenerate
+	oldKey := k2.Key()
+	_, k3, err := uc.RegenerateAPIKey(ctx, p.ID(), k.ID(), op)
+	assert.NoError(t, err)
+	assert.NotEqual(t, oldKey, k3.Key())
+	saved = lo.Must(db.Project.FindByID(ctx, p.ID()))
+	assert.Nil(t, saved.Publication().APIKeyByKey(oldKey))
+	assert.NotNil(t, saved.Publication().APIKeyByKey(k3.Key()))
+
+	// delete
+	_, err = uc.DeleteAPIKey(ctx, p.ID(), k.ID(), writer)
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+	got, err = uc.DeleteAPIKey(ctx, p.ID(), k.ID(), op)
+	assert.NoError(t, err)
+	assert.Empty(t, got.Publication().APIKeys())
+	_, err = uc.DeleteAPIKey(ctx, p.ID(), k.ID(), op)
+	assert.ErrorIs(t, err, project.ErrAPIKeyNotFound)
+}
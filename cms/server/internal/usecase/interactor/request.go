@@ -11,13 +11,16 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 )
 
 type Request struct {
@@ -92,7 +95,9 @@ func (r Request) Create(ctx context.Context, param interfaces.CreateRequestParam
 			CreatedBy(*operator.User).
 			Thread(th.ID()).
 			Items(param.Items).
-			Title(param.Title)
+			Title(param.Title).
+			PublishAt(param.PublishAt).
+			UnpublishAt(param.UnpublishAt)
 
 		if param.State != nil {
 			if *param.State == request.StateApproved || *param.State == request.StateClosed {
@@ -104,12 +109,16 @@ func (r Request) Create(ctx context.Context, param interfaces.CreateRequestParam
 			builder.Description(*param.Description)
 		}
 		if param.Reviewers != nil && param.Reviewers.Len() > 0 {
-			for _, rev := range param.Reviewers {
-				if !ws.Members().IsOwnerOrMaintainer(rev) {
-					return nil, rerror.NewE(i18n.T("reviewer should be owner or maintainer"))
-				}
+			if err := r.checkReviewers(ctx, ws, p.ID(), param.Reviewers, param.Items); err != nil {
+				return nil, err
 			}
-			builder.Reviewers(param.Reviewers)
+		}
+		reviewers, err := r.withModelReviewers(ctx, p, ws, param.Reviewers, param.Items)
+		if err != nil {
+			return nil, err
+		}
+		if len(reviewers) > 0 {
+			builder.Reviewers(reviewers)
 		}
 
 		req, err := builder.Build()
@@ -121,6 +130,12 @@ func (r Request) Create(ctx context.Context, param interfaces.CreateRequestParam
 			return nil, err
 		}
 
+		if req.State() == request.StateWaiting {
+			if err := r.requestEvent(ctx, p, req, event.RequestSubmit, operator); err != nil {
+				return nil, err
+			}
+		}
+
 		return req, nil
 	})
 }
@@ -147,10 +162,14 @@ func (r Request) Update(ctx context.Context, param interfaces.UpdateRequestParam
 			return nil, interfaces.ErrOperationDenied
 		}
 
+		prevState := req.State()
 		if param.State != nil {
 			if *param.State == request.StateApproved {
 				return nil, rerror.NewE(i18n.T("can't update by approve"))
 			}
+			if *param.State == request.StateBlocked {
+				return nil, rerror.NewE(i18n.T("can't update by request changes"))
+			}
 			req.SetState(*param.State)
 		}
 
@@ -159,10 +178,12 @@ func (r Request) Update(ctx context.Context, param interfaces.UpdateRequestParam
 		}
 
 		if param.Reviewers != nil && param.Reviewers.Len() > 0 {
-			for _, rev := range param.Reviewers {
-				if !ws.Members().IsOwnerOrMaintainer(rev) {
-					return nil, rerror.NewE(i18n.T("reviewer should be owner or maintainer"))
-				}
+			items := req.Items()
+			if param.Items != nil {
+				items = param.Items
+			}
+			if err := r.checkReviewers(ctx, ws, req.Project(), param.Reviewers, items); err != nil {
+				return nil, err
 			}
 			req.SetReviewers(param.Reviewers)
 		}
@@ -182,12 +203,42 @@ func (r Request) Update(ctx context.Context, param interfaces.UpdateRequestParam
 			if err := req.SetItems(param.Items); err != nil {
 				return nil, err
 			}
+
+			prj, err := r.repos.Project.FindByID(ctx, req.Project())
+			if err != nil {
+				return nil, err
+			}
+			reviewers, err := r.withModelReviewers(ctx, prj, ws, req.Reviewers(), param.Items)
+			if err != nil {
+				return nil, err
+			}
+			req.SetReviewers(reviewers)
+		}
+
+		if param.PublishAt != nil || param.UnpublishAt != nil {
+			if err := req.SetSchedule(param.PublishAt, param.UnpublishAt); err != nil {
+				return nil, err
+			}
 		}
 		req.SetUpdatedAt(util.Now())
 		if err := r.repos.Request.Save(ctx, req); err != nil {
 			return nil, err
 		}
 
+		if st := req.State(); st != prevState && (st == request.StateWaiting || st == request.StateClosed) {
+			prj, err := r.repos.Project.FindByID(ctx, req.Project())
+			if err != nil {
+				return nil, err
+			}
+			var ty event.Type = event.RequestSubmit
+			if st == request.StateClosed {
+				ty = event.RequestClose
+			}
+			if err := r.requestEvent(ctx, prj, req, ty, operator); err != nil {
+				return nil, err
+			}
+		}
+
 		return req, nil
 	})
 }
@@ -216,7 +267,9 @@ func (r Request) Approve(ctx context.Context, requestID id.RequestID, operator *
 		if err != nil {
 			return nil, err
 		}
-		if !operator.IsOwningWorkspace(req.Workspace()) && !operator.IsMaintainingWorkspace(req.Workspace()) {
+		if ok, err := r.canApprove(ctx, req, operator); err != nil {
+			return nil, err
+		} else if !ok {
 			return nil, interfaces.ErrInvalidOperator
 		}
 		// only reviewers can approve
@@ -232,21 +285,78 @@ func (r Request) Approve(ctx context.Context, requestID id.RequestID, operator *
 		if req.State() != request.StateWaiting {
 			return nil, rerror.NewE(i18n.T("only requests with status waiting can be approved"))
 		}
+		// approvals are given to the current versions of the items, which are published when the request is approved
+		latest, err := r.repos.Item.FindByIDs(ctx, req.Items().IDs(), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.PinItemVersions(lo.SliceToMap(latest, func(v item.Versioned) (id.ItemID, version.Version) {
+			return v.Value().ID(), v.Version()
+		}))
+		if !req.AddApproval(*operator.User) {
+			return nil, interfaces.ErrAlreadyApproved
+		}
+
+		// roles of approvers are only needed when the policy requires some roles
+		roles := make([]user.Role, len(req.ApprovedBy()))
+		if len(prj.RequestPolicy().RequiredRoles()) > 0 {
+			ws, err := r.repos.Workspace.FindByID(ctx, req.Workspace())
+			if err != nil {
+				return nil, err
+			}
+			roles = lo.Map(req.ApprovedBy(), func(u id.UserID, _ int) user.Role {
+				return ws.Members().UserRole(u)
+			})
+		}
+
+		// the request stays waiting until the approvals satisfy the policy of the project
+		if !prj.RequestPolicy().IsSatisfied(roles) {
+			req.SetUpdatedAt(util.Now())
+			if err := r.repos.Request.Save(ctx, req); err != nil {
+				return nil, err
+			}
+			if err := r.requestEvent(ctx, prj, req, event.RequestReview, operator); err != nil {
+				return nil, err
+			}
+			return req, nil
+		}
+
 		req.SetState(request.StateApproved)
 
 		if err := r.repos.Request.Save(ctx, req); err != nil {
 			return nil, err
 		}
 
+		if err := r.requestEvent(ctx, prj, req, event.RequestApprove, operator); err != nil {
+			return nil, err
+		}
+
+		// the items of a request scheduled for the future are published later by the scheduler
+		if publishAt := req.PublishAt(); publishAt != nil && publishAt.After(util.Now()) {
+			for _, itm := range req.Items() {
+				// the approved version is published instead of the latest version at the time
+				v := version.MatchVersionOrRef(itm.Pointer(), func(v version.Version) *version.Version { return &v }, nil)
+				if err := r.repos.Item.UpdateSchedule(ctx, itm.Item(), publishAt, req.UnpublishAt(), v); err != nil {
+					return nil, err
+				}
+			}
+			return req, nil
+		}
+
 		// apply changes to items (publish items)
 		for _, itm := range req.Items() {
 			// publish the approved version
-			if err := r.repos.Item.UpdateRef(ctx, itm.Item(), version.Public, version.Latest.OrVersion().Ref()); err != nil {
+			if err := r.repos.Item.UpdateRef(ctx, itm.Item(), version.Public, itm.Pointer().Ref()); err != nil {
 				return nil, err
 			}
+			if req.PublishAt() != nil || req.UnpublishAt() != nil {
+				if err := r.repos.Item.UpdateSchedule(ctx, itm.Item(), nil, req.UnpublishAt(), nil); err != nil {
+					return nil, err
+				}
+			}
 		}
 
-		items, err := r.repos.Item.FindByIDs(ctx, req.Items().IDs(), nil)
+		items, err := r.repos.Item.FindByIDs(ctx, req.Items().IDs(), version.Public.Ref())
 		if err != nil {
 			return nil, err
 		}
@@ -282,6 +392,130 @@ func (r Request) Approve(ctx context.Context, requestID id.RequestID, operator *
 	})
 }
 
+// RequestChanges blocks the request until the creator submits it again
+func (r Request) RequestChanges(ctx context.Context, requestID id.RequestID, operator *usecase.Operator) (*request.Request, error) {
+	if operator.User == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, operator, r.repos, Usecase().Transaction(), func(ctx context.Context) (*request.Request, error) {
+		req, err := r.repos.Request.FindByID(ctx, requestID)
+		if err != nil {
+			return nil, err
+		}
+		if ok, err := r.canApprove(ctx, req, operator); err != nil {
+			return nil, err
+		} else if !ok {
+			return nil, interfaces.ErrInvalidOperator
+		}
+		if !req.Reviewers().Has(*operator.User) {
+			return nil, rerror.NewE(i18n.T("only reviewers can request changes"))
+		}
+		if req.State() != request.StateWaiting {
+			return nil, rerror.NewE(i18n.T("only requests with status waiting can be blocked"))
+		}
+
+		prj, err := r.repos.Project.FindByID(ctx, req.Project())
+		if err != nil {
+			return nil, err
+		}
+
+		req.RequestChanges()
+		req.SetUpdatedAt(util.Now())
+		if err := r.repos.Request.Save(ctx, req); err != nil {
+			return nil, err
+		}
+
+		if err := r.requestEvent(ctx, prj, req, event.RequestBlock, operator); err != nil {
+			return nil, err
+		}
+		return req, nil
+	})
+}
+
+// withModelReviewers adds the reviewers who are assigned to the models of the items by the request policy of the project
+func (r Request) withModelReviewers(ctx context.Context, prj *project.Project, ws *user.Workspace, reviewers id.UserIDList, items request.ItemList) (id.UserIDList, error) {
+	policy := prj.RequestPolicy()
+	if len(policy.ModelReviewers()) == 0 {
+		return reviewers, nil
+	}
+
+	models, err := r.itemModels(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	res := reviewers.Clone()
+	for _, u := range policy.ReviewersOf(models...) {
+		// members whose role has been changed since the policy was set are skipped
+		if !res.Has(u) && canReview(ws, u, prj.ID(), models) {
+			res = append(res, u)
+		}
+	}
+	return res, nil
+}
+
+// checkReviewers returns an error when some of the users cannot review the items
+func (r Request) checkReviewers(ctx context.Context, ws *user.Workspace, pid id.ProjectID, users id.UserIDList, items request.ItemList) error {
+	var models id.ModelIDList
+	if lo.SomeBy(users, func(u id.UserID) bool { return ws.UserCustomRole(u) != nil }) {
+		var err error
+		if models, err = r.itemModels(ctx, items); err != nil {
+			return err
+		}
+	}
+	for _, u := range users {
+		if !canReview(ws, u, pid, models) {
+			return rerror.NewE(i18n.T("reviewer should be owner or maintainer"))
+		}
+	}
+	return nil
+}
+
+// canApprove returns whether the operator can approve the request or request changes to it
+func (r Request) canApprove(ctx context.Context, req *request.Request, operator *usecase.Operator) (bool, error) {
+	byRole := operator.IsOwningWorkspace(req.Workspace()) || operator.IsMaintainingWorkspace(req.Workspace())
+	if operator.CustomRole(req.Project()) == nil {
+		return byRole, nil
+	}
+	models, err := r.itemModels(ctx, req.Items())
+	if err != nil {
+		return false, err
+	}
+	return lo.EveryBy(models, func(m id.ModelID) bool {
+		return operator.Can(user.ActionApprove, req.Project(), m.Ref(), byRole)
+	}), nil
+}
+
+func (r Request) itemModels(ctx context.Context, items request.ItemList) (id.ModelIDList, error) {
+	itms, err := r.repos.Item.FindByIDs(ctx, items.IDs(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Uniq(lo.Map(itms, func(i item.Versioned, _ int) id.ModelID {
+		return i.Value().Model()
+	})), nil
+}
+
+// canReview returns whether the member can review the items of the models.
+// Reviewers are owners or maintainers, or members whose custom role allows approving items of all the models.
+func canReview(ws *user.Workspace, u id.UserID, pid id.ProjectID, models id.ModelIDList) bool {
+	if cr := ws.UserCustomRole(u); cr != nil {
+		return lo.EveryBy(models, func(m id.ModelID) bool { return cr.Allows(user.ActionApprove, pid, m.Ref()) })
+	}
+	return ws.Members().IsOwnerOrMaintainer(u)
+}
+
+func (r Request) requestEvent(ctx context.Context, prj *project.Project, req *request.Request, ty event.Type, operator *usecase.Operator) error {
+	return r.event(ctx, Event{
+		Project:   prj,
+		Workspace: req.Workspace(),
+		Type:      ty,
+		Object:    req,
+		Operator:  operator.Operator(),
+	})
+}
+
 func (r Request) event(ctx context.Context, e Event) error {
 	if r.ignoreEvent {
 		return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
 	"github.com/reearth/reearth-cms/server/internal/usecase"
@@ -395,3 +396,224 @@ func TestRequest_Approve(t *testing.T) {
 	expected := version.MustBeValue(itm.Version(), nil, version.NewRefs(version.Public, version.Latest), now, i)
 	assert.Equal(t, expected, itm)
 }
+
+func TestRequest_Approve_Scheduled(t *testing.T) {
+	now := util.Now()
+	defer util.MockNow(now)()
+
+	wid := id.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).RandomKey().MustBuild()
+	i := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID()).MustBuild()
+	ri, _ := request.NewItem(i.ID())
+	u := user.New().Name("aaa").NewID().Email("aaa@bbb.com").Workspace(wid).MustBuild()
+	publishAt, unpublishAt := now.Add(time.Hour), now.Add(2*time.Hour)
+	req := request.New().
+		NewID().
+		Workspace(wid).
+		Project(prj.ID()).
+		Reviewers(id.UserIDList{u.ID()}).
+		CreatedBy(id.NewUserID()).
+		Thread(id.NewThreadID()).
+		Items(request.ItemList{ri}).
+		Title("foo").
+		PublishAt(&publishAt).
+		UnpublishAt(&unpublishAt).
+		MustBuild()
+	op := &usecase.Operator{
+		User:             lo.ToPtr(u.ID()),
+		OwningWorkspaces: id.WorkspaceIDList{wid},
+	}
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Request.Save(ctx, req))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	lo.Must0(db.Item.Save(ctx, i))
+
+	got, err := NewRequest(db, nil).Approve(ctx, req.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, request.StateApproved, got.State())
+
+	// the item is not published until the scheduled time
+	itm, err := db.Item.FindByID(ctx, i.ID(), nil)
+	assert.NoError(t, err)
+	assert.False(t, itm.Refs().Has(version.Public))
+	assert.Equal(t, &publishAt, itm.Value().PublishAt())
+	assert.Equal(t, &unpublishAt, itm.Value().UnpublishAt())
+	approved := itm.Version()
+	assert.Equal(t, &approved, itm.Value().PublishVersion())
+
+	// the approved version is published by the scheduler even if the item has got a newer version
+	lo.Must0(db.Item.Save(ctx, item.New().ID(i.ID()).Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(i.Thread()).
+		PublishAt(&publishAt).UnpublishAt(&unpublishAt).PublishVersion(&approved).MustBuild()))
+	defer util.MockNow(publishAt)()
+	assert.NoError(t, NewItem(db, nil).RunSchedule(ctx, &usecase.Operator{Machine: true}))
+	itm, err = db.Item.FindByID(ctx, i.ID(), version.Public.Ref())
+	assert.NoError(t, err)
+	assert.Equal(t, approved, itm.Version())
+	assert.False(t, itm.Refs().Has(version.Latest))
+}
+
+func TestRequest_Approve_Policy(t *testing.T) {
+	wid := id.NewWorkspaceID()
+	u1 := user.New().Name("aaa").NewID().Email("aaa@bbb.com").Workspace(wid).MustBuild()
+	u2 := user.New().Name("bbb").NewID().Email("bbb@bbb.com").Workspace(wid).MustBuild()
+	ws := user.NewWorkspace().ID(wid).Members(map[user.ID]user.Member{
+		u1.ID(): {Role: user.RoleMaintainer},
+		u2.ID(): {Role: user.RoleOwner},
+	}).MustBuild()
+	policy := lo.Must(project.NewRequestPolicy(2, []user.Role{user.RoleOwner}, nil))
+	prj := project.New().NewID().Workspace(wid).RequestPolicy(policy).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).RandomKey().MustBuild()
+	i := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID()).MustBuild()
+	ri, _ := request.NewItem(i.ID())
+	req := request.New().
+		NewID().
+		Workspace(wid).
+		Project(prj.ID()).
+		Reviewers(id.UserIDList{u1.ID(), u2.ID()}).
+		CreatedBy(id.NewUserID()).
+		Thread(id.NewThreadID()).
+		Items(request.ItemList{ri}).
+		Title("foo").
+		MustBuild()
+	op1 := &usecase.Operator{
+		User:                   lo.ToPtr(u1.ID()),
+		MaintainableWorkspaces: id.WorkspaceIDList{wid},
+	}
+	op2 := &usecase.Operator{
+		User:             lo.ToPtr(u2.ID()),
+		OwningWorkspaces: id.WorkspaceIDList{wid},
+	}
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Request.Save(ctx, req))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	lo.Must0(db.Item.Save(ctx, i))
+	requestUC := NewRequest(db, nil)
+
+	// the first approval is not enough
+	got, err := requestUC.Approve(ctx, req.ID(), op1)
+	assert.NoError(t, err)
+	assert.Equal(t, request.StateWaiting, got.State())
+	assert.Equal(t, id.UserIDList{u1.ID()}, got.ApprovedBy())
+	itm, err := db.Item.FindByID(ctx, i.ID(), nil)
+	assert.NoError(t, err)
+	assert.False(t, itm.Refs().Has(version.Public))
+
+	_, err = requestUC.Approve(ctx, req.ID(), op1)
+	assert.Same(t, interfaces.ErrAlreadyApproved, err)
+
+	// the approval is discarded since the item has got a new version which has not been reviewed
+	lo.Must0(db.Item.Save(ctx, i))
+	got, err = requestUC.Approve(ctx, req.ID(), op2)
+	assert.NoError(t, err)
+	assert.Equal(t, request.StateWaiting, got.State())
+	assert.Equal(t, id.UserIDList{u2.ID()}, got.ApprovedBy())
+
+	// the approvals of the same version satisfy the policy
+	got, err = requestUC.Approve(ctx, req.ID(), op1)
+	assert.NoError(t, err)
+	assert.Equal(t, request.StateApproved, got.State())
+	itm, err = db.Item.FindByID(ctx, i.ID(), nil)
+	assert.NoError(t, err)
+	assert.True(t, itm.Refs().Has(version.Public))
+	assert.Equal(t, request.ItemList{lo.Must(request.NewItemWithVersion(i.ID(), itm.Version().OrRef()))}, got.Items())
+
+	// the approved version is published even if the item has got a newer version
+	lo.Must0(db.Item.Save(ctx, i))
+	itm, err = db.Item.FindByID(ctx, i.ID(), nil)
+	assert.NoError(t, err)
+	assert.False(t, itm.Refs().Has(version.Public))
+}
+
+func TestRequest_RequestChanges(t *testing.T) {
+	wid := id.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	ri, _ := request.NewItem(id.NewItemID())
+	u := user.New().Name("aaa").NewID().Email("aaa@bbb.com").Workspace(wid).MustBuild()
+	req := request.New().
+		NewID().
+		Workspace(wid).
+		Project(prj.ID()).
+		Reviewers(id.UserIDList{u.ID()}).
+		CreatedBy(id.NewUserID()).
+		Thread(id.NewThreadID()).
+		Items(request.ItemList{ri}).
+		Title("foo").
+		MustBuild()
+	op := &usecase.Operator{
+		User:             lo.ToPtr(u.ID()),
+		OwningWorkspaces: id.WorkspaceIDList{wid},
+	}
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Request.Save(ctx, req))
+	requestUC := NewRequest(db, nil)
+
+	_, err := requestUC.RequestChanges(ctx, req.ID(), &usecase.Operator{
+		User:             lo.ToPtr(id.NewUserID()),
+		OwningWorkspaces: id.WorkspaceIDList{wid},
+	})
+	assert.Error(t, err)
+
+	got, err := requestUC.RequestChanges(ctx, req.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, request.StateBlocked, got.State())
+
+	// a blocked request can't be approved until it is submitted again
+	_, err = requestUC.Approve(ctx, req.ID(), op)
+	assert.Error(t, err)
+	_, err = requestUC.RequestChanges(ctx, req.ID(), op)
+	assert.Error(t, err)
+}
+
+func TestRequest_Create_ModelReviewers(t *testing.T) {
+	wid := id.NewWorkspaceID()
+	u1 := user.New().Name("aaa").NewID().Email("aaa@bbb.com").Workspace(wid).MustBuild()
+	u2, u3 := id.NewUserID(), id.NewUserID()
+	ws := user.NewWorkspace().ID(wid).Members(map[user.ID]user.Member{
+		u1.ID(): {Role: user.RoleOwner},
+		u2:      {Role: user.RoleMaintainer},
+		u3:      {Role: user.RoleReader},
+	}).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(id.NewProjectID()).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).RandomKey().MustBuild()
+	policy := lo.Must(project.NewRequestPolicy(1, nil, map[id.ModelID]id.UserIDList{m.ID(): {u2, u3}}))
+	prj := project.New().ID(s.Project()).Workspace(wid).RequestPolicy(policy).MustBuild()
+	i := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID()).MustBuild()
+	ri, _ := request.NewItem(i.ID())
+	op := &usecase.Operator{
+		User:             lo.ToPtr(u1.ID()),
+		OwningWorkspaces: id.WorkspaceIDList{wid},
+	}
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	lo.Must0(db.Item.Save(ctx, i))
+
+	got, err := NewRequest(db, nil).Create(ctx, interfaces.CreateRequestParam{
+		ProjectID: prj.ID(),
+		Title:     "foo",
+		Reviewers: id.UserIDList{u1.ID()},
+		Items:     request.ItemList{ri},
+	}, op)
+	assert.NoError(t, err)
+	// readers are not added as reviewers even if they are assigned by the policy
+	assert.Equal(t, id.UserIDList{u1.ID(), u2}, got.Reviewers())
+}
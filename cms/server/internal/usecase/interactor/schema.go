@@ -2,20 +2,26 @@ package interactor
 
 import (
 	"context"
+	"errors"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/key"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/rerror"
 	"github.com/samber/lo"
 )
 
 type Schema struct {
-	repos    *repo.Container
-	gateways *gateway.Container
+	repos       *repo.Container
+	gateways    *gateway.Container
+	ignoreEvent bool
 }
 
 func NewSchema(r *repo.Container, g *gateway.Container) interfaces.Schema {
@@ -48,26 +54,25 @@ func (i Schema) CreateField(ctx context.Context, param interfaces.CreateFieldPar
 			return nil, schema.ErrInvalidKey
 		}
 
-		f, err := schema.NewField(param.TypeProperty).
-			NewID().
-			Unique(param.Unique).
-			Multiple(param.Multiple).
-			Required(param.Required).
-			Name(param.Name).
-			Description(lo.FromPtr(param.Description)).
-			Key(key.New(param.Key)).
-			DefaultValue(param.DefaultValue).
-			Build()
+		f, err := newField(param)
 		if err != nil {
 			return nil, err
 		}
 
+		if err := validateRequiredCondition(s, param.RequiredIf); err != nil {
+			return nil, err
+		}
+
 		s.AddField(f)
 
 		if err := i.repos.Schema.Save(ctx, s); err != nil {
 			return nil, err
 		}
 
+		if err := i.event(ctx, s, event.FieldCreate, operator); err != nil {
+			return nil, err
+		}
+
 		return f, nil
 	})
 }
@@ -91,10 +96,17 @@ func (i Schema) UpdateField(ctx context.Context, param interfaces.UpdateFieldPar
 		if err := updateField(param, f); err != nil {
 			return nil, err
 		}
+		if err := validateRequiredCondition(s, f.RequiredIf()); err != nil {
+			return nil, err
+		}
 		if err := i.repos.Schema.Save(ctx, s); err != nil {
 			return nil, err
 		}
 
+		if err := i.event(ctx, s, event.FieldUpdate, operator); err != nil {
+			return nil, err
+		}
+
 		return f, nil
 	})
 }
@@ -112,7 +124,10 @@ func (i Schema) DeleteField(ctx context.Context, schemaId id.SchemaID, fieldID i
 			}
 
 			s.RemoveField(fieldID)
-			return i.repos.Schema.Save(ctx, s)
+			if err := i.repos.Schema.Save(ctx, s); err != nil {
+				return err
+			}
+			return i.event(ctx, s, event.FieldDelete, operator)
 		})
 }
 
@@ -135,15 +150,54 @@ func (i Schema) UpdateFields(ctx context.Context, sid id.SchemaID, params []inte
 			if err != nil {
 				return nil, err
 			}
+			if err := validateRequiredCondition(s, f.RequiredIf()); err != nil {
+				return nil, err
+			}
 		}
 		if err := i.repos.Schema.Save(ctx, s); err != nil {
 			return nil, err
 		}
 
+		if err := i.event(ctx, s, event.FieldUpdate, operator); err != nil {
+			return nil, err
+		}
+
 		return nil, nil
 	})
 }
 
+// event records a change of fields of the schema with the model which has the schema
+func (i Schema) event(ctx context.Context, s *schema.Schema, ty event.Type, operator *usecase.Operator) error {
+	if i.ignoreEvent {
+		return nil
+	}
+
+	p, err := i.repos.Project.FindByID(ctx, s.Project())
+	if err != nil {
+		return err
+	}
+
+	// schemas of groups do not belong to any model
+	var mid *id.ModelID
+	m, err := i.repos.Model.FindBySchema(ctx, s.ID())
+	if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+		return err
+	}
+	if m != nil {
+		mid = m.ID().Ref()
+	}
+
+	_, err = createEvent(ctx, i.repos, i.gateways, Event{
+		Project:   p,
+		Workspace: s.Workspace(),
+		Model:     mid,
+		Type:      ty,
+		Object:    s,
+		Operator:  operator.Operator(),
+	})
+	return err
+}
+
 func updateField(param interfaces.UpdateFieldParam, f *schema.Field) error {
 	if param.TypeProperty != nil {
 		if param.DefaultValue != nil {
@@ -186,8 +240,139 @@ func updateField(param interfaces.UpdateFieldParam, f *schema.Field) error {
 		f.SetUnique(*param.Unique)
 	}
 
+	if param.Localized != nil {
+		f.SetLocalized(*param.Localized)
+	}
+
 	if param.Multiple != nil {
 		f.SetMultiple(*param.Multiple)
 	}
+
+	if param.MinItems != nil || param.MaxItems != nil {
+		if err := f.SetItemCount(param.MinItems, param.MaxItems); err != nil {
+			return err
+		}
+	}
+
+	if param.RemoveRequiredIf {
+		_ = f.SetRequiredIf(nil)
+	} else if param.RequiredIf != nil {
+		if err := f.SetRequiredIf(param.RequiredIf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MigrateFields creates a task which replaces the source fields with the target fields and converts values of items. The task is executed in background.
+func (i Schema) MigrateFields(ctx context.Context, param interfaces.MigrateFieldsParam, operator *usecase.Operator) (*task.Task, error) {
+	if operator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (*task.Task, error) {
+		m, err := i.repos.Model.FindByID(ctx, param.ModelID)
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := i.repos.Schema.FindByID(ctx, m.Schema())
+		if err != nil {
+			return nil, err
+		}
+
+		if !operator.IsMaintainingProject(s.Project()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		sources := make(schema.FieldList, 0, len(param.Sources))
+		for _, fid := range param.Sources {
+			f := s.Field(fid)
+			if f == nil {
+				return nil, interfaces.ErrFieldNotFound
+			}
+			sources = append(sources, f)
+		}
+
+		targets := make(schema.FieldList, 0, len(param.Targets))
+		for _, p := range param.Targets {
+			if p.Key == "" {
+				return nil, schema.ErrInvalidKey
+			}
+			f, err := newField(p)
+			if err != nil {
+				return nil, err
+			}
+			targets = append(targets, f)
+		}
+
+		mig, err := item.NewMigration(param.Type, sources, targets, param.Separator)
+		if err != nil {
+			return nil, err
+		}
+
+		// check that the migration can be applied to the schema without changing it
+		migrated, err := schema.New().ID(s.ID()).Workspace(s.Workspace()).Project(s.Project()).
+			Fields(lo.Map(s.Fields(), func(f *schema.Field, _ int) *schema.Field { return f.Clone() })).Build()
+		if err != nil {
+			return nil, err
+		}
+		if err := mig.Apply(migrated); err != nil {
+			return nil, err
+		}
+		for _, f := range targets {
+			if err := validateRequiredCondition(migrated, f.RequiredIf()); err != nil {
+				return nil, err
+			}
+		}
+
+		t, err := task.New().
+			NewID().
+			Workspace(s.Workspace()).
+			Project(s.Project()).
+			User(operator.User).
+			Integration(operator.Integration).
+			Type(task.TypeMigrateFields).
+			MigrateFields(&task.MigrateFieldsConfig{
+				ModelID:   m.ID(),
+				Type:      string(param.Type),
+				Sources:   param.Sources,
+				Targets:   targets,
+				Separator: param.Separator,
+				DryRun:    param.DryRun,
+			}).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := i.repos.Task.Save(ctx, t); err != nil {
+			return nil, err
+		}
+		return t, nil
+	})
+}
+
+func newField(param interfaces.CreateFieldParam) (*schema.Field, error) {
+	return schema.NewField(param.TypeProperty).
+		NewID().
+		Unique(param.Unique).
+		Multiple(param.Multiple).
+		Required(param.Required).
+		Localized(param.Localized).
+		Name(param.Name).
+		Description(lo.FromPtr(param.Description)).
+		Key(key.New(param.Key)).
+		DefaultValue(param.DefaultValue).
+		ItemCount(param.MinItems, param.MaxItems).
+		RequiredIf(param.RequiredIf).
+		Build()
+}
+
+// validateRequiredCondition checks that the field referred by the condition exists in the schema
+func validateRequiredCondition(s *schema.Schema, c *schema.RequiredCondition) error {
+	if c != nil && !s.HasField(c.Field()) {
+		return schema.ErrInvalidCondition
+	}
 	return nil
 }
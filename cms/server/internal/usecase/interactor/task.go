@@ -0,0 +1,654 @@
+package interactor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// taskBatchSize is the number of records processed between saving the progress of a task
+const taskBatchSize = 100
+
+// taskStaleDuration is the duration after which a running task without any progress is regarded as interrupted and resumed
+const taskStaleDuration = 10 * time.Minute
+
+type Task struct {
+	repos    *repo.Container
+	gateways *gateway.Container
+}
+
+func NewTask(r *repo.Container, g *gateway.Container) interfaces.Task {
+	return &Task{
+		repos:    r,
+		gateways: g,
+	}
+}
+
+func (i *Task) FindByID(ctx context.Context, tid id.TaskID, operator *usecase.Operator) (*task.Task, error) {
+	t, err := i.repos.Task.FindByID(ctx, tid)
+	if err != nil {
+		return nil, err
+	}
+	if !operator.IsReadableWorkspace(t.Workspace()) {
+		return nil, interfaces.ErrOperationDenied
+	}
+	return t, nil
+}
+
+func (i *Task) FindByProject(ctx context.Context, pid id.ProjectID, p *usecasex.Pagination, operator *usecase.Operator) (task.List, *usecasex.PageInfo, error) {
+	prj, err := i.repos.Project.FindByID(ctx, pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !operator.IsReadableWorkspace(prj.Workspace()) {
+		return nil, nil, interfaces.ErrOperationDenied
+	}
+	return i.repos.Task.FindByProject(ctx, pid, p)
+}
+
+func (i *Task) Run(ctx context.Context, operator *usecase.Operator) error {
+	if !operator.Machine {
+		return interfaces.ErrInvalidOperator
+	}
+
+	tasks, err := i.repos.Task.FindRunnable(ctx, util.Now().Add(-taskStaleDuration))
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tasks {
+		if err := i.run(ctx, t); err != nil {
+			log.Errorf("task: failed to run task: id=%s err=%v", t.ID(), err)
+			t.Fail(err)
+			if err := i.repos.Task.Save(ctx, t); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (i *Task) run(ctx context.Context, t *task.Task) error {
+	switch t.Type() {
+	case task.TypeImportItems:
+		return i.importItems(ctx, t)
+	case task.TypeExportItems:
+		return i.exportItems(ctx, t)
+	case task.TypeMigrateFields:
+		return i.migrateFields(ctx, t)
+	}
+	return task.ErrInvalidType
+}
+
+func (i *Task) importItems(ctx context.Context, t *task.Task) error {
+	cfg := t.ImportItems()
+	format, ok := item.ImportFormatFrom(cfg.Format)
+	if !ok {
+		return interfaces.ErrInvalidImportFormat
+	}
+
+	op, err := i.operator(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	m, err := i.repos.Model.FindByID(ctx, cfg.ModelID)
+	if err != nil {
+		return err
+	}
+
+	s, err := i.repos.Schema.FindByID(ctx, m.Schema())
+	if err != nil {
+		return err
+	}
+
+	a, err := i.repos.Asset.FindByID(ctx, cfg.AssetID)
+	if err != nil {
+		return err
+	}
+
+	f, err := i.gateways.File.ReadAsset(ctx, a.UUID(), a.FileName())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	rows, err := item.ReadImportRows(f, format)
+	if err != nil {
+		return err
+	}
+
+	t.Start(len(rows))
+	if err := i.repos.Task.Save(ctx, t); err != nil {
+		return err
+	}
+
+	it := Item{repos: i.repos, gateways: i.gateways}
+	// resume from the record next to the last processed one
+	for row := t.Progress().Processed; row < len(rows); row++ {
+		created, updated, errs := it.importRow(ctx, rows[row], cfg, m, s, op)
+		t.Record(row, created, updated, errs...)
+
+		if (row+1)%taskBatchSize == 0 {
+			if err := i.repos.Task.Save(ctx, t); err != nil {
+				return err
+			}
+		}
+	}
+
+	t.Complete()
+	return i.repos.Task.Save(ctx, t)
+}
+
+func (i *Task) exportItems(ctx context.Context, t *task.Task) error {
+	cfg := t.ExportItems()
+	format, ok := item.ExportFormatFrom(cfg.Format)
+	if !ok {
+		return interfaces.ErrInvalidExportFormat
+	}
+
+	op, err := i.operator(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	m, err := i.repos.Model.FindByID(ctx, cfg.ModelID)
+	if err != nil {
+		return err
+	}
+
+	s, err := i.repos.Schema.FindByID(ctx, m.Schema())
+	if err != nil {
+		return err
+	}
+
+	q, err := exportQuery(s, cfg)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp("", "reearth-cms-export-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	assetURLs := map[id.AssetID]string{}
+	w, err := item.NewExportWriter(f, format, s, func(aid id.AssetID) string {
+		return assetURLs[aid]
+	})
+	if err != nil {
+		return err
+	}
+
+	// the file being written is lost when the task is interrupted, so the task always starts over
+	t.ResetProgress()
+	for offset := int64(0); ; offset += taskBatchSize {
+		items, pi, err := i.repos.Item.Search(ctx, q, nil, usecasex.OffsetPagination{
+			Offset: offset,
+			Limit:  taskBatchSize,
+		}.Wrap())
+		if err != nil {
+			return err
+		}
+		if offset == 0 {
+			t.Start(int(pi.TotalCount))
+		}
+
+		assets, err := i.repos.Asset.FindByIDs(ctx, lo.FlatMap(items.Unwrap(), func(it *item.Item, _ int) []id.AssetID {
+			return it.AssetIDs()
+		}))
+		if err != nil {
+			return err
+		}
+		for _, a := range assets {
+			if a != nil {
+				assetURLs[a.ID()] = i.gateways.File.GetURL(a)
+			}
+		}
+
+		for j, it := range items.Unwrap() {
+			// items which the exporter cannot read, such as items of other groups, are skipped without being written
+			if !canReadItem(it, op) {
+				t.Record(int(offset)+j, false, false)
+				continue
+			}
+			var errs []task.Error
+			if err := w.Write(it); err != nil {
+				errs = append(errs, task.Error{Message: err.Error()})
+			}
+			t.Record(int(offset)+j, false, false, errs...)
+		}
+		if err := i.repos.Task.Save(ctx, t); err != nil {
+			return err
+		}
+
+		if len(items) < taskBatchSize || !pi.HasNextPage {
+			break
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	a, _, err := NewAsset(i.repos, i.gateways).Create(ctx, interfaces.CreateAssetParam{
+		ProjectID: m.Project(),
+		File: &file.File{
+			Content:     io.NopCloser(f),
+			Path:        fmt.Sprintf("%s-%s.%s", m.Key().String(), util.Now().Format("20060102150405"), format.Ext()),
+			Size:        size,
+			ContentType: format.ContentType(),
+		},
+		SkipDecompression: true,
+	}, op)
+	if err != nil {
+		return err
+	}
+
+	t.CompleteWithAsset(a.ID())
+	return i.repos.Task.Save(ctx, t)
+}
+
+// migrateFields converts values of all items of the model and then replaces the source fields of the schema with the target fields.
+// All items are checked before any of them are changed, and the schema is not changed when some items cannot be converted.
+// Converted items are saved as new versions. The task always starts over because items which have been converted are skipped.
+func (i *Task) migrateFields(ctx context.Context, t *task.Task) error {
+	cfg := t.MigrateFields()
+	typ, ok := item.MigrationTypeFrom(cfg.Type)
+	if !ok {
+		return item.ErrInvalidMigration
+	}
+
+	op, err := i.operator(ctx, t)
+	if err != nil {
+		return err
+	}
+	if !op.IsMaintainingProject(t.Project()) {
+		return interfaces.ErrOperationDenied
+	}
+
+	m, err := i.repos.Model.FindByID(ctx, cfg.ModelID)
+	if err != nil {
+		return err
+	}
+
+	s, err := i.repos.Schema.FindByID(ctx, m.Schema())
+	if err != nil {
+		return err
+	}
+
+	sources := make(schema.FieldList, 0, len(cfg.Sources))
+	for _, fid := range cfg.Sources {
+		f := s.Field(fid)
+		if f == nil {
+			return interfaces.ErrFieldNotFound
+		}
+		sources = append(sources, f)
+	}
+
+	mig, err := item.NewMigration(typ, sources, cfg.Targets, cfg.Separator)
+	if err != nil {
+		return err
+	}
+
+	q := item.NewQuery(s.Project(), s.ID().Ref(), "", nil)
+	if err := i.migrateItems(ctx, t, q, mig, false); err != nil {
+		return err
+	}
+	if cfg.DryRun {
+		t.Complete()
+		return i.repos.Task.Save(ctx, t)
+	}
+	if t.Progress().Failed > 0 {
+		t.Fail(interfaces.ErrMigrationFailed)
+		return i.repos.Task.Save(ctx, t)
+	}
+
+	if err := i.migrateItems(ctx, t, q, mig, true); err != nil {
+		return err
+	}
+	// items changed after the first pass may fail to be converted. The schema is kept so that their values are not orphaned.
+	if t.Progress().Failed > 0 {
+		t.Fail(interfaces.ErrMigrationFailed)
+		return i.repos.Task.Save(ctx, t)
+	}
+
+	if err := mig.Apply(s); err != nil {
+		return err
+	}
+	if err := i.repos.Schema.Save(ctx, s); err != nil {
+		return err
+	}
+	if err := (Schema{repos: i.repos, gateways: i.gateways}).event(ctx, s, event.FieldUpdate, op); err != nil {
+		return err
+	}
+
+	t.Complete()
+	return i.repos.Task.Save(ctx, t)
+}
+
+// migrateItems converts values of the items and records the result of each item. Items are saved only when save is true.
+func (i *Task) migrateItems(ctx context.Context, t *task.Task, q *item.Query, mig *item.Migration, save bool) error {
+	t.ResetProgress()
+	for offset := int64(0); ; offset += taskBatchSize {
+		items, pi, err := i.repos.Item.Search(ctx, q, nil, usecasex.OffsetPagination{
+			Offset: offset,
+			Limit:  taskBatchSize,
+		}.Wrap())
+		if err != nil {
+			return err
+		}
+		if offset == 0 {
+			t.Start(int(pi.TotalCount))
+		}
+
+		for j, itm := range items.Unwrap() {
+			row := int(offset) + j
+			fields, err := mig.Convert(itm)
+			if err != nil {
+				e := task.Error{Item: itm.ID().Ref(), Message: err.Error()}
+				var merr *item.MigrationError
+				if errors.As(err, &merr) {
+					e.Field, e.Message = merr.Field, merr.Err.Error()
+				}
+				t.Record(row, false, false, e)
+				continue
+			}
+
+			converted := len(fields) > 0 && !lo.EveryBy(fields, func(f *item.Field) bool {
+				old := itm.Field(f.FieldID())
+				return old != nil && old.Value().Equal(f.Value())
+			})
+			if save && converted {
+				itm.UpdateFields(fields)
+				if err := i.repos.Item.Save(ctx, itm); err != nil {
+					return err
+				}
+			}
+			t.Record(row, false, converted)
+		}
+		if err := i.repos.Task.Save(ctx, t); err != nil {
+			return err
+		}
+
+		if len(items) < taskBatchSize || !pi.HasNextPage {
+			break
+		}
+	}
+	return nil
+}
+
+// exportQuery builds a query of the items to be exported. Filters are resolved in the same way as the public API.
+func exportQuery(s *schema.Schema, cfg *task.ExportItemsConfig) (*item.Query, error) {
+	q := item.NewQuery(s.Project(), s.ID().Ref(), cfg.Keyword, nil)
+
+	for _, f := range cfg.Filters {
+		sf := s.FieldByIDOrKey(nil, key.New(f.Key).Ref())
+		if sf == nil {
+			return nil, interfaces.ErrFieldNotFound
+		}
+
+		op := item.FilterOperatorFrom(f.Operator)
+		if op == item.FilterOperatorExists {
+			exists, err := strconv.ParseBool(f.Value)
+			if err != nil {
+				return nil, item.ErrInvalidFilter
+			}
+			q = q.WithFilters(item.NewExistsFieldFilter(sf.ID(), exists))
+			continue
+		}
+
+		raw := []string{f.Value}
+		if op == item.FilterOperatorIn {
+			raw = strings.Split(f.Value, ",")
+		}
+		values := make([]*value.Value, 0, len(raw))
+		for _, r := range raw {
+			v := sf.Type().Value(strings.TrimSpace(r))
+			if v == nil {
+				return nil, item.ErrInvalidFilter
+			}
+			values = append(values, v)
+		}
+
+		ff, err := item.NewFieldFilter(sf.ID(), op, values...)
+		if err != nil {
+			return nil, err
+		}
+		q = q.WithFilters(ff)
+	}
+
+	sf, err := item.ParseSpatialFilter(cfg.BBox, cfg.Intersects)
+	if err != nil {
+		return nil, err
+	}
+	if sf != nil {
+		q = q.WithSpatialFilter(sf)
+	}
+
+	return q, nil
+}
+
+// operator returns an operator which acts as the user or the integration which created the task, with the current role in the workspace
+func (i *Task) operator(ctx context.Context, t *task.Task) (*usecase.Operator, error) {
+	ws, err := i.repos.Workspace.FindByID(ctx, t.Workspace())
+	if err != nil {
+		return nil, err
+	}
+
+	op := &usecase.Operator{
+		User:        t.User(),
+		Integration: t.Integration(),
+	}
+
+	var role user.Role
+	var customRole *user.CustomRole
+	var groups []string
+	if op.User != nil {
+		role = ws.Members().UserRole(*op.User)
+		customRole = ws.UserCustomRole(*op.User)
+		groups = ws.Members().UserGroups(*op.User)
+	} else if op.Integration != nil {
+		role = ws.Members().IntegrationRole(*op.Integration)
+		customRole = ws.IntegrationCustomRole(*op.Integration)
+		groups = ws.Members().IntegrationGroups(*op.Integration)
+	}
+
+	wids := user.WorkspaceIDList{ws.ID()}
+	pids := project.IDList{t.Project()}
+	switch role {
+	case user.RoleOwner:
+		op.OwningWorkspaces, op.OwningProjects = wids, pids
+	case user.RoleMaintainer:
+		op.MaintainableWorkspaces, op.MaintainableProjects = wids, pids
+	case user.RoleWriter:
+		op.WritableWorkspaces, op.WritableProjects = wids, pids
+	}
+
+	if customRole != nil {
+		// items are checked by the custom role one by one
+		op.ReadableWorkspaces, op.ReadableProjects = wids, pids
+		op.CustomRoles = map[project.ID]*user.CustomRole{t.Project(): customRole}
+	} else if !op.IsWritableWorkspace(ws.ID()) {
+		return nil, interfaces.ErrOperationDenied
+	}
+
+	if !op.IsMaintainingWorkspace(ws.ID()) {
+		p, err := i.repos.Project.FindByID(ctx, t.Project())
+		if err != nil {
+			return nil, err
+		}
+		if p.ItemGroupPolicy().Restricted() {
+			op.ItemGroups = map[project.ID][]string{t.Project(): groups}
+		}
+	}
+	return op, nil
+}
+
+// importRow creates or updates an item with a record. In dry run, it only validates the record.
+func (i Item) importRow(ctx context.Context, row item.ImportRow, cfg *task.ImportItemsConfig, m *model.Model, s *schema.Schema, operator *usecase.Operator) (created, updated bool, errs []task.Error) {
+	var params []interfaces.ItemFieldParam
+	var keyParam *interfaces.ItemFieldParam
+
+	columns := maps.Keys(row)
+	slices.Sort(columns)
+	for _, c := range columns {
+		sf := s.FieldByIDOrKey(nil, lo.ToPtr(key.New(cfg.FieldKey(c))))
+		if sf == nil {
+			continue
+		}
+
+		v, ok := importValue(row[c], sf)
+		if !ok {
+			errs = append(errs, task.Error{Field: sf.Key().String(), Message: interfaces.ErrInvalidValue.Error()})
+			continue
+		}
+
+		p := interfaces.ItemFieldParam{Key: lo.ToPtr(sf.Key()), Value: v}
+		params = append(params, p)
+		if cfg.KeyField != "" && sf.Key().String() == cfg.KeyField {
+			keyParam = &p
+		}
+	}
+	if len(errs) > 0 {
+		return
+	}
+	if len(params) == 0 {
+		return false, false, []task.Error{{Message: interfaces.ErrItemFieldRequired.Error()}}
+	}
+
+	fail := func(err error) (bool, bool, []task.Error) {
+		return false, false, []task.Error{{Message: err.Error()}}
+	}
+
+	var existing *item.Item
+	if keyParam != nil {
+		fields, err := itemFieldsFromParams([]interfaces.ItemFieldParam{*keyParam}, s, nil)
+		if err != nil {
+			return fail(err)
+		}
+
+		items, err := i.repos.Item.FindByModelAndValue(ctx, m.ID(), []repo.FieldAndValue{{
+			Field: fields[0].FieldID(),
+			Value: fields[0].Value(),
+		}}, nil)
+		if err != nil {
+			return fail(err)
+		}
+		if len(items) > 1 {
+			return fail(interfaces.ErrDuplicatedItemValue)
+		}
+		if len(items) == 1 {
+			existing = items[0].Value()
+		}
+	}
+
+	if cfg.DryRun {
+		fields, err := itemFieldsFromParams(params, s, existing)
+		if err != nil {
+			return fail(err)
+		}
+		if existing == nil {
+			if !operator.Can(user.ActionCreate, m.Project(), m.ID().Ref(), operator.IsWritableProject(m.Project())) {
+				err = interfaces.ErrOperationDenied
+			} else {
+				err = validateRequiredConditions(fields, s)
+			}
+		} else if !operator.CanUpdate(existing) {
+			err = interfaces.ErrOperationDenied
+		}
+		if err == nil {
+			err = i.checkUnique(ctx, fields, s, m.ID(), existing)
+		}
+		if err != nil {
+			return fail(err)
+		}
+		return existing == nil, existing != nil, nil
+	}
+
+	if existing == nil {
+		if _, err := i.Create(ctx, interfaces.CreateItemParam{
+			SchemaID: s.ID(),
+			ModelID:  m.ID(),
+			Fields:   params,
+		}, operator); err != nil {
+			return fail(err)
+		}
+		return true, false, nil
+	}
+
+	if _, err := i.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: existing.ID(),
+		Fields: params,
+	}, operator); err != nil {
+		return fail(err)
+	}
+	return false, true, nil
+}
+
+// importValue converts a value of a record to a value of a field param and reports whether it can be converted to the type of the field.
+// A value of a multiple field in CSV is a JSON array or a single value.
+func importValue(v any, sf *schema.Field) (any, bool) {
+	vs := []any{v}
+	if sf.Multiple() {
+		switch w := v.(type) {
+		case []any:
+			vs = w
+		case string:
+			if strings.HasPrefix(strings.TrimSpace(w), "[") {
+				d := json.NewDecoder(strings.NewReader(w))
+				d.UseNumber()
+				if err := d.Decode(&vs); err != nil {
+					return nil, false
+				}
+			}
+		}
+	}
+
+	if lo.SomeBy(vs, func(e any) bool { return value.New(sf.Type(), e) == nil }) {
+		return nil, false
+	}
+	if !sf.Multiple() {
+		return v, true
+	}
+	return vs, true
+}
@@ -0,0 +1,355 @@
+package interactor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTask_ImportItems(t *testing.T) {
+	uid := id.NewUserID()
+	ws := user.NewWorkspace().NewID().Members(map[user.ID]user.Member{
+		uid: {Role: user.RoleWriter},
+	}).MustBuild()
+	prj := project.New().NewID().Workspace(ws.ID()).MustBuild()
+	sfCode := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("code").Key(key.New("code")).MustBuild()
+	sfName := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("name").Key(key.New("name")).MustBuild()
+	sfPop := schema.NewField(lo.Must(schema.NewInteger(nil, nil)).TypeProperty()).NewID().Name("pop").Key(key.New("pop")).MustBuild()
+	s := schema.New().NewID().Workspace(ws.ID()).Project(prj.ID()).Fields(schema.FieldList{sfCode, sfName, sfPop}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(key.Random()).Project(prj.ID()).MustBuild()
+	a := asset.New().NewID().Project(prj.ID()).CreatedByUser(uid).Size(100).Thread(id.NewThreadID()).FileName("cities.csv").NewUUID().MustBuild()
+	existing := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID()).User(uid).Fields([]*item.Field{
+		item.NewField(sfCode.ID(), value.TypeText.Value("001").AsMultiple()),
+		item.NewField(sfName.ID(), value.TypeText.Value("old").AsMultiple()),
+	}).MustBuild()
+
+	mfs := afero.NewMemMapFs()
+	lo.Must0(afero.WriteFile(mfs, "assets/"+a.UUID()[:2]+"/"+a.UUID()[2:]+"/cities.csv", []byte(
+		"code,city,pop\n001,Tokyo,100\n002,Osaka,abc\n003,Nagoya,300\n",
+	), 0644))
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	lo.Must0(db.Asset.Save(ctx, a))
+	lo.Must0(db.Item.Save(ctx, existing))
+
+	gw := &gateway.Container{File: lo.Must(fs.NewFile(mfs, "", nil))}
+	itemUC := NewItem(db, gw)
+	taskUC := NewTask(db, gw)
+	op := &usecase.Operator{
+		User:               &uid,
+		ReadableWorkspaces: []id.WorkspaceID{ws.ID()},
+		WritableWorkspaces: []id.WorkspaceID{ws.ID()},
+		WritableProjects:   []id.ProjectID{prj.ID()},
+	}
+	machine := &usecase.Operator{Machine: true}
+
+	param := interfaces.ImportItemsParam{
+		ModelID:  m.ID(),
+		AssetID:  a.ID(),
+		Format:   item.ImportFormatCSV,
+		Fields:   map[string]string{"city": "name"},
+		KeyField: lo.ToPtr(key.New("code")),
+		DryRun:   true,
+	}
+
+	_, err := itemUC.Import(ctx, interfaces.ImportItemsParam{
+		ModelID: m.ID(),
+		AssetID: a.ID(),
+		Format:  item.ImportFormatCSV,
+		Fields:  map[string]string{"city": "unknown"},
+	}, op)
+	assert.Equal(t, interfaces.ErrImportFieldNotFound, err)
+
+	_, err = itemUC.Import(ctx, param, &usecase.Operator{User: &uid})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	assert.Equal(t, interfaces.ErrInvalidOperator, taskUC.Run(ctx, op))
+
+	// dry run
+	tk, err := itemUC.Import(ctx, param, op)
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusPending, tk.Status())
+	assert.NoError(t, taskUC.Run(ctx, machine))
+
+	tk, err = taskUC.FindByID(ctx, tk.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusCompleted, tk.Status())
+	assert.Equal(t, task.Progress{Total: 3, Processed: 3, Created: 1, Updated: 1, Failed: 1}, tk.Progress())
+	assert.Equal(t, 1, len(tk.Errors()))
+	assert.Equal(t, 1, tk.Errors()[0].Row)
+	assert.Equal(t, 1, len(lo.Must(db.Item.FindByModelAndValue(ctx, m.ID(), []repo.FieldAndValue{{Field: sfCode.ID(), Value: value.TypeText.Value("001").AsMultiple()}}, nil))))
+	assert.Equal(t, 0, len(lo.Must(db.Item.FindByModelAndValue(ctx, m.ID(), []repo.FieldAndValue{{Field: sfCode.ID(), Value: value.TypeText.Value("003").AsMultiple()}}, nil))))
+
+	// import
+	param.DryRun = false
+	tk, err = itemUC.Import(ctx, param, op)
+	assert.NoError(t, err)
+	assert.NoError(t, taskUC.Run(ctx, machine))
+
+	tk, err = taskUC.FindByID(ctx, tk.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusCompleted, tk.Status())
+	assert.Equal(t, task.Progress{Total: 3, Processed: 3, Created: 1, Updated: 1, Failed: 1}, tk.Progress())
+
+	updated := lo.Must(db.Item.FindByID(ctx, existing.ID(), nil)).Value()
+	assert.Equal(t, value.TypeText.Value("Tokyo").AsMultiple(), updated.Field(sfName.ID()).Value())
+	assert.Equal(t, value.TypeInteger.Value(int64(100)).AsMultiple(), updated.Field(sfPop.ID()).Value())
+
+	created := lo.Must(db.Item.FindByModelAndValue(ctx, m.ID(), []repo.FieldAndValue{{Field: sfCode.ID(), Value: value.TypeText.Value("003").AsMultiple()}}, nil))
+	assert.Equal(t, 1, len(created))
+	assert.Equal(t, value.TypeText.Value("Nagoya").AsMultiple(), created[0].Value().Field(sfName.ID()).Value())
+	assert.Equal(t, &uid, created[0].Value().User())
+
+	tasks, _, err := taskUC.FindByProject(ctx, prj.ID(), nil, op)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(tasks))
+
+	_, err = taskUC.FindByID(ctx, tk.ID(), &usecase.Operator{User: &uid})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+}
+
+func TestTask_ExportItems(t *testing.T) {
+	uid := id.NewUserID()
+	ws := user.NewWorkspace().NewID().Members(map[user.ID]user.Member{
+		uid: {Role: user.RoleWriter},
+	}).MustBuild()
+	prj := project.New().NewID().Workspace(ws.ID()).MustBuild()
+	sfName := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("name").Key(key.New("name")).MustBuild()
+	sfPop := schema.NewField(lo.Must(schema.NewInteger(nil, nil)).TypeProperty()).NewID().Name("pop").Key(key.New("pop")).MustBuild()
+	s := schema.New().NewID().Workspace(ws.ID()).Project(prj.ID()).Fields(schema.FieldList{sfName, sfPop}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(key.New("cities")).Project(prj.ID()).MustBuild()
+	i1 := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID()).User(uid).Fields([]*item.Field{
+		item.NewField(sfName.ID(), value.TypeText.Value("Tokyo").AsMultiple()),
+		item.NewField(sfPop.ID(), value.TypeInteger.Value(int64(100)).AsMultiple()),
+	}).MustBuild()
+	i2 := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID()).User(uid).Fields([]*item.Field{
+		item.NewField(sfName.ID(), value.TypeText.Value("Osaka").AsMultiple()),
+		item.NewField(sfPop.ID(), value.TypeInteger.Value(int64(50)).AsMultiple()),
+	}).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	lo.Must0(db.Item.Save(ctx, i1))
+	lo.Must0(db.Item.Save(ctx, i2))
+
+	gw := &gateway.Container{File: lo.Must(fs.NewFile(afero.NewMemMapFs(), "", nil))}
+	itemUC := NewItem(db, gw)
+	taskUC := NewTask(db, gw)
+	op := &usecase.Operator{
+		User:               &uid,
+		ReadableWorkspaces: []id.WorkspaceID{ws.ID()},
+		WritableWorkspaces: []id.WorkspaceID{ws.ID()},
+		WritableProjects:   []id.ProjectID{prj.ID()},
+	}
+	machine := &usecase.Operator{Machine: true}
+
+	_, err := itemUC.Export(ctx, interfaces.ExportItemsParam{ModelID: m.ID(), Format: "pdf"}, op)
+	assert.Equal(t, interfaces.ErrInvalidExportFormat, err)
+
+	_, err = itemUC.Export(ctx, interfaces.ExportItemsParam{
+		ModelID: m.ID(),
+		Format:  item.ExportFormatCSV,
+		Filters: []task.ExportFilter{{Key: "unknown", Operator: "eq", Value: "a"}},
+	}, op)
+	assert.Equal(t, interfaces.ErrFieldNotFound, err)
+
+	_, err = itemUC.Export(ctx, interfaces.ExportItemsParam{ModelID: m.ID(), Format: item.ExportFormatCSV}, &usecase.Operator{User: &uid})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	tk, err := itemUC.Export(ctx, interfaces.ExportItemsParam{
+		ModelID: m.ID(),
+		Format:  item.ExportFormatCSV,
+		Filters: []task.ExportFilter{{Key: "pop", Operator: "gt", Value: "60"}},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusPending, tk.Status())
+	assert.NoError(t, taskUC.Run(ctx, machine))
+
+	tk, err = taskUC.FindByID(ctx, tk.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusCompleted, tk.Status())
+	assert.Equal(t, task.Progress{Total: 1, Processed: 1}, tk.Progress())
+	assert.NotNil(t, tk.Asset())
+
+	a, err := db.Asset.FindByID(ctx, *tk.Asset())
+	assert.NoError(t, err)
+	assert.Equal(t, prj.ID(), a.Project())
+	assert.True(t, strings.HasPrefix(a.FileName(), "cities-"))
+	assert.True(t, strings.HasSuffix(a.FileName(), ".csv"))
+
+	r, err := gw.File.ReadAsset(ctx, a.UUID(), a.FileName())
+	assert.NoError(t, err)
+	rows, err := item.ReadImportRows(r, item.ImportFormatCSV)
+	assert.NoError(t, err)
+	assert.Equal(t, []item.ImportRow{{"id": i1.ID().String(), "name": "Tokyo", "pop": "100"}}, rows)
+
+	// items of groups which the exporter cannot access are not exported
+	prj.SetItemGroupPolicy(lo.Must(project.NewItemGroupPolicy(nil, true)))
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(ws.Members().UpdateUserGroups(uid, []string{"osaka"}))
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	i1.SetGroup("tokyo")
+	i2.SetGroup("osaka")
+	lo.Must0(db.Item.Save(ctx, i1))
+	lo.Must0(db.Item.Save(ctx, i2))
+
+	tk, err = itemUC.Export(ctx, interfaces.ExportItemsParam{ModelID: m.ID(), Format: item.ExportFormatCSV}, op)
+	assert.NoError(t, err)
+	assert.NoError(t, taskUC.Run(ctx, machine))
+
+	tk, err = taskUC.FindByID(ctx, tk.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusCompleted, tk.Status())
+	assert.NotNil(t, tk.Asset())
+
+	a, err = db.Asset.FindByID(ctx, *tk.Asset())
+	assert.NoError(t, err)
+	r, err = gw.File.ReadAsset(ctx, a.UUID(), a.FileName())
+	assert.NoError(t, err)
+	rows, err = item.ReadImportRows(r, item.ImportFormatCSV)
+	assert.NoError(t, err)
+	assert.Equal(t, []item.ImportRow{{"id": i2.ID().String(), "name": "Osaka", "pop": "50"}}, rows)
+}
+
+func TestTask_MigrateFields(t *testing.T) {
+	uid := id.NewUserID()
+	ws := user.NewWorkspace().NewID().Members(map[user.ID]user.Member{
+		uid: {Role: user.RoleMaintainer},
+	}).MustBuild()
+	prj := project.New().NewID().Workspace(ws.ID()).MustBuild()
+	sfName := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("name").Key(key.New("name")).MustBuild()
+	sfPop := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("pop").Key(key.New("pop")).MustBuild()
+	s := schema.New().NewID().Workspace(ws.ID()).Project(prj.ID()).Fields(schema.FieldList{sfName, sfPop}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(key.New("cities")).Project(prj.ID()).MustBuild()
+	i1 := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID()).User(uid).Fields([]*item.Field{
+		item.NewField(sfName.ID(), value.TypeText.Value("Tokyo").AsMultiple()),
+		item.NewField(sfPop.ID(), value.TypeText.Value("100").AsMultiple()),
+	}).MustBuild()
+	i2 := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID()).User(uid).Fields([]*item.Field{
+		item.NewField(sfName.ID(), value.TypeText.Value("Osaka").AsMultiple()),
+		item.NewField(sfPop.ID(), value.TypeText.Value("unknown").AsMultiple()),
+	}).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	lo.Must0(db.Item.Save(ctx, i1))
+	lo.Must0(db.Item.Save(ctx, i2))
+
+	schemaUC := NewSchema(db, nil)
+	taskUC := NewTask(db, nil)
+	op := &usecase.Operator{
+		User:                   &uid,
+		ReadableWorkspaces:     []id.WorkspaceID{ws.ID()},
+		MaintainableWorkspaces: []id.WorkspaceID{ws.ID()},
+		MaintainableProjects:   []id.ProjectID{prj.ID()},
+	}
+	machine := &usecase.Operator{Machine: true}
+
+	param := interfaces.MigrateFieldsParam{
+		ModelID: m.ID(),
+		Type:    item.MigrationTypeConvert,
+		Sources: id.FieldIDList{sfPop.ID()},
+		Targets: []interfaces.CreateFieldParam{{
+			Type:         value.TypeInteger,
+			Name:         "pop",
+			Key:          "pop",
+			TypeProperty: lo.Must(schema.NewInteger(nil, nil)).TypeProperty(),
+		}},
+		DryRun: true,
+	}
+
+	_, err := schemaUC.MigrateFields(ctx, param, &usecase.Operator{User: &uid})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	_, err = schemaUC.MigrateFields(ctx, interfaces.MigrateFieldsParam{
+		ModelID: m.ID(),
+		Type:    item.MigrationTypeConvert,
+		Sources: id.FieldIDList{sfPop.ID()},
+		Targets: []interfaces.CreateFieldParam{{Type: value.TypeText, Key: "name", TypeProperty: schema.NewText(nil).TypeProperty()}},
+	}, op)
+	assert.Equal(t, schema.ErrInvalidKey, err)
+
+	// dry run reports the items which cannot be converted
+	tk, err := schemaUC.MigrateFields(ctx, param, op)
+	assert.NoError(t, err)
+	assert.NoError(t, taskUC.Run(ctx, machine))
+
+	tk, err = taskUC.FindByID(ctx, tk.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusCompleted, tk.Status())
+	assert.Equal(t, task.Progress{Total: 2, Processed: 2, Updated: 1, Failed: 1}, tk.Progress())
+	assert.Equal(t, 1, len(tk.Errors()))
+	assert.Equal(t, i2.ID().Ref(), tk.Errors()[0].Item)
+	assert.Equal(t, "pop", tk.Errors()[0].Field)
+
+	// the schema is not changed when some items cannot be converted
+	param.DryRun = false
+	tk, err = schemaUC.MigrateFields(ctx, param, op)
+	assert.NoError(t, err)
+	assert.NoError(t, taskUC.Run(ctx, machine))
+
+	tk, err = taskUC.FindByID(ctx, tk.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusFailed, tk.Status())
+	assert.Equal(t, interfaces.ErrMigrationFailed.Error(), tk.Message())
+	assert.Equal(t, value.TypeText, lo.Must(db.Schema.FindByID(ctx, s.ID())).Field(sfPop.ID()).Type())
+	assert.Equal(t, 1, len(lo.Must(db.Item.FindAllVersionsByID(ctx, i1.ID()))))
+
+	// migrate
+	i2.UpdateFields([]*item.Field{item.NewField(sfPop.ID(), value.TypeText.Value("50").AsMultiple())})
+	lo.Must0(db.Item.Save(ctx, i2))
+
+	tk, err = schemaUC.MigrateFields(ctx, param, op)
+	assert.NoError(t, err)
+	assert.NoError(t, taskUC.Run(ctx, machine))
+
+	tk, err = taskUC.FindByID(ctx, tk.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusCompleted, tk.Status())
+	assert.Equal(t, task.Progress{Total: 2, Processed: 2, Updated: 2}, tk.Progress())
+
+	s2 := lo.Must(db.Schema.FindByID(ctx, s.ID()))
+	assert.Nil(t, s2.Field(sfPop.ID()))
+	assert.Equal(t, []string{"name", "pop"}, lo.Map(s2.Fields(), func(f *schema.Field, _ int) string { return f.Key().String() }))
+	pop := s2.Fields()[1]
+	assert.Equal(t, value.TypeInteger, pop.Type())
+	assert.Equal(t, tk.MigrateFields().Targets[0].ID(), pop.ID())
+
+	versions := lo.Must(db.Item.FindAllVersionsByID(ctx, i1.ID()))
+	assert.Equal(t, 2, len(versions))
+	assert.Equal(t, value.TypeInteger.Value(int64(100)).AsMultiple(), versions[1].Value().Field(pop.ID()).Value())
+	assert.Equal(t, value.TypeInteger.Value(int64(50)).AsMultiple(), lo.Must(db.Item.FindByID(ctx, i2.ID(), nil)).Value().Field(pop.ID()).Value())
+}
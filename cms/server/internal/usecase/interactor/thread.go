@@ -1,16 +1,53 @@
 package interactor
 
 import (
+	"bytes"
 	"context"
+	_ "embed"
+	"fmt"
+	htmlTmpl "html/template"
+	textTmpl "text/template"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/reearth/reearthx/log"
+	"github.com/samber/lo"
 )
 
+type commentMailContent struct {
+	UserName string
+	Message  string
+	Comment  string
+}
+
+var (
+	//go:embed emails/comment_html.tmpl
+	commentHTMLTMPLStr string
+	//go:embed emails/comment_text.tmpl
+	commentTextTMPLStr string
+
+	commentTextTMPL *textTmpl.Template
+	commentHTMLTMPL *htmlTmpl.Template
+)
+
+func init() {
+	var err error
+	commentTextTMPL, err = textTmpl.New("comment").Parse(commentTextTMPLStr)
+	if err != nil {
+		log.Panicf("comment email template parse error: %s\n", err)
+	}
+	commentHTMLTMPL, err = htmlTmpl.New("comment").Parse(commentHTMLTMPLStr)
+	if err != nil {
+		log.Panicf("comment email template parse error: %s\n", err)
+	}
+}
+
 type Thread struct {
 	repos    *repo.Container
 	gateways *gateway.Container
@@ -56,15 +93,18 @@ func (i *Thread) CreateThread(ctx context.Context, wid id.WorkspaceID, op *useca
 	)
 }
 
-func (i *Thread) AddComment(ctx context.Context, thid id.ThreadID, content string, op *usecase.Operator) (*thread.Thread, *thread.Comment, error) {
+func (i *Thread) AddComment(ctx context.Context, param interfaces.AddCommentParam, op *usecase.Operator) (*thread.Thread, *thread.Comment, error) {
 	if op.User == nil && op.Integration == nil {
 		return nil, nil, interfaces.ErrInvalidOperator
 	}
-	return Run2(
+
+	var ws *user.Workspace
+	var participants id.UserIDList
+	th, comment, err := Run2(
 		ctx, op, i.repos,
 		Usecase().Transaction(),
 		func(ctx context.Context) (*thread.Thread, *thread.Comment, error) {
-			th, err := i.repos.Thread.FindByID(ctx, thid)
+			th, err := i.repos.Thread.FindByID(ctx, param.ThreadID)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -73,7 +113,20 @@ func (i *Thread) AddComment(ctx context.Context, thid id.ThreadID, content strin
 				return nil, nil, interfaces.ErrOperationDenied
 			}
 
-			comment := thread.NewComment(thread.NewCommentID(), op.Operator(), content)
+			ws, err = i.repos.Workspace.FindByID(ctx, th.Workspace())
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if !validateMentions(ws, param.Mentions) {
+				return nil, nil, interfaces.ErrInvalidMention
+			}
+
+			// participants are collected before the new comment is added so that only the previous ones are notified as such
+			participants, _ = th.Participants()
+
+			comment := thread.NewComment(thread.NewCommentID(), op.Operator(), param.Content)
+			comment.SetMentions(param.Mentions)
 			if err := th.AddComment(comment); err != nil {
 				return nil, nil, err
 			}
@@ -82,9 +135,26 @@ func (i *Thread) AddComment(ctx context.Context, thid id.ThreadID, content strin
 				return nil, nil, err
 			}
 
+			if _, err := createEvent(ctx, i.repos, i.gateways, Event{
+				Workspace:     th.Workspace(),
+				Type:          event.CommentCreate,
+				Object:        th,
+				WebhookObject: comment,
+				Operator:      op.Operator(),
+			}); err != nil {
+				return nil, nil, err
+			}
+
 			return th, comment, nil
 		},
 	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// emails are sent after the comment is committed so that they are neither sent for rolled back comments nor slow down the transaction
+	i.notify(ctx, ws, comment, participants)
+	return th, comment, nil
 }
 
 func (i *Thread) UpdateComment(ctx context.Context, thid id.ThreadID, cid id.CommentID, content string, op *usecase.Operator) (*thread.Thread, *thread.Comment, error) {
@@ -146,3 +216,119 @@ func (i *Thread) DeleteComment(ctx context.Context, thid id.ThreadID, cid id.Com
 		},
 	)
 }
+
+func (i *Thread) ResolveThread(ctx context.Context, thid id.ThreadID, resolved bool, op *usecase.Operator) (*thread.Thread, error) {
+	if op.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	return Run1(
+		ctx, op, i.repos,
+		Usecase().Transaction(),
+		func(ctx context.Context) (*thread.Thread, error) {
+			th, err := i.repos.Thread.FindByID(ctx, thid)
+			if err != nil {
+				return nil, err
+			}
+
+			if !op.IsWritableWorkspace(th.Workspace()) {
+				return nil, interfaces.ErrOperationDenied
+			}
+
+			th.SetResolved(resolved)
+
+			if err := i.repos.Thread.Save(ctx, th); err != nil {
+				return nil, err
+			}
+
+			return th, nil
+		},
+	)
+}
+
+// validateMentions checks that all mentioned users and integrations are members of the workspace
+func validateMentions(ws *user.Workspace, m *thread.Mentions) bool {
+	if m.IsEmpty() {
+		return true
+	}
+	return lo.EveryBy(m.Users, ws.Members().HasUser) &&
+		lo.EveryBy(m.Integrations, ws.Members().HasIntegration)
+}
+
+// notify sends emails to the users who are mentioned in the comment and to the users who have participated in the thread.
+// Failures are only logged because notifications should not prevent comments from being posted.
+func (i *Thread) notify(ctx context.Context, ws *user.Workspace, c *thread.Comment, participants id.UserIDList) {
+	if i.gateways == nil || i.gateways.Mailer == nil {
+		return
+	}
+
+	author := c.Author().User()
+	var mentioned id.UserIDList
+	if m := c.Mentions(); m != nil {
+		mentioned = m.Users
+	}
+	recipients := mentioned.AddUniq(participants...)
+	recipients = lo.Filter(recipients, func(u id.UserID, _ int) bool {
+		return (author == nil || u != *author) && ws.Members().HasUser(u)
+	})
+	if len(recipients) == 0 {
+		return
+	}
+
+	users, err := i.repos.User.FindByIDs(ctx, recipients)
+	if err != nil {
+		log.Errorf("thread: failed to find users to notify: %v", err)
+		return
+	}
+
+	authorName := i.authorName(ctx, c)
+	for _, u := range users {
+		message := fmt.Sprintf("%s commented on a thread you are participating in the workspace %s.", authorName, ws.Name())
+		if mentioned.Has(u.ID()) {
+			message = fmt.Sprintf("%s mentioned you in a comment in the workspace %s.", authorName, ws.Name())
+		}
+
+		content := commentMailContent{
+			UserName: u.Name(),
+			Message:  message,
+			Comment:  c.Content(),
+		}
+
+		var text, html bytes.Buffer
+		if err := commentTextTMPL.Execute(&text, content); err != nil {
+			log.Errorf("thread: failed to render comment email: %v", err)
+			return
+		}
+		if err := commentHTMLTMPL.Execute(&html, content); err != nil {
+			log.Errorf("thread: failed to render comment email: %v", err)
+			return
+		}
+
+		if err := i.gateways.Mailer.SendMail(
+			[]gateway.Contact{
+				{
+					Email: u.Email(),
+					Name:  u.Name(),
+				},
+			},
+			"New comment in "+ws.Name(),
+			text.String(),
+			html.String(),
+		); err != nil {
+			log.Errorf("thread: failed to send comment email to %s: %v", u.ID(), err)
+		}
+	}
+}
+
+func (i *Thread) authorName(ctx context.Context, c *thread.Comment) string {
+	if uid := c.Author().User(); uid != nil {
+		if u, err := i.repos.User.FindByID(ctx, *uid); err == nil {
+			return u.Name()
+		}
+	}
+	if iid := c.Author().Integration(); iid != nil {
+		if in, err := i.repos.Integration.FindByID(ctx, *iid); err == nil {
+			return in.Name()
+		}
+	}
+	return "Someone"
+}
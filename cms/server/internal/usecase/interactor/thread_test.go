@@ -2,15 +2,22 @@ package interactor
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mailer"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
 	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
@@ -278,6 +285,7 @@ func TestThread_AddComment(t *testing.T) {
 	wid := id.NewWorkspaceID()
 	th1 := thread.New().NewID().Workspace(wid).Comments([]*thread.Comment{}).MustBuild()
 	uid := id.NewUserID()
+	ws := user.NewWorkspace().ID(wid).Members(map[user.ID]user.Member{uid: {Role: user.RoleOwner}}).MustBuild()
 	op := &usecase.Operator{
 		User:               &uid,
 		ReadableWorkspaces: nil,
@@ -347,16 +355,17 @@ func TestThread_AddComment(t *testing.T) {
 			thread := tc.seed.Clone()
 			err := db.Thread.Save(ctx, thread)
 			assert.NoError(t, err)
+			assert.NoError(t, db.Workspace.Save(ctx, ws))
 
 			threadUC := NewThread(db, nil)
 			if tc.mockError && tc.wantErr != nil {
 				thid := id.NewThreadID()
-				_, _, err := threadUC.AddComment(ctx, thid, tc.args.content, tc.args.operator)
+				_, _, err := threadUC.AddComment(ctx, interfaces.AddCommentParam{ThreadID: thid, Content: tc.args.content}, tc.args.operator)
 				assert.Equal(t, tc.wantErr, err)
 				return
 			}
 
-			th, c, err := threadUC.AddComment(ctx, thread.ID(), tc.args.content, tc.args.operator)
+			th, c, err := threadUC.AddComment(ctx, interfaces.AddCommentParam{ThreadID: thread.ID(), Content: tc.args.content}, tc.args.operator)
 			if tc.wantErr != nil {
 				assert.Equal(t, tc.wantErr, err)
 				return
@@ -375,6 +384,105 @@ func TestThread_AddComment(t *testing.T) {
 	}
 }
 
+func TestThread_AddComment_Mentions(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+
+	u1 := user.New().NewID().Name("u1").Email("u1@example.com").Workspace(id.NewWorkspaceID()).MustBuild()
+	u2 := user.New().NewID().Name("u2").Email("u2@example.com").Workspace(id.NewWorkspaceID()).MustBuild()
+	u3 := user.New().NewID().Name("u3").Email("u3@example.com").Workspace(id.NewWorkspaceID()).MustBuild()
+	iid := id.NewIntegrationID()
+	ws := user.NewWorkspace().NewID().Name("ws").
+		Members(map[user.ID]user.Member{u1.ID(): {Role: user.RoleOwner}, u2.ID(): {Role: user.RoleWriter}, u3.ID(): {Role: user.RoleReader}}).
+		Integrations(map[user.IntegrationID]user.Member{iid: {Role: user.RoleWriter}}).
+		MustBuild()
+	th := thread.New().NewID().Workspace(ws.ID()).MustBuild()
+	for _, u := range []*user.User{u1, u2, u3} {
+		assert.NoError(t, db.User.Save(ctx, u))
+	}
+	assert.NoError(t, db.Workspace.Save(ctx, ws))
+	assert.NoError(t, db.Thread.Save(ctx, th))
+
+	m := mailer.NewMock()
+	uc := NewThread(db, &gateway.Container{Mailer: m})
+	op1 := &usecase.Operator{User: lo.ToPtr(u1.ID()), WritableWorkspaces: []id.WorkspaceID{ws.ID()}}
+	op2 := &usecase.Operator{Integration: &iid, WritableWorkspaces: []id.WorkspaceID{ws.ID()}}
+
+	// mentions of non-members are rejected
+	_, _, err := uc.AddComment(ctx, interfaces.AddCommentParam{
+		ThreadID: th.ID(),
+		Content:  "hi",
+		Mentions: &thread.Mentions{Users: id.UserIDList{id.NewUserID()}},
+	}, op1)
+	assert.Equal(t, interfaces.ErrInvalidMention, err)
+
+	// the mentioned user is notified
+	_, c, err := uc.AddComment(ctx, interfaces.AddCommentParam{
+		ThreadID: th.ID(),
+		Content:  "please check",
+		Mentions: &thread.Mentions{Users: id.UserIDList{u2.ID()}, Integrations: id.IntegrationIDList{iid}},
+	}, op1)
+	assert.NoError(t, err)
+	assert.Equal(t, &thread.Mentions{Users: id.UserIDList{u2.ID()}, Integrations: id.IntegrationIDList{iid}}, c.Mentions())
+	mails := m.Mails()
+	assert.Equal(t, 1, len(mails))
+	assert.Equal(t, []gateway.Contact{{Email: "u2@example.com", Name: "u2"}}, mails[0].To)
+	assert.Contains(t, mails[0].PlainContent, "u1 mentioned you")
+	assert.Contains(t, mails[0].PlainContent, "please check")
+
+	// the participants of the thread are notified of comments by integrations
+	_, _, err = uc.AddComment(ctx, interfaces.AddCommentParam{
+		ThreadID: th.ID(),
+		Content:  "conversion finished",
+		Mentions: &thread.Mentions{Users: id.UserIDList{u3.ID()}},
+	}, op2)
+	assert.NoError(t, err)
+	mails = m.Mails()[1:]
+	assert.Equal(t, 3, len(mails))
+	to := lo.Map(mails, func(m mailer.Mail, _ int) string { return m.To[0].Email })
+	assert.ElementsMatch(t, []string{"u1@example.com", "u2@example.com", "u3@example.com"}, to)
+
+	// comment.create events are recorded
+	events, _, err := db.Event.Search(ctx, repo.EventFilter{Workspace: ws.ID().Ref(), Types: []event.Type{event.CommentCreate}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(events))
+
+	// nobody is notified of comments which fail to be committed
+	commitErr := errors.New("commit")
+	db.Transaction = &usecasex.NopTransaction{CommitError: commitErr}
+	_, _, err = uc.AddComment(ctx, interfaces.AddCommentParam{
+		ThreadID: th.ID(),
+		Content:  "lost",
+		Mentions: &thread.Mentions{Users: id.UserIDList{u2.ID()}},
+	}, op1)
+	assert.ErrorIs(t, err, commitErr)
+	assert.Equal(t, 4, len(m.Mails()))
+}
+
+func TestThread_ResolveThread(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+	wid := id.NewWorkspaceID()
+	th := thread.New().NewID().Workspace(wid).MustBuild()
+	assert.NoError(t, db.Thread.Save(ctx, th))
+	uc := NewThread(db, nil)
+
+	_, err := uc.ResolveThread(ctx, th.ID(), true, &usecase.Operator{})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+
+	_, err = uc.ResolveThread(ctx, th.ID(), true, &usecase.Operator{User: id.NewUserID().Ref(), ReadableWorkspaces: []id.WorkspaceID{wid}})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	op := &usecase.Operator{User: id.NewUserID().Ref(), WritableWorkspaces: []id.WorkspaceID{wid}}
+	got, err := uc.ResolveThread(ctx, th.ID(), true, op)
+	assert.NoError(t, err)
+	assert.True(t, got.Resolved())
+
+	got, err = uc.ResolveThread(ctx, th.ID(), false, op)
+	assert.NoError(t, err)
+	assert.False(t, got.Resolved())
+}
+
 func TestThread_UpdateComment(t *testing.T) {
 	c1 := thread.NewComment(thread.NewCommentID(), operator.OperatorFromUser(id.NewUserID()), "aaa")
 	c2 := thread.NewComment(thread.NewCommentID(), operator.OperatorFromUser(id.NewUserID()), "test")
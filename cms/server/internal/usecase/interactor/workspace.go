@@ -8,6 +8,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/usecasex"
@@ -18,6 +19,7 @@ type Workspace struct {
 	repos       *repo.Container
 	gateways    *gateway.Container
 	transaction usecasex.Transaction
+	ignoreEvent bool
 }
 
 func NewWorkspace(r *repo.Container, g *gateway.Container) interfaces.Workspace {
@@ -135,6 +137,12 @@ func (i *Workspace) AddUserMember(ctx context.Context, workspaceID id.WorkspaceI
 			return nil, err
 		}
 
+		for _, m := range ul {
+			if err := i.memberEvent(ctx, workspace, event.MemberAdd, &event.Member{User: m.ID().Ref(), Role: string(users[m.ID()])}, operator); err != nil {
+				return nil, err
+			}
+		}
+
 		return workspace, nil
 	})
 }
@@ -164,6 +172,10 @@ func (i *Workspace) AddIntegrationMember(ctx context.Context, wId id.WorkspaceID
 			return nil, err
 		}
 
+		if err := i.memberEvent(ctx, workspace, event.MemberAdd, &event.Member{Integration: iId.Ref(), Role: string(role)}, operator); err != nil {
+			return nil, err
+		}
+
 		return workspace, nil
 	})
 }
@@ -191,6 +203,7 @@ func (i *Workspace) RemoveUser(ctx context.Context, id id.WorkspaceID, u id.User
 			return nil, interfaces.ErrOwnerCannotLeaveTheWorkspace
 		}
 
+		role := workspace.Members().UserRole(u)
 		err = workspace.Members().Leave(u)
 		if err != nil {
 			return nil, err
@@ -201,6 +214,10 @@ func (i *Workspace) RemoveUser(ctx context.Context, id id.WorkspaceID, u id.User
 			return nil, err
 		}
 
+		if err := i.memberEvent(ctx, workspace, event.MemberRemove, &event.Member{User: u.Ref(), Role: string(role)}, operator); err != nil {
+			return nil, err
+		}
+
 		return workspace, nil
 	})
 }
@@ -215,6 +232,7 @@ func (i *Workspace) RemoveIntegration(ctx context.Context, wId id.WorkspaceID, i
 			return nil, err
 		}
 
+		role := workspace.Members().IntegrationRole(iId)
 		err = workspace.Members().DeleteIntegration(iId)
 		if err != nil {
 			return nil, err
@@ -225,6 +243,10 @@ func (i *Workspace) RemoveIntegration(ctx context.Context, wId id.WorkspaceID, i
 			return nil, err
 		}
 
+		if err := i.memberEvent(ctx, workspace, event.MemberRemove, &event.Member{Integration: iId.Ref(), Role: string(role)}, operator); err != nil {
+			return nil, err
+		}
+
 		return workspace, nil
 	})
 }
@@ -256,6 +278,10 @@ func (i *Workspace) UpdateUser(ctx context.Context, id id.WorkspaceID, u id.User
 			return nil, err
 		}
 
+		if err := i.memberEvent(ctx, workspace, event.MemberUpdate, &event.Member{User: u.Ref(), Role: string(role)}, operator); err != nil {
+			return nil, err
+		}
+
 		return workspace, nil
 	})
 }
@@ -280,6 +306,10 @@ func (i *Workspace) UpdateIntegration(ctx context.Context, wId id.WorkspaceID, i
 			return nil, err
 		}
 
+		if err := i.memberEvent(ctx, workspace, event.MemberUpdate, &event.Member{Integration: iId.Ref(), Role: string(role)}, operator); err != nil {
+			return nil, err
+		}
+
 		return workspace, nil
 	})
 }
@@ -314,6 +344,22 @@ func (i *Workspace) Remove(ctx context.Context, id id.WorkspaceID, operator *use
 	})
 }
 
+// memberEvent records a change of the member with the role after the change, or the role before removal
+func (i *Workspace) memberEvent(ctx context.Context, ws *user.Workspace, ty event.Type, m *event.Member, operator *usecase.Operator) error {
+	if i.ignoreEvent {
+		return nil
+	}
+
+	_, err := createEvent(ctx, i.repos, i.gateways, Event{
+		Workspace: ws.ID(),
+		Member:    m,
+		Type:      ty,
+		Object:    ws,
+		Operator:  operator.Operator(),
+	})
+	return err
+}
+
 func (i *Workspace) filterWorkspaces(workspaces []*user.Workspace, operator *usecase.Operator, err error) ([]*user.Workspace, error) {
 	if err != nil {
 		return nil, err
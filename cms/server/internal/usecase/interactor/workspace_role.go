@@ -0,0 +1,125 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/user"
+)
+
+func (i *Workspace) CreateCustomRole(ctx context.Context, param interfaces.CreateCustomRoleParam, operator *usecase.Operator) (*user.Workspace, error) {
+	return i.updateCustomRoles(ctx, param.WorkspaceID, operator, func(ctx context.Context, ws *user.Workspace) error {
+		if err := i.checkPermissions(ctx, ws, param.Permissions); err != nil {
+			return err
+		}
+		r, err := user.NewCustomRole(id.NewRoleID(), param.Name, param.Permissions)
+		if err != nil {
+			return err
+		}
+		ws.AddRole(r)
+		return nil
+	})
+}
+
+func (i *Workspace) UpdateCustomRole(ctx context.Context, param interfaces.UpdateCustomRoleParam, operator *usecase.Operator) (*user.Workspace, error) {
+	return i.updateCustomRoles(ctx, param.WorkspaceID, operator, func(ctx context.Context, ws *user.Workspace) error {
+		r := ws.Role(param.RoleID)
+		if r == nil {
+			return user.ErrCustomRoleNotFound
+		}
+		if err := i.checkPermissions(ctx, ws, param.Permissions); err != nil {
+			return err
+		}
+		return r.Update(param.Name, param.Permissions)
+	})
+}
+
+// RemoveCustomRole removes the custom role, and its members follow their fixed roles again
+func (i *Workspace) RemoveCustomRole(ctx context.Context, wid id.WorkspaceID, rid id.RoleID, operator *usecase.Operator) (*user.Workspace, error) {
+	return i.updateCustomRoles(ctx, wid, operator, func(_ context.Context, ws *user.Workspace) error {
+		return ws.RemoveRole(rid)
+	})
+}
+
+func (i *Workspace) AssignUserCustomRole(ctx context.Context, wid id.WorkspaceID, uid id.UserID, rid *id.RoleID, operator *usecase.Operator) (*user.Workspace, error) {
+	return i.updateCustomRoles(ctx, wid, operator, func(_ context.Context, ws *user.Workspace) error {
+		if uid == *operator.User {
+			return interfaces.ErrCannotChangeOwnerRole
+		}
+		return ws.AssignUserRole(uid, rid)
+	})
+}
+
+func (i *Workspace) AssignIntegrationCustomRole(ctx context.Context, wid id.WorkspaceID, iid id.IntegrationID, rid *id.RoleID, operator *usecase.Operator) (*user.Workspace, error) {
+	return i.updateCustomRoles(ctx, wid, operator, func(_ context.Context, ws *user.Workspace) error {
+		return ws.AssignIntegrationRole(iid, rid)
+	})
+}
+
+// UpdateUserItemGroups replaces the item groups of the user which are used in projects restricting items by groups
+func (i *Workspace) UpdateUserItemGroups(ctx context.Context, wid id.WorkspaceID, uid id.UserID, groups []string, operator *usecase.Operator) (*user.Workspace, error) {
+	return i.updateCustomRoles(ctx, wid, operator, func(_ context.Context, ws *user.Workspace) error {
+		return ws.Members().UpdateUserGroups(uid, groups)
+	})
+}
+
+// UpdateIntegrationItemGroups replaces the item groups of the integration which are used in projects restricting items by groups
+func (i *Workspace) UpdateIntegrationItemGroups(ctx context.Context, wid id.WorkspaceID, iid id.IntegrationID, groups []string, operator *usecase.Operator) (*user.Workspace, error) {
+	return i.updateCustomRoles(ctx, wid, operator, func(_ context.Context, ws *user.Workspace) error {
+		return ws.Members().UpdateIntegrationGroups(iid, groups)
+	})
+}
+
+// updateCustomRoles updates custom roles, their assignment or item groups of members in the workspace, which only owners can do
+func (i *Workspace) updateCustomRoles(ctx context.Context, wid id.WorkspaceID, operator *usecase.Operator, f func(context.Context, *user.Workspace) error) (*user.Workspace, error) {
+	if operator.User == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	return Run1(ctx, operator, i.repos, Usecase().Transaction().WithOwnableWorkspaces(wid), func(ctx context.Context) (*user.Workspace, error) {
+		ws, err := i.repos.Workspace.FindByID(ctx, wid)
+		if err != nil {
+			return nil, err
+		}
+		if ws.IsPersonal() {
+			return nil, user.ErrCannotModifyPersonalWorkspace
+		}
+
+		if err := f(ctx, ws); err != nil {
+			return nil, err
+		}
+
+		if err := i.repos.Workspace.Save(ctx, ws); err != nil {
+			return nil, err
+		}
+		return ws, nil
+	})
+}
+
+// checkPermissions returns an error when the permissions refer to projects of other workspaces or models of other projects
+func (i *Workspace) checkPermissions(ctx context.Context, ws *user.Workspace, permissions []user.Permission) error {
+	for _, p := range permissions {
+		if p.Project == nil {
+			continue
+		}
+		prj, err := i.repos.Project.FindByID(ctx, *p.Project)
+		if err != nil {
+			return err
+		}
+		if prj.Workspace() != ws.ID() {
+			return user.ErrInvalidCustomRole
+		}
+		if p.Model == nil {
+			continue
+		}
+		m, err := i.repos.Model.FindByID(ctx, *p.Model)
+		if err != nil {
+			return err
+		}
+		if m.Project() != prj.ID() {
+			return user.ErrInvalidCustomRole
+		}
+	}
+	return nil
+}
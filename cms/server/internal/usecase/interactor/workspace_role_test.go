@@ -0,0 +1,83 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkspace_CustomRoles(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+
+	owner, staff := id.NewUserID(), id.NewUserID()
+	iid := id.NewIntegrationID()
+	ws := user.NewWorkspace().NewID().
+		Members(map[id.UserID]user.Member{owner: {Role: user.RoleOwner}, staff: {Role: user.RoleReader}}).
+		Integrations(map[id.IntegrationID]user.Member{iid: {Role: user.RoleReader}}).
+		MustBuild()
+	prj := project.New().NewID().Workspace(ws.ID()).MustBuild()
+	other := project.New().NewID().Workspace(id.NewWorkspaceID()).MustBuild()
+	m := model.New().NewID().Project(prj.ID()).Schema(id.NewSchemaID()).Key(key.Random()).MustBuild()
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Project.Save(ctx, other))
+	lo.Must0(db.Model.Save(ctx, m))
+
+	uc := NewWorkspace(db, nil)
+	op := &usecase.Operator{User: &owner, OwningWorkspaces: id.WorkspaceIDList{ws.ID()}}
+	permissions := []user.Permission{{Project: prj.ID().Ref(), Model: m.ID().Ref(), Actions: []user.Action{user.ActionUpdate}}}
+
+	// only owners can manage custom roles
+	_, err := uc.CreateCustomRole(ctx, interfaces.CreateCustomRoleParam{WorkspaceID: ws.ID(), Name: "staff", Permissions: permissions},
+		&usecase.Operator{User: &staff, ReadableWorkspaces: id.WorkspaceIDList{ws.ID()}})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	// projects of other workspaces cannot be referred
+	_, err = uc.CreateCustomRole(ctx, interfaces.CreateCustomRoleParam{WorkspaceID: ws.ID(), Name: "staff", Permissions: []user.Permission{
+		{Project: other.ID().Ref(), Actions: []user.Action{user.ActionRead}},
+	}}, op)
+	assert.Equal(t, user.ErrInvalidCustomRole, err)
+
+	got, err := uc.CreateCustomRole(ctx, interfaces.CreateCustomRoleParam{WorkspaceID: ws.ID(), Name: "staff", Permissions: permissions}, op)
+	assert.NoError(t, err)
+	assert.Len(t, got.Roles(), 1)
+	rid := got.Roles()[0].ID()
+
+	got, err = uc.UpdateCustomRole(ctx, interfaces.UpdateCustomRoleParam{WorkspaceID: ws.ID(), RoleID: rid, Name: "city staff", Permissions: permissions}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "city staff", got.Role(rid).Name())
+
+	_, err = uc.AssignUserCustomRole(ctx, ws.ID(), staff, &rid, op)
+	assert.NoError(t, err)
+	_, err = uc.AssignUserCustomRole(ctx, ws.ID(), owner, &rid, op)
+	assert.Equal(t, interfaces.ErrCannotChangeOwnerRole, err)
+	got, err = uc.AssignIntegrationCustomRole(ctx, ws.ID(), iid, &rid, op)
+	assert.NoError(t, err)
+	assert.Equal(t, rid, got.UserCustomRole(staff).ID())
+	assert.Equal(t, rid, got.IntegrationCustomRole(iid).ID())
+
+	got, err = uc.RemoveCustomRole(ctx, ws.ID(), rid, op)
+	assert.NoError(t, err)
+	assert.Empty(t, got.Roles())
+	assert.Nil(t, got.UserCustomRole(staff))
+	_, err = uc.RemoveCustomRole(ctx, ws.ID(), rid, op)
+	assert.Equal(t, user.ErrCustomRoleNotFound, err)
+
+	got, err = uc.UpdateUserItemGroups(ctx, ws.ID(), staff, []string{"13101", "13102"}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"13101", "13102"}, got.Members().UserGroups(staff))
+	got, err = uc.UpdateIntegrationItemGroups(ctx, ws.ID(), iid, []string{"13101"}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"13101"}, got.Members().IntegrationGroups(iid))
+}
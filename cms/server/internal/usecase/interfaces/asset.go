@@ -28,14 +28,23 @@ type CreateAssetParam struct {
 	SkipDecompression bool
 }
 
+// CompressAssetParam specifies the folder in the extracted files to compress. The root folder is compressed when Path is nil.
+type CompressAssetParam struct {
+	AssetID idx.ID[id.Asset]
+	Path    *string
+}
+
 type UpdateAssetParam struct {
 	AssetID     idx.ID[id.Asset]
 	PreviewType *asset.PreviewType
 }
 
 var (
-	ErrCreateAssetFailed error = rerror.NewE(i18n.T("failed to create asset"))
-	ErrFileNotIncluded   error = rerror.NewE(i18n.T("file not included"))
+	ErrCreateAssetFailed          error = rerror.NewE(i18n.T("failed to create asset"))
+	ErrFileNotIncluded            error = rerror.NewE(i18n.T("file not included"))
+	ErrAssetNotExtracted          error = rerror.NewE(i18n.T("asset is not extracted"))
+	ErrAssetDirNotFound           error = rerror.NewE(i18n.T("folder not found in asset"))
+	ErrAssetCompressionInProgress error = rerror.NewE(i18n.T("asset is being compressed"))
 )
 
 type AssetFilter struct {
@@ -50,9 +59,18 @@ type Asset interface {
 	FindByProject(context.Context, id.ProjectID, AssetFilter, *usecase.Operator) (asset.List, *usecasex.PageInfo, error)
 	FindFileByID(context.Context, id.AssetID, *usecase.Operator) (*asset.File, error)
 	GetURL(*asset.Asset) string
+	// GetSignedURL returns a URL of a file of the asset which expires. It is used to share assets of projects whose assets are not public.
+	// The file is specified by its path in the directory of the asset. The asset itself is signed when the path is empty.
+	GetSignedURL(context.Context, *asset.Asset, string) (string, error)
+	// URLResolver returns a resolver of asset URLs. URLs of assets whose projects do not make assets public are signed.
+	// Projects of the given assets are fetched at once, and projects of other assets are fetched when they are resolved first.
+	URLResolver(context.Context, asset.List, *usecase.Operator) asset.URLResolver
 	Create(context.Context, CreateAssetParam, *usecase.Operator) (*asset.Asset, *asset.File, error)
 	Update(context.Context, UpdateAssetParam, *usecase.Operator) (*asset.Asset, error)
 	UpdateFiles(context.Context, id.AssetID, *asset.ArchiveExtractionStatus, *usecase.Operator) (*asset.Asset, error)
+	UpdateExtractionProgress(context.Context, id.AssetID, asset.ArchiveExtractionProgress, *usecase.Operator) (*asset.Asset, error)
 	Delete(context.Context, id.AssetID, *usecase.Operator) (id.AssetID, error)
 	DecompressByID(context.Context, id.AssetID, *usecase.Operator) (*asset.Asset, error)
+	Compress(context.Context, CompressAssetParam, *usecase.Operator) (*asset.Asset, error)
+	UpdateCompression(context.Context, id.AssetID, *asset.ArchiveExtractionStatus, *usecase.Operator) (*asset.Asset, error)
 }
@@ -0,0 +1,33 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+var ErrAuditLogScopeRequired error = rerror.NewE(i18n.T("workspace or project is required"))
+
+// AuditLogFilter is a condition of audit log queries. Either Workspace or Project must be specified.
+type AuditLogFilter struct {
+	Workspace   *id.WorkspaceID
+	Project     *id.ProjectID
+	Model       *id.ModelID
+	Item        *id.ItemID
+	User        *id.UserID
+	Integration *id.IntegrationID
+	Types       []event.Type
+	From        *time.Time
+	To          *time.Time
+}
+
+type AuditLog interface {
+	// Search returns the audit log of the workspace or the project in reverse chronological order
+	Search(context.Context, AuditLogFilter, *usecasex.Pagination, *usecase.Operator) ([]*event.Event[any], *usecasex.PageInfo, error)
+}
@@ -29,4 +29,6 @@ type Container struct {
 	Schema      Schema
 	Integration Integration
 	Thread      Thread
+	Task        Task
+	AuditLog    AuditLog
 }
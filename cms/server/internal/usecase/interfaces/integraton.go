@@ -3,11 +3,13 @@ package interfaces
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/usecasex"
 )
 
 type CreateIntegrationParam struct {
@@ -41,6 +43,16 @@ type UpdateWebhookParam struct {
 
 type WebhookTriggerParam map[event.Type]bool
 
+type RecordWebhookDeliveryParam struct {
+	DeliveryID id.WebhookDeliveryID
+	Attempt    int
+	At         time.Time
+	Payload    string
+	StatusCode int
+	Latency    time.Duration
+	Error      string
+}
+
 type Integration interface {
 	FindByIDs(context.Context, id.IntegrationIDList, *usecase.Operator) (integration.List, error)
 	FindByMe(context.Context, *usecase.Operator) (integration.List, error)
@@ -51,4 +63,9 @@ type Integration interface {
 	CreateWebhook(context.Context, id.IntegrationID, CreateWebhookParam, *usecase.Operator) (*integration.Webhook, error)
 	UpdateWebhook(context.Context, id.IntegrationID, id.WebhookID, UpdateWebhookParam, *usecase.Operator) (*integration.Webhook, error)
 	DeleteWebhook(context.Context, id.IntegrationID, id.WebhookID, *usecase.Operator) error
+
+	FindWebhookDeliveries(context.Context, id.IntegrationID, id.WebhookID, *usecasex.Pagination, *usecase.Operator) (integration.DeliveryList, *usecasex.PageInfo, error)
+	RedeliverWebhook(context.Context, id.IntegrationID, id.WebhookDeliveryID, *usecase.Operator) (*integration.Delivery, error)
+	RecordWebhookDelivery(context.Context, RecordWebhookDeliveryParam, *usecase.Operator) error
+	RetryWebhookDeliveries(context.Context, *usecase.Operator) error
 }
@@ -10,7 +10,9 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/key"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
@@ -23,6 +25,11 @@ var (
 	ErrFieldValueExist          = rerror.NewE(i18n.T("field value exist"))
 	ErrItemsShouldBeOnSameModel = rerror.NewE(i18n.T("items should be on the same model"))
 	ErrItemMissing              = rerror.NewE(i18n.T("one or more items not found"))
+	ErrInvalidImportFormat      = rerror.NewE(i18n.T("invalid import format"))
+	ErrImportFieldNotFound      = rerror.NewE(i18n.T("import field not found"))
+	ErrInvalidExportFormat      = rerror.NewE(i18n.T("invalid export format"))
+	ErrItemReferenced           = rerror.NewE(i18n.T("item is referenced by other items"))
+	ErrFieldNotLocalized        = rerror.NewE(i18n.T("field is not localized"))
 )
 
 type ItemFieldParam struct {
@@ -30,17 +37,62 @@ type ItemFieldParam struct {
 	Key   *key.Key
 	Type  value.Type
 	Value any
+	// Localized is values of a localized field keyed by locales. Existing values are kept on update when it is nil.
+	Localized map[string]any
 }
 
 type CreateItemParam struct {
 	SchemaID schema.ID
 	ModelID  model.ID
 	Fields   []ItemFieldParam
+	// Group sets the group which owns the item. When it is nil, the group is derived from the field of the item group policy of the project.
+	Group *string
 }
 
 type UpdateItemParam struct {
 	ItemID item.ID
 	Fields []ItemFieldParam
+	// Group changes the group which owns the item. When it is nil, the group is derived from the field of the item group policy of the project.
+	Group *string
+}
+
+type ScheduleItemParam struct {
+	ItemID      item.ID
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
+}
+
+type DiffItemParam struct {
+	ItemID item.ID
+	From   version.Version
+	// To is the latest version if nil
+	To *version.Version
+}
+
+type RollbackItemParam struct {
+	ItemID  item.ID
+	Version version.Version
+}
+
+type ImportItemsParam struct {
+	ModelID id.ModelID
+	AssetID id.AssetID
+	Format  item.ImportFormat
+	// Fields maps columns of the asset to field keys
+	Fields map[string]string
+	// KeyField is the key of the field which is used to update existing items
+	KeyField *key.Key
+	DryRun   bool
+}
+
+type ExportItemsParam struct {
+	ModelID id.ModelID
+	Format  item.ExportFormat
+	Keyword string
+	// Filters narrows down items by values of fields keyed by field keys
+	Filters    []task.ExportFilter
+	BBox       string
+	Intersects string
 }
 
 type Item interface {
@@ -48,6 +100,8 @@ type Item interface {
 	FindPublicByID(context.Context, id.ItemID, *usecase.Operator) (item.Versioned, error)
 	FindByIDs(context.Context, id.ItemIDList, *usecase.Operator) (item.VersionedList, error)
 	FindByAssets(context.Context, id.AssetIDList, *usecase.Operator) (map[id.AssetID]item.VersionedList, error)
+	// FindBackReferences returns the items which reference the item
+	FindBackReferences(context.Context, id.ItemID, *usecase.Operator) (item.VersionedList, error)
 	ItemStatus(context.Context, id.ItemIDList, *usecase.Operator) (map[id.ItemID]item.Status, error)
 	FindBySchema(context.Context, id.SchemaID, *usecasex.Sort, *usecasex.Pagination, *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error)
 	FindByModel(context.Context, id.ModelID, *usecasex.Pagination, *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error)
@@ -56,8 +110,15 @@ type Item interface {
 	Search(context.Context, *item.Query, *usecasex.Sort, *usecasex.Pagination, *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error)
 	LastModifiedByModel(context.Context, id.ModelID, *usecase.Operator) (time.Time, error)
 	FindAllVersionsByID(context.Context, id.ItemID, *usecase.Operator) (item.VersionedList, error)
+	Diff(context.Context, DiffItemParam, *usecase.Operator) ([]item.FieldDiff, error)
 	Create(context.Context, CreateItemParam, *usecase.Operator) (item.Versioned, error)
 	Update(context.Context, UpdateItemParam, *usecase.Operator) (item.Versioned, error)
+	// Delete deletes the item and applies the on-delete behaviors of reference fields of items which reference it
 	Delete(context.Context, id.ItemID, *usecase.Operator) error
 	Unpublish(context.Context, id.ItemIDList, *usecase.Operator) (item.VersionedList, error)
+	Rollback(context.Context, RollbackItemParam, *usecase.Operator) (item.Versioned, error)
+	Schedule(context.Context, ScheduleItemParam, *usecase.Operator) (item.Versioned, error)
+	RunSchedule(context.Context, *usecase.Operator) error
+	Import(context.Context, ImportItemsParam, *usecase.Operator) (*task.Task, error)
+	Export(context.Context, ExportItemsParam, *usecase.Operator) (*task.Task, error)
 }
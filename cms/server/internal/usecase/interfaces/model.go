@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/definition"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearthx/i18n"
@@ -29,8 +30,20 @@ type UpdateModelParam struct {
 	Public      *bool
 }
 
+type ImportModelsParam struct {
+	ProjectID  id.ProjectID
+	Definition *definition.Definition
+	// DryRun only returns the changes without applying them
+	DryRun bool
+	// AllowDestructive allows the import to remove models with their schemas and items, and to remove fields.
+	// Types of fields cannot be changed since values of items would be lost silently.
+	AllowDestructive bool
+}
+
 var (
-	ErrModelKey error = rerror.NewE(i18n.T("model key is already used by another model"))
+	ErrModelKey           error = rerror.NewE(i18n.T("model key is already used by another model"))
+	ErrDestructiveChanges error = rerror.NewE(i18n.T("model definition contains destructive changes"))
+	ErrFieldTypeChanged   error = rerror.NewE(i18n.T("types of fields cannot be changed by model definition"))
 )
 
 type Model interface {
@@ -44,4 +57,6 @@ type Model interface {
 	CheckKey(context.Context, id.ProjectID, string) (bool, error)
 	Delete(context.Context, id.ModelID, *usecase.Operator) error
 	Publish(context.Context, id.ModelID, bool, *usecase.Operator) (bool, error)
+	Export(context.Context, id.ProjectID, *usecase.Operator) (*definition.Definition, error)
+	Import(context.Context, ImportModelsParam, *usecase.Operator) (definition.Changes, error)
 }
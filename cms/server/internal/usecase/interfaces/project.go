@@ -6,6 +6,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
@@ -19,11 +20,13 @@ type CreateProjectParam struct {
 }
 
 type UpdateProjectParam struct {
-	ID          id.ProjectID
-	Name        *string
-	Description *string
-	Alias       *string
-	Publication *UpdateProjectPublicationParam
+	ID              id.ProjectID
+	Name            *string
+	Description     *string
+	Alias           *string
+	Publication     *UpdateProjectPublicationParam
+	RequestPolicy   *UpdateProjectRequestPolicyParam
+	ItemGroupPolicy *UpdateProjectItemGroupPolicyParam
 }
 
 type UpdateProjectPublicationParam struct {
@@ -31,9 +34,41 @@ type UpdateProjectPublicationParam struct {
 	AssetPublic *bool
 }
 
+type CreateAPIKeyParam struct {
+	ProjectID id.ProjectID
+	Name      string
+	// Models is the allow-list of models. All public models can be read when it is empty.
+	Models id.ModelIDList
+	// RateLimit is the max number of requests per minute. Zero means unlimited.
+	RateLimit int
+}
+
+// UpdateAPIKeyParam updates the API key. Nil fields are left unchanged.
+type UpdateAPIKeyParam struct {
+	ProjectID id.ProjectID
+	KeyID     id.APIKeyID
+	Name      *string
+	Models    id.ModelIDList
+	RateLimit *int
+}
+
+// UpdateProjectRequestPolicyParam updates the request policy of the project. Nil fields are left unchanged.
+type UpdateProjectRequestPolicyParam struct {
+	RequiredApprovals *int
+	RequiredRoles     []user.Role
+	ModelReviewers    map[id.ModelID]id.UserIDList
+}
+
+// UpdateProjectItemGroupPolicyParam updates the item group policy of the project. Nil fields are left unchanged, and an empty field key unsets the field.
+type UpdateProjectItemGroupPolicyParam struct {
+	Field      *string
+	Restricted *bool
+}
+
 var (
 	ErrProjectAliasIsNotSet    error = rerror.NewE(i18n.T("project alias is not set"))
 	ErrProjectAliasAlreadyUsed error = rerror.NewE(i18n.T("project alias is already used by another project"))
+	ErrInvalidAPIKeyModels     error = rerror.NewE(i18n.T("models of API key should belong to the project"))
 )
 
 type Project interface {
@@ -44,4 +79,10 @@ type Project interface {
 	Update(context.Context, UpdateProjectParam, *usecase.Operator) (*project.Project, error)
 	CheckAlias(context.Context, string) (bool, error)
 	Delete(context.Context, id.ProjectID, *usecase.Operator) error
+	CreateAPIKey(context.Context, CreateAPIKeyParam, *usecase.Operator) (*project.Project, *project.APIKey, error)
+	UpdateAPIKey(context.Context, UpdateAPIKeyParam, *usecase.Operator) (*project.Project, *project.APIKey, error)
+	// RegenerateAPIKey rotates the key. The old key is no longer valid.
+	RegenerateAPIKey(context.Context, id.ProjectID, id.APIKeyID, *usecase.Operator) (*project.Project, *project.APIKey, error)
+	// DeleteAPIKey revokes the key
+	DeleteAPIKey(context.Context, id.ProjectID, id.APIKeyID, *usecase.Operator) (*project.Project, error)
 }
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/pkg/id"
@@ -13,6 +14,7 @@ import (
 
 var (
 	ErrAlreadyPublished = rerror.NewE(i18n.T("already published"))
+	ErrAlreadyApproved  = rerror.NewE(i18n.T("already approved"))
 )
 
 type CreateRequestParam struct {
@@ -22,6 +24,8 @@ type CreateRequestParam struct {
 	State       *request.State
 	Reviewers   id.UserIDList
 	Items       request.ItemList
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
 }
 
 type UpdateRequestParam struct {
@@ -31,6 +35,9 @@ type UpdateRequestParam struct {
 	State       *request.State
 	Reviewers   id.UserIDList
 	Items       request.ItemList
+	// the schedule is replaced when either PublishAt or UnpublishAt is given
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
 }
 
 type RequestFilter struct {
@@ -47,5 +54,6 @@ type Request interface {
 	Create(context.Context, CreateRequestParam, *usecase.Operator) (*request.Request, error)
 	Update(context.Context, UpdateRequestParam, *usecase.Operator) (*request.Request, error)
 	Approve(context.Context, id.RequestID, *usecase.Operator) (*request.Request, error)
+	RequestChanges(context.Context, id.RequestID, *usecase.Operator) (*request.Request, error)
 	CloseAll(context.Context, id.ProjectID, id.RequestIDList, *usecase.Operator) error
 }
@@ -5,7 +5,9 @@ import (
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
@@ -20,28 +22,50 @@ type CreateFieldParam struct {
 	Multiple     bool
 	Unique       bool
 	Required     bool
+	Localized    bool
+	MinItems     *int
+	MaxItems     *int
+	RequiredIf   *schema.RequiredCondition
 	TypeProperty *schema.TypeProperty
 	DefaultValue *value.Multiple
 }
 
 type UpdateFieldParam struct {
-	SchemaId     id.SchemaID
-	FieldId      id.FieldID
-	Name         *string
-	Description  *string
-	Order        *int
-	Key          *string
-	Multiple     *bool
-	Unique       *bool
-	Required     *bool
-	TypeProperty *schema.TypeProperty
-	DefaultValue *value.Multiple
+	SchemaId    id.SchemaID
+	FieldId     id.FieldID
+	Name        *string
+	Description *string
+	Order       *int
+	Key         *string
+	Multiple    *bool
+	Unique      *bool
+	Required    *bool
+	Localized   *bool
+	MinItems    *int
+	MaxItems    *int
+	// RequiredIf replaces the required condition of the field. RemoveRequiredIf removes it.
+	RequiredIf       *schema.RequiredCondition
+	RemoveRequiredIf bool
+	TypeProperty     *schema.TypeProperty
+	DefaultValue     *value.Multiple
+}
+
+// MigrateFieldsParam replaces the source fields of the schema of the model with the target fields and converts values of items
+type MigrateFieldsParam struct {
+	ModelID id.ModelID
+	Type    item.MigrationType
+	Sources id.FieldIDList
+	// Targets are the new fields. SchemaId of the params is ignored.
+	Targets   []CreateFieldParam
+	Separator string
+	DryRun    bool
 }
 
 var (
 	ErrInvalidTypeProperty = rerror.NewE(i18n.T("invalid type property"))
 	ErrFieldNotFound       = rerror.NewE(i18n.T("field not found"))
 	ErrInvalidValue        = rerror.NewE(i18n.T("invalid value"))
+	ErrMigrationFailed     = rerror.NewE(i18n.T("some items cannot be converted"))
 )
 
 type Schema interface {
@@ -51,4 +75,5 @@ type Schema interface {
 	UpdateField(context.Context, UpdateFieldParam, *usecase.Operator) (*schema.Field, error)
 	UpdateFields(context.Context, id.SchemaID, []UpdateFieldParam, *usecase.Operator) (schema.FieldList, error)
 	DeleteField(context.Context, id.SchemaID, id.FieldID, *usecase.Operator) error
+	MigrateFields(context.Context, MigrateFieldsParam, *usecase.Operator) (*task.Task, error)
 }
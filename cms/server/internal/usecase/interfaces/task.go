@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type Task interface {
+	FindByID(context.Context, id.TaskID, *usecase.Operator) (*task.Task, error)
+	FindByProject(context.Context, id.ProjectID, *usecasex.Pagination, *usecase.Operator) (task.List, *usecasex.PageInfo, error)
+	// Run executes pending tasks and resumes tasks which have been interrupted
+	Run(context.Context, *usecase.Operator) error
+}
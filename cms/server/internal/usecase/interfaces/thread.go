@@ -13,13 +13,21 @@ import (
 var (
 	ErrCommentAlreadyExist = rerror.NewE(i18n.T("Comment already exist in this thread"))
 	ErrCommentDoesNotExist = rerror.NewE(i18n.T("Comment does not exist in this thread"))
+	ErrInvalidMention      = rerror.NewE(i18n.T("mentioned user or integration is not a member of the workspace"))
 )
 
+type AddCommentParam struct {
+	ThreadID id.ThreadID
+	Content  string
+	Mentions *thread.Mentions
+}
+
 type Thread interface {
 	FindByID(context.Context, id.ThreadID, *usecase.Operator) (*thread.Thread, error)
 	FindByIDs(context.Context, []id.ThreadID, *usecase.Operator) (thread.List, error)
 	CreateThread(context.Context, id.WorkspaceID, *usecase.Operator) (*thread.Thread, error)
-	AddComment(context.Context, id.ThreadID, string, *usecase.Operator) (*thread.Thread, *thread.Comment, error)
+	AddComment(context.Context, AddCommentParam, *usecase.Operator) (*thread.Thread, *thread.Comment, error)
 	UpdateComment(context.Context, id.ThreadID, id.CommentID, string, *usecase.Operator) (*thread.Thread, *thread.Comment, error)
 	DeleteComment(context.Context, id.ThreadID, id.CommentID, *usecase.Operator) (*thread.Thread, error)
+	ResolveThread(context.Context, id.ThreadID, bool, *usecase.Operator) (*thread.Thread, error)
 }
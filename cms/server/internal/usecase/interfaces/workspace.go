@@ -17,6 +17,19 @@ var (
 	ErrWorkspaceWithProjects        = rerror.NewE(i18n.T("target workspace still has some project"))
 )
 
+type CreateCustomRoleParam struct {
+	WorkspaceID id.WorkspaceID
+	Name        string
+	Permissions []user.Permission
+}
+
+type UpdateCustomRoleParam struct {
+	WorkspaceID id.WorkspaceID
+	RoleID      id.RoleID
+	Name        string
+	Permissions []user.Permission
+}
+
 type Workspace interface {
 	Fetch(context.Context, []id.WorkspaceID, *usecase.Operator) ([]*user.Workspace, error)
 	FindByUser(context.Context, id.UserID, *usecase.Operator) ([]*user.Workspace, error)
@@ -29,4 +42,11 @@ type Workspace interface {
 	RemoveUser(context.Context, id.WorkspaceID, id.UserID, *usecase.Operator) (*user.Workspace, error)
 	RemoveIntegration(context.Context, id.WorkspaceID, id.IntegrationID, *usecase.Operator) (*user.Workspace, error)
 	Remove(context.Context, id.WorkspaceID, *usecase.Operator) error
+	CreateCustomRole(context.Context, CreateCustomRoleParam, *usecase.Operator) (*user.Workspace, error)
+	UpdateCustomRole(context.Context, UpdateCustomRoleParam, *usecase.Operator) (*user.Workspace, error)
+	RemoveCustomRole(context.Context, id.WorkspaceID, id.RoleID, *usecase.Operator) (*user.Workspace, error)
+	AssignUserCustomRole(context.Context, id.WorkspaceID, id.UserID, *id.RoleID, *usecase.Operator) (*user.Workspace, error)
+	AssignIntegrationCustomRole(context.Context, id.WorkspaceID, id.IntegrationID, *id.RoleID, *usecase.Operator) (*user.Workspace, error)
+	UpdateUserItemGroups(context.Context, id.WorkspaceID, id.UserID, []string, *usecase.Operator) (*user.Workspace, error)
+	UpdateIntegrationItemGroups(context.Context, id.WorkspaceID, id.IntegrationID, []string, *usecase.Operator) (*user.Workspace, error)
 }
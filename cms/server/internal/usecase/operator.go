@@ -6,6 +6,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/user"
+	"golang.org/x/exp/slices"
 )
 
 type Operator struct {
@@ -21,6 +22,10 @@ type Operator struct {
 	WritableProjects       project.IDList
 	OwningProjects         project.IDList
 	MaintainableProjects   project.IDList
+	// CustomRoles are the custom roles of the operator by projects in the workspaces where they are assigned
+	CustomRoles map[project.ID]*user.CustomRole
+	// ItemGroups are the item groups which the operator can access by projects restricting items by groups. Projects not in the map are not restricted.
+	ItemGroups map[project.ID][]string
 }
 
 type Ownable interface {
@@ -153,10 +158,93 @@ func (o *Operator) Operator() operator.Operator {
 	return eOp
 }
 
+// CustomRole returns the custom role of the operator in the workspace of the project, or nil when the operator follows the fixed role
+func (o *Operator) CustomRole(p project.ID) *user.CustomRole {
+	if o == nil {
+		return nil
+	}
+	return o.CustomRoles[p]
+}
+
+// Can returns whether the operator can do the action on items of the model in the project.
+// Operators who have a custom role in the project can do only the actions of the role, and for others byRole, the result of the check by their fixed role, is returned.
+func (o *Operator) Can(a user.Action, p project.ID, m *id.ModelID, byRole bool) bool {
+	if r := o.CustomRole(p); r != nil {
+		return r.Allows(a, p, m)
+	}
+	return byRole
+}
+
+// CanAny returns whether the operator can do the action on items of some model in the project.
+// It is used for objects which are shared by models such as assets.
+func (o *Operator) CanAny(a user.Action, p project.ID, byRole bool) bool {
+	if r := o.CustomRole(p); r != nil {
+		return r.AllowsAny(a, p)
+	}
+	return byRole
+}
+
+// ReadableModels returns the models whose items the operator can read by projects where the custom role of the operator limits reading to some models
+func (o *Operator) ReadableModels() map[project.ID][]id.ModelID {
+	if o == nil || len(o.CustomRoles) == 0 {
+		return nil
+	}
+	res := map[project.ID][]id.ModelID{}
+	for p, r := range o.CustomRoles {
+		if models, all := r.AllowedModels(user.ActionRead, p); !all {
+			res[p] = models
+		}
+	}
+	return res
+}
+
+// CanRead returns false only when the custom role of the operator does not allow reading items of the model in the project.
+// It is used where reads are not limited by fixed roles.
+func (o *Operator) CanRead(p project.ID, m *id.ModelID) bool {
+	return o.Can(user.ActionRead, p, m, true)
+}
+
+// CanAccessItemGroup returns whether the operator can read and write items of the group in the project.
+// Items without a group can be accessed by anyone who can access the project.
+func (o *Operator) CanAccessItemGroup(p project.ID, group string) bool {
+	if o == nil || o.Machine || group == "" {
+		return true
+	}
+	groups, ok := o.ItemGroups[p]
+	return !ok || slices.Contains(groups, group)
+}
+
+// CanAssignItemGroup returns whether the operator can make items of the project belong to the group.
+// Operators restricted by groups cannot leave items without a group since such items can be accessed by anyone in the project.
+func (o *Operator) CanAssignItemGroup(p project.ID, group string) bool {
+	if o == nil || o.Machine {
+		return true
+	}
+	groups, ok := o.ItemGroups[p]
+	return !ok || group != "" && slices.Contains(groups, group)
+}
+
 func (o *Operator) CanUpdate(obj Ownable) bool {
+	return o.CanDo(user.ActionUpdate, obj)
+}
+
+// CanDo returns whether the operator can do the action on the object.
+// Without a custom role, maintainers can do it on any objects and writers only on their own objects.
+// With a custom role, objects of models such as items follow the permissions of the models,
+// and other objects such as assets follow the permissions without a model, or the ones of any model only for their owners.
+func (o *Operator) CanDo(a user.Action, obj Ownable) bool {
+	if o.Machine {
+		return true
+	}
+	if r := o.CustomRole(obj.Project()); r != nil {
+		if mo, ok := obj.(interface{ Model() id.ModelID }); ok {
+			return r.Allows(a, obj.Project(), mo.Model().Ref())
+		}
+		return r.Allows(a, obj.Project(), nil) || (r.AllowsAny(a, obj.Project()) && o.Owns(obj))
+	}
 	isWriter := o.IsWritableProject(obj.Project())
 	isMaintainer := o.IsMaintainingProject(obj.Project())
-	return isMaintainer || (isWriter && o.Owns(obj)) || o.Machine
+	return isMaintainer || (isWriter && o.Owns(obj))
 }
 
 func (o *Operator) Owns(obj Ownable) bool {
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,3 +34,103 @@ func TestOperator_EventOperator(t *testing.T) {
 	assert.NotNil(t, eOp.Integration())
 	assert.Equal(t, &iId, eOp.Integration())
 }
+
+type testOwnable struct {
+	user    *id.UserID
+	project id.ProjectID
+	model   *id.ModelID
+}
+
+func (o testOwnable) User() *id.UserID               { return o.user }
+func (o testOwnable) Integration() *id.IntegrationID { return nil }
+func (o testOwnable) Project() id.ProjectID          { return o.project }
+
+type testModelOwnable struct {
+	testOwnable
+}
+
+func (o testModelOwnable) Model() id.ModelID { return *o.model }
+
+func TestOperator_Can(t *testing.T) {
+	pid1, pid2 := id.NewProjectID(), id.NewProjectID()
+	mid1, mid2 := id.NewModelID(), id.NewModelID()
+	r := lo.Must(user.NewCustomRole(id.NewRoleID(), "staff", []user.Permission{
+		{Project: &pid1, Model: &mid1, Actions: []user.Action{user.ActionRead, user.ActionUpdate}},
+	}))
+	op := &Operator{
+		User:             id.NewUserID().Ref(),
+		ReadableProjects: id.ProjectIDList{pid1},
+		WritableProjects: id.ProjectIDList{pid2},
+		CustomRoles:      map[id.ProjectID]*user.CustomRole{pid1: r},
+	}
+
+	assert.True(t, op.Can(user.ActionUpdate, pid1, &mid1, false))
+	assert.False(t, op.Can(user.ActionUpdate, pid1, &mid2, true))
+	assert.True(t, op.Can(user.ActionUpdate, pid2, &mid2, true))
+	assert.False(t, op.Can(user.ActionUpdate, pid2, &mid2, false))
+
+	assert.True(t, op.CanRead(pid1, &mid1))
+	assert.False(t, op.CanRead(pid1, &mid2))
+	assert.True(t, op.CanRead(pid2, nil))
+
+	assert.True(t, op.CanAny(user.ActionUpdate, pid1, false))
+	assert.False(t, op.CanAny(user.ActionCreate, pid1, true))
+
+	assert.Equal(t, map[id.ProjectID][]id.ModelID{pid1: {mid1}}, op.ReadableModels())
+
+	var nilOp *Operator
+	assert.True(t, nilOp.CanRead(pid1, nil))
+	assert.Nil(t, nilOp.ReadableModels())
+}
+
+func TestOperator_CanDo(t *testing.T) {
+	uid := id.NewUserID()
+	pid1, pid2 := id.NewProjectID(), id.NewProjectID()
+	mid1, mid2 := id.NewModelID(), id.NewModelID()
+	r := lo.Must(user.NewCustomRole(id.NewRoleID(), "staff", []user.Permission{
+		{Project: &pid1, Model: &mid1, Actions: []user.Action{user.ActionUpdate}},
+	}))
+	op := &Operator{
+		User:             &uid,
+		ReadableProjects: id.ProjectIDList{pid1},
+		WritableProjects: id.ProjectIDList{pid2},
+		CustomRoles:      map[id.ProjectID]*user.CustomRole{pid1: r},
+	}
+
+	// items follow the permissions of their models regardless of their owners
+	assert.True(t, op.CanUpdate(testModelOwnable{testOwnable{project: pid1, model: &mid1}}))
+	assert.False(t, op.CanUpdate(testModelOwnable{testOwnable{user: &uid, project: pid1, model: &mid2}}))
+	assert.False(t, op.CanDo(user.ActionDelete, testModelOwnable{testOwnable{project: pid1, model: &mid1}}))
+
+	// assets are allowed only for their owners
+	assert.True(t, op.CanUpdate(testOwnable{user: &uid, project: pid1}))
+	assert.False(t, op.CanUpdate(testOwnable{project: pid1}))
+
+	// writers without a custom role can update only their own objects
+	assert.True(t, op.CanUpdate(testModelOwnable{testOwnable{user: &uid, project: pid2, model: &mid2}}))
+	assert.False(t, op.CanUpdate(testModelOwnable{testOwnable{project: pid2, model: &mid2}}))
+
+	assert.True(t, (&Operator{Machine: true}).CanUpdate(testOwnable{project: pid1}))
+}
+
+func TestOperator_CanAccessItemGroup(t *testing.T) {
+	pid1, pid2 := id.NewProjectID(), id.NewProjectID()
+	op := &Operator{
+		User:       id.NewUserID().Ref(),
+		ItemGroups: map[id.ProjectID][]string{pid1: {"13101"}},
+	}
+
+	assert.True(t, op.CanAccessItemGroup(pid1, "13101"))
+	assert.False(t, op.CanAccessItemGroup(pid1, "13102"))
+	assert.True(t, op.CanAccessItemGroup(pid1, ""))
+	assert.True(t, op.CanAccessItemGroup(pid2, "13102"))
+	assert.True(t, (&Operator{Machine: true, ItemGroups: op.ItemGroups}).CanAccessItemGroup(pid1, "13102"))
+
+	var nilOp *Operator
+	assert.True(t, nilOp.CanAccessItemGroup(pid1, "13102"))
+
+	assert.True(t, op.CanAssignItemGroup(pid1, "13101"))
+	assert.False(t, op.CanAssignItemGroup(pid1, "13102"))
+	assert.False(t, op.CanAssignItemGroup(pid1, ""))
+	assert.True(t, op.CanAssignItemGroup(pid2, ""))
+}
@@ -25,14 +25,21 @@ type Item interface {
 	FindByProject(context.Context, id.ProjectID, *version.Ref, *usecasex.Pagination) (item.VersionedList, *usecasex.PageInfo, error)
 	FindByModel(context.Context, id.ModelID, *version.Ref, *usecasex.Pagination) (item.VersionedList, *usecasex.PageInfo, error)
 	FindByAssets(context.Context, id.AssetIDList, *version.Ref) (item.VersionedList, error)
+	// FindByReferences returns the items which reference any of the items
+	FindByReferences(context.Context, id.ItemIDList, *version.Ref) (item.VersionedList, error)
+	// FindAllByReferences returns the latest versions of all items which reference any of the items including ones hidden by the filter.
+	// It is only for keeping references consistent, so the items must not be exposed as they are.
+	FindAllByReferences(context.Context, id.ItemIDList) (item.VersionedList, error)
 	LastModifiedByModel(context.Context, id.ModelID) (time.Time, error)
 	Search(context.Context, *item.Query, *usecasex.Sort, *usecasex.Pagination) (item.VersionedList, *usecasex.PageInfo, error)
 	FindAllVersionsByID(context.Context, id.ItemID) (item.VersionedList, error)
 	FindAllVersionsByIDs(context.Context, id.ItemIDList) (item.VersionedList, error)
 	FindByModelAndValue(context.Context, id.ModelID, []FieldAndValue, *version.Ref) (item.VersionedList, error)
+	FindDueSchedule(context.Context, time.Time) (item.VersionedList, error)
 	IsArchived(context.Context, id.ItemID) (bool, error)
 	Save(context.Context, *item.Item) error
 	UpdateRef(context.Context, id.ItemID, version.Ref, *version.VersionOrRef) error
+	UpdateSchedule(context.Context, id.ItemID, *time.Time, *time.Time, *version.Version) error
 	Remove(context.Context, id.ItemID) error
 	Archive(context.Context, id.ItemID, id.ProjectID, bool) error
 }
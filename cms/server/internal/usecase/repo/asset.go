@@ -5,6 +5,7 @@ import (
 
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/usecasex"
 )
 
@@ -19,6 +20,10 @@ type Asset interface {
 	FindByProject(context.Context, id.ProjectID, AssetFilter) ([]*asset.Asset, *usecasex.PageInfo, error)
 	FindByID(context.Context, id.AssetID) (*asset.Asset, error)
 	FindByIDs(context.Context, id.AssetIDList) ([]*asset.Asset, error)
+	// FindByUUID returns the asset whose files are stored under the UUID
+	FindByUUID(context.Context, string) (*asset.Asset, error)
+	// FindByArea returns assets of the project whose bounding boxes overlap the area
+	FindByArea(context.Context, id.ProjectID, value.Geometry) ([]*asset.Asset, error)
 	Save(context.Context, *asset.Asset) error
 	Delete(context.Context, id.AssetID) error
 }
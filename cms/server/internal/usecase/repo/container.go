@@ -2,32 +2,38 @@ package repo
 
 import (
 	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/user"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
 )
 
 type Container struct {
-	Asset       Asset
-	AssetFile   AssetFile
-	Lock        Lock
-	User        User
-	Workspace   Workspace
-	Project     Project
-	Model       Model
-	Schema      Schema
-	Item        Item
-	Integration Integration
-	Thread      Thread
-	Event       Event
-	Request     Request
-	Transaction usecasex.Transaction
+	Asset           Asset
+	AssetFile       AssetFile
+	Lock            Lock
+	User            User
+	Workspace       Workspace
+	Project         Project
+	Model           Model
+	Schema          Schema
+	Item            Item
+	Integration     Integration
+	Thread          Thread
+	Event           Event
+	Request         Request
+	Transaction     usecasex.Transaction
+	WebhookDelivery WebhookDelivery
+	Task            Task
 }
 
 var (
-	ErrOperationDenied = rerror.NewE(i18n.T("operation denied"))
+	ErrOperationDenied       = rerror.NewE(i18n.T("operation denied"))
+	ErrUnsupportedPagination = rerror.NewE(i18n.T("cursor pagination is not supported with sorting by a field"))
 )
 
 func (c *Container) Filtered(workspace WorkspaceFilter, project ProjectFilter) *Container {
@@ -35,20 +41,22 @@ func (c *Container) Filtered(workspace WorkspaceFilter, project ProjectFilter) *
 		return c
 	}
 	return &Container{
-		Asset:       c.Asset.Filtered(project),
-		AssetFile:   c.AssetFile,
-		Lock:        c.Lock,
-		Transaction: c.Transaction,
-		Workspace:   c.Workspace,
-		User:        c.User,
-		Request:     c.Request,
-		Item:        c.Item.Filtered(project),
-		Project:     c.Project.Filtered(workspace),
-		Model:       c.Model.Filtered(project),
-		Schema:      c.Schema.Filtered(workspace),
-		Thread:      c.Thread.Filtered(workspace),
-		Integration: c.Integration,
-		Event:       c.Event,
+		Asset:           c.Asset.Filtered(project),
+		AssetFile:       c.AssetFile,
+		Lock:            c.Lock,
+		Transaction:     c.Transaction,
+		Workspace:       c.Workspace,
+		User:            c.User,
+		Request:         c.Request,
+		Item:            c.Item.Filtered(project),
+		Project:         c.Project.Filtered(workspace),
+		Model:           c.Model.Filtered(project),
+		Schema:          c.Schema.Filtered(workspace),
+		Thread:          c.Thread.Filtered(workspace),
+		Integration:     c.Integration,
+		Event:           c.Event,
+		WebhookDelivery: c.WebhookDelivery,
+		Task:            c.Task,
 	}
 }
 
@@ -104,19 +112,27 @@ func (f WorkspaceFilter) CanWrite(id user.WorkspaceID) bool {
 type ProjectFilter struct {
 	Readable project.IDList
 	Writable project.IDList
+	// ItemGroups are the groups of items which can be accessed by projects. Items of projects not in the map are not filtered by groups.
+	ItemGroups map[project.ID][]string
+	// ReadableModels are the models whose items can be read by projects. Items of projects not in the map are not filtered by models.
+	ReadableModels map[project.ID][]id.ModelID
 }
 
 func ProjectFilterFromOperator(o *usecase.Operator) ProjectFilter {
 	return ProjectFilter{
-		Readable: o.AllReadableProjects(),
-		Writable: o.AllWritableProjects(),
+		Readable:       o.AllReadableProjects(),
+		Writable:       o.AllWritableProjects(),
+		ItemGroups:     o.ItemGroups,
+		ReadableModels: o.ReadableModels(),
 	}
 }
 
 func (f ProjectFilter) Clone() ProjectFilter {
 	return ProjectFilter{
-		Readable: f.Readable.Clone(),
-		Writable: f.Writable.Clone(),
+		Readable:       f.Readable.Clone(),
+		Writable:       f.Writable.Clone(),
+		ItemGroups:     cloneRestrictions(f.ItemGroups),
+		ReadableModels: cloneRestrictions(f.ReadableModels),
 	}
 }
 
@@ -137,9 +153,29 @@ func (f ProjectFilter) Merge(g ProjectFilter) ProjectFilter {
 		}
 	}
 	return ProjectFilter{
-		Readable: r,
-		Writable: w,
+		Readable:       r,
+		Writable:       w,
+		ItemGroups:     mergeRestrictions(f.ItemGroups, g.ItemGroups),
+		ReadableModels: mergeRestrictions(f.ReadableModels, g.ReadableModels),
+	}
+}
+
+// mergeRestrictions combines restrictions by projects such as item groups of two filters. When only one filter has restrictions, they are used as they are.
+// Otherwise projects restricted by both filters are restricted to the values of either, and other projects are not restricted.
+func mergeRestrictions[T comparable](f, g map[project.ID][]T) map[project.ID][]T {
+	if f == nil {
+		return cloneRestrictions(g)
+	}
+	if g == nil {
+		return cloneRestrictions(f)
+	}
+	res := map[project.ID][]T{}
+	for p, values := range f {
+		if values2, ok := g[p]; ok {
+			res[p] = lo.Uniq(append(slices.Clone(values), values2...))
+		}
 	}
+	return res
 }
 
 func (f ProjectFilter) CanRead(id project.ID) bool {
@@ -149,3 +185,29 @@ func (f ProjectFilter) CanRead(id project.ID) bool {
 func (f ProjectFilter) CanWrite(id project.ID) bool {
 	return f.Writable == nil || f.Writable.Has(id)
 }
+
+// CanReadModel returns whether items of the model in the project can be read
+func (f ProjectFilter) CanReadModel(id project.ID, model id.ModelID) bool {
+	models, ok := f.ReadableModels[id]
+	return !ok || slices.Contains(models, model)
+}
+
+// CanAccessItemGroup returns whether items of the group in the project can be accessed. Items without a group can always be accessed.
+func (f ProjectFilter) CanAccessItemGroup(id project.ID, group string) bool {
+	if group == "" {
+		return true
+	}
+	groups, ok := f.ItemGroups[id]
+	return !ok || slices.Contains(groups, group)
+}
+
+func cloneRestrictions[T any](m map[project.ID][]T) map[project.ID][]T {
+	if m == nil {
+		return nil
+	}
+	res := make(map[project.ID][]T, len(m))
+	for p, values := range m {
+		res[p] = slices.Clone(values)
+	}
+	return res
+}
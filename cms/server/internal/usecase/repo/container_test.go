@@ -0,0 +1,37 @@
+package repo
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectFilter_Merge_ItemGroups(t *testing.T) {
+	p1, p2 := id.NewProjectID(), id.NewProjectID()
+	f := ProjectFilter{ItemGroups: map[id.ProjectID][]string{p1: {"a"}, p2: {"b"}}}
+	g := ProjectFilter{ItemGroups: map[id.ProjectID][]string{p1: {"c", "a"}}}
+
+	assert.Equal(t, f.ItemGroups, ProjectFilter{}.Merge(f).ItemGroups)
+	assert.Equal(t, f.ItemGroups, f.Merge(ProjectFilter{}).ItemGroups)
+
+	got := f.Merge(g)
+	assert.Equal(t, map[id.ProjectID][]string{p1: {"a", "c"}}, got.ItemGroups)
+	assert.True(t, got.CanAccessItemGroup(p1, "c"))
+	assert.False(t, got.CanAccessItemGroup(p1, "b"))
+	// p2 is not restricted by g
+	assert.True(t, got.CanAccessItemGroup(p2, "x"))
+}
+
+func TestProjectFilter_Merge_ReadableModels(t *testing.T) {
+	p1, p2 := id.NewProjectID(), id.NewProjectID()
+	m1, m2 := id.NewModelID(), id.NewModelID()
+	f := ProjectFilter{ReadableModels: map[id.ProjectID][]id.ModelID{p1: {m1}, p2: {}}}
+	g := ProjectFilter{ReadableModels: map[id.ProjectID][]id.ModelID{p1: {m2}}}
+
+	got := f.Merge(g)
+	assert.Equal(t, map[id.ProjectID][]id.ModelID{p1: {m1, m2}}, got.ReadableModels)
+	assert.True(t, got.CanReadModel(p1, m2))
+	assert.True(t, got.CanReadModel(p2, m1))
+	assert.False(t, f.CanReadModel(p2, m1))
+}
@@ -2,12 +2,29 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/usecasex"
 )
 
+// EventFilter narrows down events for audit log queries. Nil fields are not used as conditions.
+type EventFilter struct {
+	Workspace   *id.WorkspaceID
+	Project     *id.ProjectID
+	Model       *id.ModelID
+	Item        *id.ItemID
+	User        *id.UserID
+	Integration *id.IntegrationID
+	Types       []event.Type
+	From        *time.Time
+	To          *time.Time
+}
+
 type Event interface {
 	FindByID(context.Context, id.EventID) (*event.Event[any], error)
+	// Search returns events which match the filter in reverse chronological order
+	Search(context.Context, EventFilter, *usecasex.Pagination) ([]*event.Event[any], *usecasex.PageInfo, error)
 	Save(context.Context, *event.Event[any]) error
 }
@@ -14,6 +14,7 @@ type Model interface {
 	FindByIDs(context.Context, id.ModelIDList) (model.List, error)
 	FindByProject(context.Context, id.ProjectID, *usecasex.Pagination) (model.List, *usecasex.PageInfo, error)
 	FindByKey(context.Context, id.ProjectID, string) (*model.Model, error)
+	FindBySchema(context.Context, id.SchemaID) (*model.Model, error)
 	FindByIDOrKey(context.Context, id.ProjectID, model.IDOrKey) (*model.Model, error)
 	CountByProject(context.Context, id.ProjectID) (int, error)
 	Save(context.Context, *model.Model) error
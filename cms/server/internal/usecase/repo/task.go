@@ -0,0 +1,18 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type Task interface {
+	FindByID(context.Context, id.TaskID) (*task.Task, error)
+	FindByProject(context.Context, id.ProjectID, *usecasex.Pagination) (task.List, *usecasex.PageInfo, error)
+	// FindRunnable returns pending tasks and running tasks which have not been updated since the time
+	FindRunnable(context.Context, time.Time) (task.List, error)
+	Save(context.Context, *task.Task) error
+}
@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type WebhookDelivery interface {
+	FindByID(context.Context, id.WebhookDeliveryID) (*integration.Delivery, error)
+	FindByWebhook(context.Context, id.WebhookID, *usecasex.Pagination) (integration.DeliveryList, *usecasex.PageInfo, error)
+	FindDue(context.Context, time.Time) (integration.DeliveryList, error)
+	Save(context.Context, *integration.Delivery) error
+}
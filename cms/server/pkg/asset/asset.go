@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/reearth/reearthx/util"
+	"golang.org/x/exp/slices"
 )
 
 type Asset struct {
@@ -18,6 +19,12 @@ type Asset struct {
 	uuid                    string
 	thread                  ThreadID
 	archiveExtractionStatus *ArchiveExtractionStatus
+	bbox                    []float64
+
+	archiveExtractionProgress *ArchiveExtractionProgress
+	archiveExtractionFailures []ArchiveExtractionFailure
+	compressionStatus         *ArchiveExtractionStatus
+	compressedPath            string
 }
 
 type URLResolver = func(*Asset) string
@@ -72,6 +79,18 @@ func (a *Asset) ArchiveExtractionStatus() *ArchiveExtractionStatus {
 	return a.archiveExtractionStatus
 }
 
+// BBox returns the bounding box of the geospatial data of the asset as [west, south, east, north]
+func (a *Asset) BBox() []float64 {
+	return slices.Clone(a.bbox)
+}
+
+func (a *Asset) UpdateBBox(b []float64) {
+	if b != nil && len(b) != 4 {
+		return
+	}
+	a.bbox = slices.Clone(b)
+}
+
 func (a *Asset) UpdatePreviewType(p *PreviewType) {
 	a.previewType = util.CloneRef(p)
 }
@@ -97,6 +116,12 @@ func (a *Asset) Clone() *Asset {
 		uuid:                    a.uuid,
 		thread:                  a.thread.Clone(),
 		archiveExtractionStatus: a.archiveExtractionStatus,
+		bbox:                    slices.Clone(a.bbox),
+
+		archiveExtractionProgress: a.ArchiveExtractionProgress(),
+		archiveExtractionFailures: a.ArchiveExtractionFailures(),
+		compressionStatus:         a.CompressionStatus(),
+		compressedPath:            a.compressedPath,
 	}
 }
 
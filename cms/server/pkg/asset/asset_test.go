@@ -148,3 +148,26 @@ func TestAsset_Clone(t *testing.T) {
 	assert.NotSame(t, a, got)
 	assert.Nil(t, (*Asset)(nil).Clone())
 }
+
+func TestAsset_UpdateBBox(t *testing.T) {
+	a := &Asset{}
+	a.UpdateBBox([]float64{139, 35, 140, 36})
+	assert.Equal(t, []float64{139, 35, 140, 36}, a.BBox())
+	assert.Equal(t, []float64{139, 35, 140, 36}, a.Clone().BBox())
+
+	a.UpdateBBox([]float64{1, 2})
+	assert.Equal(t, []float64{139, 35, 140, 36}, a.BBox())
+
+	a.UpdateBBox(nil)
+	assert.Nil(t, a.BBox())
+}
+
+func TestAsset_Compression(t *testing.T) {
+	assert.Equal(t, "xxx/yyy.compressed.zip", CompressedPath("xxx/yyy/"))
+
+	a := &Asset{}
+	a.UpdateCompressionStatus(lo.ToPtr(ArchiveExtractionStatusDone))
+	a.UpdateCompressedPath("xxx.compressed.zip")
+	assert.Equal(t, lo.ToPtr(ArchiveExtractionStatusDone), a.Clone().CompressionStatus())
+	assert.Equal(t, "xxx.compressed.zip", a.Clone().CompressedPath())
+}
@@ -0,0 +1,228 @@
+package asset
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// WGS84 ellipsoid
+const (
+	earthRadius = 6378137.0
+	earthE2     = 6.69437999014e-3
+)
+
+// BBoxFromGeoJSON returns the bounding box of all coordinates in a GeoJSON document. It returns nil when there are no coordinates.
+func BBoxFromGeoJSON(r io.Reader) ([]float64, error) {
+	var doc any
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	var b []float64
+	var walk func(any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			for k, w := range v {
+				if k == "coordinates" {
+					b = MergeBBox(b, bboxFromCoordinates(w))
+				} else {
+					walk(w)
+				}
+			}
+		case []any:
+			for _, w := range v {
+				walk(w)
+			}
+		}
+	}
+	walk(doc)
+	return b, nil
+}
+
+func bboxFromCoordinates(c any) []float64 {
+	l, ok := c.([]any)
+	if !ok || len(l) == 0 {
+		return nil
+	}
+	if _, ok := l[0].(float64); ok {
+		if len(l) < 2 {
+			return nil
+		}
+		x, ok1 := l[0].(float64)
+		y, ok2 := l[1].(float64)
+		if !ok1 || !ok2 {
+			return nil
+		}
+		return []float64{x, y, x, y}
+	}
+
+	var b []float64
+	for _, e := range l {
+		b = MergeBBox(b, bboxFromCoordinates(e))
+	}
+	return b
+}
+
+// BBoxFromTileset returns the bounding box of the root tile of a 3D Tiles tileset.json. It returns nil when the bounding volume is not on the earth.
+func BBoxFromTileset(r io.Reader) ([]float64, error) {
+	var t struct {
+		Root struct {
+			Transform      []float64 `json:"transform"`
+			BoundingVolume struct {
+				Region []float64 `json:"region"`
+				Box    []float64 `json:"box"`
+				Sphere []float64 `json:"sphere"`
+			} `json:"boundingVolume"`
+		} `json:"root"`
+	}
+	if err := json.NewDecoder(r).Decode(&t); err != nil {
+		return nil, err
+	}
+
+	bv := t.Root.BoundingVolume
+	if len(bv.Region) >= 4 {
+		return clampBBox([]float64{
+			bv.Region[0] * 180 / math.Pi,
+			bv.Region[1] * 180 / math.Pi,
+			bv.Region[2] * 180 / math.Pi,
+			bv.Region[3] * 180 / math.Pi,
+		}), nil
+	}
+
+	var center [3]float64
+	var radius float64
+	var axes [][3]float64
+	switch {
+	case len(bv.Box) == 12:
+		center = [3]float64{bv.Box[0], bv.Box[1], bv.Box[2]}
+		axes = [][3]float64{
+			{bv.Box[3], bv.Box[4], bv.Box[5]},
+			{bv.Box[6], bv.Box[7], bv.Box[8]},
+			{bv.Box[9], bv.Box[10], bv.Box[11]},
+		}
+	case len(bv.Sphere) == 4:
+		center = [3]float64{bv.Sphere[0], bv.Sphere[1], bv.Sphere[2]}
+		radius = bv.Sphere[3]
+	default:
+		return nil, nil
+	}
+
+	if m := t.Root.Transform; len(m) == 16 {
+		// the matrix is in column-major order
+		center = [3]float64{
+			m[0]*center[0] + m[4]*center[1] + m[8]*center[2] + m[12],
+			m[1]*center[0] + m[5]*center[1] + m[9]*center[2] + m[13],
+			m[2]*center[0] + m[6]*center[1] + m[10]*center[2] + m[14],
+		}
+		for i, a := range axes {
+			axes[i] = [3]float64{
+				m[0]*a[0] + m[4]*a[1] + m[8]*a[2],
+				m[1]*a[0] + m[5]*a[1] + m[9]*a[2],
+				m[2]*a[0] + m[6]*a[1] + m[10]*a[2],
+			}
+		}
+	}
+	for _, a := range axes {
+		radius += a[0]*a[0] + a[1]*a[1] + a[2]*a[2]
+	}
+	if len(axes) > 0 {
+		radius = math.Sqrt(radius)
+	}
+
+	// a bounding volume in a local coordinate system cannot be located on the earth
+	if math.Sqrt(center[0]*center[0]+center[1]*center[1]+center[2]*center[2]) < earthRadius/2 {
+		return nil, nil
+	}
+
+	lon, lat := ecefToLonLat(center)
+	dlat := radius / earthRadius * 180 / math.Pi
+	dlon := 180.0
+	if c := math.Cos(lat * math.Pi / 180); c > 1e-6 {
+		dlon = math.Min(dlat/c, 180)
+	}
+	return clampBBox([]float64{lon - dlon, lat - dlat, lon + dlon, lat + dlat}), nil
+}
+
+// ecefToLonLat converts earth-centered, earth-fixed coordinates into longitude and latitude in degrees
+func ecefToLonLat(c [3]float64) (float64, float64) {
+	x, y, z := c[0], c[1], c[2]
+	lon := math.Atan2(y, x)
+	p := math.Sqrt(x*x + y*y)
+	lat := math.Atan2(z, p*(1-earthE2))
+	for i := 0; i < 5; i++ {
+		sin := math.Sin(lat)
+		n := earthRadius / math.Sqrt(1-earthE2*sin*sin)
+		h := p/math.Cos(lat) - n
+		lat = math.Atan2(z, p*(1-earthE2*n/(n+h)))
+	}
+	return lon * 180 / math.Pi, lat * 180 / math.Pi
+}
+
+// BBoxFromMVTMetadata returns the bounds in metadata.json of vector tiles generated by tippecanoe or in TileJSON
+func BBoxFromMVTMetadata(r io.Reader) ([]float64, error) {
+	var m struct {
+		Bounds any `json:"bounds"`
+	}
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, err
+	}
+
+	var b []float64
+	switch v := m.Bounds.(type) {
+	case string:
+		for _, s := range strings.Split(v, ",") {
+			f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil {
+				return nil, nil
+			}
+			b = append(b, f)
+		}
+	case []any:
+		for _, e := range v {
+			f, ok := e.(float64)
+			if !ok {
+				return nil, nil
+			}
+			b = append(b, f)
+		}
+	}
+	if len(b) != 4 {
+		return nil, nil
+	}
+	return clampBBox(b), nil
+}
+
+// MergeBBox returns the bounding box which covers both of the bounding boxes
+func MergeBBox(a, b []float64) []float64 {
+	if len(a) != 4 {
+		if len(b) != 4 {
+			return nil
+		}
+		return []float64{b[0], b[1], b[2], b[3]}
+	}
+	if len(b) != 4 {
+		return []float64{a[0], a[1], a[2], a[3]}
+	}
+	return []float64{
+		math.Min(a[0], b[0]),
+		math.Min(a[1], b[1]),
+		math.Max(a[2], b[2]),
+		math.Max(a[3], b[3]),
+	}
+}
+
+func clampBBox(b []float64) []float64 {
+	if b[0] > b[2] || b[1] > b[3] {
+		return nil
+	}
+	return []float64{
+		math.Max(b[0], -180),
+		math.Max(b[1], -90),
+		math.Min(b[2], 180),
+		math.Min(b[3], 90),
+	}
+}
@@ -0,0 +1,74 @@
+package asset
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBBoxFromGeoJSON(t *testing.T) {
+	b, err := BBoxFromGeoJSON(strings.NewReader(`{
+		"type": "FeatureCollection",
+		"features": [
+			{"type": "Feature", "geometry": {"type": "Point", "coordinates": [139.5, 35.5, 10]}},
+			{"type": "Feature", "geometry": {"type": "Polygon", "coordinates": [[[139, 35], [140, 35], [140, 36], [139, 35]]]}},
+			{"type": "Feature", "geometry": {"type": "GeometryCollection", "geometries": [{"type": "LineString", "coordinates": [[141, 34], [141.5, 34.5]]}]}}
+		]
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{139, 34, 141.5, 36}, b)
+
+	b, err = BBoxFromGeoJSON(strings.NewReader(`{"type": "FeatureCollection", "features": []}`))
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+
+	_, err = BBoxFromGeoJSON(strings.NewReader(`{`))
+	assert.Error(t, err)
+}
+
+func TestBBoxFromTileset(t *testing.T) {
+	// region
+	b, err := BBoxFromTileset(strings.NewReader(`{"root": {"boundingVolume": {"region": [2.4, 0.6, 2.5, 0.7, 0, 100]}}}`))
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{137.5099, 34.3775, 143.2394, 40.1070}, b, 1e-4)
+
+	// box moved to Tokyo by the transform
+	b, err = BBoxFromTileset(strings.NewReader(`{"root": {
+		"transform": [1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, -3954811.8315901463, 3353924.3377002263, 3701210.935903534, 1],
+		"boundingVolume": {"box": [0, 0, 0, 1000, 0, 0, 0, 0, 0, 0, 0, 0]}
+	}}`))
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{139.7 - 0.01106, 35.7 - 0.00898, 139.7 + 0.01106, 35.7 + 0.00898}, b, 1e-4)
+
+	// sphere in ECEF
+	b, err = BBoxFromTileset(strings.NewReader(`{"root": {"boundingVolume": {"sphere": [-3954811.8315901463, 3353924.3377002263, 3701210.935903534, 1000]}}}`))
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{139.7 - 0.01106, 35.7 - 0.00898, 139.7 + 0.01106, 35.7 + 0.00898}, b, 1e-4)
+
+	// local coordinates
+	b, err = BBoxFromTileset(strings.NewReader(`{"root": {"boundingVolume": {"box": [0, 0, 0, 10, 0, 0, 0, 10, 0, 0, 0, 10]}}}`))
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+}
+
+func TestBBoxFromMVTMetadata(t *testing.T) {
+	b, err := BBoxFromMVTMetadata(strings.NewReader(`{"name": "a", "bounds": "139.000000,35.000000,140.000000,36.000000"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{139, 35, 140, 36}, b)
+
+	b, err = BBoxFromMVTMetadata(strings.NewReader(`{"tilejson": "3.0.0", "bounds": [139, 35, 140, 36]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{139, 35, 140, 36}, b)
+
+	b, err = BBoxFromMVTMetadata(strings.NewReader(`{"name": "a"}`))
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+}
+
+func TestMergeBBox(t *testing.T) {
+	assert.Equal(t, []float64{0, -1, 3, 2}, MergeBBox([]float64{0, 0, 1, 2}, []float64{1, -1, 3, 1}))
+	assert.Equal(t, []float64{0, 0, 1, 2}, MergeBBox(nil, []float64{0, 0, 1, 2}))
+	assert.Equal(t, []float64{0, 0, 1, 2}, MergeBBox([]float64{0, 0, 1, 2}, nil))
+	assert.Nil(t, MergeBBox(nil, nil))
+}
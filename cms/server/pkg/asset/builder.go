@@ -113,3 +113,28 @@ func (b *Builder) ArchiveExtractionStatus(s *ArchiveExtractionStatus) *Builder {
 	b.a.archiveExtractionStatus = s
 	return b
 }
+
+func (b *Builder) ArchiveExtractionProgress(p *ArchiveExtractionProgress) *Builder {
+	b.a.UpdateArchiveExtractionProgress(p)
+	return b
+}
+
+func (b *Builder) ArchiveExtractionFailures(f []ArchiveExtractionFailure) *Builder {
+	b.a.UpdateArchiveExtractionFailures(f)
+	return b
+}
+
+func (b *Builder) CompressionStatus(s *ArchiveExtractionStatus) *Builder {
+	b.a.UpdateCompressionStatus(s)
+	return b
+}
+
+func (b *Builder) CompressedPath(p string) *Builder {
+	b.a.UpdateCompressedPath(p)
+	return b
+}
+
+func (b *Builder) BBox(bbox []float64) *Builder {
+	b.a.UpdateBBox(bbox)
+	return b
+}
@@ -0,0 +1,34 @@
+package asset
+
+import (
+	"strings"
+
+	"github.com/reearth/reearthx/util"
+)
+
+// CompressedSuffix is appended to the path of a folder to name the zip archive generated from the folder
+const CompressedSuffix = ".compressed.zip"
+
+// CompressedPath returns the path of the zip archive generated from the folder
+func CompressedPath(dir string) string {
+	return strings.TrimSuffix(dir, "/") + CompressedSuffix
+}
+
+// CompressionStatus is the status of the zip archive generated on demand from the extracted files.
+// It shares the values with ArchiveExtractionStatus.
+func (a *Asset) CompressionStatus() *ArchiveExtractionStatus {
+	return util.CloneRef(a.compressionStatus)
+}
+
+// CompressedPath returns the path of the generated zip archive relative to the directory of the asset file
+func (a *Asset) CompressedPath() string {
+	return a.compressedPath
+}
+
+func (a *Asset) UpdateCompressionStatus(s *ArchiveExtractionStatus) {
+	a.compressionStatus = util.CloneRef(s)
+}
+
+func (a *Asset) UpdateCompressedPath(p string) {
+	a.compressedPath = p
+}
@@ -0,0 +1,57 @@
+package asset
+
+import (
+	"golang.org/x/exp/slices"
+)
+
+// MaxArchiveExtractionFailures is the maximum number of failed files recorded for an asset
+const MaxArchiveExtractionFailures = 1000
+
+// ArchiveExtractionProgress is the progress of the extraction of an archive.
+// Totals are zero while they are unknown, as in tar archives which cannot be listed in advance.
+type ArchiveExtractionProgress struct {
+	Files      int
+	TotalFiles int
+	Failed     int
+	Bytes      int64
+	TotalBytes int64
+}
+
+// ArchiveExtractionFailure is a file which failed to be extracted from an archive
+type ArchiveExtractionFailure struct {
+	Path  string
+	Error string
+}
+
+func (a *Asset) ArchiveExtractionProgress() *ArchiveExtractionProgress {
+	if a.archiveExtractionProgress == nil {
+		return nil
+	}
+	p := *a.archiveExtractionProgress
+	return &p
+}
+
+func (a *Asset) UpdateArchiveExtractionProgress(p *ArchiveExtractionProgress) {
+	if p == nil {
+		a.archiveExtractionProgress = nil
+		return
+	}
+	p2 := *p
+	a.archiveExtractionProgress = &p2
+}
+
+func (a *Asset) ArchiveExtractionFailures() []ArchiveExtractionFailure {
+	return slices.Clone(a.archiveExtractionFailures)
+}
+
+// UpdateArchiveExtractionFailures records failed files up to MaxArchiveExtractionFailures
+func (a *Asset) UpdateArchiveExtractionFailures(f []ArchiveExtractionFailure) {
+	if len(f) == 0 {
+		a.archiveExtractionFailures = nil
+		return
+	}
+	if len(f) > MaxArchiveExtractionFailures {
+		f = f[:MaxArchiveExtractionFailures]
+	}
+	a.archiveExtractionFailures = slices.Clone(f)
+}
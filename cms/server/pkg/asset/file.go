@@ -13,6 +13,7 @@ type File struct {
 	size        uint64
 	contentType string
 	path        string
+	crc32       uint32
 	children    []*File
 }
 
@@ -41,6 +42,14 @@ func (f *File) ContentType() string {
 	return f.contentType
 }
 
+// CRC32 returns the CRC-32 (IEEE) checksum of the file. It is zero when it is unknown.
+func (f *File) CRC32() uint32 {
+	if f == nil {
+		return 0
+	}
+	return f.crc32
+}
+
 func (f *File) Path() string {
 	if f == nil {
 		return ""
@@ -81,6 +90,7 @@ func (f *File) Clone() *File {
 		size:        f.size,
 		contentType: f.contentType,
 		path:        f.path,
+		crc32:       f.crc32,
 		children:    children,
 	}
 }
@@ -43,6 +43,11 @@ func (b *FileBuilder) Size(size uint64) *FileBuilder {
 	return b
 }
 
+func (b *FileBuilder) CRC32(crc uint32) *FileBuilder {
+	b.f.crc32 = crc
+	return b
+}
+
 func (b *FileBuilder) Children(children []*File) *FileBuilder {
 	b.f.children = slices.Clone(children)
 	return b
@@ -20,6 +20,15 @@ func (l List) Clone() List {
 	return util.Map(l, func(p *Asset) *Asset { return p.Clone() })
 }
 
+func (l List) IDs() IDList {
+	return lo.FilterMap(l, func(a *Asset, _ int) (ID, bool) {
+		if a == nil {
+			return ID{}, false
+		}
+		return a.ID(), true
+	})
+}
+
 func (l List) Map() Map {
 	return lo.SliceToMap(lo.Filter(l, func(a *Asset, _ int) bool {
 		return a != nil
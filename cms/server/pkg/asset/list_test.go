@@ -50,3 +50,11 @@ func TestList_Map(t *testing.T) {
 	}, List{a, nil}.Map())
 	assert.Equal(t, Map{}, List(nil).Map())
 }
+
+func TestList_IDs(t *testing.T) {
+	id1 := NewID()
+	id2 := NewID()
+
+	assert.Equal(t, IDList{id1, id2}, List{&Asset{id: id1}, nil, &Asset{id: id2}}.IDs())
+	assert.Empty(t, List{}.IDs())
+}
@@ -0,0 +1,237 @@
+// Package definition converts models and schemas of a project from and to a portable definition file,
+// which can be imported into another project or another CMS instance.
+package definition
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+// Version is the current version of the definition format
+const Version = 1
+
+var (
+	ErrInvalidDefinition  = rerror.NewE(i18n.T("invalid model definition"))
+	ErrUnsupportedVersion = rerror.NewE(i18n.T("unsupported version of model definition"))
+	ErrUnsupportedFormat  = rerror.NewE(i18n.T("unsupported format of model definition"))
+)
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// Definition is a set of models and their schemas. Models and fields are identified by their keys
+// instead of IDs so that the definition can be shared between projects.
+type Definition struct {
+	Version int      `json:"version" yaml:"version"`
+	Models  []*Model `json:"models" yaml:"models"`
+}
+
+type Model struct {
+	Key         string   `json:"key" yaml:"key"`
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Public      bool     `json:"public,omitempty" yaml:"public,omitempty"`
+	Fields      []*Field `json:"fields" yaml:"fields"`
+}
+
+// Field is a field of a schema. Fields are listed in order.
+type Field struct {
+	Key          string      `json:"key" yaml:"key"`
+	Name         string      `json:"name" yaml:"name"`
+	Description  string      `json:"description,omitempty" yaml:"description,omitempty"`
+	Type         value.Type  `json:"type" yaml:"type"`
+	Required     bool        `json:"required,omitempty" yaml:"required,omitempty"`
+	Unique       bool        `json:"unique,omitempty" yaml:"unique,omitempty"`
+	Multiple     bool        `json:"multiple,omitempty" yaml:"multiple,omitempty"`
+	Localized    bool        `json:"localized,omitempty" yaml:"localized,omitempty"`
+	MinItems     *int        `json:"minItems,omitempty" yaml:"minItems,omitempty"`
+	MaxItems     *int        `json:"maxItems,omitempty" yaml:"maxItems,omitempty"`
+	DefaultValue []any       `json:"defaultValue,omitempty" yaml:"defaultValue,omitempty"`
+	RequiredIf   *RequiredIf `json:"requiredIf,omitempty" yaml:"requiredIf,omitempty"`
+	Text         *Text       `json:"text,omitempty" yaml:"text,omitempty"`
+	Select       *Select     `json:"select,omitempty" yaml:"select,omitempty"`
+	Number       *Number     `json:"number,omitempty" yaml:"number,omitempty"`
+	Integer      *Integer    `json:"integer,omitempty" yaml:"integer,omitempty"`
+	DateTime     *DateTime   `json:"dateTime,omitempty" yaml:"dateTime,omitempty"`
+	Reference    *Reference  `json:"reference,omitempty" yaml:"reference,omitempty"`
+	URL          *URL        `json:"url,omitempty" yaml:"url,omitempty"`
+	Geometry     *Geometry   `json:"geometry,omitempty" yaml:"geometry,omitempty"`
+	Group        *Group      `json:"group,omitempty" yaml:"group,omitempty"`
+}
+
+// RequiredIf makes the field required when the field of the key has one of the values
+type RequiredIf struct {
+	Field  string   `json:"field" yaml:"field"`
+	Values []string `json:"values" yaml:"values"`
+}
+
+// Text is the type property of text, textArea, richText and markdown fields
+type Text struct {
+	MaxLength *int    `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	Pattern   *string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+}
+
+type Select struct {
+	Values []string `json:"values" yaml:"values"`
+}
+
+type Number struct {
+	Min *float64 `json:"min,omitempty" yaml:"min,omitempty"`
+	Max *float64 `json:"max,omitempty" yaml:"max,omitempty"`
+}
+
+type Integer struct {
+	Min *int64 `json:"min,omitempty" yaml:"min,omitempty"`
+	Max *int64 `json:"max,omitempty" yaml:"max,omitempty"`
+}
+
+type DateTime struct {
+	Min *time.Time `json:"min,omitempty" yaml:"min,omitempty"`
+	Max *time.Time `json:"max,omitempty" yaml:"max,omitempty"`
+}
+
+// Reference refers to a model by its key
+type Reference struct {
+	Model    string `json:"model" yaml:"model"`
+	OnDelete string `json:"onDelete,omitempty" yaml:"onDelete,omitempty"`
+}
+
+type URL struct {
+	Pattern *string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+}
+
+type Geometry struct {
+	SupportedTypes []string `json:"supportedTypes" yaml:"supportedTypes"`
+}
+
+type Group struct {
+	Fields []*Field `json:"fields" yaml:"fields"`
+}
+
+// Parse reads a definition written in JSON or YAML and validates it
+func Parse(data []byte) (*Definition, error) {
+	d := &Definition{}
+	var err error
+	if b := bytes.TrimSpace(data); len(b) > 0 && b[0] == '{' {
+		err = json.Unmarshal(b, d)
+	} else {
+		err = yaml.Unmarshal(data, d)
+	}
+	if err != nil {
+		return nil, &rerror.Error{
+			Label: ErrInvalidDefinition,
+			Err:   err,
+		}
+	}
+
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Marshal writes the definition in the format
+func (d *Definition) Marshal(f Format) ([]byte, error) {
+	switch f {
+	case FormatJSON:
+		return json.MarshalIndent(d, "", "  ")
+	case FormatYAML:
+		return yaml.Marshal(d)
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// Validate checks the version of the definition and the uniqueness and validity of keys.
+// Keys of models referred by reference fields should be included in the definition or already exist in the project.
+func (d *Definition) Validate() error {
+	if d.Version <= 0 || d.Version > Version {
+		return ErrUnsupportedVersion
+	}
+
+	keys := map[string]struct{}{}
+	for _, m := range d.Models {
+		if m == nil {
+			return ErrInvalidDefinition
+		}
+		if _, ok := keys[m.Key]; ok || !key.New(m.Key).IsValid() {
+			return invalid("invalid or duplicated model key: %s", m.Key)
+		}
+		keys[m.Key] = struct{}{}
+
+		if err := validateFields(m.Key, m.Fields, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Model returns the model of the key
+func (d *Definition) Model(k string) *Model {
+	if d == nil {
+		return nil
+	}
+	m, _ := lo.Find(d.Models, func(m *Model) bool { return m.Key == k })
+	return m
+}
+
+// Field returns the field of the key
+func (m *Model) Field(k string) *Field {
+	if m == nil {
+		return nil
+	}
+	return findField(m.Fields, k)
+}
+
+func validateFields(model string, fields []*Field, allowGroup bool) error {
+	keys := map[string]struct{}{}
+	for _, f := range fields {
+		if f == nil {
+			return ErrInvalidDefinition
+		}
+		if _, ok := keys[f.Key]; ok || !key.New(f.Key).IsValid() {
+			return invalid("invalid or duplicated field key: %s.%s", model, f.Key)
+		}
+		keys[f.Key] = struct{}{}
+
+		if f.Type == value.TypeGroup {
+			if !allowGroup || f.Group == nil {
+				return invalid("invalid group field: %s.%s", model, f.Key)
+			}
+			if err := validateFields(model, f.Group.Fields, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, f := range fields {
+		if f.RequiredIf != nil && findField(fields, f.RequiredIf.Field) == nil {
+			return invalid("field of the required condition is not found: %s.%s", model, f.Key)
+		}
+	}
+	return nil
+}
+
+func findField(fields []*Field, k string) *Field {
+	f, _ := lo.Find(fields, func(f *Field) bool { return f.Key == k })
+	return f
+}
+
+func invalid(format string, a ...any) error {
+	return &rerror.Error{
+		Label: ErrInvalidDefinition,
+		Err:   fmt.Errorf(format, a...),
+	}
+}
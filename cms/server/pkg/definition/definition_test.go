@@ -0,0 +1,168 @@
+package definition
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func testModels() (model.List, schema.List) {
+	pid := id.NewProjectID()
+	wid := id.NewWorkspaceID()
+	s1 := schema.New().NewID().Project(pid).Workspace(wid).MustBuild()
+	s2 := schema.New().NewID().Project(pid).Workspace(wid).MustBuild()
+	m1 := model.New().NewID().Project(pid).Schema(s1.ID()).Key(key.New("city")).Name("City").Public(true).MustBuild()
+	m2 := model.New().NewID().Project(pid).Schema(s2.ID()).Key(key.New("building")).Name("Building").Description("desc").MustBuild()
+
+	text := schema.NewText(lo.ToPtr(10))
+	_ = text.SetPattern(lo.ToPtr("^[a-z]+$"))
+	f1 := schema.NewField(text.TypeProperty()).NewID().Key(key.New("name")).Name("Name").Required(true).
+		DefaultValue(value.New(value.TypeText, "abc").AsMultiple()).MustBuild()
+	f2 := schema.NewField(schema.NewSelect([]string{"a", "b"}).TypeProperty()).NewID().Key(key.New("kind")).Name("Kind").MustBuild()
+	f3 := schema.NewField(lo.Must(schema.NewInteger(lo.ToPtr(int64(1)), nil)).TypeProperty()).NewID().Key(key.New("floors")).Name("Floors").
+		RequiredIf(schema.NewRequiredCondition(f2.ID(), []string{"a"})).MustBuild()
+	f4 := schema.NewField(schema.NewReference(m1.ID()).TypeProperty()).NewID().Key(key.New("city")).Name("City").MustBuild()
+	f5 := schema.NewField(schema.NewAsset().TypeProperty()).NewID().Key(key.New("file")).Name("File").Multiple(true).MustBuild()
+	s1.AddField(f1)
+	s2.AddField(f2)
+	s2.AddField(f3)
+	s2.AddField(f4)
+	s2.AddField(f5)
+
+	return model.List{m1, m2}, schema.List{s1, s2}
+}
+
+func TestNew(t *testing.T) {
+	models, schemas := testModels()
+	got := New(models, schemas)
+
+	assert.Equal(t, &Definition{
+		Version: Version,
+		Models: []*Model{
+			{
+				Key:    "city",
+				Name:   "City",
+				Public: true,
+				Fields: []*Field{
+					{Key: "name", Name: "Name", Type: value.TypeText, Required: true, DefaultValue: []any{"abc"}, Text: &Text{MaxLength: lo.ToPtr(10), Pattern: lo.ToPtr("^[a-z]+$")}},
+				},
+			},
+			{
+				Key:         "building",
+				Name:        "Building",
+				Description: "desc",
+				Fields: []*Field{
+					{Key: "kind", Name: "Kind", Type: value.TypeSelect, Select: &Select{Values: []string{"a", "b"}}},
+					{Key: "floors", Name: "Floors", Type: value.TypeInteger, Integer: &Integer{Min: lo.ToPtr(int64(1))}, RequiredIf: &RequiredIf{Field: "kind", Values: []string{"a"}}},
+					{Key: "city", Name: "City", Type: value.TypeReference, Reference: &Reference{Model: "city", OnDelete: "nullify"}},
+					{Key: "file", Name: "File", Type: value.TypeAsset, Multiple: true},
+				},
+			},
+		},
+	}, got)
+}
+
+func TestParse(t *testing.T) {
+	models, schemas := testModels()
+	d := New(models, schemas)
+
+	for _, f := range []Format{FormatJSON, FormatYAML} {
+		f := f
+		t.Run(string(f), func(t *testing.T) {
+			data, err := d.Marshal(f)
+			assert.NoError(t, err)
+
+			got, err := Parse(data)
+			assert.NoError(t, err)
+			assert.Empty(t, Diff(d, got))
+		})
+	}
+
+	_, err := d.Marshal("xml")
+	assert.Equal(t, ErrUnsupportedFormat, err)
+
+	_, err = Parse([]byte(`{"version": 2, "models": []}`))
+	assert.Equal(t, ErrUnsupportedVersion, err)
+
+	_, err = Parse([]byte("version: 1\nmodels:\n  - key: a\n  - key: a\n"))
+	assert.True(t, rerror.Is(err, ErrInvalidDefinition))
+
+	_, err = Parse([]byte("version: 1\nmodels:\n  - key: aaa\n    fields:\n      - key: bbb\n        type: text\n        requiredIf:\n          field: ccc\n"))
+	assert.True(t, rerror.Is(err, ErrInvalidDefinition))
+
+	_, err = Parse([]byte("{"))
+	assert.True(t, rerror.Is(err, ErrInvalidDefinition))
+}
+
+func TestModel_SchemaFields(t *testing.T) {
+	models, schemas := testModels()
+	d := New(models, schemas)
+	mids := map[string]id.ModelID{"city": id.NewModelID()}
+
+	// a new schema
+	got, err := d.Model("building").SchemaFields(nil, mids)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"kind", "floors", "city", "file"}, lo.Map(got, func(f *schema.Field, _ int) string { return f.Key().String() }))
+	assert.Equal(t, got[0].ID(), got[1].RequiredIf().Field())
+	assert.Equal(t, value.TypeReference, got[2].Type())
+
+	// fields of the same key and type are reused
+	existing := schemas[1].Fields()
+	d.Model("building").Field("kind").Type = value.TypeText
+	d.Model("building").Field("kind").Select = nil
+	got, err = d.Model("building").SchemaFields(existing, mids)
+	assert.NoError(t, err)
+	assert.NotEqual(t, existing[0].ID(), got[0].ID())
+	assert.Equal(t, existing[1].ID(), got[1].ID())
+	assert.Equal(t, existing[3].ID(), got[3].ID())
+
+	// unknown models cannot be referred
+	_, err = d.Model("building").SchemaFields(nil, nil)
+	assert.True(t, rerror.Is(err, ErrInvalidDefinition))
+}
+
+func TestDiff(t *testing.T) {
+	models, schemas := testModels()
+	current := New(models, schemas)
+	target := New(models, schemas)
+
+	assert.Empty(t, Diff(current, target))
+
+	target.Models[0].Name = "City2"
+	target.Models[0].Fields = append(target.Models[0].Fields, &Field{Key: "code", Type: value.TypeText})
+	target.Models[1].Fields = []*Field{
+		{Key: "floors", Name: "Floors", Type: value.TypeNumber},
+		target.Models[1].Fields[0],
+		target.Models[1].Fields[2],
+	}
+	target.Models = append(target.Models, &Model{Key: "road", Fields: []*Field{{Key: "name", Type: value.TypeText}}})
+
+	got := Diff(current, target)
+	assert.Equal(t, Changes{
+		{Type: ChangeTypeUpdate, Model: "city"},
+		{Type: ChangeTypeAdd, Model: "city", Field: "code"},
+		{Type: ChangeTypeUpdate, Model: "building", Field: "floors", Destructive: true},
+		{Type: ChangeTypeUpdate, Model: "building", Field: "kind"},
+		{Type: ChangeTypeRemove, Model: "building", Field: "file", Destructive: true},
+		{Type: ChangeTypeAdd, Model: "road"},
+		{Type: ChangeTypeAdd, Model: "road", Field: "name"},
+	}, got)
+	assert.True(t, got.HasDestructive())
+	assert.Equal(t, Changes{
+		{Type: ChangeTypeUpdate, Model: "building", Field: "floors", Destructive: true},
+	}, got.TypeChanges())
+
+	got = Diff(current, &Definition{Version: Version})
+	assert.Equal(t, Changes{
+		{Type: ChangeTypeRemove, Model: "city", Destructive: true},
+		{Type: ChangeTypeRemove, Model: "building", Destructive: true},
+	}, got)
+	assert.Empty(t, got.TypeChanges())
+}
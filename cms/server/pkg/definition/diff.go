@@ -0,0 +1,99 @@
+package definition
+
+import (
+	"encoding/json"
+
+	"github.com/samber/lo"
+)
+
+type ChangeType string
+
+const (
+	ChangeTypeAdd    ChangeType = "add"
+	ChangeTypeUpdate ChangeType = "update"
+	ChangeTypeRemove ChangeType = "remove"
+)
+
+// Change is a difference of a model or a field between two definitions. Field is empty when the change is about the model itself.
+// Destructive changes lose values of items: removals of models and their items, removals of fields, and changes of field types.
+type Change struct {
+	Type        ChangeType
+	Model       string
+	Field       string
+	Destructive bool
+}
+
+type Changes []Change
+
+func (c Changes) HasDestructive() bool {
+	return lo.SomeBy(c, func(c Change) bool { return c.Destructive })
+}
+
+// TypeChanges returns the changes of types of fields
+func (c Changes) TypeChanges() Changes {
+	return lo.Filter(c, func(c Change, _ int) bool { return c.TypeChanged() })
+}
+
+// TypeChanged returns whether the change is a change of the type of a field
+func (c Change) TypeChanged() bool {
+	return c.Type == ChangeTypeUpdate && c.Field != "" && c.Destructive
+}
+
+// Diff returns the changes needed to make the current definition the same as the target one
+func Diff(current, target *Definition) Changes {
+	var res Changes
+	for _, tm := range target.Models {
+		cm := current.Model(tm.Key)
+		if cm == nil {
+			res = append(res, Change{Type: ChangeTypeAdd, Model: tm.Key})
+			for _, f := range tm.Fields {
+				res = append(res, Change{Type: ChangeTypeAdd, Model: tm.Key, Field: f.Key})
+			}
+			continue
+		}
+
+		if cm.Name != tm.Name || cm.Description != tm.Description || cm.Public != tm.Public {
+			res = append(res, Change{Type: ChangeTypeUpdate, Model: tm.Key})
+		}
+		res = append(res, diffFields(tm.Key, cm.Fields, tm.Fields)...)
+	}
+
+	for _, cm := range current.Models {
+		if target.Model(cm.Key) == nil {
+			res = append(res, Change{Type: ChangeTypeRemove, Model: cm.Key, Destructive: true})
+		}
+	}
+	return res
+}
+
+func diffFields(model string, current, target []*Field) Changes {
+	var res Changes
+	for i, tf := range target {
+		cf, ci, _ := lo.FindIndexOf(current, func(f *Field) bool { return f.Key == tf.Key })
+		if cf == nil {
+			res = append(res, Change{Type: ChangeTypeAdd, Model: model, Field: tf.Key})
+			continue
+		}
+		if cf.Type != tf.Type {
+			res = append(res, Change{Type: ChangeTypeUpdate, Model: model, Field: tf.Key, Destructive: true})
+			continue
+		}
+		if ci != i || !equalField(cf, tf) {
+			res = append(res, Change{Type: ChangeTypeUpdate, Model: model, Field: tf.Key})
+		}
+	}
+
+	for _, cf := range current {
+		if findField(target, cf.Key) == nil {
+			res = append(res, Change{Type: ChangeTypeRemove, Model: model, Field: cf.Key, Destructive: true})
+		}
+	}
+	return res
+}
+
+// equalField compares fields by their JSON representation so that values decoded from different formats are compared equally
+func equalField(a, b *Field) bool {
+	ja, erra := json.Marshal(a)
+	jb, errb := json.Marshal(b)
+	return erra == nil && errb == nil && string(ja) == string(jb)
+}
@@ -0,0 +1,136 @@
+package definition
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+// New returns a definition of the models and their schemas. Models whose schema is not found are ignored.
+// Default values of asset and reference fields are not exported because they refer to IDs which are specific to the project.
+func New(models model.List, schemas schema.List) *Definition {
+	modelKeys := lo.SliceToMap(models, func(m *model.Model) (id.ModelID, string) {
+		return m.ID(), m.Key().String()
+	})
+
+	res := make([]*Model, 0, len(models))
+	for _, m := range models {
+		s, ok := lo.Find(schemas, func(s *schema.Schema) bool { return s.ID() == m.Schema() })
+		if !ok {
+			continue
+		}
+
+		res = append(res, &Model{
+			Key:         m.Key().String(),
+			Name:        m.Name(),
+			Description: m.Description(),
+			Public:      m.Public(),
+			Fields:      newFields(s.Fields(), modelKeys),
+		})
+	}
+
+	return &Definition{
+		Version: Version,
+		Models:  res,
+	}
+}
+
+func newFields(fields schema.FieldList, modelKeys map[id.ModelID]string) []*Field {
+	fieldKeys := lo.SliceToMap(fields, func(f *schema.Field) (id.FieldID, string) {
+		return f.ID(), f.Key().String()
+	})
+	return util.Map(fields.Ordered(), func(f *schema.Field) *Field {
+		return newField(f, fieldKeys, modelKeys)
+	})
+}
+
+func newField(f *schema.Field, fieldKeys map[id.FieldID]string, modelKeys map[id.ModelID]string) *Field {
+	res := &Field{
+		Key:         f.Key().String(),
+		Name:        f.Name(),
+		Description: f.Description(),
+		Type:        f.Type(),
+		Required:    f.Required(),
+		Unique:      f.Unique(),
+		Multiple:    f.Multiple(),
+		Localized:   f.Localized(),
+		MinItems:    f.MinItems(),
+		MaxItems:    f.MaxItems(),
+	}
+
+	if f.Type() != value.TypeAsset && f.Type() != value.TypeReference && !f.DefaultValue().IsEmpty() {
+		res.DefaultValue = f.DefaultValue().Interface()
+	}
+
+	if c := f.RequiredIf(); c != nil {
+		if k, ok := fieldKeys[c.Field()]; ok {
+			res.RequiredIf = &RequiredIf{
+				Field:  k,
+				Values: c.Values(),
+			}
+		}
+	}
+
+	text := func(maxLength *int, pattern *string) {
+		if maxLength != nil || pattern != nil {
+			res.Text = &Text{MaxLength: maxLength, Pattern: pattern}
+		}
+	}
+
+	f.TypeProperty().Match(schema.TypePropertyMatch{
+		Text: func(fp *schema.FieldText) {
+			text(fp.MaxLength(), fp.Pattern())
+		},
+		TextArea: func(fp *schema.FieldTextArea) {
+			text(fp.MaxLength(), fp.Pattern())
+		},
+		RichText: func(fp *schema.FieldRichText) {
+			text(fp.MaxLength(), fp.Pattern())
+		},
+		Markdown: func(fp *schema.FieldMarkdown) {
+			text(fp.MaxLength(), fp.Pattern())
+		},
+		Select: func(fp *schema.FieldSelect) {
+			res.Select = &Select{Values: fp.Values()}
+		},
+		Number: func(fp *schema.FieldNumber) {
+			if fp.Min() != nil || fp.Max() != nil {
+				res.Number = &Number{Min: fp.Min(), Max: fp.Max()}
+			}
+		},
+		Integer: func(fp *schema.FieldInteger) {
+			if fp.Min() != nil || fp.Max() != nil {
+				res.Integer = &Integer{Min: fp.Min(), Max: fp.Max()}
+			}
+		},
+		DateTime: func(fp *schema.FieldDateTime) {
+			if fp.Min() != nil || fp.Max() != nil {
+				res.DateTime = &DateTime{Min: fp.Min(), Max: fp.Max()}
+			}
+		},
+		Reference: func(fp *schema.FieldReference) {
+			res.Reference = &Reference{
+				Model:    modelKeys[fp.Model()],
+				OnDelete: string(fp.OnDelete()),
+			}
+		},
+		URL: func(fp *schema.FieldURL) {
+			if fp.Pattern() != nil {
+				res.URL = &URL{Pattern: fp.Pattern()}
+			}
+		},
+		Geometry: func(fp *schema.FieldGeometry) {
+			res.Geometry = &Geometry{
+				SupportedTypes: util.Map(fp.SupportedTypes(), func(t value.GeometryType) string { return string(t) }),
+			}
+		},
+		Group: func(fp *schema.FieldGroup) {
+			res.Group = &Group{Fields: newFields(fp.Fields(), modelKeys)}
+		},
+	})
+
+	return res
+}
@@ -0,0 +1,195 @@
+package definition
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+// SchemaFields builds fields of a schema from the model definition in order.
+// Existing fields which have the same key and type are reused so that values of items are kept.
+// models maps keys of models to their IDs in the destination project and is used to resolve reference fields.
+func (m *Model) SchemaFields(existing schema.FieldList, models map[string]id.ModelID) (schema.FieldList, error) {
+	return buildFields(m.Key, m.Fields, existing, models)
+}
+
+func buildFields(model string, defs []*Field, existing schema.FieldList, models map[string]id.ModelID) (schema.FieldList, error) {
+	res := make(schema.FieldList, 0, len(defs))
+	for i, fd := range defs {
+		ex, _ := lo.Find(existing, func(f *schema.Field) bool { return f.Key().String() == fd.Key && f.Type() == fd.Type })
+
+		tp, err := fd.typeProperty(model, ex, models)
+		if err != nil {
+			return nil, err
+		}
+
+		b := schema.NewField(tp).
+			Key(key.New(fd.Key)).
+			Name(fd.Name).
+			Description(fd.Description).
+			Required(fd.Required).
+			Unique(fd.Unique).
+			Multiple(fd.Multiple).
+			Localized(fd.Localized).
+			ItemCount(fd.MinItems, fd.MaxItems).
+			Order(i)
+		if ex != nil {
+			b = b.ID(ex.ID())
+		} else {
+			b = b.NewID()
+		}
+		if len(fd.DefaultValue) > 0 {
+			b = b.DefaultValue(value.NewMultiple(fd.Type, fd.DefaultValue))
+		}
+
+		f, err := b.Build()
+		if err != nil {
+			return nil, invalid("%s.%s: %v", model, fd.Key, err)
+		}
+		res = append(res, f)
+	}
+
+	// required conditions are resolved after all fields are built because they refer to other fields by keys
+	for i, fd := range defs {
+		if fd.RequiredIf == nil {
+			continue
+		}
+		cf, _ := lo.Find(res, func(f *schema.Field) bool { return f.Key().String() == fd.RequiredIf.Field })
+		if cf == nil {
+			return nil, invalid("field of the required condition is not found: %s.%s", model, fd.Key)
+		}
+		if err := res[i].SetRequiredIf(schema.NewRequiredCondition(cf.ID(), fd.RequiredIf.Values)); err != nil {
+			return nil, invalid("%s.%s: %v", model, fd.Key, err)
+		}
+	}
+
+	return res, nil
+}
+
+func (fd *Field) typeProperty(model string, existing *schema.Field, models map[string]id.ModelID) (*schema.TypeProperty, error) {
+	var maxLength *int
+	var pattern *string
+	if fd.Text != nil {
+		maxLength, pattern = fd.Text.MaxLength, fd.Text.Pattern
+	}
+
+	switch fd.Type {
+	case value.TypeText:
+		tp := schema.NewText(maxLength)
+		if err := tp.SetPattern(pattern); err != nil {
+			return nil, err
+		}
+		return tp.TypeProperty(), nil
+	case value.TypeTextArea:
+		tp := schema.NewTextArea(maxLength)
+		if err := tp.SetPattern(pattern); err != nil {
+			return nil, err
+		}
+		return tp.TypeProperty(), nil
+	case value.TypeRichText:
+		tp := schema.NewRichText(maxLength)
+		if err := tp.SetPattern(pattern); err != nil {
+			return nil, err
+		}
+		return tp.TypeProperty(), nil
+	case value.TypeMarkdown:
+		tp := schema.NewMarkdown(maxLength)
+		if err := tp.SetPattern(pattern); err != nil {
+			return nil, err
+		}
+		return tp.TypeProperty(), nil
+	case value.TypeAsset:
+		return schema.NewAsset().TypeProperty(), nil
+	case value.TypeBool:
+		return schema.NewBool().TypeProperty(), nil
+	case value.TypeSelect:
+		if fd.Select == nil {
+			return nil, invalid("values of the select field are missing: %s.%s", model, fd.Key)
+		}
+		return schema.NewSelect(fd.Select.Values).TypeProperty(), nil
+	case value.TypeNumber:
+		var min, max *float64
+		if fd.Number != nil {
+			min, max = fd.Number.Min, fd.Number.Max
+		}
+		tp, err := schema.NewNumber(min, max)
+		if err != nil {
+			return nil, err
+		}
+		return tp.TypeProperty(), nil
+	case value.TypeInteger:
+		var min, max *int64
+		if fd.Integer != nil {
+			min, max = fd.Integer.Min, fd.Integer.Max
+		}
+		tp, err := schema.NewInteger(min, max)
+		if err != nil {
+			return nil, err
+		}
+		return tp.TypeProperty(), nil
+	case value.TypeDateTime:
+		var min, max *time.Time
+		if fd.DateTime != nil {
+			min, max = fd.DateTime.Min, fd.DateTime.Max
+		}
+		tp, err := schema.NewDateTime(min, max)
+		if err != nil {
+			return nil, err
+		}
+		return tp.TypeProperty(), nil
+	case value.TypeReference:
+		if fd.Reference == nil {
+			return nil, invalid("model of the reference field is missing: %s.%s", model, fd.Key)
+		}
+		mid, ok := models[fd.Reference.Model]
+		if !ok {
+			return nil, invalid("referenced model is not found: %s", fd.Reference.Model)
+		}
+		tp := schema.NewReference(mid)
+		if err := tp.SetOnDelete(schema.ReferenceOnDelete(fd.Reference.OnDelete)); err != nil {
+			return nil, err
+		}
+		return tp.TypeProperty(), nil
+	case value.TypeURL:
+		tp := schema.NewURL()
+		if fd.URL != nil {
+			if err := tp.SetPattern(fd.URL.Pattern); err != nil {
+				return nil, err
+			}
+		}
+		return tp.TypeProperty(), nil
+	case value.TypeGeometry:
+		var types []value.GeometryType
+		if fd.Geometry != nil {
+			types = util.Map(fd.Geometry.SupportedTypes, func(t string) value.GeometryType { return value.GeometryType(t) })
+		}
+		return schema.NewGeometry(types).TypeProperty(), nil
+	case value.TypeGroup:
+		if fd.Group == nil {
+			return nil, invalid("fields of the group field are missing: %s.%s", model, fd.Key)
+		}
+		var existingFields schema.FieldList
+		if existing != nil {
+			existing.TypeProperty().Match(schema.TypePropertyMatch{
+				Group: func(g *schema.FieldGroup) {
+					existingFields = g.Fields()
+				},
+			})
+		}
+		fields, err := buildFields(model, fd.Group.Fields, existingFields, models)
+		if err != nil {
+			return nil, err
+		}
+		tp, err := schema.NewGroup(fields)
+		if err != nil {
+			return nil, err
+		}
+		return tp.TypeProperty(), nil
+	}
+	return nil, invalid("unsupported field type: %s.%s: %s", model, fd.Key, fd.Type)
+}
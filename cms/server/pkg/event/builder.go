@@ -51,6 +51,26 @@ func (b *Builder[T]) Project(prj *Project) *Builder[T] {
 	return b
 }
 
+func (b *Builder[T]) Workspace(ws *WorkspaceID) *Builder[T] {
+	b.i.workspace = ws.CloneRef()
+	return b
+}
+
+func (b *Builder[T]) Model(m *ModelID) *Builder[T] {
+	b.i.model = m.CloneRef()
+	return b
+}
+
+func (b *Builder[T]) Item(i *ItemID) *Builder[T] {
+	b.i.item = i.CloneRef()
+	return b
+}
+
+func (b *Builder[T]) Member(m *Member) *Builder[T] {
+	b.i.member = m.Clone()
+	return b
+}
+
 func (b *Builder[T]) Operator(o operator.Operator) *Builder[T] {
 	b.i.operator = o
 	return b
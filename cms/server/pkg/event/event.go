@@ -17,6 +17,21 @@ const (
 	AssetCreate     = "asset.create"
 	AssetDecompress = "asset.decompress"
 	AssetDelete     = "asset.delete"
+	RequestSubmit   = "request.submit"
+	RequestReview   = "request.review"
+	RequestApprove  = "request.approve"
+	RequestBlock    = "request.block"
+	RequestClose    = "request.close"
+	ModelCreate     = "model.create"
+	ModelUpdate     = "model.update"
+	ModelDelete     = "model.delete"
+	FieldCreate     = "schema.field.create"
+	FieldUpdate     = "schema.field.update"
+	FieldDelete     = "schema.field.delete"
+	MemberAdd       = "member.add"
+	MemberUpdate    = "member.update"
+	MemberRemove    = "member.remove"
+	CommentCreate   = "comment.create"
 )
 
 type Event[T any] struct {
@@ -25,6 +40,10 @@ type Event[T any] struct {
 	operator  operator.Operator
 	ty        Type
 	prj       *Project
+	workspace *WorkspaceID
+	model     *ModelID
+	item      *ItemID
+	member    *Member
 	object    T
 }
 
@@ -48,6 +67,26 @@ func (e *Event[T]) Project() *Project {
 	return e.prj.Clone()
 }
 
+// Workspace returns the workspace where the event occurred
+func (e *Event[T]) Workspace() *WorkspaceID {
+	return e.workspace.CloneRef()
+}
+
+// Model returns the model which the event is about
+func (e *Event[T]) Model() *ModelID {
+	return e.model.CloneRef()
+}
+
+// Item returns the item which the event is about
+func (e *Event[T]) Item() *ItemID {
+	return e.item.CloneRef()
+}
+
+// Member returns the workspace member affected by member events
+func (e *Event[T]) Member() *Member {
+	return e.member.Clone()
+}
+
 func (e *Event[T]) Object() any {
 	return e.object
 }
@@ -62,6 +101,10 @@ func (e *Event[T]) Clone() *Event[T] {
 		operator:  e.operator,
 		ty:        e.ty,
 		prj:       e.prj.Clone(),
+		workspace: e.workspace.CloneRef(),
+		model:     e.model.CloneRef(),
+		item:      e.item.CloneRef(),
+		member:    e.member.Clone(),
 		object:    e.object,
 	}
 }
@@ -80,3 +123,20 @@ func (p *Project) Clone() *Project {
 		Alias: p.Alias,
 	}
 }
+
+type Member struct {
+	User        *UserID
+	Integration *IntegrationID
+	Role        string
+}
+
+func (m *Member) Clone() *Member {
+	if m == nil {
+		return nil
+	}
+	return &Member{
+		User:        m.User.CloneRef(),
+		Integration: m.Integration.CloneRef(),
+		Role:        m.Role,
+	}
+}
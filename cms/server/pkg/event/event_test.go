@@ -29,3 +29,27 @@ func TestEvent(t *testing.T) {
 	assert.Equal(t, ev, ev.Clone())
 	assert.NotSame(t, ev, ev.Clone())
 }
+
+func TestEvent_AuditTargets(t *testing.T) {
+	wid := id.NewWorkspaceID()
+	mid := id.NewModelID()
+	iid := id.NewItemID()
+	uid := id.NewUserID()
+	m := &Member{User: &uid, Role: "writer"}
+
+	ev := New[any]().NewID().Type(MemberUpdate).Operator(operator.OperatorFromMachine()).
+		Workspace(&wid).Model(&mid).Item(&iid).Member(m).MustBuild()
+
+	assert.Equal(t, &wid, ev.Workspace())
+	assert.Equal(t, &mid, ev.Model())
+	assert.Equal(t, &iid, ev.Item())
+	assert.Equal(t, m, ev.Member())
+	assert.NotSame(t, m, ev.Member())
+	assert.Equal(t, ev, ev.Clone())
+
+	ev2 := New[any]().NewID().Type(ItemCreate).Operator(operator.OperatorFromMachine()).MustBuild()
+	assert.Nil(t, ev2.Workspace())
+	assert.Nil(t, ev2.Model())
+	assert.Nil(t, ev2.Item())
+	assert.Nil(t, ev2.Member())
+}
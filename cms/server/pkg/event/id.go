@@ -5,6 +5,9 @@ import "github.com/reearth/reearth-cms/server/pkg/id"
 type ID = id.EventID
 type UserID = id.UserID
 type IntegrationID = id.IntegrationID
+type WorkspaceID = id.WorkspaceID
+type ModelID = id.ModelID
+type ItemID = id.ItemID
 
 var NewID = id.NewEventID
 var MustID = id.MustEventID
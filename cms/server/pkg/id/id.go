@@ -168,6 +168,32 @@ var WebhookIDFrom = idx.From[Webhook]
 var WebhookIDFromRef = idx.FromRef[Webhook]
 var WebhookIDListFrom = idx.ListFrom[Webhook]
 
+type Role struct{}
+
+func (Role) Type() string { return "role" }
+
+type RoleID = idx.ID[Role]
+type RoleIDList = idx.List[Role]
+
+var MustRoleID = idx.Must[Role]
+var NewRoleID = idx.New[Role]
+var RoleIDFrom = idx.From[Role]
+var RoleIDFromRef = idx.FromRef[Role]
+var RoleIDListFrom = idx.ListFrom[Role]
+
+type WebhookDelivery struct{}
+
+func (WebhookDelivery) Type() string { return "webhookDelivery" }
+
+type WebhookDeliveryID = idx.ID[WebhookDelivery]
+type WebhookDeliveryIDList = idx.List[WebhookDelivery]
+
+var MustWebhookDeliveryID = idx.Must[WebhookDelivery]
+var NewWebhookDeliveryID = idx.New[WebhookDelivery]
+var WebhookDeliveryIDFrom = idx.From[WebhookDelivery]
+var WebhookDeliveryIDFromRef = idx.FromRef[WebhookDelivery]
+var WebhookDeliveryIDListFrom = idx.ListFrom[WebhookDelivery]
+
 type Task struct{}
 
 func (Task) Type() string { return "task" }
@@ -198,3 +224,15 @@ var NewRequestID = idx.New[Request]
 var MustRequestID = idx.Must[Request]
 var RequestIDFrom = idx.From[Request]
 var RequestIDFromRef = idx.FromRef[Request]
+
+type APIKey struct{}
+
+func (APIKey) Type() string { return "apiKey" }
+
+type APIKeyID = idx.ID[APIKey]
+type APIKeyIDList = idx.List[APIKey]
+
+var NewAPIKeyID = idx.New[APIKey]
+var MustAPIKeyID = idx.Must[APIKey]
+var APIKeyIDFrom = idx.From[APIKey]
+var APIKeyIDFromRef = idx.FromRef[APIKey]
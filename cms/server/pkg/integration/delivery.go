@@ -0,0 +1,244 @@
+package integration
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusSucceeded DeliveryStatus = "succeeded"
+	DeliveryStatusRetrying  DeliveryStatus = "retrying"
+	DeliveryStatusDead      DeliveryStatus = "dead"
+)
+
+func (s DeliveryStatus) IsFinished() bool {
+	return s == DeliveryStatusSucceeded || s == DeliveryStatusDead
+}
+
+// Delivery is a record of sending an event to a webhook. It keeps the request body so that the event can be redelivered later.
+type Delivery struct {
+	id            DeliveryID
+	integration   ID
+	webhook       WebhookID
+	event         id.EventID
+	eventType     event.Type
+	url           string
+	payload       string
+	status        DeliveryStatus
+	attempts      []*DeliveryAttempt
+	nextAttemptAt *time.Time
+	updatedAt     time.Time
+}
+
+type DeliveryList []*Delivery
+
+func (d *Delivery) ID() DeliveryID {
+	return d.id
+}
+
+func (d *Delivery) Integration() ID {
+	return d.integration
+}
+
+func (d *Delivery) Webhook() WebhookID {
+	return d.webhook
+}
+
+func (d *Delivery) Event() id.EventID {
+	return d.event
+}
+
+func (d *Delivery) EventType() event.Type {
+	return d.eventType
+}
+
+func (d *Delivery) URL() string {
+	return d.url
+}
+
+func (d *Delivery) Payload() string {
+	return d.payload
+}
+
+func (d *Delivery) Status() DeliveryStatus {
+	return d.status
+}
+
+func (d *Delivery) Attempts() []*DeliveryAttempt {
+	return d.attempts
+}
+
+func (d *Delivery) LastAttempt() *DeliveryAttempt {
+	if len(d.attempts) == 0 {
+		return nil
+	}
+	return d.attempts[len(d.attempts)-1]
+}
+
+// NextAttempt returns the number of the attempt which will be sent next
+func (d *Delivery) NextAttempt() int {
+	return len(d.attempts) + 1
+}
+
+// NextAttemptAt returns the time of the next retry. For pending deliveries, it is the deadline of the report of the result.
+func (d *Delivery) NextAttemptAt() *time.Time {
+	return util.CloneRef(d.nextAttemptAt)
+}
+
+func (d *Delivery) CreatedAt() time.Time {
+	return d.id.Timestamp()
+}
+
+func (d *Delivery) UpdatedAt() time.Time {
+	if d.updatedAt.IsZero() {
+		return d.id.Timestamp()
+	}
+	return d.updatedAt
+}
+
+// IsDue returns true when the delivery is waiting for a retry and its time has come,
+// or when the delivery is pending and its result has not been reported by the deadline
+func (d *Delivery) IsDue(t time.Time) bool {
+	return (d.status == DeliveryStatusRetrying || d.status == DeliveryStatusPending) && d.nextAttemptAt != nil && !d.nextAttemptAt.After(t)
+}
+
+// Record appends the result of an attempt and decides the next status according to the policy.
+// It returns false when the attempt is not the expected one, e.g. a duplicated report or a report for a finished delivery.
+func (d *Delivery) Record(a *DeliveryAttempt, attempt int, payload string, p RetryPolicy) bool {
+	if a == nil || d.status.IsFinished() || attempt != d.NextAttempt() {
+		return false
+	}
+
+	if d.payload == "" {
+		d.payload = payload
+	}
+	d.attempts = append(d.attempts, a)
+	d.updatedAt = a.At()
+	d.nextAttemptAt = nil
+
+	switch {
+	case a.Succeeded():
+		d.status = DeliveryStatusSucceeded
+	case len(d.attempts) >= p.MaxAttempts:
+		d.status = DeliveryStatusDead
+	default:
+		d.status = DeliveryStatusRetrying
+		d.nextAttemptAt = lo.ToPtr(a.At().Add(p.Backoff(len(d.attempts))))
+	}
+	return true
+}
+
+// Dispatch marks the delivery as sent to the task runner so that it is not retried twice.
+// The result has to be reported within the dispatch timeout of the policy, otherwise the delivery becomes due again.
+func (d *Delivery) Dispatch(p RetryPolicy) {
+	d.status = DeliveryStatusPending
+	d.updatedAt = util.Now()
+	d.nextAttemptAt = lo.ToPtr(d.updatedAt.Add(p.DispatchTimeout))
+}
+
+// Timeout records the pending attempt as failed since its result has not been reported, e.g. the task runner lost it.
+// A late report of the attempt is ignored after that.
+func (d *Delivery) Timeout(t time.Time, p RetryPolicy) bool {
+	if d.status != DeliveryStatusPending {
+		return false
+	}
+	return d.Record(NewDeliveryAttempt(t, 0, 0, "the result was not reported in time"), d.NextAttempt(), "", p)
+}
+
+// Kill moves the delivery to the dead-letter state without further attempts
+func (d *Delivery) Kill() {
+	d.status = DeliveryStatusDead
+	d.nextAttemptAt = nil
+	d.updatedAt = util.Now()
+}
+
+// Redeliver returns a new pending delivery which sends the same payload again
+func (d *Delivery) Redeliver() *Delivery {
+	return &Delivery{
+		id:          NewDeliveryID(),
+		integration: d.integration,
+		webhook:     d.webhook,
+		event:       d.event,
+		eventType:   d.eventType,
+		url:         d.url,
+		payload:     d.payload,
+		status:      DeliveryStatusPending,
+	}
+}
+
+type DeliveryAttempt struct {
+	at         time.Time
+	statusCode int
+	latency    time.Duration
+	err        string
+}
+
+func NewDeliveryAttempt(at time.Time, statusCode int, latency time.Duration, err string) *DeliveryAttempt {
+	return &DeliveryAttempt{
+		at:         at,
+		statusCode: statusCode,
+		latency:    latency,
+		err:        err,
+	}
+}
+
+func (a *DeliveryAttempt) At() time.Time {
+	return a.at
+}
+
+func (a *DeliveryAttempt) StatusCode() int {
+	return a.statusCode
+}
+
+func (a *DeliveryAttempt) Latency() time.Duration {
+	return a.latency
+}
+
+func (a *DeliveryAttempt) Error() string {
+	return a.err
+}
+
+func (a *DeliveryAttempt) Succeeded() bool {
+	return a.err == "" && a.statusCode >= 200 && a.statusCode < 300
+}
+
+// RetryPolicy decides when failed deliveries are retried. The interval doubles on each failure up to MaxInterval.
+// Dispatched deliveries whose results are not reported within DispatchTimeout are regarded as failed.
+type RetryPolicy struct {
+	MaxAttempts     int
+	Interval        time.Duration
+	MaxInterval     time.Duration
+	DispatchTimeout time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:     5,
+	Interval:        time.Minute,
+	MaxInterval:     time.Hour,
+	DispatchTimeout: 30 * time.Minute,
+}
+
+// Backoff returns the duration to wait after the n-th failed attempt
+func (p RetryPolicy) Backoff(n int) time.Duration {
+	if n < 1 {
+		n = 1
+	}
+	b := p.Interval
+	for i := 1; i < n; i++ {
+		b *= 2
+		if p.MaxInterval > 0 && b >= p.MaxInterval {
+			return p.MaxInterval
+		}
+	}
+	if p.MaxInterval > 0 && b > p.MaxInterval {
+		return p.MaxInterval
+	}
+	return b
+}
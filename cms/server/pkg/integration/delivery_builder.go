@@ -0,0 +1,94 @@
+package integration
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+)
+
+type DeliveryBuilder struct {
+	d *Delivery
+}
+
+func NewDelivery() *DeliveryBuilder {
+	return &DeliveryBuilder{d: &Delivery{}}
+}
+
+func (b *DeliveryBuilder) Build() (*Delivery, error) {
+	if b.d.id.IsNil() || b.d.integration.IsNil() || b.d.webhook.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if b.d.status == "" {
+		b.d.status = DeliveryStatusPending
+	}
+	return b.d, nil
+}
+
+func (b *DeliveryBuilder) MustBuild() *Delivery {
+	r, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (b *DeliveryBuilder) NewID() *DeliveryBuilder {
+	b.d.id = NewDeliveryID()
+	return b
+}
+
+func (b *DeliveryBuilder) ID(id DeliveryID) *DeliveryBuilder {
+	b.d.id = id
+	return b
+}
+
+func (b *DeliveryBuilder) Integration(iid ID) *DeliveryBuilder {
+	b.d.integration = iid
+	return b
+}
+
+func (b *DeliveryBuilder) Webhook(wid WebhookID) *DeliveryBuilder {
+	b.d.webhook = wid
+	return b
+}
+
+func (b *DeliveryBuilder) Event(eid id.EventID) *DeliveryBuilder {
+	b.d.event = eid
+	return b
+}
+
+func (b *DeliveryBuilder) EventType(t event.Type) *DeliveryBuilder {
+	b.d.eventType = t
+	return b
+}
+
+func (b *DeliveryBuilder) URL(url string) *DeliveryBuilder {
+	b.d.url = url
+	return b
+}
+
+func (b *DeliveryBuilder) Payload(payload string) *DeliveryBuilder {
+	b.d.payload = payload
+	return b
+}
+
+func (b *DeliveryBuilder) Status(status DeliveryStatus) *DeliveryBuilder {
+	b.d.status = status
+	return b
+}
+
+func (b *DeliveryBuilder) Attempts(attempts []*DeliveryAttempt) *DeliveryBuilder {
+	b.d.attempts = attempts
+	return b
+}
+
+func (b *DeliveryBuilder) NextAttemptAt(t *time.Time) *DeliveryBuilder {
+	b.d.nextAttemptAt = t
+	return b
+}
+
+func (b *DeliveryBuilder) UpdatedAt(t time.Time) *DeliveryBuilder {
+	b.d.updatedAt = t
+	return b
+}
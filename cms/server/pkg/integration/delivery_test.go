@@ -0,0 +1,113 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelivery_Record(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := RetryPolicy{MaxAttempts: 3, Interval: time.Minute, MaxInterval: time.Hour}
+	d := NewDelivery().NewID().Integration(NewID()).Webhook(NewWebhookID()).Event(id.NewEventID()).EventType(event.ItemCreate).URL("https://example.com").MustBuild()
+
+	assert.Equal(t, DeliveryStatusPending, d.Status())
+	assert.Equal(t, 1, d.NextAttempt())
+
+	// first attempt fails
+	assert.True(t, d.Record(NewDeliveryAttempt(now, 500, time.Second, ""), 1, "{}", p))
+	assert.Equal(t, DeliveryStatusRetrying, d.Status())
+	assert.Equal(t, "{}", d.Payload())
+	assert.Equal(t, now.Add(time.Minute), *d.NextAttemptAt())
+	assert.False(t, d.IsDue(now))
+	assert.True(t, d.IsDue(now.Add(time.Minute)))
+
+	// duplicated report is ignored
+	assert.False(t, d.Record(NewDeliveryAttempt(now, 500, time.Second, ""), 1, "{}", p))
+	assert.Equal(t, 1, len(d.Attempts()))
+
+	// second attempt fails
+	assert.True(t, d.Record(NewDeliveryAttempt(now, 0, 0, "timeout"), 2, "", p))
+	assert.Equal(t, DeliveryStatusRetrying, d.Status())
+	assert.Equal(t, now.Add(2*time.Minute), *d.NextAttemptAt())
+
+	// third attempt fails and the delivery goes to dead-letter
+	assert.True(t, d.Record(NewDeliveryAttempt(now, 404, time.Second, ""), 3, "", p))
+	assert.Equal(t, DeliveryStatusDead, d.Status())
+	assert.Nil(t, d.NextAttemptAt())
+	assert.False(t, d.Record(NewDeliveryAttempt(now, 200, time.Second, ""), 4, "", p))
+
+	// success
+	d2 := d.Redeliver()
+	assert.NotEqual(t, d.ID(), d2.ID())
+	assert.Equal(t, d.Webhook(), d2.Webhook())
+	assert.Equal(t, d.Payload(), d2.Payload())
+	assert.Equal(t, DeliveryStatusPending, d2.Status())
+	assert.Empty(t, d2.Attempts())
+	assert.True(t, d2.Record(NewDeliveryAttempt(now, 204, time.Second, ""), 1, "", p))
+	assert.Equal(t, DeliveryStatusSucceeded, d2.Status())
+	assert.Equal(t, 204, d2.LastAttempt().StatusCode())
+}
+
+func TestDelivery_Dispatch(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := NewDelivery().NewID().Integration(NewID()).Webhook(NewWebhookID()).Status(DeliveryStatusRetrying).NextAttemptAt(&now).MustBuild()
+	assert.True(t, d.IsDue(now))
+
+	p := RetryPolicy{MaxAttempts: 2, Interval: time.Minute, DispatchTimeout: 10 * time.Minute}
+	d.Dispatch(p)
+	assert.Equal(t, DeliveryStatusPending, d.Status())
+	assert.False(t, d.IsDue(now))
+	deadline := *d.NextAttemptAt()
+	assert.Equal(t, d.UpdatedAt().Add(10*time.Minute), deadline)
+	assert.True(t, d.IsDue(deadline))
+
+	// the result of the dispatched attempt is lost
+	assert.True(t, d.Timeout(deadline, p))
+	assert.Equal(t, DeliveryStatusRetrying, d.Status())
+	assert.Equal(t, 2, d.NextAttempt())
+	assert.False(t, d.LastAttempt().Succeeded())
+	assert.False(t, d.Timeout(deadline, p))
+	// a late report is ignored
+	assert.False(t, d.Record(NewDeliveryAttempt(deadline, 200, time.Second, ""), 1, "", p))
+
+	d.Dispatch(p)
+	assert.True(t, d.Timeout(deadline, p))
+	assert.Equal(t, DeliveryStatusDead, d.Status())
+	assert.False(t, d.IsDue(deadline.Add(time.Hour)))
+
+	d.Kill()
+	assert.Equal(t, DeliveryStatusDead, d.Status())
+}
+
+func TestDeliveryBuilder_Build(t *testing.T) {
+	_, err := NewDelivery().NewID().Build()
+	assert.Equal(t, ErrInvalidID, err)
+
+	d, err := NewDelivery().NewID().Integration(NewID()).Webhook(NewWebhookID()).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, DeliveryStatusPending, d.Status())
+	assert.Equal(t, d.ID().Timestamp(), d.UpdatedAt())
+}
+
+func TestDeliveryAttempt_Succeeded(t *testing.T) {
+	assert.True(t, NewDeliveryAttempt(time.Time{}, 200, 0, "").Succeeded())
+	assert.False(t, NewDeliveryAttempt(time.Time{}, 200, 0, "err").Succeeded())
+	assert.True(t, NewDeliveryAttempt(time.Time{}, 299, 0, "").Succeeded())
+	assert.False(t, NewDeliveryAttempt(time.Time{}, 300, 0, "").Succeeded())
+	assert.False(t, NewDeliveryAttempt(time.Time{}, 301, 0, "").Succeeded())
+	assert.False(t, NewDeliveryAttempt(time.Time{}, 0, 0, "").Succeeded())
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{Interval: time.Minute, MaxInterval: 10 * time.Minute}
+	assert.Equal(t, time.Minute, p.Backoff(0))
+	assert.Equal(t, time.Minute, p.Backoff(1))
+	assert.Equal(t, 2*time.Minute, p.Backoff(2))
+	assert.Equal(t, 8*time.Minute, p.Backoff(4))
+	assert.Equal(t, 10*time.Minute, p.Backoff(5))
+	assert.Equal(t, 10*time.Minute, p.Backoff(100))
+}
@@ -6,11 +6,14 @@ import (
 
 type ID = id.IntegrationID
 type WebhookID = id.WebhookID
+type DeliveryID = id.WebhookDeliveryID
 type UserID = id.UserID
 type ModelID = id.ModelID
 
 var NewID = id.NewIntegrationID
 var NewWebhookID = id.NewWebhookID
+var NewDeliveryID = id.NewWebhookDeliveryID
+var DeliveryIDFrom = id.WebhookDeliveryIDFrom
 var MustID = id.MustIntegrationID
 var IDFrom = id.IntegrationIDFrom
 var IDFromRef = id.IntegrationIDFromRef
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
@@ -91,6 +92,15 @@ func (i *Integration) Webhook(wId WebhookID) (*Webhook, bool) {
 	return lo.Find(i.webhooks, func(w *Webhook) bool { return w.id == wId })
 }
 
+func (i *Integration) ActiveWebhooks(ty event.Type) []*Webhook {
+	if i == nil {
+		return nil
+	}
+	return lo.Filter(i.webhooks, func(w *Webhook, _ int) bool {
+		return w.Trigger().IsActive(ty) && w.Active()
+	})
+}
+
 func (i *Integration) AddWebhook(w *Webhook) {
 	if w == nil {
 		return
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -1058,6 +1059,18 @@ func TestIntegration_Webhook(t *testing.T) {
 	}
 }
 
+func TestIntegration_ActiveWebhooks(t *testing.T) {
+	w1 := NewWebhookBuilder().NewID().Active(true).Trigger(WebhookTrigger{event.ItemCreate: true}).MustBuild()
+	w2 := NewWebhookBuilder().NewID().Active(false).Trigger(WebhookTrigger{event.ItemCreate: true}).MustBuild()
+	w3 := NewWebhookBuilder().NewID().Active(true).Trigger(WebhookTrigger{event.ItemUpdate: true}).MustBuild()
+	i := &Integration{webhooks: []*Webhook{w1, w2, w3}}
+
+	assert.Equal(t, []*Webhook{w1}, i.ActiveWebhooks(event.ItemCreate))
+	assert.Equal(t, []*Webhook{w3}, i.ActiveWebhooks(event.ItemUpdate))
+	assert.Empty(t, i.ActiveWebhooks(event.AssetCreate))
+	assert.Nil(t, (*Integration)(nil).ActiveWebhooks(event.ItemCreate))
+}
+
 func TestIntegration_AddWebhook(t *testing.T) {
 	wId := id.NewWebhookID()
 	now := time.Now()
@@ -23,8 +23,6 @@ func (l List) Clone() List {
 
 func (l List) ActiveWebhooks(ty event.Type) []*Webhook {
 	return lo.FlatMap(l, func(i *Integration, _ int) []*Webhook {
-		return lo.Filter(i.Webhooks(), func(w *Webhook, _ int) bool {
-			return w.Trigger().IsActive(ty) && w.Active()
-		})
+		return i.ActiveWebhooks(ty)
 	})
 }
@@ -1,6 +1,9 @@
 package integrationapi
 
 import (
+	"net/url"
+	"strings"
+
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/samber/lo"
 )
@@ -18,6 +21,11 @@ func NewAsset(a *asset.Asset, f *asset.File, url string, all bool) *Asset {
 		n = lo.ToPtr(fn)
 	}
 
+	var bbox *[]float64
+	if b := a.BBox(); len(b) > 0 {
+		bbox = &b
+	}
+
 	return &Asset{
 		Id:                      a.ID(),
 		ContentType:             ct,
@@ -29,7 +37,39 @@ func NewAsset(a *asset.Asset, f *asset.File, url string, all bool) *Asset {
 		Url:                     url,
 		File:                    ToAssetFile(f, all),
 		ArchiveExtractionStatus: ToAssetArchiveExtractionStatus(a.ArchiveExtractionStatus()),
+		CompressionStatus:       ToAssetCompressionStatus(a.CompressionStatus()),
+		CompressedUrl:           compressedURL(a, url),
+		Bbox:                    bbox,
+	}
+}
+
+func ToAssetCompressionStatus(s *asset.ArchiveExtractionStatus) *AssetCompressionStatus {
+	if ss := ToAssetArchiveExtractionStatus(s); ss != nil {
+		return lo.ToPtr(AssetCompressionStatus(*ss))
+	}
+	return nil
+}
+
+// compressedURL returns the URL of the zip archive generated from the extracted files, which is stored in the same directory as the asset file.
+// It returns nil until the compression is done.
+func compressedURL(a *asset.Asset, u string) *string {
+	s := a.CompressionStatus()
+	p := a.CompressedPath()
+	if s == nil || *s != asset.ArchiveExtractionStatusDone || p == "" {
+		return nil
+	}
+
+	// a signed URL is valid only for the asset itself
+	if strings.Contains(u, "?") {
+		return nil
+	}
+
+	i := strings.LastIndex(u, "/")
+	if i < 0 {
+		return nil
 	}
+	segs := lo.Map(strings.Split(p, "/"), func(s string, _ int) string { return url.PathEscape(s) })
+	return lo.ToPtr(u[:i+1] + strings.Join(segs, "/"))
 }
 
 func ToAssetArchiveExtractionStatus(s *asset.ArchiveExtractionStatus) *AssetArchiveExtractionStatus {
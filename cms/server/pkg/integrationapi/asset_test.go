@@ -47,3 +47,29 @@ func TestToAssetFile(t *testing.T) {
 	}
 	assert.Equal(t, e, a)
 }
+
+func TestNewAsset_Compressed(t *testing.T) {
+	a := asset.New().NewID().Project(asset.NewProjectID()).CreatedByUser(asset.NewUserID()).Thread(asset.NewThreadID()).NewUUID().Size(1).
+		CompressionStatus(lo.ToPtr(asset.ArchiveExtractionStatusInProgress)).
+		CompressedPath("xxx/a b.compressed.zip").
+		MustBuild()
+
+	got := NewAsset(a, nil, "https://example.com/assets/aa/bbb/xxx.zip", false)
+	assert.Equal(t, lo.ToPtr(AssetCompressionStatusInProgress), got.CompressionStatus)
+	assert.Nil(t, got.CompressedUrl)
+
+	a.UpdateCompressionStatus(lo.ToPtr(asset.ArchiveExtractionStatusDone))
+	got = NewAsset(a, nil, "https://example.com/assets/aa/bbb/xxx.zip", false)
+	assert.Equal(t, lo.ToPtr(AssetCompressionStatusDone), got.CompressionStatus)
+	assert.Equal(t, lo.ToPtr("https://example.com/assets/aa/bbb/xxx/a%20b.compressed.zip"), got.CompressedUrl)
+}
+
+func TestNewAsset_CompressedSigned(t *testing.T) {
+	a := asset.New().NewID().Project(asset.NewProjectID()).CreatedByUser(asset.NewUserID()).Thread(asset.NewThreadID()).NewUUID().Size(1).
+		CompressionStatus(lo.ToPtr(asset.ArchiveExtractionStatusDone)).
+		CompressedPath("xxx.compressed.zip").
+		MustBuild()
+
+	got := NewAsset(a, nil, "https://example.com/assets/aa/bbb/xxx.zip?expires=1&signature=xxx", false)
+	assert.Nil(t, got.CompressedUrl)
+}
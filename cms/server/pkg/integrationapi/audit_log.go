@@ -0,0 +1,31 @@
+package integrationapi
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/samber/lo"
+)
+
+func NewAuditLog(e *event.Event[any]) *AuditLog {
+	if e == nil {
+		return nil
+	}
+
+	var pid *id.ProjectID
+	if p := e.Project(); p != nil {
+		pid = id.ProjectIDFromRef(&p.ID)
+	}
+
+	o := e.Operator()
+	return &AuditLog{
+		Id:            e.ID().Ref(),
+		Type:          lo.ToPtr(string(e.Type())),
+		ProjectId:     pid,
+		ModelId:       e.Model(),
+		ItemId:        e.Item(),
+		UserId:        o.User(),
+		IntegrationId: o.Integration(),
+		Machine:       lo.ToPtr(o.Machine()),
+		CreatedAt:     lo.ToPtr(e.Timestamp()),
+	}
+}
@@ -25,6 +25,27 @@ func NewComment(c *thread.Comment) *Comment {
 		AuthorId:   &authorID,
 		AuthorType: &authorType,
 		Content:    lo.ToPtr(c.Content()),
+		Mentions:   NewCommentMentions(c.Mentions()),
 		CreatedAt:  lo.ToPtr(c.CreatedAt()),
 	}
 }
+
+func NewCommentMentions(m *thread.Mentions) *CommentMentions {
+	if m.IsEmpty() {
+		return nil
+	}
+	return &CommentMentions{
+		UserIds:        lo.ToPtr([]thread.UserID(m.Users.Clone())),
+		IntegrationIds: lo.ToPtr([]thread.IntegrationID(m.Integrations.Clone())),
+	}
+}
+
+func FromCommentMentions(m *CommentMentions) *thread.Mentions {
+	if m == nil {
+		return nil
+	}
+	return &thread.Mentions{
+		Users:        lo.FromPtr(m.UserIds),
+		Integrations: lo.FromPtr(m.IntegrationIds),
+	}
+}
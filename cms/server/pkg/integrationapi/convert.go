@@ -6,6 +6,8 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 )
@@ -24,11 +26,15 @@ func New(obj any, v string, urlResolver asset.URLResolver) (res any, err error)
 	case *asset.File:
 		res = ToAssetFile(o, true)
 	case *item.Item:
-		res = NewItem(o, nil, nil)
+		res = NewItem(o, nil, nil, "")
 	case item.Versioned:
-		res = NewVersionedItem(o, nil, nil)
+		res = NewVersionedItem(o, nil, nil, "")
 	case item.ItemModelSchema:
 		res = NewItemModelSchema(o, nil)
+	case *request.Request:
+		res = NewRequest(o)
+	case *thread.Comment:
+		res = NewComment(o)
 	// TODO: add later
 	// case *schema.Schema:
 	// case *project.Project:
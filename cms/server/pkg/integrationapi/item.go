@@ -2,14 +2,17 @@ package integrationapi
 
 import (
 	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
 
-func NewVersionedItem(ver item.Versioned, s *schema.Schema, assets *AssetContext) VersionedItem {
+// NewVersionedItem converts the item. Values of localized fields are resolved in the locale when lang is not empty.
+func NewVersionedItem(ver item.Versioned, s *schema.Schema, assets *AssetContext, lang string) VersionedItem {
 	ps := lo.Map(ver.Parents().Values(), func(v version.Version, _ int) types.UUID {
 		return types.UUID(v)
 	})
@@ -17,7 +20,7 @@ func NewVersionedItem(ver item.Versioned, s *schema.Schema, assets *AssetContext
 		return string(r)
 	})
 
-	ii := NewItem(ver.Value(), s, assets)
+	ii := NewItem(ver.Value(), s, assets, lang)
 	return VersionedItem{
 		Id:        ii.Id,
 		CreatedAt: ii.CreatedAt,
@@ -30,7 +33,8 @@ func NewVersionedItem(ver item.Versioned, s *schema.Schema, assets *AssetContext
 	}
 }
 
-func NewItem(i *item.Item, s *schema.Schema, assets *AssetContext) Item {
+// NewItem converts the item. Values of localized fields are resolved in the locale when lang is not empty.
+func NewItem(i *item.Item, s *schema.Schema, assets *AssetContext, lang string) Item {
 	fs := lo.FilterMap(i.Fields(), func(f *item.Field, _ int) (Field, bool) {
 		if s == nil {
 			return Field{}, false
@@ -40,11 +44,26 @@ func NewItem(i *item.Item, s *schema.Schema, assets *AssetContext) Item {
 			return Field{}, false
 		}
 
+		toInterface := func(m *value.Multiple) any {
+			if sf.Type() == value.TypeGroup {
+				return sf.ValueInterface(m, true)
+			}
+			return ToValues(m, sf.Multiple(), assets)
+		}
+
+		var localized *map[string]any
+		if l := f.Localized(); lang == "" && len(l) > 0 {
+			localized = lo.ToPtr(lo.MapValues(l, func(m *value.Multiple, _ string) any {
+				return toInterface(m)
+			}))
+		}
+
 		return Field{
-			Id:    f.FieldID().Ref(),
-			Type:  lo.ToPtr(ToValueType(f.Type())),
-			Value: lo.ToPtr(ToValues(f.Value(), sf.Multiple(), assets)),
-			Key:   util.ToPtrIfNotEmpty(sf.Key().String()),
+			Id:              f.FieldID().Ref(),
+			Type:            lo.ToPtr(ToValueType(f.Type())),
+			Value:           lo.ToPtr(toInterface(f.ValueIn(lang))),
+			Key:             util.ToPtrIfNotEmpty(sf.Key().String()),
+			LocalizedValues: localized,
 		}, true
 	})
 
@@ -56,3 +75,39 @@ func NewItem(i *item.Item, s *schema.Schema, assets *AssetContext) Item {
 		UpdatedAt: lo.ToPtr(i.Timestamp()),
 	}
 }
+
+func NewFieldDiff(d item.FieldDiff, s *schema.Schema) FieldDiff {
+	// the field may have been removed from the schema
+	sf := s.Field(d.Field)
+	toInterface := func(m *value.Multiple) *any {
+		if m == nil {
+			return nil
+		}
+		if sf == nil {
+			return lo.ToPtr[any](m.Interface())
+		}
+		if sf.Type() == value.TypeGroup {
+			return lo.ToPtr(sf.ValueInterface(m, true))
+		}
+		return lo.ToPtr(ToValues(m, sf.Multiple(), nil))
+	}
+
+	var key *string
+	if sf != nil {
+		key = util.ToPtrIfNotEmpty(sf.Key().String())
+	}
+
+	addedAssets, removedAssets := d.Assets()
+	addedRefs, removedRefs := d.References()
+	return FieldDiff{
+		Id:                d.Field.Ref(),
+		Key:               key,
+		Type:              lo.ToPtr(ToValueType(d.Type())),
+		OldValue:          toInterface(d.Old),
+		NewValue:          toInterface(d.New),
+		AddedAssets:       lo.ToPtr([]id.AssetID(addedAssets)),
+		RemovedAssets:     lo.ToPtr([]id.AssetID(removedAssets)),
+		AddedReferences:   lo.ToPtr([]id.ItemID(addedRefs)),
+		RemovedReferences: lo.ToPtr([]id.ItemID(removedRefs)),
+	}
+}
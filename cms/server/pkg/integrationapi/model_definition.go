@@ -0,0 +1,43 @@
+package integrationapi
+
+import (
+	"encoding/json"
+
+	"github.com/reearth/reearth-cms/server/pkg/definition"
+	"github.com/samber/lo"
+)
+
+func NewModelDefinition(d *definition.Definition) (ModelDefinition, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	var res ModelDefinition
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (d ModelDefinition) Definition() (*definition.Definition, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	return definition.Parse(data)
+}
+
+func NewModelDefinitionChanges(changes definition.Changes) []ModelDefinitionChange {
+	return lo.Map(changes, func(c definition.Change, _ int) ModelDefinitionChange {
+		var fieldKey *string
+		if c.Field != "" {
+			fieldKey = lo.ToPtr(c.Field)
+		}
+		return ModelDefinitionChange{
+			Type:        lo.ToPtr(ModelDefinitionChangeType(c.Type)),
+			ModelKey:    lo.ToPtr(c.Model),
+			FieldKey:    fieldKey,
+			Destructive: lo.ToPtr(c.Destructive),
+		}
+	})
+}
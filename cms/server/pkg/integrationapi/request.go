@@ -0,0 +1,28 @@
+package integrationapi
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+func NewRequest(r *request.Request) *Request {
+	if r == nil {
+		return nil
+	}
+
+	return &Request{
+		Id:           r.ID().Ref(),
+		ProjectId:    r.Project().Ref(),
+		Title:        lo.ToPtr(r.Title()),
+		Description:  util.ToPtrIfNotEmpty(r.Description()),
+		State:        lo.ToPtr(RequestState(r.State())),
+		CreatedById:  r.CreatedBy().Ref(),
+		ReviewersId:  lo.ToPtr([]id.UserID(r.Reviewers())),
+		ApprovedById: lo.ToPtr([]id.UserID(r.ApprovedBy())),
+		ItemIds:      lo.ToPtr([]id.ItemID(r.Items().IDs())),
+		CreatedAt:    lo.ToPtr(r.CreatedAt()),
+		UpdatedAt:    lo.ToPtr(r.UpdatedAt()),
+	}
+}
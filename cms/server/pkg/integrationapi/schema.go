@@ -18,7 +18,7 @@ type ItemModelSchema struct {
 
 func NewItemModelSchema(i item.ItemModelSchema, assets *AssetContext) ItemModelSchema {
 	return ItemModelSchema{
-		Item:   NewItem(i.Item, i.Schema, assets),
+		Item:   NewItem(i.Item, i.Schema, assets, ""),
 		Model:  NewModel(i.Model, time.Time{}),
 		Schema: NewSchema(i.Schema),
 	}
@@ -42,10 +42,11 @@ func NewModel(m *model.Model, lastModified time.Time) Model {
 func NewSchema(i *schema.Schema) Schema {
 	fs := lo.Map(i.Fields(), func(f *schema.Field, _ int) SchemaField {
 		return SchemaField{
-			Id:       f.ID().Ref(),
-			Type:     lo.ToPtr(ValueType(f.Type())),
-			Key:      lo.ToPtr(f.Key().String()),
-			Required: lo.ToPtr(f.Required()),
+			Id:        f.ID().Ref(),
+			Type:      lo.ToPtr(ValueType(f.Type())),
+			Key:       lo.ToPtr(f.Key().String()),
+			Required:  lo.ToPtr(f.Required()),
+			Localized: lo.ToPtr(f.Localized()),
 		}
 	})
 
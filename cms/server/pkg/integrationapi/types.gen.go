@@ -18,10 +18,18 @@ const (
 
 // Defines values for AssetArchiveExtractionStatus.
 const (
-	Done       AssetArchiveExtractionStatus = "done"
-	Failed     AssetArchiveExtractionStatus = "failed"
-	InProgress AssetArchiveExtractionStatus = "in_progress"
-	Pending    AssetArchiveExtractionStatus = "pending"
+	AssetArchiveExtractionStatusDone       AssetArchiveExtractionStatus = "done"
+	AssetArchiveExtractionStatusFailed     AssetArchiveExtractionStatus = "failed"
+	AssetArchiveExtractionStatusInProgress AssetArchiveExtractionStatus = "in_progress"
+	AssetArchiveExtractionStatusPending    AssetArchiveExtractionStatus = "pending"
+)
+
+// Defines values for AssetCompressionStatus.
+const (
+	AssetCompressionStatusDone       AssetCompressionStatus = "done"
+	AssetCompressionStatusFailed     AssetCompressionStatus = "failed"
+	AssetCompressionStatusInProgress AssetCompressionStatus = "in_progress"
+	AssetCompressionStatusPending    AssetCompressionStatus = "pending"
 )
 
 // Defines values for AssetPreviewType.
@@ -48,17 +56,35 @@ const (
 	User        CommentAuthorType = "user"
 )
 
+// Defines values for ModelDefinitionChangeType.
+const (
+	Add    ModelDefinitionChangeType = "add"
+	Remove ModelDefinitionChangeType = "remove"
+	Update ModelDefinitionChangeType = "update"
+)
+
 // Defines values for RefOrVersionRef.
 const (
 	RefOrVersionRefLatest RefOrVersionRef = "latest"
 	RefOrVersionRefPublic RefOrVersionRef = "public"
 )
 
+// Defines values for RequestState.
+const (
+	Approved RequestState = "approved"
+	Blocked  RequestState = "blocked"
+	Closed   RequestState = "closed"
+	Draft    RequestState = "draft"
+	Waiting  RequestState = "waiting"
+)
+
 // Defines values for ValueType.
 const (
 	ValueTypeAsset     ValueType = "asset"
 	ValueTypeBool      ValueType = "bool"
 	ValueTypeDate      ValueType = "date"
+	ValueTypeGeometry  ValueType = "geometry"
+	ValueTypeGroup     ValueType = "group"
 	ValueTypeInteger   ValueType = "integer"
 	ValueTypeMarkdown  ValueType = "markdown"
 	ValueTypeReference ValueType = "reference"
@@ -145,27 +171,54 @@ const (
 // Asset defines model for asset.
 type Asset struct {
 	ArchiveExtractionStatus *AssetArchiveExtractionStatus `json:"archiveExtractionStatus,omitempty"`
-	ContentType             *string                       `json:"contentType,omitempty"`
-	CreatedAt               time.Time                     `json:"createdAt"`
-	File                    *File                         `json:"file,omitempty"`
-	Id                      id.AssetID                    `json:"id"`
-	Name                    *string                       `json:"name,omitempty"`
-	PreviewType             *AssetPreviewType             `json:"previewType,omitempty"`
-	ProjectId               id.ProjectID                  `json:"projectId"`
-	TotalSize               *float32                      `json:"totalSize,omitempty"`
-	UpdatedAt               time.Time                     `json:"updatedAt"`
-	Url                     string                        `json:"url"`
+
+	// Bbox Bounding box of the geospatial data in the form of [west, south, east, north]
+	Bbox *[]float64 `json:"bbox,omitempty"`
+
+	// CompressedUrl URL of the zip file generated from the extracted files
+	CompressedUrl *string `json:"compressedUrl,omitempty"`
+
+	// CompressionStatus Status of the zip file generated from the extracted files
+	CompressionStatus *AssetCompressionStatus `json:"compressionStatus,omitempty"`
+	ContentType       *string                 `json:"contentType,omitempty"`
+	CreatedAt         time.Time               `json:"createdAt"`
+	File              *File                   `json:"file,omitempty"`
+	Id                id.AssetID              `json:"id"`
+	Name              *string                 `json:"name,omitempty"`
+	PreviewType       *AssetPreviewType       `json:"previewType,omitempty"`
+	ProjectId         id.ProjectID            `json:"projectId"`
+	TotalSize         *float32                `json:"totalSize,omitempty"`
+	UpdatedAt         time.Time               `json:"updatedAt"`
+
+	// Url URL of the asset. It is a signed URL which expires when assets of the project are not public.
+	Url string `json:"url"`
 }
 
 // AssetArchiveExtractionStatus defines model for Asset.ArchiveExtractionStatus.
 type AssetArchiveExtractionStatus string
 
+// AssetCompressionStatus Status of the zip file generated from the extracted files
+type AssetCompressionStatus string
+
 // AssetPreviewType defines model for Asset.PreviewType.
 type AssetPreviewType string
 
 // AssetEmbedding defines model for assetEmbedding.
 type AssetEmbedding string
 
+// AuditLog defines model for auditLog.
+type AuditLog struct {
+	CreatedAt     *time.Time        `json:"createdAt,omitempty"`
+	Id            *id.EventID       `json:"id,omitempty"`
+	IntegrationId *id.IntegrationID `json:"integrationId,omitempty"`
+	ItemId        *id.ItemID        `json:"itemId,omitempty"`
+	Machine       *bool             `json:"machine,omitempty"`
+	ModelId       *id.ModelID       `json:"modelId,omitempty"`
+	ProjectId     *id.ProjectID     `json:"projectId,omitempty"`
+	Type          *string           `json:"type,omitempty"`
+	UserId        *id.UserID        `json:"userId,omitempty"`
+}
+
 // Comment defines model for comment.
 type Comment struct {
 	AuthorId   *any               `json:"authorId,omitempty"`
@@ -173,17 +226,40 @@ type Comment struct {
 	Content    *string            `json:"content,omitempty"`
 	CreatedAt  *time.Time         `json:"createdAt,omitempty"`
 	Id         *id.CommentID      `json:"id,omitempty"`
+	Mentions   *CommentMentions   `json:"mentions,omitempty"`
 }
 
 // CommentAuthorType defines model for Comment.AuthorType.
 type CommentAuthorType string
 
+// CommentMentions defines model for commentMentions.
+type CommentMentions struct {
+	IntegrationIds *[]id.IntegrationID `json:"integrationIds,omitempty"`
+	UserIds        *[]id.UserID        `json:"userIds,omitempty"`
+}
+
 // Field defines model for field.
 type Field struct {
-	Id    *id.FieldID  `json:"id,omitempty"`
-	Key   *string      `json:"key,omitempty"`
-	Type  *ValueType   `json:"type,omitempty"`
-	Value *interface{} `json:"value,omitempty"`
+	Id  *id.FieldID `json:"id,omitempty"`
+	Key *string     `json:"key,omitempty"`
+
+	// LocalizedValues Values of a localized field keyed by locales. It is omitted when lang is specified.
+	LocalizedValues *map[string]interface{} `json:"localizedValues,omitempty"`
+	Type            *ValueType              `json:"type,omitempty"`
+	Value           *interface{}            `json:"value,omitempty"`
+}
+
+// FieldDiff defines model for fieldDiff.
+type FieldDiff struct {
+	AddedAssets       *[]id.AssetID `json:"addedAssets,omitempty"`
+	AddedReferences   *[]id.ItemID  `json:"addedReferences,omitempty"`
+	Id                *id.FieldID   `json:"id,omitempty"`
+	Key               *string       `json:"key,omitempty"`
+	NewValue          *interface{}  `json:"newValue,omitempty"`
+	OldValue          *interface{}  `json:"oldValue,omitempty"`
+	RemovedAssets     *[]id.AssetID `json:"removedAssets,omitempty"`
+	RemovedReferences *[]id.ItemID  `json:"removedReferences,omitempty"`
+	Type              *ValueType    `json:"type,omitempty"`
 }
 
 // File defines model for file.
@@ -218,6 +294,20 @@ type Model struct {
 	UpdatedAt    *time.Time    `json:"updatedAt,omitempty"`
 }
 
+// ModelDefinition Portable definition of models and schemas. See the version 1 format of the definition file.
+type ModelDefinition map[string]interface{}
+
+// ModelDefinitionChange defines model for modelDefinitionChange.
+type ModelDefinitionChange struct {
+	Destructive *bool                      `json:"destructive,omitempty"`
+	FieldKey    *string                    `json:"fieldKey,omitempty"`
+	ModelKey    *string                    `json:"modelKey,omitempty"`
+	Type        *ModelDefinitionChangeType `json:"type,omitempty"`
+}
+
+// ModelDefinitionChangeType defines model for ModelDefinitionChange.Type.
+type ModelDefinitionChangeType string
+
 // RefOrVersion defines model for refOrVersion.
 type RefOrVersion struct {
 	Ref     *RefOrVersionRef    `json:"ref,omitempty"`
@@ -227,6 +317,24 @@ type RefOrVersion struct {
 // RefOrVersionRef defines model for RefOrVersion.Ref.
 type RefOrVersionRef string
 
+// Request defines model for request.
+type Request struct {
+	ApprovedById *[]id.UserID  `json:"approvedById,omitempty"`
+	CreatedAt    *time.Time    `json:"createdAt,omitempty"`
+	CreatedById  *id.UserID    `json:"createdById,omitempty"`
+	Description  *string       `json:"description,omitempty"`
+	Id           *id.RequestID `json:"id,omitempty"`
+	ItemIds      *[]id.ItemID  `json:"itemIds,omitempty"`
+	ProjectId    *id.ProjectID `json:"projectId,omitempty"`
+	ReviewersId  *[]id.UserID  `json:"reviewersId,omitempty"`
+	State        *RequestState `json:"state,omitempty"`
+	Title        *string       `json:"title,omitempty"`
+	UpdatedAt    *time.Time    `json:"updatedAt,omitempty"`
+}
+
+// RequestState defines model for Request.State.
+type RequestState string
+
 // Schema defines model for schema.
 type Schema struct {
 	CreatedAt *time.Time     `json:"createdAt,omitempty"`
@@ -237,10 +345,11 @@ type Schema struct {
 
 // SchemaField defines model for schemaField.
 type SchemaField struct {
-	Id       *id.FieldID `json:"id,omitempty"`
-	Key      *string     `json:"key,omitempty"`
-	Required *bool       `json:"required,omitempty"`
-	Type     *ValueType  `json:"type,omitempty"`
+	Id        *id.FieldID `json:"id,omitempty"`
+	Key       *string     `json:"key,omitempty"`
+	Localized *bool       `json:"localized,omitempty"`
+	Required  *bool       `json:"required,omitempty"`
+	Type      *ValueType  `json:"type,omitempty"`
 }
 
 // ValueType defines model for valueType.
@@ -265,18 +374,57 @@ type VersionedItem struct {
 	Version   *openapi_types.UUID   `json:"version,omitempty"`
 }
 
+// WebhookDelivery defines model for webhookDelivery.
+type WebhookDelivery struct {
+	Attempts      *[]WebhookDeliveryAttempt `json:"attempts,omitempty"`
+	CreatedAt     *time.Time                `json:"createdAt,omitempty"`
+	EventId       *id.EventID               `json:"eventId,omitempty"`
+	EventType     *string                   `json:"eventType,omitempty"`
+	Id            *id.WebhookDeliveryID     `json:"id,omitempty"`
+	NextAttemptAt *time.Time                `json:"nextAttemptAt,omitempty"`
+	Payload       *string                   `json:"payload,omitempty"`
+
+	// Status one of pending, succeeded, retrying and dead
+	Status    *string       `json:"status,omitempty"`
+	UpdatedAt *time.Time    `json:"updatedAt,omitempty"`
+	Url       *string       `json:"url,omitempty"`
+	WebhookId *id.WebhookID `json:"webhookId,omitempty"`
+}
+
+// WebhookDeliveryAttempt defines model for webhookDeliveryAttempt.
+type WebhookDeliveryAttempt struct {
+	At    *time.Time `json:"at,omitempty"`
+	Error *string    `json:"error,omitempty"`
+
+	// Latency latency in milliseconds
+	Latency    *int `json:"latency,omitempty"`
+	StatusCode *int `json:"statusCode,omitempty"`
+}
+
 // AssetIdParam defines model for assetIdParam.
 type AssetIdParam = id.AssetID
 
 // AssetParam defines model for assetParam.
 type AssetParam = AssetEmbedding
 
+// BboxParam defines model for bboxParam.
+type BboxParam = string
+
 // CommentIdParam defines model for commentIdParam.
 type CommentIdParam = id.CommentID
 
+// DeliveryIdParam defines model for deliveryIdParam.
+type DeliveryIdParam = id.WebhookDeliveryID
+
+// IntersectsParam defines model for intersectsParam.
+type IntersectsParam = string
+
 // ItemIdParam defines model for itemIdParam.
 type ItemIdParam = id.ItemID
 
+// LangParam defines model for langParam.
+type LangParam = string
+
 // ModelIdOrKeyParam defines model for modelIdOrKeyParam.
 type ModelIdOrKeyParam = model.IDOrKey
 
@@ -304,9 +452,13 @@ type SortDirParam string
 // SortParam defines model for sortParam.
 type SortParam string
 
+// WebhookIdParam defines model for webhookIdParam.
+type WebhookIdParam = id.WebhookID
+
 // AssetCommentCreateJSONBody defines parameters for AssetCommentCreate.
 type AssetCommentCreateJSONBody struct {
-	Content *string `json:"content,omitempty"`
+	Content  *string          `json:"content,omitempty"`
+	Mentions *CommentMentions `json:"mentions,omitempty"`
 }
 
 // AssetCommentUpdateJSONBody defines parameters for AssetCommentUpdate.
@@ -314,6 +466,12 @@ type AssetCommentUpdateJSONBody struct {
 	Content *string `json:"content,omitempty"`
 }
 
+// AssetCompressJSONBody defines parameters for AssetCompress.
+type AssetCompressJSONBody struct {
+	// Path Path of the folder to compress. The root folder of the extracted files is compressed when omitted.
+	Path *string `json:"path"`
+}
+
 // ItemGetParams defines parameters for ItemGet.
 type ItemGetParams struct {
 	// Ref Used to select a ref or ver
@@ -321,6 +479,9 @@ type ItemGetParams struct {
 
 	// Asset Specifies whether asset data are embedded in the results
 	Asset *AssetParam `form:"asset,omitempty" json:"asset,omitempty"`
+
+	// Lang Returns values of localized fields in the locale such as "en". Values of the default locale are returned when the field has no value of the locale.
+	Lang *LangParam `form:"lang,omitempty" json:"lang,omitempty"`
 }
 
 // ItemGetParamsRef defines parameters for ItemGet.
@@ -334,7 +495,8 @@ type ItemUpdateJSONBody struct {
 
 // ItemCommentCreateJSONBody defines parameters for ItemCommentCreate.
 type ItemCommentCreateJSONBody struct {
-	Content *string `json:"content,omitempty"`
+	Content  *string          `json:"content,omitempty"`
+	Mentions *CommentMentions `json:"mentions,omitempty"`
 }
 
 // ItemCommentUpdateJSONBody defines parameters for ItemCommentUpdate.
@@ -342,6 +504,20 @@ type ItemCommentUpdateJSONBody struct {
 	Content *string `json:"content,omitempty"`
 }
 
+// ItemDiffParams defines parameters for ItemDiff.
+type ItemDiffParams struct {
+	// From The version to compare from
+	From openapi_types.UUID `form:"from" json:"from"`
+
+	// To The version to compare to. The latest version is used if omitted.
+	To *openapi_types.UUID `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ItemRollbackJSONBody defines parameters for ItemRollback.
+type ItemRollbackJSONBody struct {
+	Version openapi_types.UUID `json:"version"`
+}
+
 // ItemFilterParams defines parameters for ItemFilter.
 type ItemFilterParams struct {
 	// Sort Used to define the order of the response list
@@ -361,6 +537,15 @@ type ItemFilterParams struct {
 
 	// Asset Specifies whether asset data are embedded in the results
 	Asset *AssetParam `form:"asset,omitempty" json:"asset,omitempty"`
+
+	// Lang Returns values of localized fields in the locale such as "en". Values of the default locale are returned when the field has no value of the locale.
+	Lang *LangParam `form:"lang,omitempty" json:"lang,omitempty"`
+
+	// Bbox Returns only items whose geometries or assets overlap the bounding box in the form of "west,south,east,north"
+	Bbox *BboxParam `form:"bbox,omitempty" json:"bbox,omitempty"`
+
+	// Intersects Returns only items whose geometries or assets overlap the GeoJSON geometry
+	Intersects *IntersectsParam `form:"intersects,omitempty" json:"intersects,omitempty"`
 }
 
 // ItemFilterParamsSort defines parameters for ItemFilter.
@@ -396,6 +581,15 @@ type ItemFilterWithProjectParams struct {
 
 	// Asset Specifies whether asset data are embedded in the results
 	Asset *AssetParam `form:"asset,omitempty" json:"asset,omitempty"`
+
+	// Lang Returns values of localized fields in the locale such as "en". Values of the default locale are returned when the field has no value of the locale.
+	Lang *LangParam `form:"lang,omitempty" json:"lang,omitempty"`
+
+	// Bbox Returns only items whose geometries or assets overlap the bounding box in the form of "west,south,east,north"
+	Bbox *BboxParam `form:"bbox,omitempty" json:"bbox,omitempty"`
+
+	// Intersects Returns only items whose geometries or assets overlap the GeoJSON geometry
+	Intersects *IntersectsParam `form:"intersects,omitempty" json:"intersects,omitempty"`
 }
 
 // ItemFilterWithProjectParamsSort defines parameters for ItemFilterWithProject.
@@ -444,12 +638,60 @@ type AssetCreateMultipartBody struct {
 	File *openapi_types.File `json:"file,omitempty"`
 }
 
+// AuditLogListParams defines parameters for AuditLogList.
+type AuditLogListParams struct {
+	ModelId *id.ModelID `form:"modelId,omitempty" json:"modelId,omitempty"`
+	ItemId  *id.ItemID  `form:"itemId,omitempty" json:"itemId,omitempty"`
+
+	// UserId Returns only events operated by the user
+	UserId *id.UserID `form:"userId,omitempty" json:"userId,omitempty"`
+
+	// IntegrationId Returns only events operated by the integration
+	IntegrationId *id.IntegrationID `form:"integrationId,omitempty" json:"integrationId,omitempty"`
+
+	// Type Returns only events of the types such as "item.update"
+	Type *[]string `form:"type,omitempty" json:"type,omitempty"`
+
+	// From Returns only events which occurred at or after the time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Returns only events which occurred before the time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Page Used to select the page
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Used to select the page
+	PerPage *PerPageParam `form:"perPage,omitempty" json:"perPage,omitempty"`
+}
+
+// ModelDefinitionImportParams defines parameters for ModelDefinitionImport.
+type ModelDefinitionImportParams struct {
+	// DryRun Returns the changes without applying them
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// AllowDestructive Allows changes which lose values of items
+	AllowDestructive *bool `form:"allowDestructive,omitempty" json:"allowDestructive,omitempty"`
+}
+
+// WebhookDeliveryListParams defines parameters for WebhookDeliveryList.
+type WebhookDeliveryListParams struct {
+	// Page Used to select the page
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Used to select the page
+	PerPage *PerPageParam `form:"perPage,omitempty" json:"perPage,omitempty"`
+}
+
 // AssetCommentCreateJSONRequestBody defines body for AssetCommentCreate for application/json ContentType.
 type AssetCommentCreateJSONRequestBody AssetCommentCreateJSONBody
 
 // AssetCommentUpdateJSONRequestBody defines body for AssetCommentUpdate for application/json ContentType.
 type AssetCommentUpdateJSONRequestBody AssetCommentUpdateJSONBody
 
+// AssetCompressJSONRequestBody defines body for AssetCompress for application/json ContentType.
+type AssetCompressJSONRequestBody AssetCompressJSONBody
+
 // ItemUpdateJSONRequestBody defines body for ItemUpdate for application/json ContentType.
 type ItemUpdateJSONRequestBody ItemUpdateJSONBody
 
@@ -459,6 +701,9 @@ type ItemCommentCreateJSONRequestBody ItemCommentCreateJSONBody
 // ItemCommentUpdateJSONRequestBody defines body for ItemCommentUpdate for application/json ContentType.
 type ItemCommentUpdateJSONRequestBody ItemCommentUpdateJSONBody
 
+// ItemRollbackJSONRequestBody defines body for ItemRollback for application/json ContentType.
+type ItemRollbackJSONRequestBody ItemRollbackJSONBody
+
 // ItemCreateJSONRequestBody defines body for ItemCreate for application/json ContentType.
 type ItemCreateJSONRequestBody ItemCreateJSONBody
 
@@ -470,3 +715,6 @@ type AssetCreateJSONRequestBody AssetCreateJSONBody
 
 // AssetCreateMultipartRequestBody defines body for AssetCreate for multipart/form-data ContentType.
 type AssetCreateMultipartRequestBody AssetCreateMultipartBody
+
+// ModelDefinitionImportJSONRequestBody defines body for ModelDefinitionImport for application/json ContentType.
+type ModelDefinitionImportJSONRequestBody = ModelDefinition
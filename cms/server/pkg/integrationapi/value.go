@@ -33,6 +33,10 @@ func FromValueType(t *ValueType) value.Type {
 		return value.TypeReference
 	case ValueTypeUrl:
 		return value.TypeURL
+	case ValueTypeGeometry:
+		return value.TypeGeometry
+	case ValueTypeGroup:
+		return value.TypeGroup
 	default:
 		return value.TypeUnknown
 	}
@@ -62,6 +66,10 @@ func ToValueType(t value.Type) ValueType {
 		return ValueTypeReference
 	case value.TypeURL:
 		return ValueTypeUrl
+	case value.TypeGeometry:
+		return ValueTypeGeometry
+	case value.TypeGroup:
+		return ValueTypeGroup
 	default:
 		return ""
 	}
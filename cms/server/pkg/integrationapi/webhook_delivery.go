@@ -0,0 +1,36 @@
+package integrationapi
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+func NewWebhookDelivery(d *integration.Delivery) *WebhookDelivery {
+	if d == nil {
+		return nil
+	}
+
+	attempts := lo.Map(d.Attempts(), func(a *integration.DeliveryAttempt, _ int) WebhookDeliveryAttempt {
+		return WebhookDeliveryAttempt{
+			At:         lo.ToPtr(a.At()),
+			StatusCode: util.ToPtrIfNotEmpty(a.StatusCode()),
+			Latency:    lo.ToPtr(int(a.Latency().Milliseconds())),
+			Error:      util.ToPtrIfNotEmpty(a.Error()),
+		}
+	})
+
+	return &WebhookDelivery{
+		Id:            d.ID().Ref(),
+		WebhookId:     d.Webhook().Ref(),
+		EventId:       d.Event().Ref(),
+		EventType:     lo.ToPtr(string(d.EventType())),
+		Url:           lo.ToPtr(d.URL()),
+		Payload:       lo.ToPtr(d.Payload()),
+		Status:        lo.ToPtr(string(d.Status())),
+		Attempts:      &attempts,
+		NextAttemptAt: d.NextAttemptAt(),
+		CreatedAt:     lo.ToPtr(d.CreatedAt()),
+		UpdatedAt:     lo.ToPtr(d.UpdatedAt()),
+	}
+}
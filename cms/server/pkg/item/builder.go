@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
@@ -33,6 +34,9 @@ func (b *Builder) Build() (*Item, error) {
 	if b.i.thread.IsNil() {
 		return nil, ErrInvalidID
 	}
+	if err := ValidateSchedule(b.i.publishAt, b.i.unpublishAt); err != nil {
+		return nil, err
+	}
 	if b.i.timestamp.IsZero() {
 		b.i.timestamp = util.Now()
 	}
@@ -101,3 +105,23 @@ func (b *Builder) Timestamp(createdAt time.Time) *Builder {
 	b.i.timestamp = createdAt
 	return b
 }
+
+func (b *Builder) PublishAt(t *time.Time) *Builder {
+	b.i.publishAt = util.CloneRef(t)
+	return b
+}
+
+func (b *Builder) UnpublishAt(t *time.Time) *Builder {
+	b.i.unpublishAt = util.CloneRef(t)
+	return b
+}
+
+func (b *Builder) PublishVersion(v *version.Version) *Builder {
+	b.i.publishVersion = util.CloneRef(v)
+	return b
+}
+
+func (b *Builder) Group(group string) *Builder {
+	b.i.group = group
+	return b
+}
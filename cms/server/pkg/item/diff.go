@@ -0,0 +1,77 @@
+package item
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+// FieldDiff is a change of a field between two versions of an item.
+// Old is nil when the field is added, and New is nil when the field is removed.
+type FieldDiff struct {
+	Field FieldID
+	Old   *value.Multiple
+	New   *value.Multiple
+}
+
+func (d FieldDiff) Type() value.Type {
+	if d.New != nil {
+		return d.New.Type()
+	}
+	return d.Old.Type()
+}
+
+// Assets returns the assets which are linked and unlinked by the change including ones in groups
+func (d FieldDiff) Assets() (added, removed AssetIDList) {
+	toAsset := func(v *value.Value) (AssetID, bool) { return v.ValueAsset() }
+	return diffList(collect(d.Old, toAsset), collect(d.New, toAsset))
+}
+
+// References returns the items which are referenced and unreferenced by the change including ones in groups
+func (d FieldDiff) References() (added, removed id.ItemIDList) {
+	toRef := func(v *value.Value) (ID, bool) { return v.ValueReference() }
+	return diffList(collect(d.Old, toRef), collect(d.New, toRef))
+}
+
+// Diff returns the changes of fields from the item to the other item.
+// The changes are ordered by the fields of the item, followed by the fields only the other item has.
+func (i *Item) Diff(other *Item) []FieldDiff {
+	var res []FieldDiff
+	for _, f := range i.fields {
+		g := other.Field(f.FieldID())
+		if g == nil {
+			res = append(res, FieldDiff{Field: f.FieldID(), Old: f.Value()})
+		} else if !f.Equal(g) {
+			res = append(res, FieldDiff{Field: f.FieldID(), Old: f.Value(), New: g.Value()})
+		}
+	}
+	for _, g := range other.fields {
+		if i.Field(g.FieldID()) == nil {
+			res = append(res, FieldDiff{Field: g.FieldID(), New: g.Value()})
+		}
+	}
+	return res
+}
+
+func collect[T any](m *value.Multiple, f func(*value.Value) (T, bool)) []T {
+	return lo.FlatMap(m.Values(), func(v *value.Value, _ int) []T {
+		if t, ok := f(v); ok {
+			return []T{t}
+		}
+		if g, ok := v.ValueGroup(); ok {
+			keys := lo.Keys(g)
+			slices.SortFunc(keys, func(a, b FieldID) bool { return a.Compare(b) < 0 })
+			return lo.FlatMap(keys, func(k FieldID, _ int) []T {
+				return collect(g[k], f)
+			})
+		}
+		return nil
+	})
+}
+
+func diffList[T comparable](old, new []T) (added, removed []T) {
+	added = lo.Uniq(lo.Filter(new, func(t T, _ int) bool { return !lo.Contains(old, t) }))
+	removed = lo.Uniq(lo.Filter(old, func(t T, _ int) bool { return !lo.Contains(new, t) }))
+	return
+}
@@ -0,0 +1,62 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItem_Diff(t *testing.T) {
+	f1, f2, f3, f4 := id.NewFieldID(), id.NewFieldID(), id.NewFieldID(), id.NewFieldID()
+	a := value.TypeText.Value("a").AsMultiple()
+	b := value.TypeText.Value("b").AsMultiple()
+	c := value.TypeText.Value("c").AsMultiple()
+	old := &Item{fields: []*Field{NewField(f1, a), NewField(f2, b), NewField(f3, c)}}
+	new := &Item{fields: []*Field{NewField(f4, a), NewField(f2, b), NewField(f1, b)}}
+
+	assert.Equal(t, []FieldDiff{
+		{Field: f1, Old: a, New: b},
+		{Field: f3, Old: c},
+		{Field: f4, New: a},
+	}, old.Diff(new))
+	assert.Empty(t, old.Diff(old))
+}
+
+func TestFieldDiff_Type(t *testing.T) {
+	assert.Equal(t, value.TypeText, FieldDiff{Old: value.TypeText.Value("a").AsMultiple()}.Type())
+	assert.Equal(t, value.TypeAsset, FieldDiff{New: value.TypeAsset.Value(id.NewAssetID()).AsMultiple()}.Type())
+}
+
+func TestFieldDiff_Assets(t *testing.T) {
+	a1, a2, a3 := id.NewAssetID(), id.NewAssetID(), id.NewAssetID()
+	d := FieldDiff{
+		Old: value.NewMultiple(value.TypeAsset, []any{a1, a2}),
+		New: value.NewMultiple(value.TypeAsset, []any{a2, a3, a3}),
+	}
+	added, removed := d.Assets()
+	assert.Equal(t, AssetIDList{a3}, added)
+	assert.Equal(t, AssetIDList{a1}, removed)
+
+	// assets in groups
+	d = FieldDiff{
+		New: value.NewMultiple(value.TypeGroup, []any{
+			value.Group{id.NewFieldID(): value.NewMultiple(value.TypeAsset, []any{a1})},
+		}),
+	}
+	added, removed = d.Assets()
+	assert.Equal(t, AssetIDList{a1}, added)
+	assert.Empty(t, removed)
+}
+
+func TestFieldDiff_References(t *testing.T) {
+	i1, i2 := id.NewItemID(), id.NewItemID()
+	d := FieldDiff{
+		Old: value.TypeReference.Value(i1).AsMultiple(),
+		New: value.TypeReference.Value(i2).AsMultiple(),
+	}
+	added, removed := d.References()
+	assert.Equal(t, id.ItemIDList{i2}, added)
+	assert.Equal(t, id.ItemIDList{i1}, removed)
+}
@@ -0,0 +1,337 @@
+package item
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+	"github.com/xuri/excelize/v2"
+)
+
+type ExportFormat string
+
+const (
+	ExportFormatCSV     ExportFormat = "csv"
+	ExportFormatJSONL   ExportFormat = "jsonl"
+	ExportFormatGeoJSON ExportFormat = "geojson"
+	ExportFormatXLSX    ExportFormat = "xlsx"
+)
+
+// IDColumn is the column which the ID of an item is exported to
+const IDColumn = "id"
+
+var ErrInvalidExportFormat = errors.New("invalid export format")
+
+func ExportFormatFrom(s string) (ExportFormat, bool) {
+	f := ExportFormat(strings.ToLower(s))
+	switch f {
+	case ExportFormatCSV, ExportFormatJSONL, ExportFormatGeoJSON, ExportFormatXLSX:
+		return f, true
+	}
+	return "", false
+}
+
+// Ext returns the file extension of the format
+func (f ExportFormat) Ext() string {
+	return string(f)
+}
+
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportFormatCSV:
+		return "text/csv"
+	case ExportFormatJSONL:
+		return "application/jsonl"
+	case ExportFormatGeoJSON:
+		return "application/geo+json"
+	case ExportFormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return ""
+}
+
+// AssetURLResolver returns the URL of the asset. An empty string means that the asset is not found.
+type AssetURLResolver func(AssetID) string
+
+// ExportWriter writes items of a schema in an export format.
+// Fields are keyed by their keys, assets are written as their URLs and references are written as IDs of the referenced items.
+// Values of multiple fields are written as arrays, which are encoded in JSON in CSV and XLSX.
+type ExportWriter struct {
+	w        exportWriter
+	fields   schema.FieldList
+	assetURL AssetURLResolver
+}
+
+type exportWriter interface {
+	write(id string, values []any) error
+	close() error
+}
+
+func NewExportWriter(w io.Writer, f ExportFormat, s *schema.Schema, assetURL AssetURLResolver) (*ExportWriter, error) {
+	fields := s.Fields()
+	keys := lo.Map(fields, func(f *schema.Field, _ int) string { return f.Key().String() })
+
+	var ew exportWriter
+	switch f {
+	case ExportFormatCSV:
+		ew = newCSVExportWriter(w, keys)
+	case ExportFormatJSONL:
+		ew = &jsonlExportWriter{w: bufio.NewWriter(w), keys: keys}
+	case ExportFormatGeoJSON:
+		ew = newGeoJSONExportWriter(w, fields)
+	case ExportFormatXLSX:
+		ew = newXLSXExportWriter(w, keys)
+	default:
+		return nil, ErrInvalidExportFormat
+	}
+
+	return &ExportWriter{w: ew, fields: fields, assetURL: assetURL}, nil
+}
+
+func (e *ExportWriter) Write(i *Item) error {
+	values := lo.Map(e.fields, func(sf *schema.Field, _ int) any {
+		f := i.Field(sf.ID())
+		if f == nil || f.Type() != sf.Type() {
+			return nil
+		}
+		vs := lo.Map(f.Value().Values(), func(v *value.Value, _ int) any {
+			return e.exportValue(v)
+		})
+		if sf.Multiple() {
+			return vs
+		}
+		if len(vs) == 0 {
+			return nil
+		}
+		return vs[0]
+	})
+	return e.w.write(i.ID().String(), values)
+}
+
+// Close flushes the remaining data. It does not close the underlying writer.
+func (e *ExportWriter) Close() error {
+	return e.w.close()
+}
+
+func (e *ExportWriter) exportValue(v *value.Value) any {
+	if a, ok := v.ValueAsset(); ok && e.assetURL != nil {
+		if u := e.assetURL(a); u != "" {
+			return u
+		}
+	}
+	return v.Interface()
+}
+
+// exportCell converts a value to a cell of CSV and XLSX
+func exportCell(v any) any {
+	switch v.(type) {
+	case nil:
+		return ""
+	case string, bool, int64, float64:
+		return v
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+type csvExportWriter struct {
+	w      *csv.Writer
+	header []string
+	wrote  bool
+}
+
+func newCSVExportWriter(w io.Writer, keys []string) *csvExportWriter {
+	return &csvExportWriter{w: csv.NewWriter(w), header: append([]string{IDColumn}, keys...)}
+}
+
+func (c *csvExportWriter) write(id string, values []any) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	rec := make([]string, 0, len(values)+1)
+	rec = append(rec, id)
+	for _, v := range values {
+		rec = append(rec, fmt.Sprint(exportCell(v)))
+	}
+	return c.w.Write(rec)
+}
+
+func (c *csvExportWriter) writeHeader() error {
+	if c.wrote {
+		return nil
+	}
+	c.wrote = true
+	return c.w.Write(c.header)
+}
+
+func (c *csvExportWriter) close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type jsonlExportWriter struct {
+	w    *bufio.Writer
+	keys []string
+}
+
+func (j *jsonlExportWriter) write(id string, values []any) error {
+	obj := make(map[string]any, len(values)+1)
+	obj[IDColumn] = id
+	for i, v := range values {
+		obj[j.keys[i]] = v
+	}
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	if _, err := j.w.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (j *jsonlExportWriter) close() error {
+	return j.w.Flush()
+}
+
+// geoJSONExportWriter writes a feature collection. The first geometry field is used as the geometry of features and the other fields are written as properties.
+type geoJSONExportWriter struct {
+	w        *bufio.Writer
+	keys     []string
+	geometry int
+	count    int
+}
+
+func newGeoJSONExportWriter(w io.Writer, fields schema.FieldList) *geoJSONExportWriter {
+	_, geometry, _ := lo.FindIndexOf(fields, func(f *schema.Field) bool {
+		return f.Type() == value.TypeGeometry
+	})
+	return &geoJSONExportWriter{
+		w:        bufio.NewWriter(w),
+		keys:     lo.Map(fields, func(f *schema.Field, _ int) string { return f.Key().String() }),
+		geometry: geometry,
+	}
+}
+
+func (g *geoJSONExportWriter) write(id string, values []any) error {
+	var geometry any
+	properties := make(map[string]any, len(values))
+	for i, v := range values {
+		if i == g.geometry {
+			if vs, ok := v.([]any); ok {
+				// a feature has only one geometry
+				v = nil
+				if len(vs) > 0 {
+					v = vs[0]
+				}
+			}
+			geometry = v
+			continue
+		}
+		properties[g.keys[i]] = v
+	}
+
+	b, err := json.Marshal(map[string]any{
+		"type":       "Feature",
+		"id":         id,
+		"geometry":   geometry,
+		"properties": properties,
+	})
+	if err != nil {
+		return err
+	}
+
+	sep := ","
+	if g.count == 0 {
+		sep = `{"type":"FeatureCollection","features":[`
+	}
+	g.count++
+	if _, err := g.w.WriteString(sep); err != nil {
+		return err
+	}
+	_, err = g.w.Write(b)
+	return err
+}
+
+func (g *geoJSONExportWriter) close() error {
+	end := "]}"
+	if g.count == 0 {
+		end = `{"type":"FeatureCollection","features":[]}`
+	}
+	if _, err := g.w.WriteString(end); err != nil {
+		return err
+	}
+	return g.w.Flush()
+}
+
+const xlsxSheet = "Sheet1"
+
+type xlsxExportWriter struct {
+	w      io.Writer
+	f      *excelize.File
+	sw     *excelize.StreamWriter
+	header []string
+	row    int
+	err    error
+}
+
+func newXLSXExportWriter(w io.Writer, keys []string) *xlsxExportWriter {
+	f := excelize.NewFile()
+	sw, err := f.NewStreamWriter(xlsxSheet)
+	return &xlsxExportWriter{w: w, f: f, sw: sw, header: append([]string{IDColumn}, keys...), err: err}
+}
+
+func (x *xlsxExportWriter) write(id string, values []any) error {
+	if err := x.writeHeader(); err != nil {
+		return err
+	}
+	row := make([]any, 0, len(values)+1)
+	row = append(row, id)
+	for _, v := range values {
+		row = append(row, exportCell(v))
+	}
+	return x.writeRow(row)
+}
+
+func (x *xlsxExportWriter) writeHeader() error {
+	if x.err != nil {
+		return x.err
+	}
+	if x.row > 0 {
+		return nil
+	}
+	return x.writeRow(lo.ToAnySlice(x.header))
+}
+
+func (x *xlsxExportWriter) writeRow(row []any) error {
+	x.row++
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+	return x.sw.SetRow(cell, row)
+}
+
+func (x *xlsxExportWriter) close() error {
+	defer func() { _ = x.f.Close() }()
+	if err := x.writeHeader(); err != nil {
+		return err
+	}
+	if err := x.sw.Flush(); err != nil {
+		return err
+	}
+	return x.f.Write(x.w)
+}
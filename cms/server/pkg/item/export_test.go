@@ -0,0 +1,109 @@
+package item
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestExportFormatFrom(t *testing.T) {
+	f, ok := ExportFormatFrom("XLSX")
+	assert.True(t, ok)
+	assert.Equal(t, ExportFormatXLSX, f)
+	assert.Equal(t, "xlsx", f.Ext())
+
+	_, ok = ExportFormatFrom("xml")
+	assert.False(t, ok)
+}
+
+func TestExportWriter(t *testing.T) {
+	sfName := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("name")).MustBuild()
+	sfFiles := schema.NewField(schema.NewAsset().TypeProperty()).NewID().Key(id.NewKey("files")).Multiple(true).MustBuild()
+	sfGeo := schema.NewField(schema.NewGeometry(nil).TypeProperty()).NewID().Key(id.NewKey("location")).MustBuild()
+	s := schema.New().NewID().Workspace(id.NewWorkspaceID()).Project(id.NewProjectID()).Fields(schema.FieldList{sfName, sfFiles, sfGeo}).MustBuild()
+
+	aid := id.NewAssetID()
+	point := value.TypeGeometry.Value(map[string]any{"type": "Point", "coordinates": []any{139.0, 35.0}})
+	i1 := New().NewID().Schema(s.ID()).Model(id.NewModelID()).Project(s.Project()).Thread(id.NewThreadID()).Fields([]*Field{
+		NewField(sfName.ID(), value.TypeText.Value("Tokyo").AsMultiple()),
+		NewField(sfFiles.ID(), value.NewMultiple(value.TypeAsset, []any{aid, id.NewAssetID()})),
+		NewField(sfGeo.ID(), point.AsMultiple()),
+	}).MustBuild()
+	i2 := New().NewID().Schema(s.ID()).Model(id.NewModelID()).Project(s.Project()).Thread(id.NewThreadID()).Fields([]*Field{
+		NewField(sfName.ID(), value.TypeText.Value("Osaka").AsMultiple()),
+	}).MustBuild()
+
+	urls := func(a AssetID) string {
+		if a == aid {
+			return "https://example.com/a.png"
+		}
+		return ""
+	}
+	export := func(t *testing.T, f ExportFormat) []byte {
+		t.Helper()
+		buf := &bytes.Buffer{}
+		w, err := NewExportWriter(buf, f, s, urls)
+		assert.NoError(t, err)
+		assert.NoError(t, w.Write(i1))
+		assert.NoError(t, w.Write(i2))
+		assert.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+	files := `["https://example.com/a.png","` + i1.Field(sfFiles.ID()).Value().Values()[1].Interface().(string) + `"]`
+
+	t.Run("csv", func(t *testing.T) {
+		rows, err := ReadImportRows(bytes.NewReader(export(t, ExportFormatCSV)), ImportFormatCSV)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(rows))
+		assert.Equal(t, i1.ID().String(), rows[0]["id"])
+		assert.Equal(t, "Tokyo", rows[0]["name"])
+		assert.Equal(t, files, rows[0]["files"])
+		assert.True(t, strings.HasPrefix(rows[0]["location"].(string), `{"bbox":[139,35,139,35],"coordinates":[139,35],"type":"Point"}`))
+		assert.Equal(t, ImportRow{"id": i2.ID().String(), "name": "Osaka"}, rows[1])
+	})
+
+	t.Run("jsonl", func(t *testing.T) {
+		lines := strings.Split(strings.TrimSpace(string(export(t, ExportFormatJSONL))), "\n")
+		assert.Equal(t, 2, len(lines))
+		var obj map[string]any
+		assert.NoError(t, json.Unmarshal([]byte(lines[1]), &obj))
+		assert.Equal(t, map[string]any{"id": i2.ID().String(), "name": "Osaka", "files": nil, "location": nil}, obj)
+	})
+
+	t.Run("geojson", func(t *testing.T) {
+		rows, err := ReadImportRows(bytes.NewReader(export(t, ExportFormatGeoJSON)), ImportFormatGeoJSON)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(rows))
+		assert.Equal(t, "Point", rows[0]["geometry"].(map[string]any)["type"])
+		assert.Equal(t, "Tokyo", rows[0]["name"])
+		assert.NotContains(t, rows[0], "location")
+		assert.NotContains(t, rows[1], "geometry")
+
+		buf := &bytes.Buffer{}
+		w := lo.Must(NewExportWriter(buf, ExportFormatGeoJSON, s, nil))
+		assert.NoError(t, w.Close())
+		assert.Equal(t, `{"type":"FeatureCollection","features":[]}`, buf.String())
+	})
+
+	t.Run("xlsx", func(t *testing.T) {
+		f, err := excelize.OpenReader(bytes.NewReader(export(t, ExportFormatXLSX)))
+		assert.NoError(t, err)
+		rows, err := f.GetRows(xlsxSheet)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(rows))
+		assert.Equal(t, []string{"id", "name", "files", "location"}, rows[0])
+		assert.Equal(t, []string{i2.ID().String(), "Osaka"}, rows[2])
+		assert.Equal(t, files, rows[1][2])
+	})
+
+	_, err := NewExportWriter(&bytes.Buffer{}, "xml", s, nil)
+	assert.Same(t, ErrInvalidExportFormat, err)
+}
@@ -3,11 +3,16 @@ package item
 import (
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
 type Field struct {
 	field FieldID
 	value *value.Multiple
+	// localized holds values of a localized field keyed by locales. value is the value of the default locale.
+	localized map[string]*value.Multiple
 }
 
 func NewField(field FieldID, v *value.Multiple) *Field {
@@ -17,6 +22,21 @@ func NewField(field FieldID, v *value.Multiple) *Field {
 	return &Field{field: field, value: v}
 }
 
+// NewLocalizedField returns a field which has values per locale in addition to the value of the default locale
+func NewLocalizedField(field FieldID, v *value.Multiple, localized map[string]*value.Multiple) *Field {
+	f := NewField(field, v)
+	if f == nil {
+		return nil
+	}
+	f.localized = lo.PickBy(localized, func(_ string, m *value.Multiple) bool {
+		return m != nil && m.Type() == v.Type()
+	})
+	if len(f.localized) == 0 {
+		f.localized = nil
+	}
+	return f
+}
+
 func (f *Field) FieldID() schema.FieldID {
 	return f.field
 }
@@ -28,3 +48,43 @@ func (f *Field) Type() value.Type {
 func (f *Field) Value() *value.Multiple {
 	return f.value
 }
+
+// Localized returns the values of the field keyed by locales except the default locale
+func (f *Field) Localized() map[string]*value.Multiple {
+	if f.localized == nil {
+		return nil
+	}
+	return maps.Clone(f.localized)
+}
+
+// Locales returns the sorted locales which the field has values of
+func (f *Field) Locales() []string {
+	l := maps.Keys(f.localized)
+	slices.Sort(l)
+	return l
+}
+
+// ValueIn returns the value of the locale. It falls back to the value of the base language of the locale, and then to the value of the default locale.
+func (f *Field) ValueIn(locale string) *value.Multiple {
+	if locale == "" || len(f.localized) == 0 {
+		return f.value
+	}
+	if v, ok := f.localized[locale]; ok {
+		return v
+	}
+	if base := BaseLocale(locale); base != locale {
+		if v, ok := f.localized[base]; ok {
+			return v
+		}
+	}
+	return f.value
+}
+
+func (f *Field) Equal(g *Field) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+	return f.field == g.field && f.value.Equal(g.value) && maps.EqualFunc(f.localized, g.localized, func(v, w *value.Multiple) bool {
+		return v.Equal(w)
+	})
+}
@@ -16,3 +16,43 @@ func TestNewField(t *testing.T) {
 		value: value.TypeBool.Value(true).AsMultiple(),
 	}, NewField(f, value.TypeBool.Value(true).AsMultiple()))
 }
+
+func TestNewLocalizedField(t *testing.T) {
+	f := id.NewFieldID()
+	ja := value.TypeText.Value("東京").AsMultiple()
+	en := value.TypeText.Value("Tokyo").AsMultiple()
+	assert.Nil(t, NewLocalizedField(f, nil, nil))
+	assert.Equal(t, &Field{
+		field:     f,
+		value:     ja,
+		localized: map[string]*value.Multiple{"en": en},
+	}, NewLocalizedField(f, ja, map[string]*value.Multiple{"en": en, "fr": nil, "de": value.TypeBool.Value(true).AsMultiple()}))
+	assert.Equal(t, &Field{field: f, value: ja}, NewLocalizedField(f, ja, map[string]*value.Multiple{}))
+}
+
+func TestField_ValueIn(t *testing.T) {
+	ja := value.TypeText.Value("東京").AsMultiple()
+	en := value.TypeText.Value("Tokyo").AsMultiple()
+	enGB := value.TypeText.Value("Tokyo (GB)").AsMultiple()
+	f := NewLocalizedField(id.NewFieldID(), ja, map[string]*value.Multiple{"en": en, "en-GB": enGB})
+
+	assert.Equal(t, []string{"en", "en-GB"}, f.Locales())
+	assert.Equal(t, ja, f.ValueIn(""))
+	assert.Equal(t, en, f.ValueIn("en"))
+	assert.Equal(t, enGB, f.ValueIn("en-GB"))
+	assert.Equal(t, en, f.ValueIn("en-US"))
+	assert.Equal(t, ja, f.ValueIn("fr"))
+	assert.Equal(t, ja, NewField(id.NewFieldID(), ja).ValueIn("en"))
+}
+
+func TestField_Equal(t *testing.T) {
+	fid := id.NewFieldID()
+	ja := value.TypeText.Value("東京").AsMultiple()
+	en := value.TypeText.Value("Tokyo").AsMultiple()
+
+	assert.True(t, NewField(fid, ja).Equal(NewField(fid, ja)))
+	assert.True(t, NewLocalizedField(fid, ja, map[string]*value.Multiple{"en": en}).Equal(NewLocalizedField(fid, ja, map[string]*value.Multiple{"en": en})))
+	assert.False(t, NewField(fid, ja).Equal(NewLocalizedField(fid, ja, map[string]*value.Multiple{"en": en})))
+	assert.False(t, NewField(fid, ja).Equal(NewField(id.NewFieldID(), ja)))
+	assert.False(t, NewField(fid, ja).Equal(nil))
+}
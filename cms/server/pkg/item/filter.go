@@ -0,0 +1,261 @@
+package item
+
+import (
+	"strings"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+var ErrInvalidFilter = rerror.NewE(i18n.T("invalid filter"))
+
+type FilterOperator string
+
+const (
+	FilterOperatorEq     FilterOperator = "eq"
+	FilterOperatorNe     FilterOperator = "ne"
+	FilterOperatorGt     FilterOperator = "gt"
+	FilterOperatorGte    FilterOperator = "gte"
+	FilterOperatorLt     FilterOperator = "lt"
+	FilterOperatorLte    FilterOperator = "lte"
+	FilterOperatorIn     FilterOperator = "in"
+	FilterOperatorExists FilterOperator = "exists"
+)
+
+func FilterOperatorFrom(s string) FilterOperator {
+	switch o := FilterOperator(strings.ToLower(s)); o {
+	case FilterOperatorEq, FilterOperatorNe, FilterOperatorGt, FilterOperatorGte, FilterOperatorLt, FilterOperatorLte, FilterOperatorIn, FilterOperatorExists:
+		return o
+	case "":
+		return FilterOperatorEq
+	}
+	return ""
+}
+
+// IsRange returns true when the operator compares the order of values
+func (o FilterOperator) IsRange() bool {
+	return o == FilterOperatorGt || o == FilterOperatorGte || o == FilterOperatorLt || o == FilterOperatorLte
+}
+
+// FieldFilter is a condition on a value of a field of items
+type FieldFilter struct {
+	field    FieldID
+	operator FilterOperator
+	values   []*value.Value
+	exists   bool
+}
+
+// NewFieldFilter returns a filter which compares the field with the values.
+// "in" accepts one or more values and the other operators accept exactly one value.
+func NewFieldFilter(field FieldID, op FilterOperator, values ...*value.Value) (*FieldFilter, error) {
+	values = lo.Filter(values, func(v *value.Value, _ int) bool { return v != nil })
+
+	switch {
+	case op == FilterOperatorExists || op == "":
+		return nil, ErrInvalidFilter
+	case op == FilterOperatorIn && len(values) == 0:
+		return nil, ErrInvalidFilter
+	case op != FilterOperatorIn && len(values) != 1:
+		return nil, ErrInvalidFilter
+	case op.IsRange() && !isOrderedType(values[0].Type()):
+		return nil, ErrInvalidFilter
+	}
+
+	return &FieldFilter{
+		field:    field,
+		operator: op,
+		values:   values,
+	}, nil
+}
+
+// NewExistsFieldFilter returns a filter which checks whether the field has a value
+func NewExistsFieldFilter(field FieldID, exists bool) *FieldFilter {
+	return &FieldFilter{
+		field:    field,
+		operator: FilterOperatorExists,
+		exists:   exists,
+	}
+}
+
+func (f *FieldFilter) Field() FieldID {
+	return f.field
+}
+
+func (f *FieldFilter) Operator() FilterOperator {
+	return f.operator
+}
+
+func (f *FieldFilter) Values() []*value.Value {
+	return lo.Map(f.values, func(v *value.Value, _ int) *value.Value { return v.Clone() })
+}
+
+// Exists returns the expected existence of the value when the operator is "exists"
+func (f *FieldFilter) Exists() bool {
+	return f.exists
+}
+
+// Match returns true when the item satisfies the filter.
+// A field which has multiple values matches when any of the values satisfies the condition, in the same way as MongoDB.
+func (f *FieldFilter) Match(i *Item) bool {
+	if f == nil {
+		return true
+	}
+	if i == nil {
+		return false
+	}
+
+	var values []*value.Value
+	if fi := i.Field(f.field); fi != nil {
+		values = lo.Filter(fi.Value().Values(), func(v *value.Value, _ int) bool { return !v.IsEmpty() })
+	}
+
+	switch f.operator {
+	case FilterOperatorExists:
+		return (len(values) > 0) == f.exists
+	case FilterOperatorNe:
+		return !lo.SomeBy(values, func(v *value.Value) bool { return equalValue(v, f.values[0]) })
+	case FilterOperatorIn:
+		return lo.SomeBy(values, func(v *value.Value) bool {
+			return lo.SomeBy(f.values, func(w *value.Value) bool { return equalValue(v, w) })
+		})
+	}
+
+	return lo.SomeBy(values, func(v *value.Value) bool {
+		if f.operator == FilterOperatorEq {
+			return equalValue(v, f.values[0])
+		}
+
+		c, ok := CompareValue(v, f.values[0])
+		if !ok {
+			return false
+		}
+		switch f.operator {
+		case FilterOperatorGt:
+			return c > 0
+		case FilterOperatorGte:
+			return c >= 0
+		case FilterOperatorLt:
+			return c < 0
+		case FilterOperatorLte:
+			return c <= 0
+		}
+		return false
+	})
+}
+
+type FieldFilterList []*FieldFilter
+
+// Match returns true when the item satisfies all of the filters
+func (l FieldFilterList) Match(i *Item) bool {
+	return lo.EveryBy(l, func(f *FieldFilter) bool { return f.Match(i) })
+}
+
+// FieldSort is an order of items by a value of a field
+type FieldSort struct {
+	field     FieldID
+	direction Direction
+}
+
+func NewFieldSort(field FieldID, dir Direction) *FieldSort {
+	if dir == "" {
+		dir = AscDirection
+	}
+	return &FieldSort{field: field, direction: dir}
+}
+
+func (s *FieldSort) Field() FieldID {
+	return s.field
+}
+
+func (s *FieldSort) Direction() Direction {
+	return s.direction
+}
+
+// Sort returns a new list sorted by the first value of the field. Items without the value come first in ascending order.
+func (s *FieldSort) Sort(l VersionedList) VersionedList {
+	if s == nil {
+		return l
+	}
+
+	m := append(VersionedList{}, l...)
+	firstValue := func(v Versioned) *value.Value {
+		if f := v.Value().Field(s.field); f != nil {
+			return f.Value().First()
+		}
+		return nil
+	}
+	less := func(a, b Versioned) bool {
+		av, bv := firstValue(a), firstValue(b)
+		if av == nil || bv == nil {
+			return av == nil && bv != nil
+		}
+		c, _ := CompareValue(av, bv)
+		return c < 0
+	}
+
+	slices.SortStableFunc(m, func(a, b Versioned) bool {
+		if s.direction == DescDirection {
+			return less(b, a)
+		}
+		return less(a, b)
+	})
+	return m
+}
+
+// CompareValue compares two values of the same type. It returns false when they cannot be ordered.
+func CompareValue(a, b *value.Value) (int, bool) {
+	if a == nil || b == nil {
+		return 0, false
+	}
+
+	switch av := a.Value().(type) {
+	case value.Integer:
+		switch bv := b.Value().(type) {
+		case value.Integer:
+			return compare(av, bv), true
+		case value.Number:
+			return compare(float64(av), bv), true
+		}
+	case value.Number:
+		switch bv := b.Value().(type) {
+		case value.Integer:
+			return compare(av, float64(bv)), true
+		case value.Number:
+			return compare(av, bv), true
+		}
+	case time.Time:
+		if bv, ok := b.Value().(time.Time); ok {
+			return compare(av.UnixNano(), bv.UnixNano()), true
+		}
+	case string:
+		if bv, ok := b.Value().(string); ok {
+			return strings.Compare(av, bv), true
+		}
+	}
+	return 0, false
+}
+
+func equalValue(a, b *value.Value) bool {
+	if c, ok := CompareValue(a, b); ok {
+		return c == 0
+	}
+	return a.Equal(b)
+}
+
+func isOrderedType(t value.Type) bool {
+	return t == value.TypeInteger || t == value.TypeNumber || t == value.TypeDateTime
+}
+
+func compare[T int64 | float64](a, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
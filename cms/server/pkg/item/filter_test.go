@@ -0,0 +1,139 @@
+package item
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterOperatorFrom(t *testing.T) {
+	assert.Equal(t, FilterOperatorEq, FilterOperatorFrom(""))
+	assert.Equal(t, FilterOperatorGte, FilterOperatorFrom("GTE"))
+	assert.Equal(t, FilterOperatorExists, FilterOperatorFrom("exists"))
+	assert.Equal(t, FilterOperator(""), FilterOperatorFrom("xxx"))
+}
+
+func TestNewFieldFilter(t *testing.T) {
+	fid := id.NewFieldID()
+
+	f, err := NewFieldFilter(fid, FilterOperatorGte, value.TypeInteger.Value(1))
+	assert.NoError(t, err)
+	assert.Equal(t, fid, f.Field())
+	assert.Equal(t, FilterOperatorGte, f.Operator())
+	assert.Equal(t, []*value.Value{value.TypeInteger.Value(1)}, f.Values())
+
+	_, err = NewFieldFilter(fid, FilterOperatorIn, value.TypeSelect.Value("a"), value.TypeSelect.Value("b"))
+	assert.NoError(t, err)
+
+	_, err = NewFieldFilter(fid, FilterOperatorIn)
+	assert.Equal(t, ErrInvalidFilter, err)
+	_, err = NewFieldFilter(fid, FilterOperatorEq, value.TypeText.Value("a"), value.TypeText.Value("b"))
+	assert.Equal(t, ErrInvalidFilter, err)
+	_, err = NewFieldFilter(fid, FilterOperatorGt, value.TypeText.Value("a"))
+	assert.Equal(t, ErrInvalidFilter, err)
+	_, err = NewFieldFilter(fid, FilterOperatorExists, value.TypeBool.Value(true))
+	assert.Equal(t, ErrInvalidFilter, err)
+	_, err = NewFieldFilter(fid, "", value.TypeText.Value("a"))
+	assert.Equal(t, ErrInvalidFilter, err)
+}
+
+func TestFieldFilter_Match(t *testing.T) {
+	f1, f2, f3 := id.NewFieldID(), id.NewFieldID(), id.NewFieldID()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	i := New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(id.NewProjectID()).Thread(id.NewThreadID()).Fields([]*Field{
+		NewField(f1, value.NewMultiple(value.TypeInteger, []any{1, 10})),
+		NewField(f2, value.TypeSelect.Value("a").AsMultiple()),
+		NewField(f3, value.TypeDateTime.Value(now).AsMultiple()),
+	}).MustBuild()
+
+	tests := []struct {
+		name   string
+		filter *FieldFilter
+		want   bool
+	}{
+		{"eq", mustFilter(NewFieldFilter(f1, FilterOperatorEq, value.TypeInteger.Value(10))), true},
+		{"eq number", mustFilter(NewFieldFilter(f1, FilterOperatorEq, value.TypeNumber.Value(1.0))), true},
+		{"eq not match", mustFilter(NewFieldFilter(f1, FilterOperatorEq, value.TypeInteger.Value(2))), false},
+		{"ne", mustFilter(NewFieldFilter(f2, FilterOperatorNe, value.TypeSelect.Value("b"))), true},
+		{"ne not match", mustFilter(NewFieldFilter(f2, FilterOperatorNe, value.TypeSelect.Value("a"))), false},
+		{"gt", mustFilter(NewFieldFilter(f1, FilterOperatorGt, value.TypeInteger.Value(5))), true},
+		{"gt not match", mustFilter(NewFieldFilter(f1, FilterOperatorGt, value.TypeInteger.Value(10))), false},
+		{"gte", mustFilter(NewFieldFilter(f1, FilterOperatorGte, value.TypeInteger.Value(10))), true},
+		{"lt", mustFilter(NewFieldFilter(f1, FilterOperatorLt, value.TypeInteger.Value(1))), false},
+		{"lte", mustFilter(NewFieldFilter(f1, FilterOperatorLte, value.TypeInteger.Value(1))), true},
+		{"datetime", mustFilter(NewFieldFilter(f3, FilterOperatorLt, value.TypeDateTime.Value(now.Add(time.Hour)))), true},
+		{"in", mustFilter(NewFieldFilter(f2, FilterOperatorIn, value.TypeSelect.Value("b"), value.TypeSelect.Value("a"))), true},
+		{"in not match", mustFilter(NewFieldFilter(f2, FilterOperatorIn, value.TypeSelect.Value("b"))), false},
+		{"exists", NewExistsFieldFilter(f2, true), true},
+		{"not exists", NewExistsFieldFilter(id.NewFieldID(), false), true},
+		{"missing field", mustFilter(NewFieldFilter(id.NewFieldID(), FilterOperatorEq, value.TypeText.Value("a"))), false},
+		{"missing field ne", mustFilter(NewFieldFilter(id.NewFieldID(), FilterOperatorNe, value.TypeText.Value("a"))), true},
+		{"nil", nil, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, tc.filter.Match(i))
+		})
+	}
+
+	assert.True(t, FieldFilterList{NewExistsFieldFilter(f1, true), NewExistsFieldFilter(f2, true)}.Match(i))
+	assert.False(t, FieldFilterList{NewExistsFieldFilter(f1, true), NewExistsFieldFilter(f2, false)}.Match(i))
+}
+
+func TestFieldSort_Sort(t *testing.T) {
+	fid := id.NewFieldID()
+	newItem := func(v *value.Value) Versioned {
+		var fields []*Field
+		if v != nil {
+			fields = append(fields, NewField(fid, v.AsMultiple()))
+		}
+		i := New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(id.NewProjectID()).Thread(id.NewThreadID()).Fields(fields).MustBuild()
+		return version.NewValue(version.New(), nil, version.NewRefs(version.Latest), time.Time{}, i)
+	}
+
+	i1 := newItem(value.TypeInteger.Value(2))
+	i2 := newItem(nil)
+	i3 := newItem(value.TypeInteger.Value(1))
+	l := VersionedList{i1, i2, i3}
+
+	assert.Equal(t, VersionedList{i2, i3, i1}, NewFieldSort(fid, "").Sort(l))
+	assert.Equal(t, VersionedList{i1, i3, i2}, NewFieldSort(fid, DescDirection).Sort(l))
+	assert.Equal(t, VersionedList{i1, i2, i3}, l)
+	assert.Equal(t, l, (*FieldSort)(nil).Sort(l))
+}
+
+func TestCompareValue(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	c, ok := CompareValue(value.TypeInteger.Value(1), value.TypeNumber.Value(1.5))
+	assert.True(t, ok)
+	assert.Equal(t, -1, c)
+
+	c, ok = CompareValue(value.TypeDateTime.Value(now), value.TypeDateTime.Value(now))
+	assert.True(t, ok)
+	assert.Equal(t, 0, c)
+
+	c, ok = CompareValue(value.TypeText.Value("b"), value.TypeSelect.Value("a"))
+	assert.True(t, ok)
+	assert.Equal(t, 1, c)
+
+	_, ok = CompareValue(value.TypeBool.Value(true), value.TypeBool.Value(false))
+	assert.False(t, ok)
+
+	_, ok = CompareValue(nil, value.TypeBool.Value(false))
+	assert.False(t, ok)
+}
+
+func mustFilter(f *FieldFilter, err error) *FieldFilter {
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
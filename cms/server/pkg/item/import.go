@@ -0,0 +1,126 @@
+package item
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+)
+
+type ImportFormat string
+
+const (
+	ImportFormatCSV     ImportFormat = "csv"
+	ImportFormatJSON    ImportFormat = "json"
+	ImportFormatGeoJSON ImportFormat = "geojson"
+)
+
+// GeometryColumn is the column which the geometry of a GeoJSON feature is imported from
+const GeometryColumn = "geometry"
+
+var (
+	ErrInvalidImportFormat = errors.New("invalid import format")
+	ErrInvalidImportData   = errors.New("invalid import data")
+)
+
+func ImportFormatFrom(s string) (ImportFormat, bool) {
+	f := ImportFormat(strings.ToLower(s))
+	switch f {
+	case ImportFormatCSV, ImportFormatJSON, ImportFormatGeoJSON:
+		return f, true
+	}
+	return "", false
+}
+
+// ImportRow is a record to be imported as an item, keyed by the columns.
+// Values of CSV rows are strings and empty cells are omitted.
+type ImportRow map[string]any
+
+// ReadImportRows reads records from a CSV with a header row, a JSON array of objects, or a GeoJSON feature collection.
+func ReadImportRows(r io.Reader, f ImportFormat) ([]ImportRow, error) {
+	switch f {
+	case ImportFormatCSV:
+		return readCSVRows(r)
+	case ImportFormatJSON:
+		return readJSONRows(r)
+	case ImportFormatGeoJSON:
+		return readGeoJSONRows(r)
+	}
+	return nil, ErrInvalidImportFormat
+}
+
+func readCSVRows(r io.Reader) ([]ImportRow, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	cr := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(b, []byte("\ufeff"))))
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, errors.Join(ErrInvalidImportData, err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	rows := make([]ImportRow, 0, len(records)-1)
+	for _, rec := range records[1:] {
+		row := ImportRow{}
+		for i, c := range rec {
+			if i >= len(header) || header[i] == "" || c == "" {
+				continue
+			}
+			row[header[i]] = c
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func readJSONRows(r io.Reader) ([]ImportRow, error) {
+	var rows []ImportRow
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	if err := d.Decode(&rows); err != nil {
+		return nil, errors.Join(ErrInvalidImportData, err)
+	}
+	return rows, nil
+}
+
+func readGeoJSONRows(r io.Reader) ([]ImportRow, error) {
+	var fc struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Type       string         `json:"type"`
+			Geometry   map[string]any `json:"geometry"`
+			Properties map[string]any `json:"properties"`
+		} `json:"features"`
+	}
+
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	if err := d.Decode(&fc); err != nil {
+		return nil, errors.Join(ErrInvalidImportData, err)
+	}
+	if fc.Type != "FeatureCollection" {
+		return nil, ErrInvalidImportData
+	}
+
+	rows := make([]ImportRow, 0, len(fc.Features))
+	for _, f := range fc.Features {
+		row := ImportRow{}
+		for k, v := range f.Properties {
+			row[k] = v
+		}
+		if f.Geometry != nil {
+			row[GeometryColumn] = f.Geometry
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
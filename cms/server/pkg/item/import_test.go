@@ -0,0 +1,89 @@
+package item
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportFormatFrom(t *testing.T) {
+	f, ok := ImportFormatFrom("GeoJSON")
+	assert.True(t, ok)
+	assert.Equal(t, ImportFormatGeoJSON, f)
+
+	_, ok = ImportFormatFrom("xml")
+	assert.False(t, ok)
+}
+
+func TestReadImportRows(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  ImportFormat
+		input   string
+		want    []ImportRow
+		wantErr error
+	}{
+		{
+			name:   "csv",
+			format: ImportFormatCSV,
+			input:  "\ufeffname,pop,tags\nTokyo,100,\"[\"\"a\"\",\"\"b\"\"]\"\nOsaka,,\n",
+			want: []ImportRow{
+				{"name": "Tokyo", "pop": "100", "tags": `["a","b"]`},
+				{"name": "Osaka"},
+			},
+		},
+		{
+			name:   "json",
+			format: ImportFormatJSON,
+			input:  `[{"name":"Tokyo","pop":100}]`,
+			want: []ImportRow{
+				{"name": "Tokyo", "pop": json.Number("100")},
+			},
+		},
+		{
+			name:   "geojson",
+			format: ImportFormatGeoJSON,
+			input:  `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[139,35]},"properties":{"name":"Tokyo"}}]}`,
+			want: []ImportRow{
+				{
+					"name":     "Tokyo",
+					"geometry": map[string]any{"type": "Point", "coordinates": []any{json.Number("139"), json.Number("35")}},
+				},
+			},
+		},
+		{
+			name:    "invalid geojson",
+			format:  ImportFormatGeoJSON,
+			input:   `{"type":"Feature"}`,
+			wantErr: ErrInvalidImportData,
+		},
+		{
+			name:    "invalid json",
+			format:  ImportFormatJSON,
+			input:   `{`,
+			wantErr: ErrInvalidImportData,
+		},
+		{
+			name:    "invalid format",
+			format:  "xml",
+			input:   ``,
+			wantErr: ErrInvalidImportFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ReadImportRows(strings.NewReader(tt.input), tt.format)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -7,21 +7,29 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
 )
 
+var ErrInvalidSchedule = rerror.NewE(i18n.T("invalid schedule"))
+
 type Item struct {
-	id          ID
-	schema      SchemaID
-	model       ModelID
-	project     ProjectID
-	fields      []*Field
-	timestamp   time.Time
-	thread      ThreadID
-	user        *UserID
-	integration *IntegrationID
+	id             ID
+	schema         SchemaID
+	model          ModelID
+	project        ProjectID
+	fields         []*Field
+	timestamp      time.Time
+	thread         ThreadID
+	user           *UserID
+	integration    *IntegrationID
+	publishAt      *time.Time
+	unpublishAt    *time.Time
+	publishVersion *version.Version
+	group          string
 }
 
 type Versioned = *version.Value[*Item]
@@ -69,6 +77,74 @@ func (i *Item) Thread() ThreadID {
 	return i.thread
 }
 
+// Group returns the group which owns the item, such as a municipality. It is empty when the item is not owned by any group.
+func (i *Item) Group() string {
+	return i.group
+}
+
+func (i *Item) SetGroup(group string) {
+	i.group = group
+}
+
+// GroupOfField returns the value of the field as a group. It returns false when the field does not have a value convertible to a text.
+func (i *Item) GroupOfField(f FieldID) (string, bool) {
+	field := i.Field(f)
+	if field == nil || field.Value().IsEmpty() {
+		return "", false
+	}
+	return stringValue(field.Value().First())
+}
+
+// PublishAt returns the time when the item will be published automatically
+func (i *Item) PublishAt() *time.Time {
+	return util.CloneRef(i.publishAt)
+}
+
+// UnpublishAt returns the time when the item will be unpublished automatically
+func (i *Item) UnpublishAt() *time.Time {
+	return util.CloneRef(i.unpublishAt)
+}
+
+// PublishVersion returns the version which will be published automatically. It is nil when the latest version will be published.
+func (i *Item) PublishVersion() *version.Version {
+	return util.CloneRef(i.publishVersion)
+}
+
+// SetSchedule sets the times when the item will be published and unpublished automatically.
+// A nil time cancels the corresponding transition.
+func (i *Item) SetSchedule(publishAt, unpublishAt *time.Time) error {
+	if err := ValidateSchedule(publishAt, unpublishAt); err != nil {
+		return err
+	}
+	i.publishAt = util.CloneRef(publishAt)
+	i.unpublishAt = util.CloneRef(unpublishAt)
+	if publishAt == nil {
+		i.publishVersion = nil
+	}
+	return nil
+}
+
+// SetPublishVersion sets the version which will be published automatically, such as the version approved in a request.
+// A nil version publishes the latest version at the time.
+func (i *Item) SetPublishVersion(v *version.Version) {
+	i.publishVersion = util.CloneRef(v)
+}
+
+// ScheduleDue reports whether the scheduled publication and unpublication have come by the time
+func (i *Item) ScheduleDue(now time.Time) (publish bool, unpublish bool) {
+	publish = i.publishAt != nil && !i.publishAt.After(now)
+	unpublish = i.unpublishAt != nil && !i.unpublishAt.After(now)
+	return
+}
+
+// ValidateSchedule returns an error when the item would be unpublished before it is published
+func ValidateSchedule(publishAt, unpublishAt *time.Time) error {
+	if publishAt != nil && unpublishAt != nil && !unpublishAt.After(*publishAt) {
+		return ErrInvalidSchedule
+	}
+	return nil
+}
+
 func (i *Item) UpdateFields(fields []*Field) {
 	if fields == nil {
 		return
@@ -93,6 +169,12 @@ func (i *Item) UpdateFields(fields []*Field) {
 	i.timestamp = util.Now()
 }
 
+// RestoreFields replaces all fields of the item with the fields, which are usually ones of an old version of the item
+func (i *Item) RestoreFields(fields []*Field) {
+	i.fields = slices.Clone(fields)
+	i.timestamp = util.Now()
+}
+
 func (i *Item) FilterFields(list FieldIDList) *Item {
 	if i == nil || list == nil {
 		return nil
@@ -123,6 +205,29 @@ func (i *Item) AssetIDs() AssetIDList {
 	})
 }
 
+// Geometries returns all geometry values of the item including ones in groups
+func (i *Item) Geometries() []value.Geometry {
+	return lo.FlatMap(i.fields, func(f *Field, _ int) []value.Geometry {
+		return geometries(f.Value())
+	})
+}
+
+func geometries(m *value.Multiple) []value.Geometry {
+	return lo.FlatMap(m.Values(), func(v *value.Value, _ int) []value.Geometry {
+		if g, ok := v.ValueGeometry(); ok {
+			return []value.Geometry{g}
+		}
+		if g, ok := v.ValueGroup(); ok {
+			keys := lo.Keys(g)
+			slices.SortFunc(keys, func(a, b FieldID) bool { return a.Compare(b) < 0 })
+			return lo.FlatMap(keys, func(k FieldID, _ int) []value.Geometry {
+				return geometries(g[k])
+			})
+		}
+		return nil
+	})
+}
+
 type ItemModelSchema struct {
 	Item   *Item
 	Model  *model.Model
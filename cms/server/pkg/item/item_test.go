@@ -6,6 +6,7 @@ import (
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/util"
 	"github.com/stretchr/testify/assert"
 )
@@ -176,3 +177,83 @@ func TestItem_AssetIDs(t *testing.T) {
 		},
 	}).AssetIDs())
 }
+
+func TestItem_Geometries(t *testing.T) {
+	g1 := value.Geometry{Type: value.GeometryTypePoint, Coordinates: value.Position{1, 2}}
+	g2 := value.Geometry{Type: value.GeometryTypePoint, Coordinates: value.Position{3, 4}}
+	g3 := value.Geometry{Type: value.GeometryTypeLineString, Coordinates: []value.Position{{5, 6}, {7, 8}}}
+	assert.Equal(t, []value.Geometry{g1, g2, g3}, (&Item{
+		fields: []*Field{
+			{value: value.New(value.TypeGeometry, g1).AsMultiple()},
+			{value: value.New(value.TypeText, "aa").AsMultiple()},
+			{value: value.NewMultiple(value.TypeGroup, []any{
+				value.Group{id.NewFieldID(): value.NewMultiple(value.TypeGeometry, []any{g2, g3})},
+			})},
+		},
+	}).Geometries())
+}
+
+func TestItem_SetSchedule(t *testing.T) {
+	now := time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	i := &Item{}
+
+	assert.NoError(t, i.SetSchedule(&now, &later))
+	assert.Equal(t, &now, i.PublishAt())
+	assert.Equal(t, &later, i.UnpublishAt())
+
+	publish, unpublish := i.ScheduleDue(now)
+	assert.True(t, publish)
+	assert.False(t, unpublish)
+	publish, unpublish = i.ScheduleDue(later)
+	assert.True(t, publish)
+	assert.True(t, unpublish)
+
+	assert.Same(t, ErrInvalidSchedule, i.SetSchedule(&later, &now))
+	assert.Same(t, ErrInvalidSchedule, i.SetSchedule(&now, &now))
+	assert.Equal(t, &now, i.PublishAt())
+
+	v := version.New()
+	i.SetPublishVersion(&v)
+	assert.Equal(t, &v, i.PublishVersion())
+
+	// the version to be published is discarded with the publication
+	assert.NoError(t, i.SetSchedule(nil, &now))
+	assert.Nil(t, i.PublishAt())
+	assert.Nil(t, i.PublishVersion())
+	assert.Equal(t, &now, i.UnpublishAt())
+	publish, unpublish = i.ScheduleDue(now.Add(-time.Second))
+	assert.False(t, publish)
+	assert.False(t, unpublish)
+}
+
+func TestItem_RestoreFields(t *testing.T) {
+	now := time.Now()
+	defer util.MockNow(now)()
+	f1 := NewField(id.NewFieldID(), value.TypeText.Value("a").AsMultiple())
+	f2 := NewField(id.NewFieldID(), value.TypeText.Value("b").AsMultiple())
+	i := &Item{fields: []*Field{f1}}
+
+	i.RestoreFields([]*Field{f2})
+	assert.Equal(t, []*Field{f2}, i.Fields())
+	assert.Equal(t, now, i.Timestamp())
+}
+
+func TestItem_Group(t *testing.T) {
+	f1 := NewField(id.NewFieldID(), value.TypeText.Value("13101").AsMultiple())
+	f2 := NewField(id.NewFieldID(), value.TypeInteger.Value(int64(13102)).AsMultiple())
+	i := &Item{fields: []*Field{f1, f2}}
+
+	assert.Equal(t, "", i.Group())
+	i.SetGroup("13101")
+	assert.Equal(t, "13101", i.Group())
+
+	g, ok := i.GroupOfField(f1.FieldID())
+	assert.True(t, ok)
+	assert.Equal(t, "13101", g)
+	g, ok = i.GroupOfField(f2.FieldID())
+	assert.True(t, ok)
+	assert.Equal(t, "13102", g)
+	_, ok = i.GroupOfField(id.NewFieldID())
+	assert.False(t, ok)
+}
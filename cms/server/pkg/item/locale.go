@@ -0,0 +1,28 @@
+package item
+
+import (
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"golang.org/x/text/language"
+)
+
+var ErrInvalidLocale = rerror.NewE(i18n.T("invalid locale"))
+
+// NormalizeLocale returns the canonical form of the BCP 47 language tag such as "en" or "zh-Hant"
+func NormalizeLocale(l string) (string, error) {
+	t, err := language.Parse(l)
+	if err != nil || t == language.Und {
+		return "", ErrInvalidLocale
+	}
+	return t.String(), nil
+}
+
+// BaseLocale returns the base language of the locale such as "en" of "en-US"
+func BaseLocale(l string) string {
+	t, err := language.Parse(l)
+	if err != nil {
+		return l
+	}
+	b, _ := t.Base()
+	return b.String()
+}
@@ -0,0 +1,28 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	l, err := NormalizeLocale("en-us")
+	assert.NoError(t, err)
+	assert.Equal(t, "en-US", l)
+
+	l, err = NormalizeLocale("ja")
+	assert.NoError(t, err)
+	assert.Equal(t, "ja", l)
+
+	_, err = NormalizeLocale("")
+	assert.Equal(t, ErrInvalidLocale, err)
+	_, err = NormalizeLocale("!!")
+	assert.Equal(t, ErrInvalidLocale, err)
+}
+
+func TestBaseLocale(t *testing.T) {
+	assert.Equal(t, "en", BaseLocale("en-US"))
+	assert.Equal(t, "zh", BaseLocale("zh-Hant-TW"))
+	assert.Equal(t, "!!", BaseLocale("!!"))
+}
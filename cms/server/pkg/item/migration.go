@@ -0,0 +1,295 @@
+package item
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+// MigrationType is how values of fields are converted when the fields of a schema are migrated
+type MigrationType string
+
+const (
+	// MigrationTypeConvert converts values of a field into a field of another type or multiplicity
+	MigrationTypeConvert MigrationType = "convert"
+	// MigrationTypeSplit splits a value of a field into fields by the separator
+	MigrationTypeSplit MigrationType = "split"
+	// MigrationTypeMerge joins values of fields into a field with the separator
+	MigrationTypeMerge MigrationType = "merge"
+)
+
+var (
+	ErrInvalidMigration    = rerror.NewE(i18n.T("invalid field migration"))
+	ErrValueNotConvertible = rerror.NewE(i18n.T("value cannot be converted"))
+	ErrTooManyValues       = rerror.NewE(i18n.T("too many values to be converted"))
+)
+
+func MigrationTypeFrom(s string) (MigrationType, bool) {
+	switch t := MigrationType(s); t {
+	case MigrationTypeConvert, MigrationTypeSplit, MigrationTypeMerge:
+		return t, true
+	}
+	return "", false
+}
+
+// Migration replaces source fields of a schema with target fields and converts values of items from the sources to the targets.
+// Values of the sources are left in items so that they can be found in old versions of the items.
+type Migration struct {
+	typ       MigrationType
+	sources   schema.FieldList
+	targets   schema.FieldList
+	separator string
+}
+
+// MigrationError is an error of a value of an item which cannot be converted
+type MigrationError struct {
+	Field string
+	Err   error
+}
+
+func (e *MigrationError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Field, e.Err)
+}
+
+func (e *MigrationError) Unwrap() error {
+	return e.Err
+}
+
+func NewMigration(t MigrationType, sources, targets schema.FieldList, separator string) (*Migration, error) {
+	if len(sources) == 0 || len(targets) == 0 {
+		return nil, ErrInvalidMigration
+	}
+	fields := append(append(schema.FieldList{}, sources...), targets...)
+	if lo.SomeBy(fields, func(f *schema.Field) bool { return f == nil || f.Type() == value.TypeGroup }) {
+		return nil, ErrInvalidMigration
+	}
+	if len(lo.UniqBy(sources, func(f *schema.Field) schema.FieldID { return f.ID() })) != len(sources) {
+		return nil, ErrInvalidMigration
+	}
+
+	switch t {
+	case MigrationTypeConvert:
+		if len(sources) != 1 || len(targets) != 1 {
+			return nil, ErrInvalidMigration
+		}
+	case MigrationTypeSplit:
+		if len(sources) != 1 || len(targets) < 2 || separator == "" || sources[0].Multiple() {
+			return nil, ErrInvalidMigration
+		}
+	case MigrationTypeMerge:
+		if len(sources) < 2 || len(targets) != 1 || lo.SomeBy(sources, func(f *schema.Field) bool { return f.Multiple() }) {
+			return nil, ErrInvalidMigration
+		}
+	default:
+		return nil, ErrInvalidMigration
+	}
+
+	return &Migration{
+		typ:       t,
+		sources:   sources,
+		targets:   targets,
+		separator: separator,
+	}, nil
+}
+
+func (m *Migration) Type() MigrationType {
+	return m.typ
+}
+
+func (m *Migration) Sources() schema.FieldList {
+	return m.sources
+}
+
+func (m *Migration) Targets() schema.FieldList {
+	return m.targets
+}
+
+// Apply replaces the sources of the schema with the targets at the position of the first source
+func (m *Migration) Apply(s *schema.Schema) error {
+	for _, f := range m.sources {
+		if !s.HasField(f.ID()) {
+			return ErrInvalidMigration
+		}
+	}
+
+	isSource := func(f *schema.Field) bool {
+		return lo.SomeBy(m.sources, func(g *schema.Field) bool { return g.ID() == f.ID() })
+	}
+	for _, f := range s.Fields() {
+		// fields which depend on the sources lose their conditions
+		if c := f.RequiredIf(); c != nil && !isSource(f) && lo.SomeBy(m.sources, func(g *schema.Field) bool { return g.ID() == c.Field() }) {
+			return ErrInvalidMigration
+		}
+	}
+	for i, t := range m.targets {
+		if c := s.FieldByIDOrKey(nil, t.Key().Ref()); c != nil && !isSource(c) {
+			return schema.ErrInvalidKey
+		}
+		if lo.SomeBy(m.targets[:i], func(g *schema.Field) bool { return g.Key() == t.Key() || g.ID() == t.ID() }) {
+			return schema.ErrInvalidKey
+		}
+	}
+
+	var fields schema.FieldList
+	inserted := false
+	for _, f := range s.Fields() {
+		s.RemoveField(f.ID())
+		if !isSource(f) {
+			fields = append(fields, f)
+			continue
+		}
+		if !inserted {
+			fields = append(fields, lo.Map(m.targets, func(t *schema.Field, _ int) *schema.Field { return t.Clone() })...)
+			inserted = true
+		}
+	}
+	for _, f := range fields {
+		s.AddField(f)
+	}
+	return nil
+}
+
+// Convert returns fields of the targets which have the converted values of the item.
+// It returns no fields when the item does not have any values of the sources.
+func (m *Migration) Convert(i *Item) ([]*Field, error) {
+	switch m.typ {
+	case MigrationTypeConvert:
+		return m.convert(i)
+	case MigrationTypeSplit:
+		return m.split(i)
+	case MigrationTypeMerge:
+		return m.merge(i)
+	}
+	return nil, ErrInvalidMigration
+}
+
+func (m *Migration) convert(i *Item) ([]*Field, error) {
+	source, target := m.sources[0], m.targets[0]
+	f := i.Field(source.ID())
+	if f == nil {
+		return nil, nil
+	}
+
+	v, err := convertValues(f.Value().Values(), target)
+	if err != nil {
+		return nil, &MigrationError{Field: source.Key().String(), Err: err}
+	}
+	if v == nil {
+		return nil, nil
+	}
+
+	var localized map[string]*value.Multiple
+	if target.Localized() {
+		for _, l := range f.Locales() {
+			lv, err := convertValues(f.Localized()[l].Values(), target)
+			if err != nil {
+				return nil, &MigrationError{Field: source.Key().String() + "." + l, Err: err}
+			}
+			if lv != nil {
+				if localized == nil {
+					localized = map[string]*value.Multiple{}
+				}
+				localized[l] = lv
+			}
+		}
+	}
+	return []*Field{NewLocalizedField(target.ID(), v, localized)}, nil
+}
+
+func (m *Migration) split(i *Item) ([]*Field, error) {
+	source := m.sources[0]
+	f := i.Field(source.ID())
+	if f == nil || f.Value().IsEmpty() {
+		return nil, nil
+	}
+
+	s, ok := stringValue(f.Value().First())
+	if !ok {
+		return nil, &MigrationError{Field: source.Key().String(), Err: ErrValueNotConvertible}
+	}
+	parts := strings.Split(s, m.separator)
+	if len(parts) > len(m.targets) {
+		return nil, &MigrationError{Field: source.Key().String(), Err: ErrTooManyValues}
+	}
+
+	var res []*Field
+	for j, p := range parts {
+		target := m.targets[j]
+		v, err := convertText(strings.TrimSpace(p), target)
+		if err != nil {
+			return nil, &MigrationError{Field: target.Key().String(), Err: err}
+		}
+		if v != nil {
+			res = append(res, NewField(target.ID(), v))
+		}
+	}
+	return res, nil
+}
+
+func (m *Migration) merge(i *Item) ([]*Field, error) {
+	target := m.targets[0]
+	var parts []string
+	for _, source := range m.sources {
+		f := i.Field(source.ID())
+		if f == nil || f.Value().IsEmpty() {
+			continue
+		}
+		s, ok := stringValue(f.Value().First())
+		if !ok {
+			return nil, &MigrationError{Field: source.Key().String(), Err: ErrValueNotConvertible}
+		}
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+
+	v, err := convertText(strings.Join(parts, m.separator), target)
+	if err != nil {
+		return nil, &MigrationError{Field: target.Key().String(), Err: err}
+	}
+	if v == nil {
+		return nil, nil
+	}
+	return []*Field{NewField(target.ID(), v)}, nil
+}
+
+// convertValues casts the values to the type of the field and validates them
+func convertValues(vs []*value.Value, f *schema.Field) (*value.Multiple, error) {
+	if len(vs) == 0 {
+		return nil, nil
+	}
+	if !f.Multiple() && len(vs) > 1 {
+		return nil, ErrTooManyValues
+	}
+
+	res := make([]*value.Value, 0, len(vs))
+	for _, v := range vs {
+		c := v.Cast(f.Type())
+		if c == nil {
+			return nil, ErrValueNotConvertible
+		}
+		res = append(res, c)
+	}
+
+	m := value.MultipleFrom(f.Type(), res)
+	if err := f.ValidateValue(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func convertText(s string, f *schema.Field) (*value.Multiple, error) {
+	if s == "" {
+		return nil, nil
+	}
+	return convertValues([]*value.Value{value.TypeText.Value(s)}, f)
+}
+
+func stringValue(v *value.Value) (string, bool) {
+	return v.Cast(value.TypeText).ValueString()
+}
@@ -0,0 +1,140 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func testMigrationField(k string, tp *schema.TypeProperty, multiple bool) *schema.Field {
+	return schema.NewField(tp).NewID().Key(key.New(k)).Multiple(multiple).MustBuild()
+}
+
+func testMigrationItem(fields ...*Field) *Item {
+	return New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(id.NewProjectID()).
+		Thread(id.NewThreadID()).Fields(fields).MustBuild()
+}
+
+func TestNewMigration(t *testing.T) {
+	text := testMigrationField("a", schema.NewText(nil).TypeProperty(), false)
+	text2 := testMigrationField("b", schema.NewText(nil).TypeProperty(), false)
+	texts := testMigrationField("c", schema.NewText(nil).TypeProperty(), true)
+	group := testMigrationField("d", lo.Must(schema.NewGroup(nil)).TypeProperty(), false)
+
+	_, err := NewMigration(MigrationTypeConvert, schema.FieldList{text}, schema.FieldList{texts}, "")
+	assert.NoError(t, err)
+	_, err = NewMigration(MigrationTypeConvert, schema.FieldList{text, text2}, schema.FieldList{texts}, "")
+	assert.Equal(t, ErrInvalidMigration, err)
+	_, err = NewMigration(MigrationTypeConvert, schema.FieldList{group}, schema.FieldList{text}, "")
+	assert.Equal(t, ErrInvalidMigration, err)
+
+	_, err = NewMigration(MigrationTypeSplit, schema.FieldList{text}, schema.FieldList{text2, texts}, ",")
+	assert.NoError(t, err)
+	_, err = NewMigration(MigrationTypeSplit, schema.FieldList{text}, schema.FieldList{text2, texts}, "")
+	assert.Equal(t, ErrInvalidMigration, err)
+	_, err = NewMigration(MigrationTypeSplit, schema.FieldList{texts}, schema.FieldList{text, text2}, ",")
+	assert.Equal(t, ErrInvalidMigration, err)
+
+	_, err = NewMigration(MigrationTypeMerge, schema.FieldList{text, text2}, schema.FieldList{texts}, " ")
+	assert.NoError(t, err)
+	_, err = NewMigration(MigrationTypeMerge, schema.FieldList{text, text}, schema.FieldList{texts}, " ")
+	assert.Equal(t, ErrInvalidMigration, err)
+	_, err = NewMigration(MigrationTypeMerge, schema.FieldList{text, texts}, schema.FieldList{text2}, " ")
+	assert.Equal(t, ErrInvalidMigration, err)
+
+	_, err = NewMigration("xxx", schema.FieldList{text}, schema.FieldList{text2}, "")
+	assert.Equal(t, ErrInvalidMigration, err)
+}
+
+func TestMigration_Apply(t *testing.T) {
+	f1 := testMigrationField("a", schema.NewText(nil).TypeProperty(), false)
+	f2 := testMigrationField("b", schema.NewText(nil).TypeProperty(), false)
+	f3 := testMigrationField("c", schema.NewText(nil).TypeProperty(), false)
+	s := schema.New().NewID().Workspace(id.NewWorkspaceID()).Project(id.NewProjectID()).Fields(schema.FieldList{f1, f2, f3}).MustBuild()
+
+	t1 := testMigrationField("b", schema.NewSelect([]string{"x"}).TypeProperty(), false)
+	m := lo.Must(NewMigration(MigrationTypeConvert, schema.FieldList{f2}, schema.FieldList{t1}, ""))
+	assert.NoError(t, m.Apply(s))
+	assert.Equal(t, id.FieldIDList{f1.ID(), t1.ID(), f3.ID()}, s.Fields().IDs())
+	assert.Equal(t, []int{0, 1, 2}, lo.Map(s.Fields(), func(f *schema.Field, _ int) int { return f.Order() }))
+
+	// the source is not in the schema anymore
+	assert.Equal(t, ErrInvalidMigration, m.Apply(s))
+
+	t2 := testMigrationField("c", schema.NewText(nil).TypeProperty(), false)
+	m = lo.Must(NewMigration(MigrationTypeConvert, schema.FieldList{f1}, schema.FieldList{t2}, ""))
+	assert.Equal(t, schema.ErrInvalidKey, m.Apply(s))
+}
+
+func TestMigration_Convert(t *testing.T) {
+	text := testMigrationField("text", schema.NewText(nil).TypeProperty(), false)
+	texts := testMigrationField("texts", schema.NewText(nil).TypeProperty(), true)
+	integer := testMigrationField("integer", lo.Must(schema.NewInteger(nil, nil)).TypeProperty(), false)
+	sel := testMigrationField("select", schema.NewSelect([]string{"a", "b"}).TypeProperty(), false)
+
+	// text to integer
+	m := lo.Must(NewMigration(MigrationTypeConvert, schema.FieldList{text}, schema.FieldList{integer}, ""))
+	got, err := m.Convert(testMigrationItem(NewField(text.ID(), value.TypeText.Value("10").AsMultiple())))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Field{NewField(integer.ID(), value.TypeInteger.Value(int64(10)).AsMultiple())}, got)
+
+	_, err = m.Convert(testMigrationItem(NewField(text.ID(), value.TypeText.Value("abc").AsMultiple())))
+	assert.Equal(t, &MigrationError{Field: "text", Err: ErrValueNotConvertible}, err)
+
+	got, err = m.Convert(testMigrationItem())
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	// text to select
+	m = lo.Must(NewMigration(MigrationTypeConvert, schema.FieldList{text}, schema.FieldList{sel}, ""))
+	got, err = m.Convert(testMigrationItem(NewField(text.ID(), value.TypeText.Value("a").AsMultiple())))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Field{NewField(sel.ID(), value.TypeSelect.Value("a").AsMultiple())}, got)
+
+	_, err = m.Convert(testMigrationItem(NewField(text.ID(), value.TypeText.Value("c").AsMultiple())))
+	assert.ErrorIs(t, err, schema.ErrInvalidValue)
+
+	// single to multiple, and multiple to single
+	m = lo.Must(NewMigration(MigrationTypeConvert, schema.FieldList{text}, schema.FieldList{texts}, ""))
+	got, err = m.Convert(testMigrationItem(NewField(text.ID(), value.TypeText.Value("a").AsMultiple())))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Field{NewField(texts.ID(), value.TypeText.Value("a").AsMultiple())}, got)
+
+	m = lo.Must(NewMigration(MigrationTypeConvert, schema.FieldList{texts}, schema.FieldList{text}, ""))
+	_, err = m.Convert(testMigrationItem(NewField(texts.ID(), value.NewMultiple(value.TypeText, []any{"a", "b"}))))
+	assert.Equal(t, &MigrationError{Field: "texts", Err: ErrTooManyValues}, err)
+
+	// split
+	code := testMigrationField("code", schema.NewText(nil).TypeProperty(), false)
+	m = lo.Must(NewMigration(MigrationTypeSplit, schema.FieldList{text}, schema.FieldList{code, integer}, "-"))
+	got, err = m.Convert(testMigrationItem(NewField(text.ID(), value.TypeText.Value("a - 1").AsMultiple())))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Field{
+		NewField(code.ID(), value.TypeText.Value("a").AsMultiple()),
+		NewField(integer.ID(), value.TypeInteger.Value(int64(1)).AsMultiple()),
+	}, got)
+
+	_, err = m.Convert(testMigrationItem(NewField(text.ID(), value.TypeText.Value("a-1-2").AsMultiple())))
+	assert.Equal(t, &MigrationError{Field: "text", Err: ErrTooManyValues}, err)
+
+	_, err = m.Convert(testMigrationItem(NewField(text.ID(), value.TypeText.Value("a-b").AsMultiple())))
+	assert.Equal(t, &MigrationError{Field: "integer", Err: ErrValueNotConvertible}, err)
+
+	// merge
+	m = lo.Must(NewMigration(MigrationTypeMerge, schema.FieldList{code, integer}, schema.FieldList{text}, "-"))
+	got, err = m.Convert(testMigrationItem(
+		NewField(code.ID(), value.TypeText.Value("a").AsMultiple()),
+		NewField(integer.ID(), value.TypeInteger.Value(int64(1)).AsMultiple()),
+	))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Field{NewField(text.ID(), value.TypeText.Value("a-1").AsMultiple())}, got)
+
+	got, err = m.Convert(testMigrationItem(NewField(integer.ID(), value.TypeInteger.Value(int64(1)).AsMultiple())))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Field{NewField(text.ID(), value.TypeText.Value("1").AsMultiple())}, got)
+}
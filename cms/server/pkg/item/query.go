@@ -4,6 +4,8 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
 )
 
 type Query struct {
@@ -11,6 +13,9 @@ type Query struct {
 	schema  *id.SchemaID
 	q       string
 	ref     *version.Ref
+	filters FieldFilterList
+	sort    *FieldSort
+	spatial *SpatialFilter
 }
 
 func NewQuery(project id.ProjectID, schema *id.SchemaID, q string, ref *version.Ref) *Query {
@@ -38,3 +43,37 @@ func (q *Query) Schema() *id.SchemaID {
 func (q *Query) Ref() *version.Ref {
 	return util.CloneRef(q.ref)
 }
+
+// WithFilters returns a copy of the query which narrows down items by values of fields
+func (q *Query) WithFilters(filters ...*FieldFilter) *Query {
+	r := *q
+	r.filters = append(FieldFilterList{}, q.filters...)
+	r.filters = append(r.filters, lo.Filter(filters, func(f *FieldFilter, _ int) bool { return f != nil })...)
+	return &r
+}
+
+// WithSort returns a copy of the query which orders items by a value of a field
+func (q *Query) WithSort(sort *FieldSort) *Query {
+	r := *q
+	r.sort = sort
+	return &r
+}
+
+func (q *Query) Filters() FieldFilterList {
+	return slices.Clone(q.filters)
+}
+
+func (q *Query) Sort() *FieldSort {
+	return q.sort
+}
+
+// WithSpatialFilter returns a copy of the query which narrows down items by their geometries and assets
+func (q *Query) WithSpatialFilter(f *SpatialFilter) *Query {
+	r := *q
+	r.spatial = f
+	return &r
+}
+
+func (q *Query) SpatialFilter() *SpatialFilter {
+	return q.spatial
+}
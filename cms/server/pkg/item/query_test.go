@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/stretchr/testify/assert"
 )
@@ -66,3 +67,25 @@ func TestQuery_Ref(t *testing.T) {
 	}
 	assert.Equal(t, version.Public.Ref(), q.Ref())
 }
+
+func TestQuery_WithFilters(t *testing.T) {
+	fid := id.NewFieldID()
+	f := NewExistsFieldFilter(fid, true)
+	s := NewFieldSort(fid, DescDirection)
+	q := NewQuery(id.NewProjectID(), nil, "", nil)
+
+	q2 := q.WithFilters(f, nil).WithSort(s)
+	assert.Equal(t, FieldFilterList{f}, q2.Filters())
+	assert.Equal(t, s, q2.Sort())
+	assert.Nil(t, q.Filters())
+	assert.Nil(t, q.Sort())
+}
+
+func TestQuery_WithSpatialFilter(t *testing.T) {
+	f := NewSpatialFilter(value.Geometry{Type: value.GeometryTypePoint, Coordinates: value.Position{1, 2}})
+	q := NewQuery(id.NewProjectID(), nil, "", nil)
+
+	q2 := q.WithSpatialFilter(f)
+	assert.Equal(t, f, q2.SpatialFilter())
+	assert.Nil(t, q.SpatialFilter())
+}
@@ -0,0 +1,82 @@
+package item
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+)
+
+// References returns the items which are referenced by the item including ones in groups
+func (i *Item) References() id.ItemIDList {
+	return lo.Uniq(lo.FlatMap(i.fields, func(f *Field, _ int) []ID {
+		return collect(f.Value(), func(v *value.Value) (ID, bool) { return v.ValueReference() })
+	}))
+}
+
+// ReferencingFields returns the fields including sub-fields in groups which reference the target item
+func (i *Item) ReferencingFields(target ID) FieldIDList {
+	var res FieldIDList
+	for _, f := range i.fields {
+		res = append(res, referencingFields(f.FieldID(), f.Value(), target)...)
+	}
+	return lo.Uniq(res)
+}
+
+// RemoveReference removes references to the target item from the fields including sub-fields in groups.
+// It returns false when the item does not reference the target.
+func (i *Item) RemoveReference(target ID) bool {
+	removed := false
+	fields := lo.Map(i.fields, func(f *Field, _ int) *Field {
+		m, ok := removeReference(f.Value(), target)
+		if !ok {
+			return f
+		}
+		removed = true
+		return NewLocalizedField(f.FieldID(), m, f.Localized())
+	})
+	if removed {
+		i.RestoreFields(fields)
+	}
+	return removed
+}
+
+func referencingFields(fid FieldID, m *value.Multiple, target ID) FieldIDList {
+	var res FieldIDList
+	for _, v := range m.Values() {
+		if r, ok := v.ValueReference(); ok && r == target {
+			res = append(res, fid)
+		}
+		if g, ok := v.ValueGroup(); ok {
+			for sfid, sm := range g {
+				res = append(res, referencingFields(sfid, sm, target)...)
+			}
+		}
+	}
+	return res
+}
+
+func removeReference(m *value.Multiple, target ID) (*value.Multiple, bool) {
+	removed := false
+	values := lo.FilterMap(m.Values(), func(v *value.Value, _ int) (*value.Value, bool) {
+		if r, ok := v.ValueReference(); ok && r == target {
+			removed = true
+			return nil, false
+		}
+		if g, ok := v.ValueGroup(); ok {
+			g2 := value.Group{}
+			for sfid, sm := range g {
+				if sm2, ok := removeReference(sm, target); ok {
+					removed = true
+					sm = sm2
+				}
+				g2[sfid] = sm
+			}
+			return value.TypeGroup.Value(g2), true
+		}
+		return v, true
+	})
+	if !removed {
+		return m, false
+	}
+	return value.MultipleFrom(m.Type(), values), true
+}
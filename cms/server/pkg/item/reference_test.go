@@ -0,0 +1,36 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItem_References(t *testing.T) {
+	r1, r2, r3 := id.NewItemID(), id.NewItemID(), id.NewItemID()
+	f1, f2, sf := id.NewFieldID(), id.NewFieldID(), id.NewFieldID()
+	i := &Item{
+		fields: []*Field{
+			NewField(f1, value.NewMultiple(value.TypeReference, []any{r1, r2})),
+			NewField(id.NewFieldID(), value.New(value.TypeText, "aa").AsMultiple()),
+			NewField(f2, value.NewMultiple(value.TypeGroup, []any{
+				value.Group{sf: value.NewMultiple(value.TypeReference, []any{r1, r3})},
+			})),
+		},
+	}
+
+	assert.Equal(t, id.ItemIDList{r1, r2, r3}, i.References())
+	assert.Equal(t, FieldIDList{f1, sf}, i.ReferencingFields(r1))
+	assert.Equal(t, FieldIDList{f1}, i.ReferencingFields(r2))
+	assert.Empty(t, i.ReferencingFields(id.NewItemID()))
+
+	assert.False(t, i.RemoveReference(id.NewItemID()))
+	assert.True(t, i.RemoveReference(r1))
+	assert.Equal(t, id.ItemIDList{r2, r3}, i.References())
+	assert.Equal(t, value.NewMultiple(value.TypeReference, []any{r2}), i.Field(f1).Value())
+	g, _ := i.Field(f2).Value().First().ValueGroup()
+	assert.Equal(t, value.NewMultiple(value.TypeReference, []any{r3}), g[sf])
+	assert.Empty(t, i.ReferencingFields(r1))
+}
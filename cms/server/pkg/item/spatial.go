@@ -0,0 +1,99 @@
+package item
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+var ErrInvalidSpatialFilter = rerror.NewE(i18n.T("invalid spatial filter"))
+
+// SpatialFilter narrows down items to ones whose geometry values or assets overlap the area
+type SpatialFilter struct {
+	area   value.Geometry
+	assets AssetIDList
+}
+
+func NewSpatialFilter(area value.Geometry) *SpatialFilter {
+	return &SpatialFilter{area: area}
+}
+
+// ParseSpatialFilter builds a filter from "bbox" in the form of "west,south,east,north" or "intersects" in the form of a GeoJSON geometry.
+// It returns nil when both are empty.
+func ParseSpatialFilter(bbox, intersects string) (*SpatialFilter, error) {
+	if bbox != "" && intersects != "" {
+		return nil, ErrInvalidSpatialFilter
+	}
+
+	if bbox != "" {
+		b, err := parseBBox(bbox)
+		if err != nil {
+			return nil, err
+		}
+		g, ok := value.GeometryFromBBox(b)
+		if !ok {
+			return nil, ErrInvalidSpatialFilter
+		}
+		return NewSpatialFilter(g), nil
+	}
+
+	if intersects != "" {
+		g, ok := value.TypeGeometry.Value(json.RawMessage(intersects)).ValueGeometry()
+		if !ok {
+			return nil, ErrInvalidSpatialFilter
+		}
+		return NewSpatialFilter(g), nil
+	}
+
+	return nil, nil
+}
+
+func parseBBox(s string) ([]float64, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 {
+		return nil, ErrInvalidSpatialFilter
+	}
+	res := make([]float64, 0, len(parts))
+	for _, p := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil {
+			return nil, ErrInvalidSpatialFilter
+		}
+		res = append(res, f)
+	}
+	return res, nil
+}
+
+func (f *SpatialFilter) Area() value.Geometry {
+	return f.area
+}
+
+// Assets returns IDs of assets which overlap the area. Items which have any of them also match the filter.
+func (f *SpatialFilter) Assets() AssetIDList {
+	return slices.Clone(f.assets)
+}
+
+// WithAssets returns a copy of the filter with assets which overlap the area
+func (f *SpatialFilter) WithAssets(assets AssetIDList) *SpatialFilter {
+	r := *f
+	r.assets = slices.Clone(assets)
+	return &r
+}
+
+// Match reports whether the item matches the filter. Geometries are compared by their bounding boxes.
+func (f *SpatialFilter) Match(i *Item) bool {
+	if f == nil {
+		return true
+	}
+	b := f.area.BBox()
+	if lo.SomeBy(i.Geometries(), func(g value.Geometry) bool { return g.IntersectsBBox(b) }) {
+		return true
+	}
+	return lo.SomeBy(i.AssetIDs(), func(a AssetID) bool { return f.assets.Has(a) })
+}
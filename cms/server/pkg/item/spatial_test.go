@@ -0,0 +1,63 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSpatialFilter(t *testing.T) {
+	f, err := ParseSpatialFilter("139, 35, 140, 36", "")
+	assert.NoError(t, err)
+	assert.Equal(t, value.GeometryTypePolygon, f.Area().Type)
+	assert.Equal(t, []float64{139, 35, 140, 36}, f.Area().BBox())
+
+	f, err = ParseSpatialFilter("", `{"type":"Point","coordinates":[139,35]}`)
+	assert.NoError(t, err)
+	assert.Equal(t, value.Geometry{Type: value.GeometryTypePoint, Coordinates: value.Position{139, 35}}, f.Area())
+
+	f, err = ParseSpatialFilter("", "")
+	assert.NoError(t, err)
+	assert.Nil(t, f)
+
+	for _, tt := range [][2]string{
+		{"139,35,140", ""},
+		{"139,35,140,x", ""},
+		{"140,35,139,36", ""},
+		{"", `{"type":"Point"}`},
+		{"", `{`},
+		{"139,35,140,36", `{"type":"Point","coordinates":[139,35]}`},
+	} {
+		_, err := ParseSpatialFilter(tt[0], tt[1])
+		assert.Equal(t, ErrInvalidSpatialFilter, err, tt)
+	}
+}
+
+func TestSpatialFilter_Match(t *testing.T) {
+	aid := id.NewAssetID()
+	f := NewSpatialFilter(value.Geometry{
+		Type:        value.GeometryTypePolygon,
+		Coordinates: [][]value.Position{{{139, 35}, {140, 35}, {140, 36}, {139, 35}}},
+	})
+	f2 := f.WithAssets(AssetIDList{aid})
+	assert.Empty(t, f.Assets())
+	assert.Equal(t, AssetIDList{aid}, f2.Assets())
+
+	inside := &Item{fields: []*Field{
+		{value: value.TypeGeometry.Value(value.Geometry{Type: value.GeometryTypePoint, Coordinates: value.Position{139.5, 35.5}}).AsMultiple()},
+	}}
+	outside := &Item{fields: []*Field{
+		{value: value.TypeGeometry.Value(value.Geometry{Type: value.GeometryTypePoint, Coordinates: value.Position{0, 0}}).AsMultiple()},
+	}}
+	withAsset := &Item{fields: []*Field{
+		{value: value.TypeAsset.Value(aid).AsMultiple()},
+	}}
+
+	assert.True(t, f.Match(inside))
+	assert.False(t, f.Match(outside))
+	assert.False(t, f.Match(withAsset))
+	assert.True(t, f2.Match(withAsset))
+	assert.True(t, (*SpatialFilter)(nil).Match(outside))
+}
@@ -0,0 +1,159 @@
+package project
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"golang.org/x/exp/slices"
+)
+
+// APIKeyPrefix is the prefix of keys of the public API, which distinguishes them from tokens of integrations
+const APIKeyPrefix = "pub_"
+
+var (
+	ErrInvalidAPIKey  = rerror.NewE(i18n.T("invalid API key"))
+	ErrAPIKeyNotFound = rerror.NewE(i18n.T("API key not found"))
+)
+
+// APIKey is a key to read items of a project with the limited publication scope via the public API
+type APIKey struct {
+	id   APIKeyID
+	name string
+	// hash is the SHA-256 hash of the key. Only the hash is stored so that the key cannot be read after it is issued.
+	hash string
+	// key is the raw key. It is set only when the key is generated.
+	key string
+	// models is the allow-list of models which can be read with the key. All public models can be read when it is empty.
+	models ModelIDList
+	// rateLimit is the max number of requests per minute. It is unlimited when it is zero.
+	// Requests are counted by each server instance, so the limit applies per instance when the server is scaled out.
+	rateLimit int
+}
+
+// NewAPIKey returns a new API key with a random key
+func NewAPIKey(name string, models ModelIDList, rateLimit int) (*APIKey, error) {
+	k := &APIKey{id: NewAPIKeyID()}
+	if err := k.SetName(name); err != nil {
+		return nil, err
+	}
+	if err := k.SetRateLimit(rateLimit); err != nil {
+		return nil, err
+	}
+	k.SetModels(models)
+	if err := k.Regenerate(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// APIKeyFrom restores an API key from the hash of its key. It is used by repositories.
+func APIKeyFrom(id APIKeyID, name, hash string, models ModelIDList, rateLimit int) *APIKey {
+	return &APIKey{
+		id:        id,
+		name:      name,
+		hash:      hash,
+		models:    slices.Clone(models),
+		rateLimit: rateLimit,
+	}
+}
+
+func (k *APIKey) ID() APIKeyID {
+	return k.id
+}
+
+func (k *APIKey) Name() string {
+	return k.name
+}
+
+// Key returns the raw key. It is empty unless the key has just been generated, as only its hash is stored.
+func (k *APIKey) Key() string {
+	return k.key
+}
+
+func (k *APIKey) Hash() string {
+	return k.hash
+}
+
+// Matches returns true if the given key is the key of the API key. Hashes are compared in constant time.
+func (k *APIKey) Matches(key string) bool {
+	return key != "" && k.hash != "" && subtle.ConstantTimeCompare([]byte(HashAPIKey(key)), []byte(k.hash)) == 1
+}
+
+func (k *APIKey) Models() ModelIDList {
+	return slices.Clone(k.models)
+}
+
+func (k *APIKey) RateLimit() int {
+	return k.rateLimit
+}
+
+// AllowsModel returns true if the model can be read with the key
+func (k *APIKey) AllowsModel(m ModelID) bool {
+	return len(k.models) == 0 || k.models.Has(m)
+}
+
+func (k *APIKey) SetName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrInvalidAPIKey
+	}
+	k.name = name
+	return nil
+}
+
+func (k *APIKey) SetModels(models ModelIDList) {
+	k.models = models.Clone()
+}
+
+func (k *APIKey) SetRateLimit(rateLimit int) error {
+	if rateLimit < 0 {
+		return ErrInvalidAPIKey
+	}
+	k.rateLimit = rateLimit
+	return nil
+}
+
+// Regenerate replaces the key with a new random one. The old key is no longer valid.
+func (k *APIKey) Regenerate() error {
+	key, err := randomKey(43)
+	if err != nil {
+		return err
+	}
+	k.key = APIKeyPrefix + key
+	k.hash = HashAPIKey(k.key)
+	return nil
+}
+
+func (k *APIKey) Clone() *APIKey {
+	if k == nil {
+		return nil
+	}
+	c := APIKeyFrom(k.id, k.name, k.hash, k.models, k.rateLimit)
+	c.key = k.key
+	return c
+}
+
+// HashAPIKey returns the hex-encoded SHA-256 hash of the key
+func HashAPIKey(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
+
+func randomKey(n int) (string, error) {
+	const letters = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	result := make([]byte, n)
+	for i := 0; i < n; i++ {
+		randIndex, err := rand.Int(rand.Reader, big.NewInt(int64(len(letters))))
+		if err != nil {
+			return "", err
+		}
+		result[i] = letters[randIndex.Int64()]
+	}
+	return string(result), nil
+}
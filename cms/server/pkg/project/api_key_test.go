@@ -0,0 +1,62 @@
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	m := id.NewModelID()
+	k, err := NewAPIKey(" key ", ModelIDList{m}, 10)
+	assert.NoError(t, err)
+	assert.NotEqual(t, APIKeyID{}, k.ID())
+	assert.Equal(t, "key", k.Name())
+	assert.True(t, strings.HasPrefix(k.Key(), APIKeyPrefix))
+	assert.Len(t, k.Key(), len(APIKeyPrefix)+43)
+	assert.Equal(t, HashAPIKey(k.Key()), k.Hash())
+	assert.Equal(t, ModelIDList{m}, k.Models())
+	assert.Equal(t, 10, k.RateLimit())
+
+	_, err = NewAPIKey("", nil, 0)
+	assert.ErrorIs(t, err, ErrInvalidAPIKey)
+	_, err = NewAPIKey("key", nil, -1)
+	assert.ErrorIs(t, err, ErrInvalidAPIKey)
+}
+
+func TestAPIKey_AllowsModel(t *testing.T) {
+	m1, m2 := id.NewModelID(), id.NewModelID()
+	assert.True(t, (&APIKey{}).AllowsModel(m1))
+	assert.True(t, (&APIKey{models: ModelIDList{m1}}).AllowsModel(m1))
+	assert.False(t, (&APIKey{models: ModelIDList{m1}}).AllowsModel(m2))
+}
+
+func TestAPIKey_Matches(t *testing.T) {
+	k, _ := NewAPIKey("key", nil, 0)
+	restored := APIKeyFrom(k.ID(), k.Name(), k.Hash(), nil, 0)
+	assert.Empty(t, restored.Key())
+	assert.True(t, restored.Matches(k.Key()))
+	assert.False(t, restored.Matches(k.Key()+"x"))
+	assert.False(t, restored.Matches(""))
+	assert.False(t, (&APIKey{}).Matches(""))
+}
+
+func TestAPIKey_Regenerate(t *testing.T) {
+	k, _ := NewAPIKey("key", nil, 0)
+	old := k.Key()
+	assert.NoError(t, k.Regenerate())
+	assert.NotEqual(t, old, k.Key())
+	assert.True(t, strings.HasPrefix(k.Key(), APIKeyPrefix))
+	assert.False(t, k.Matches(old))
+	assert.True(t, k.Matches(k.Key()))
+}
+
+func TestAPIKey_Clone(t *testing.T) {
+	k, _ := NewAPIKey("key", ModelIDList{id.NewModelID()}, 1)
+	c := k.Clone()
+	assert.Equal(t, k, c)
+	assert.NotSame(t, k, c)
+	assert.Nil(t, (*APIKey)(nil).Clone())
+}
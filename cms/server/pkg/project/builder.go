@@ -84,3 +84,13 @@ func (b *Builder) Publication(publication *Publication) *Builder {
 	b.p.publication = publication
 	return b
 }
+
+func (b *Builder) RequestPolicy(policy *RequestPolicy) *Builder {
+	b.p.reqPolicy = policy
+	return b
+}
+
+func (b *Builder) ItemGroupPolicy(policy *ItemGroupPolicy) *Builder {
+	b.p.itemGroups = policy
+	return b
+}
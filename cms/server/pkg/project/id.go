@@ -6,12 +6,18 @@ import (
 )
 
 type ID = id.ProjectID
+type APIKeyID = id.APIKeyID
 type WorkspaceID = id.WorkspaceID
+type ModelID = id.ModelID
+type UserID = id.UserID
+type UserIDList = id.UserIDList
+type ModelIDList = id.ModelIDList
 
 type IDList = id.ProjectIDList
 
 var NewID = id.NewProjectID
 var NewWorkspaceID = id.NewWorkspaceID
+var NewAPIKeyID = id.NewAPIKeyID
 
 var MustID = id.MustProjectID
 var MustWorkspaceID = id.MustWorkspaceID
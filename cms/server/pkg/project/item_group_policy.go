@@ -0,0 +1,55 @@
+package project
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+var ErrInvalidItemGroupPolicy = rerror.NewE(i18n.T("invalid item group policy"))
+
+// ItemGroupPolicy is a set of rules about the groups which own items of the project, such as municipalities sharing the project
+type ItemGroupPolicy struct {
+	field      *id.Key
+	restricted bool
+}
+
+// NewItemGroupPolicy returns a new policy. Groups of items are derived from values of the field of the key when it is not nil,
+// and items can be read and written only by members of their groups when restricted is true.
+func NewItemGroupPolicy(field *id.Key, restricted bool) (*ItemGroupPolicy, error) {
+	if field != nil && !field.IsValid() {
+		return nil, ErrInvalidItemGroupPolicy
+	}
+	var f *id.Key
+	if field != nil {
+		f = field.Ref()
+	}
+	return &ItemGroupPolicy{
+		field:      f,
+		restricted: restricted,
+	}, nil
+}
+
+// Field returns the key of the field whose value is the group of items. It returns nil when groups are set only explicitly.
+func (p *ItemGroupPolicy) Field() *id.Key {
+	if p == nil || p.field == nil {
+		return nil
+	}
+	return p.field.Ref()
+}
+
+// Restricted returns whether items of a group can be read and written only by members of the group.
+// Items without a group, owners and maintainers are not restricted.
+func (p *ItemGroupPolicy) Restricted() bool {
+	return p != nil && p.restricted
+}
+
+func (p *ItemGroupPolicy) Clone() *ItemGroupPolicy {
+	if p == nil {
+		return nil
+	}
+	return &ItemGroupPolicy{
+		field:      p.Field(),
+		restricted: p.restricted,
+	}
+}
@@ -0,0 +1,34 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewItemGroupPolicy(t *testing.T) {
+	got, err := NewItemGroupPolicy(id.NewKey("city_code").Ref(), true)
+	assert.NoError(t, err)
+	assert.Equal(t, id.NewKey("city_code").Ref(), got.Field())
+	assert.True(t, got.Restricted())
+
+	got, err = NewItemGroupPolicy(nil, false)
+	assert.NoError(t, err)
+	assert.Nil(t, got.Field())
+	assert.False(t, got.Restricted())
+
+	got, err = NewItemGroupPolicy(id.NewKey("id").Ref(), true)
+	assert.Same(t, ErrInvalidItemGroupPolicy, err)
+	assert.Nil(t, got)
+}
+
+func TestItemGroupPolicy_Clone(t *testing.T) {
+	p := &ItemGroupPolicy{field: id.NewKey("city_code").Ref(), restricted: true}
+	got := p.Clone()
+	assert.Equal(t, p, got)
+	assert.NotSame(t, p, got)
+	assert.Nil(t, (*ItemGroupPolicy)(nil).Clone())
+	assert.False(t, (*ItemGroupPolicy)(nil).Restricted())
+	assert.Nil(t, (*ItemGroupPolicy)(nil).Field())
+}
@@ -24,6 +24,8 @@ type Project struct {
 	imageURL    *url.URL
 	updatedAt   time.Time
 	publication *Publication
+	reqPolicy   *RequestPolicy
+	itemGroups  *ItemGroupPolicy
 }
 
 func (p *Project) ID() ID {
@@ -67,6 +69,14 @@ func (p *Project) Publication() *Publication {
 	return p.publication
 }
 
+func (p *Project) RequestPolicy() *RequestPolicy {
+	return p.reqPolicy
+}
+
+func (p *Project) ItemGroupPolicy() *ItemGroupPolicy {
+	return p.itemGroups
+}
+
 func (p *Project) SetUpdatedAt(updatedAt time.Time) {
 	p.updatedAt = updatedAt
 }
@@ -85,6 +95,14 @@ func (p *Project) SetPublication(publication *Publication) {
 	p.publication = publication
 }
 
+func (p *Project) SetRequestPolicy(policy *RequestPolicy) {
+	p.reqPolicy = policy
+}
+
+func (p *Project) SetItemGroupPolicy(policy *ItemGroupPolicy) {
+	p.itemGroups = policy
+}
+
 func (p *Project) UpdateName(name string) {
 	p.name = name
 }
@@ -120,6 +138,8 @@ func (p *Project) Clone() *Project {
 		imageURL:    util.CopyURL(p.imageURL),
 		updatedAt:   p.updatedAt,
 		publication: p.publication.Clone(),
+		reqPolicy:   p.reqPolicy.Clone(),
+		itemGroups:  p.itemGroups.Clone(),
 	}
 }
 
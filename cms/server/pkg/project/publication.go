@@ -1,5 +1,10 @@
 package project
 
+import (
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
 const (
 	PublicationScopePrivate PublicationScope = "private"
 	PublicationScopeLimited PublicationScope = "limited"
@@ -11,6 +16,8 @@ type PublicationScope string
 type Publication struct {
 	scope       PublicationScope
 	assetPublic bool
+	// apiKeys are keys to read items via the public API when the scope is limited
+	apiKeys []*APIKey
 }
 
 func NewPublication(scope PublicationScope, assetPublic bool) *Publication {
@@ -31,6 +38,48 @@ func (p *Publication) AssetPublic() bool {
 	return p.assetPublic
 }
 
+func (p *Publication) APIKeys() []*APIKey {
+	return slices.Clone(p.apiKeys)
+}
+
+func (p *Publication) APIKey(id APIKeyID) *APIKey {
+	k, _ := lo.Find(p.apiKeys, func(k *APIKey) bool { return k.ID() == id })
+	return k
+}
+
+// APIKeyByKey returns the API key whose key equals to the given key. It returns nil when the key is empty or not found.
+// All keys are compared so that the time taken does not depend on which key matches.
+func (p *Publication) APIKeyByKey(key string) *APIKey {
+	if key == "" {
+		return nil
+	}
+	var res *APIKey
+	for _, k := range p.apiKeys {
+		if k.Matches(key) && res == nil {
+			res = k
+		}
+	}
+	return res
+}
+
+func (p *Publication) SetAPIKeys(keys []*APIKey) {
+	p.apiKeys = lo.Filter(keys, func(k *APIKey, _ int) bool { return k != nil })
+}
+
+func (p *Publication) AddAPIKey(k *APIKey) {
+	if k == nil || p.APIKey(k.ID()) != nil {
+		return
+	}
+	p.apiKeys = append(p.apiKeys, k)
+}
+
+// RemoveAPIKey revokes the API key. It returns false when the key is not found.
+func (p *Publication) RemoveAPIKey(id APIKeyID) bool {
+	l := len(p.apiKeys)
+	p.apiKeys = lo.Reject(p.apiKeys, func(k *APIKey, _ int) bool { return k.ID() == id })
+	return len(p.apiKeys) != l
+}
+
 func (p *Publication) SetScope(scope PublicationScope) {
 	if scope != PublicationScopePrivate && scope != PublicationScopeLimited && scope != PublicationScopePublic {
 		scope = PublicationScopePrivate
@@ -48,8 +97,14 @@ func (p *Publication) Clone() *Publication {
 		return nil
 	}
 
+	var keys []*APIKey
+	if len(p.apiKeys) > 0 {
+		keys = lo.Map(p.apiKeys, func(k *APIKey, _ int) *APIKey { return k.Clone() })
+	}
+
 	return &Publication{
 		scope:       p.scope,
 		assetPublic: p.assetPublic,
+		apiKeys:     keys,
 	}
 }
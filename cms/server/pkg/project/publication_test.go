@@ -92,3 +92,30 @@ func TestPublication_Clone(t *testing.T) {
 	assert.NotSame(t, p, p2)
 	assert.Nil(t, (*Publication)(nil).Clone())
 }
+
+func TestPublication_APIKeys(t *testing.T) {
+	k1, _ := NewAPIKey("a", nil, 0)
+	k2, _ := NewAPIKey("b", nil, 0)
+	p := NewPublication(PublicationScopeLimited, false)
+
+	p.AddAPIKey(k1)
+	p.AddAPIKey(k1)
+	p.AddAPIKey(nil)
+	p.AddAPIKey(k2)
+	assert.Equal(t, []*APIKey{k1, k2}, p.APIKeys())
+	assert.Same(t, k2, p.APIKey(k2.ID()))
+	assert.Same(t, k1, p.APIKeyByKey(k1.Key()))
+	assert.Nil(t, p.APIKeyByKey(""))
+	assert.Nil(t, p.APIKeyByKey("pub_xxx"))
+
+	c := p.Clone()
+	assert.Equal(t, p, c)
+	assert.NotSame(t, p.APIKey(k1.ID()), c.APIKey(k1.ID()))
+
+	assert.True(t, p.RemoveAPIKey(k1.ID()))
+	assert.False(t, p.RemoveAPIKey(k1.ID()))
+	assert.Equal(t, []*APIKey{k2}, p.APIKeys())
+
+	p.SetAPIKeys([]*APIKey{nil, k1})
+	assert.Equal(t, []*APIKey{k1}, p.APIKeys())
+}
@@ -0,0 +1,124 @@
+package project
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+var ErrInvalidRequestPolicy = rerror.NewE(i18n.T("invalid request policy"))
+
+// RequestPolicy is a set of rules to approve requests of the project
+type RequestPolicy struct {
+	requiredApprovals int
+	requiredRoles     []user.Role
+	modelReviewers    map[ModelID]UserIDList
+}
+
+// NewRequestPolicy returns a new policy. Only owners and maintainers can be required as they are the only roles who can review requests.
+func NewRequestPolicy(requiredApprovals int, requiredRoles []user.Role, modelReviewers map[ModelID]UserIDList) (*RequestPolicy, error) {
+	if requiredApprovals < 0 {
+		return nil, ErrInvalidRequestPolicy
+	}
+	for _, r := range requiredRoles {
+		if r != user.RoleOwner && r != user.RoleMaintainer {
+			return nil, ErrInvalidRequestPolicy
+		}
+	}
+
+	var reviewers map[ModelID]UserIDList
+	if len(modelReviewers) > 0 {
+		reviewers = lo.MapValues(modelReviewers, func(l UserIDList, _ ModelID) UserIDList {
+			return lo.Uniq(l)
+		})
+	}
+
+	return &RequestPolicy{
+		requiredApprovals: requiredApprovals,
+		requiredRoles:     slices.Clone(requiredRoles),
+		modelReviewers:    reviewers,
+	}, nil
+}
+
+// RequiredApprovals returns the number of approvals to approve a request. It is at least one.
+func (p *RequestPolicy) RequiredApprovals() int {
+	if p == nil || p.requiredApprovals < 1 {
+		return 1
+	}
+	return p.requiredApprovals
+}
+
+func (p *RequestPolicy) RequiredRoles() []user.Role {
+	if p == nil {
+		return nil
+	}
+	return slices.Clone(p.requiredRoles)
+}
+
+func (p *RequestPolicy) ModelReviewers() map[ModelID]UserIDList {
+	if p == nil || p.modelReviewers == nil {
+		return nil
+	}
+	return lo.MapValues(p.modelReviewers, func(l UserIDList, _ ModelID) UserIDList {
+		return slices.Clone(l)
+	})
+}
+
+// ReviewersOf returns the reviewers who are automatically requested to review requests including items of the models
+func (p *RequestPolicy) ReviewersOf(models ...ModelID) UserIDList {
+	if p == nil {
+		return nil
+	}
+	var res UserIDList
+	for _, m := range lo.Uniq(models) {
+		for _, u := range p.modelReviewers[m] {
+			if !res.Has(u) {
+				res = append(res, u)
+			}
+		}
+	}
+	return res
+}
+
+// IsSatisfied reports whether approvals by users of the roles are enough to approve a request.
+// Each required role must be fulfilled by a different approver whose role includes it.
+func (p *RequestPolicy) IsSatisfied(approvers []user.Role) bool {
+	if len(approvers) < p.RequiredApprovals() {
+		return false
+	}
+
+	required := p.RequiredRoles()
+	// stronger roles first so that they are not fulfilled by approvers needed for weaker roles
+	slices.SortStableFunc(required, func(a, b user.Role) bool {
+		return a != b && a.Includes(b)
+	})
+
+	rest := slices.Clone(approvers)
+	for _, r := range required {
+		i := -1
+		for j, a := range rest {
+			// pick the weakest approver who can fulfill the role
+			if a.Includes(r) && (i < 0 || rest[i].Includes(a)) {
+				i = j
+			}
+		}
+		if i < 0 {
+			return false
+		}
+		rest = slices.Delete(rest, i, i+1)
+	}
+	return true
+}
+
+func (p *RequestPolicy) Clone() *RequestPolicy {
+	if p == nil {
+		return nil
+	}
+	return &RequestPolicy{
+		requiredApprovals: p.requiredApprovals,
+		requiredRoles:     slices.Clone(p.requiredRoles),
+		modelReviewers:    p.ModelReviewers(),
+	}
+}
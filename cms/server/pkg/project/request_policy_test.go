@@ -0,0 +1,130 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequestPolicy(t *testing.T) {
+	mid := id.NewModelID()
+	uid := id.NewUserID()
+
+	got, err := NewRequestPolicy(2, []user.Role{user.RoleOwner}, map[ModelID]UserIDList{mid: {uid, uid}})
+	assert.NoError(t, err)
+	assert.Equal(t, &RequestPolicy{
+		requiredApprovals: 2,
+		requiredRoles:     []user.Role{user.RoleOwner},
+		modelReviewers:    map[ModelID]UserIDList{mid: {uid}},
+	}, got)
+
+	got, err = NewRequestPolicy(-1, nil, nil)
+	assert.Same(t, ErrInvalidRequestPolicy, err)
+	assert.Nil(t, got)
+
+	got, err = NewRequestPolicy(1, []user.Role{user.RoleWriter}, nil)
+	assert.Same(t, ErrInvalidRequestPolicy, err)
+	assert.Nil(t, got)
+}
+
+func TestRequestPolicy_RequiredApprovals(t *testing.T) {
+	assert.Equal(t, 1, (*RequestPolicy)(nil).RequiredApprovals())
+	assert.Equal(t, 1, (&RequestPolicy{}).RequiredApprovals())
+	assert.Equal(t, 3, (&RequestPolicy{requiredApprovals: 3}).RequiredApprovals())
+}
+
+func TestRequestPolicy_ReviewersOf(t *testing.T) {
+	m1, m2, m3 := id.NewModelID(), id.NewModelID(), id.NewModelID()
+	u1, u2, u3 := id.NewUserID(), id.NewUserID(), id.NewUserID()
+	p := &RequestPolicy{
+		modelReviewers: map[ModelID]UserIDList{
+			m1: {u1, u2},
+			m2: {u2, u3},
+		},
+	}
+
+	assert.Equal(t, UserIDList{u1, u2}, p.ReviewersOf(m1))
+	assert.Equal(t, UserIDList{u1, u2, u3}, p.ReviewersOf(m1, m2, m1))
+	assert.Nil(t, p.ReviewersOf(m3))
+	assert.Nil(t, (*RequestPolicy)(nil).ReviewersOf(m1))
+}
+
+func TestRequestPolicy_IsSatisfied(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    *RequestPolicy
+		approvers []user.Role
+		want      bool
+	}{
+		{
+			name:      "default",
+			policy:    nil,
+			approvers: []user.Role{user.RoleMaintainer},
+			want:      true,
+		},
+		{
+			name:      "no approvals",
+			policy:    nil,
+			approvers: nil,
+			want:      false,
+		},
+		{
+			name:      "not enough approvals",
+			policy:    &RequestPolicy{requiredApprovals: 2},
+			approvers: []user.Role{user.RoleOwner},
+			want:      false,
+		},
+		{
+			name:      "enough approvals",
+			policy:    &RequestPolicy{requiredApprovals: 2},
+			approvers: []user.Role{user.RoleMaintainer, user.RoleMaintainer},
+			want:      true,
+		},
+		{
+			name:      "required role is missing",
+			policy:    &RequestPolicy{requiredRoles: []user.Role{user.RoleOwner}},
+			approvers: []user.Role{user.RoleMaintainer},
+			want:      false,
+		},
+		{
+			name:      "owner fulfills maintainer",
+			policy:    &RequestPolicy{requiredRoles: []user.Role{user.RoleMaintainer}},
+			approvers: []user.Role{user.RoleOwner},
+			want:      true,
+		},
+		{
+			name:      "each role needs a different approver",
+			policy:    &RequestPolicy{requiredRoles: []user.Role{user.RoleMaintainer, user.RoleOwner}},
+			approvers: []user.Role{user.RoleOwner},
+			want:      false,
+		},
+		{
+			name:      "roles are fulfilled by different approvers",
+			policy:    &RequestPolicy{requiredRoles: []user.Role{user.RoleMaintainer, user.RoleOwner}},
+			approvers: []user.Role{user.RoleOwner, user.RoleMaintainer},
+			want:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.policy.IsSatisfied(tt.approvers))
+		})
+	}
+}
+
+func TestRequestPolicy_Clone(t *testing.T) {
+	p := &RequestPolicy{
+		requiredApprovals: 2,
+		requiredRoles:     []user.Role{user.RoleOwner},
+		modelReviewers:    map[ModelID]UserIDList{id.NewModelID(): {id.NewUserID()}},
+	}
+	got := p.Clone()
+	assert.Equal(t, p, got)
+	assert.NotSame(t, p, got)
+	assert.Nil(t, (*RequestPolicy)(nil).Clone())
+}
@@ -3,6 +3,8 @@ package request
 import (
 	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
 
@@ -39,6 +41,9 @@ func (b *Builder) Build() (*Request, error) {
 	if b.r.title == "" {
 		return nil, ErrEmptyTitle
 	}
+	if err := item.ValidateSchedule(b.r.publishAt, b.r.unpublishAt); err != nil {
+		return nil, err
+	}
 	if b.r.state == "" {
 		b.r.state = StateWaiting
 	}
@@ -121,3 +126,18 @@ func (b *Builder) ClosedAt(c *time.Time) *Builder {
 	b.r.closedAt = c
 	return b
 }
+
+func (b *Builder) PublishAt(t *time.Time) *Builder {
+	b.r.publishAt = util.CloneRef(t)
+	return b
+}
+
+func (b *Builder) UnpublishAt(t *time.Time) *Builder {
+	b.r.unpublishAt = util.CloneRef(t)
+	return b
+}
+
+func (b *Builder) ApprovedBy(a UserIDList) *Builder {
+	b.r.approvedBy = a
+	return b
+}
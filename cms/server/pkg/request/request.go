@@ -3,6 +3,8 @@ package request
 import (
 	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/util"
@@ -25,11 +27,14 @@ type Request struct {
 	description string
 	createdBy   UserID
 	reviewers   UserIDList
+	approvedBy  UserIDList
 	state       State
 	updatedAt   time.Time
 	approvedAt  *time.Time
 	closedAt    *time.Time
 	thread      ThreadID
+	publishAt   *time.Time
+	unpublishAt *time.Time
 }
 
 func (r *Request) ID() ID {
@@ -64,6 +69,11 @@ func (r *Request) Reviewers() UserIDList {
 	return r.reviewers
 }
 
+// ApprovedBy returns the reviewers who have approved the current items of the request
+func (r *Request) ApprovedBy() UserIDList {
+	return slices.Clone(r.approvedBy)
+}
+
 func (r *Request) State() State {
 	return r.state
 }
@@ -88,6 +98,25 @@ func (r *Request) Thread() ThreadID {
 	return r.thread
 }
 
+// PublishAt returns the time when the items will be published after the request is approved
+func (r *Request) PublishAt() *time.Time {
+	return util.CloneRef(r.publishAt)
+}
+
+// UnpublishAt returns the time when the items will be unpublished after the request is approved
+func (r *Request) UnpublishAt() *time.Time {
+	return util.CloneRef(r.unpublishAt)
+}
+
+func (r *Request) SetSchedule(publishAt, unpublishAt *time.Time) error {
+	if err := item.ValidateSchedule(publishAt, unpublishAt); err != nil {
+		return err
+	}
+	r.publishAt = util.CloneRef(publishAt)
+	r.unpublishAt = util.CloneRef(unpublishAt)
+	return nil
+}
+
 func (r *Request) SetTitle(title string) error {
 	if title == "" {
 		return ErrEmptyTitle
@@ -109,9 +138,46 @@ func (r *Request) SetItems(items ItemList) error {
 		return ErrDuplicatedItem
 	}
 	r.items = slices.Clone(items)
+	// approvals are for the previous items
+	r.approvedBy = nil
 	return nil
 }
 
+// AddApproval records an approval by the reviewer. It returns false if the reviewer has already approved.
+func (r *Request) AddApproval(u UserID) bool {
+	if r.approvedBy.Has(u) {
+		return false
+	}
+	r.approvedBy = append(r.approvedBy, u)
+	return true
+}
+
+// PinItemVersions points the items to the versions which are being approved.
+// Approvals given so far are discarded when any item has got a version which the approvers have not reviewed.
+func (r *Request) PinItemVersions(versions map[ItemID]version.Version) {
+	changed := false
+	for i, itm := range r.items {
+		v, ok := versions[itm.Item()]
+		if !ok {
+			continue
+		}
+		if version.MatchVersionOrRef(itm.Pointer(), func(pinned version.Version) bool { return pinned == v }, nil) {
+			continue
+		}
+		r.items[i] = &Item{item: itm.Item(), pointer: v.OrRef()}
+		changed = true
+	}
+	if changed {
+		r.approvedBy = nil
+	}
+}
+
+// RequestChanges blocks the request until it is submitted again. Approvals given so far are discarded.
+func (r *Request) RequestChanges() {
+	r.approvedBy = nil
+	r.state = StateBlocked
+}
+
 func (r *Request) SetState(state State) {
 	r.state = state
 	switch state {
@@ -2,8 +2,11 @@ package request
 
 import (
 	"testing"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,6 +30,52 @@ func TestRequest_SetItems(t *testing.T) {
 	assert.Same(t, ErrDuplicatedItem, err)
 }
 
+func TestRequest_AddApproval(t *testing.T) {
+	u1, u2 := id.NewUserID(), id.NewUserID()
+	req := &Request{}
+
+	assert.True(t, req.AddApproval(u1))
+	assert.False(t, req.AddApproval(u1))
+	assert.True(t, req.AddApproval(u2))
+	assert.Equal(t, id.UserIDList{u1, u2}, req.ApprovedBy())
+
+	// approvals are discarded when the items are changed
+	i1, _ := NewItem(id.NewItemID())
+	assert.NoError(t, req.SetItems(ItemList{i1}))
+	assert.Empty(t, req.ApprovedBy())
+}
+
+func TestRequest_PinItemVersions(t *testing.T) {
+	u := id.NewUserID()
+	iid := id.NewItemID()
+	v1, v2 := version.New(), version.New()
+	i1, _ := NewItem(iid)
+	req := &Request{items: ItemList{i1}}
+
+	req.PinItemVersions(map[ItemID]version.Version{iid: v1})
+	assert.True(t, req.AddApproval(u))
+	assert.Equal(t, ItemList{{item: iid, pointer: v1.OrRef()}}, req.Items())
+
+	// approvals are kept while the versions are the same
+	req.PinItemVersions(map[ItemID]version.Version{iid: v1})
+	assert.Equal(t, id.UserIDList{u}, req.ApprovedBy())
+
+	// approvals are discarded when the items have got new versions
+	req.PinItemVersions(map[ItemID]version.Version{iid: v2})
+	assert.Empty(t, req.ApprovedBy())
+	assert.Equal(t, ItemList{{item: iid, pointer: v2.OrRef()}}, req.Items())
+}
+
+func TestRequest_RequestChanges(t *testing.T) {
+	req := &Request{
+		state:      StateWaiting,
+		approvedBy: id.UserIDList{id.NewUserID()},
+	}
+	req.RequestChanges()
+	assert.Equal(t, StateBlocked, req.State())
+	assert.Empty(t, req.ApprovedBy())
+}
+
 func TestRequest_SetReviewers(t *testing.T) {
 	req := &Request{}
 	reviewers := id.UserIDList{id.NewUserID()}
@@ -56,6 +105,19 @@ func TestRequest_SetTitle(t *testing.T) {
 
 }
 
+func TestRequest_SetSchedule(t *testing.T) {
+	now := time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	req := &Request{}
+
+	assert.NoError(t, req.SetSchedule(&now, &later))
+	assert.Equal(t, &now, req.PublishAt())
+	assert.Equal(t, &later, req.UnpublishAt())
+
+	assert.Same(t, item.ErrInvalidSchedule, req.SetSchedule(&later, &now))
+	assert.Equal(t, &now, req.PublishAt())
+}
+
 func TestRequest_SetState1(t *testing.T) {
 	item, _ := NewItem(id.NewItemID())
 
@@ -9,6 +9,9 @@ var StateClosed State = "closed"
 var StateWaiting State = "waiting"
 var StateDraft State = "draft"
 
+// StateBlocked is a state of a request on which a reviewer requested changes
+var StateBlocked State = "blocked"
+
 func (s State) String() string {
 	return string(s)
 }
@@ -24,6 +27,8 @@ func StateFrom(s string) State {
 		return StateApproved
 	case StateClosed:
 		return StateClosed
+	case StateBlocked:
+		return StateBlocked
 	default:
 		return State("")
 	}
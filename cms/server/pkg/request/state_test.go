@@ -17,6 +17,8 @@ func TestStateFrom(t *testing.T) {
 	assert.Equal(t, StateDraft, s)
 	s = StateFrom("closed")
 	assert.Equal(t, StateClosed, s)
+	s = StateFrom("blocked")
+	assert.Equal(t, StateBlocked, s)
 }
 
 func TestState_String(t *testing.T) {
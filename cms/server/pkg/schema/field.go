@@ -8,22 +8,33 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 )
 
-var ErrValueRequired = rerror.NewE(i18n.T("value is required"))
+var (
+	ErrValueRequired    = rerror.NewE(i18n.T("value is required"))
+	ErrInvalidItemCount = rerror.NewE(i18n.T("invalid item count"))
+	ErrInvalidCondition = rerror.NewE(i18n.T("invalid required condition"))
+)
 
 type Field struct {
-	id           FieldID
-	name         string
-	description  string
-	key          key.Key
-	unique       bool
-	multiple     bool
-	required     bool
+	id          FieldID
+	name        string
+	description string
+	key         key.Key
+	unique      bool
+	multiple    bool
+	required    bool
+	// localized fields have values per locale in addition to the value of the default locale
+	localized    bool
 	updatedAt    time.Time
 	defaultValue *value.Multiple
 	typeProperty *TypeProperty
 	order        int
+	minItems     *int
+	maxItems     *int
+	requiredIf   *RequiredCondition
 }
 
 func (f *Field) ID() FieldID {
@@ -101,6 +112,14 @@ func (f *Field) Required() bool {
 	return f.required
 }
 
+func (f *Field) Localized() bool {
+	return f.localized
+}
+
+func (f *Field) SetLocalized(l bool) {
+	f.localized = l
+}
+
 func (f *Field) SetRequired(req bool) {
 	f.required = req
 }
@@ -113,6 +132,37 @@ func (f *Field) SetMultiple(m bool) {
 	f.multiple = m
 }
 
+func (f *Field) MinItems() *int {
+	return util.CloneRef(f.minItems)
+}
+
+func (f *Field) MaxItems() *int {
+	return util.CloneRef(f.maxItems)
+}
+
+// SetItemCount sets the range of the number of values of a multiple field
+func (f *Field) SetItemCount(min, max *int) error {
+	if min != nil && *min < 0 || max != nil && *max < 0 || min != nil && max != nil && *min > *max {
+		return ErrInvalidItemCount
+	}
+	f.minItems = util.CloneRef(min)
+	f.maxItems = util.CloneRef(max)
+	return nil
+}
+
+func (f *Field) RequiredIf() *RequiredCondition {
+	return f.requiredIf.Clone()
+}
+
+// SetRequiredIf makes the field required when the other field has one of the values. nil removes the condition.
+func (f *Field) SetRequiredIf(c *RequiredCondition) error {
+	if c != nil && c.Field() == f.id {
+		return ErrInvalidCondition
+	}
+	f.requiredIf = c.Clone()
+	return nil
+}
+
 func (f *Field) CreatedAt() time.Time {
 	return f.id.Timestamp()
 }
@@ -161,12 +211,51 @@ func (f *Field) Clone() *Field {
 		unique:       f.unique,
 		multiple:     f.multiple,
 		required:     f.required,
+		localized:    f.localized,
 		updatedAt:    f.updatedAt,
 		typeProperty: f.typeProperty.Clone(),
 		defaultValue: f.defaultValue.Clone(),
+		minItems:     util.CloneRef(f.minItems),
+		maxItems:     util.CloneRef(f.maxItems),
+		requiredIf:   f.requiredIf.Clone(),
 	}
 }
 
+// NewValue converts the raw values into a value of the field. Values which cannot be converted are dropped.
+func (f *Field) NewValue(vs []any) *value.Multiple {
+	var g *FieldGroup
+	f.typeProperty.Match(TypePropertyMatch{
+		Group: func(fg *FieldGroup) { g = fg },
+	})
+	if g == nil {
+		return value.NewMultiple(f.Type(), vs)
+	}
+	return value.MultipleFrom(value.TypeGroup, lo.FilterMap(vs, func(v any, _ int) (*value.Value, bool) {
+		w := g.ValueFrom(v)
+		return w, w != nil
+	}))
+}
+
+// ValueInterface converts the value of the field into a generic representation.
+// A group value is converted into an object keyed by the keys of the sub-fields, or by the IDs when byKey is false.
+func (f *Field) ValueInterface(m *value.Multiple, byKey bool) any {
+	toInterface := func(v *value.Value) any {
+		if g, ok := v.ValueGroup(); ok {
+			var res any
+			f.typeProperty.Match(TypePropertyMatch{
+				Group: func(fg *FieldGroup) { res = fg.Interface(g, byKey) },
+			})
+			return res
+		}
+		return v.Interface()
+	}
+
+	if !f.multiple {
+		return toInterface(m.First())
+	}
+	return lo.Map(m.Values(), func(v *value.Value, _ int) any { return toInterface(v) })
+}
+
 // Validate the Multiple value against the Field schema
 // if its multiple it will return only the first error
 func (f *Field) Validate(m *value.Multiple) error {
@@ -177,12 +266,19 @@ func (f *Field) Validate(m *value.Multiple) error {
 }
 
 func (f *Field) ValidateValue(m *value.Multiple) error {
+	// the number of values is checked even when the value is empty
+	if f.minItems != nil && m.Len() < *f.minItems {
+		return rerror.FmtE(i18n.T("at least %d values are required"), *f.minItems)
+	}
 	if m.IsEmpty() {
 		return nil
 	}
 	if !f.multiple && m.Len() > 1 {
 		return ErrInvalidValue
 	}
+	if f.maxItems != nil && m.Len() > *f.maxItems {
+		return rerror.FmtE(i18n.T("no more than %d values are allowed"), *f.maxItems)
+	}
 	for _, v := range m.Values() {
 		if err := f.typeProperty.Validate(v); err != nil {
 			return err
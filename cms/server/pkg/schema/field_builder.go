@@ -43,6 +43,9 @@ func (b *FieldBuilder) Build() (*Field, error) {
 			Err:   fmt.Errorf("%s", b.f.key.String()),
 		}
 	}
+	if err := b.f.SetRequiredIf(b.f.requiredIf); err != nil {
+		return nil, err
+	}
 	if err := b.f.SetDefaultValue(b.dv); err != nil {
 		return nil, err
 	}
@@ -87,6 +90,11 @@ func (b *FieldBuilder) Required(required bool) *FieldBuilder {
 	return b
 }
 
+func (b *FieldBuilder) Localized(localized bool) *FieldBuilder {
+	b.f.localized = localized
+	return b
+}
+
 func (b *FieldBuilder) Order(o int) *FieldBuilder {
 	b.f.order = o
 	return b
@@ -121,3 +129,15 @@ func (b *FieldBuilder) DefaultValue(v *value.Multiple) *FieldBuilder {
 	b.dv = v
 	return b
 }
+
+func (b *FieldBuilder) ItemCount(min, max *int) *FieldBuilder {
+	if err := b.f.SetItemCount(min, max); err != nil {
+		b.err = err
+	}
+	return b
+}
+
+func (b *FieldBuilder) RequiredIf(c *RequiredCondition) *FieldBuilder {
+	b.f.requiredIf = c.Clone()
+	return b
+}
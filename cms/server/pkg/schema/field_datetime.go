@@ -1,13 +1,27 @@
 package schema
 
 import (
+	"time"
+
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
 )
 
-type FieldDateTime struct{}
+type FieldDateTime struct {
+	min *time.Time
+	max *time.Time
+}
 
-func NewDateTime() *FieldDateTime {
-	return &FieldDateTime{}
+func NewDateTime(min, max *time.Time) (*FieldDateTime, error) {
+	if min != nil && max != nil && min.After(*max) {
+		return nil, ErrInvalidMinMax
+	}
+	return &FieldDateTime{
+		min: util.CloneRef(min),
+		max: util.CloneRef(max),
+	}, nil
 }
 
 func (f *FieldDateTime) TypeProperty() *TypeProperty {
@@ -17,6 +31,14 @@ func (f *FieldDateTime) TypeProperty() *TypeProperty {
 	}
 }
 
+func (f *FieldDateTime) Min() *time.Time {
+	return util.CloneRef(f.min)
+}
+
+func (f *FieldDateTime) Max() *time.Time {
+	return util.CloneRef(f.max)
+}
+
 func (f *FieldDateTime) Type() value.Type {
 	return value.TypeDateTime
 }
@@ -25,13 +47,21 @@ func (f *FieldDateTime) Clone() *FieldDateTime {
 	if f == nil {
 		return nil
 	}
-	return &FieldDateTime{}
+	return &FieldDateTime{
+		min: util.CloneRef(f.min),
+		max: util.CloneRef(f.max),
+	}
 }
 
 func (f *FieldDateTime) Validate(v *value.Value) (err error) {
 	v.Match(value.Match{
 		DateTime: func(a value.DateTime) {
-			// ok
+			if f.min != nil && a.Before(*f.min) {
+				err = rerror.FmtE(i18n.T("value should be later than %s"), f.min.Format(time.RFC3339))
+			}
+			if f.max != nil && a.After(*f.max) {
+				err = rerror.FmtE(i18n.T("value should be earlier than %s"), f.max.Format(time.RFC3339))
+			}
 		},
 		Default: func() {
 			err = ErrInvalidValue
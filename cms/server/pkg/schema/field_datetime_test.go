@@ -5,11 +5,22 @@ import (
 	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNewDateTime(t *testing.T) {
-	assert.Equal(t, &FieldDateTime{}, NewDateTime())
+	now := time.Now()
+	f, err := NewDateTime(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &FieldDateTime{}, f)
+
+	f, err = NewDateTime(&now, &now)
+	assert.NoError(t, err)
+	assert.Equal(t, &FieldDateTime{min: &now, max: &now}, f)
+
+	_, err = NewDateTime(&now, lo.ToPtr(now.Add(-time.Hour)))
+	assert.Equal(t, ErrInvalidMinMax, err)
 }
 
 func TestFieldDateTime_Type(t *testing.T) {
@@ -17,12 +28,19 @@ func TestFieldDateTime_Type(t *testing.T) {
 }
 
 func TestFieldDateTime_Clone(t *testing.T) {
+	now := time.Now()
 	assert.Nil(t, (*FieldDateTime)(nil).Clone())
 	assert.Equal(t, &FieldDateTime{}, (&FieldDateTime{}).Clone())
+	assert.Equal(t, &FieldDateTime{min: &now}, (&FieldDateTime{min: &now}).Clone())
 }
 
 func TestFieldDateTime_Validate(t *testing.T) {
-	now := time.Now()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	assert.NoError(t, (&FieldDateTime{}).Validate(value.TypeDateTime.Value(now)))
 	assert.Equal(t, ErrInvalidValue, (&FieldDateTime{}).Validate(value.TypeText.Value("")))
+
+	f := &FieldDateTime{min: &now, max: lo.ToPtr(now.Add(time.Hour))}
+	assert.NoError(t, f.Validate(value.TypeDateTime.Value(now)))
+	assert.EqualError(t, f.Validate(value.TypeDateTime.Value(now.Add(-time.Second))), "value should be later than 2023-01-01T00:00:00Z")
+	assert.EqualError(t, f.Validate(value.TypeDateTime.Value(now.Add(2*time.Hour))), "value should be earlier than 2023-01-01T01:00:00Z")
 }
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+var ErrUnsupportedGeometryType = rerror.NewE(i18n.T("unsupported geometry type"))
+
+type FieldGeometry struct {
+	supportedTypes []value.GeometryType
+}
+
+// NewGeometry returns a geometry field which accepts the given types of geometries. All types are accepted when types is empty.
+func NewGeometry(types []value.GeometryType) *FieldGeometry {
+	return &FieldGeometry{
+		supportedTypes: lo.Uniq(lo.Filter(types, func(t value.GeometryType, _ int) bool {
+			return slices.Contains(value.GeometryTypes, t)
+		})),
+	}
+}
+
+func (f *FieldGeometry) TypeProperty() *TypeProperty {
+	return &TypeProperty{
+		t:        f.Type(),
+		geometry: f,
+	}
+}
+
+func (f *FieldGeometry) SupportedTypes() []value.GeometryType {
+	return slices.Clone(f.supportedTypes)
+}
+
+func (*FieldGeometry) Type() value.Type {
+	return value.TypeGeometry
+}
+
+func (f *FieldGeometry) Clone() *FieldGeometry {
+	if f == nil {
+		return nil
+	}
+	return &FieldGeometry{
+		supportedTypes: slices.Clone(f.supportedTypes),
+	}
+}
+
+func (f *FieldGeometry) Validate(v *value.Value) (err error) {
+	v.Match(value.Match{
+		Geometry: func(a value.Geometry) {
+			if len(f.supportedTypes) > 0 && !slices.Contains(f.supportedTypes, a.Type) {
+				err = ErrUnsupportedGeometryType
+			}
+		},
+		Default: func() {
+			err = ErrInvalidValue
+		},
+	})
+	return
+}
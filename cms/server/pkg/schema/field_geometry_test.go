@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGeometry(t *testing.T) {
+	assert.Equal(t, &FieldGeometry{supportedTypes: []value.GeometryType{value.GeometryTypePoint}}, NewGeometry([]value.GeometryType{value.GeometryTypePoint, "Circle", value.GeometryTypePoint}))
+}
+
+func TestFieldGeometry_Type(t *testing.T) {
+	assert.Equal(t, value.TypeGeometry, (&FieldGeometry{}).Type())
+}
+
+func TestFieldGeometry_Clone(t *testing.T) {
+	assert.Nil(t, (*FieldGeometry)(nil).Clone())
+	f := NewGeometry([]value.GeometryType{value.GeometryTypePolygon})
+	assert.Equal(t, f, f.Clone())
+}
+
+func TestFieldGeometry_Validate(t *testing.T) {
+	point := value.TypeGeometry.Value(map[string]any{"type": "Point", "coordinates": []any{139.7, 35.6}})
+	assert.NoError(t, NewGeometry(nil).Validate(point))
+	assert.NoError(t, NewGeometry([]value.GeometryType{value.GeometryTypePoint}).Validate(point))
+	assert.Same(t, ErrUnsupportedGeometryType, NewGeometry([]value.GeometryType{value.GeometryTypePolygon}).Validate(point))
+	assert.Same(t, ErrInvalidValue, NewGeometry(nil).Validate(value.TypeText.Value("a")))
+}
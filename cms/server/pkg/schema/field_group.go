@@ -0,0 +1,149 @@
+package schema
+
+import (
+	"reflect"
+
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+var ErrInvalidGroupField = rerror.NewE(i18n.T("invalid group field"))
+
+// FieldGroup is a type property of a field which holds a set of sub-fields like a nested object.
+// A group field which allows multiple values is a repeatable and ordered list of the sets.
+type FieldGroup struct {
+	fields FieldList
+}
+
+// NewGroup returns a group of the fields. Groups cannot be nested, and IDs and keys of the fields should be unique in the group.
+func NewGroup(fields FieldList) (*FieldGroup, error) {
+	if lo.SomeBy(fields, func(f *Field) bool { return f == nil || f.Type() == value.TypeGroup }) {
+		return nil, ErrInvalidGroupField
+	}
+	if len(lo.UniqBy(fields, func(f *Field) FieldID { return f.ID() })) != len(fields) ||
+		len(lo.UniqBy(fields, func(f *Field) string { return f.Key().String() })) != len(fields) {
+		return nil, ErrInvalidGroupField
+	}
+	return &FieldGroup{
+		fields: fields.Clone(),
+	}, nil
+}
+
+func (f *FieldGroup) TypeProperty() *TypeProperty {
+	return &TypeProperty{
+		t:     f.Type(),
+		group: f,
+	}
+}
+
+func (f *FieldGroup) Fields() FieldList {
+	return f.fields.Clone().Ordered()
+}
+
+func (f *FieldGroup) Field(fid FieldID) *Field {
+	return f.fields.Find(fid)
+}
+
+func (*FieldGroup) Type() value.Type {
+	return value.TypeGroup
+}
+
+func (f *FieldGroup) Clone() *FieldGroup {
+	if f == nil {
+		return nil
+	}
+	return &FieldGroup{
+		fields: f.fields.Clone(),
+	}
+}
+
+func (f *FieldGroup) Validate(v *value.Value) (err error) {
+	v.Match(value.Match{
+		Group: func(a value.Group) {
+			for fid := range a {
+				if f.fields.Find(fid) == nil {
+					err = ErrInvalidValue
+					return
+				}
+			}
+			for _, sf := range f.fields.Ordered() {
+				if err2 := sf.Validate(a[sf.ID()]); err2 != nil {
+					err = rerror.FmtE(i18n.T("field %s: %w"), sf.Name(), err2)
+					return
+				}
+			}
+		},
+		Default: func() {
+			err = ErrInvalidValue
+		},
+	})
+	return
+}
+
+// ValueFrom converts an object whose keys are keys or IDs of the sub-fields into a group value.
+// It returns nil when the object has an unknown key.
+func (f *FieldGroup) ValueFrom(i any) *value.Value {
+	if g, ok := i.(value.Group); ok {
+		return value.TypeGroup.Value(g)
+	}
+
+	m, ok := i.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	g := value.Group{}
+	for k, v := range m {
+		sf := f.fieldByIDOrKey(k)
+		if sf == nil {
+			return nil
+		}
+		g[sf.ID()] = value.NewMultiple(sf.Type(), toSlice(v, sf.Multiple()))
+	}
+	return value.TypeGroup.Value(g)
+}
+
+// Interface converts a group value into an object keyed by the keys of the sub-fields, or by the IDs when byKey is false.
+func (f *FieldGroup) Interface(g value.Group, byKey bool) map[string]any {
+	res := map[string]any{}
+	for _, sf := range f.fields.Ordered() {
+		m, ok := g[sf.ID()]
+		if !ok {
+			continue
+		}
+		k := sf.ID().String()
+		if byKey {
+			k = sf.Key().String()
+		}
+		if sf.Multiple() {
+			res[k] = m.Interface()
+		} else {
+			res[k] = m.First().Interface()
+		}
+	}
+	return res
+}
+
+func (f *FieldGroup) fieldByIDOrKey(k string) *Field {
+	sf, _ := lo.Find(f.fields, func(sf *Field) bool {
+		return sf.Key().String() == k || sf.ID().String() == k
+	})
+	return sf
+}
+
+func toSlice(v any, multiple bool) []any {
+	if !multiple || v == nil {
+		return []any{v}
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []any{v}
+	}
+	res := make([]any, rv.Len())
+	for i := range res {
+		res[i] = rv.Index(i).Interface()
+	}
+	return res
+}
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGroup(t *testing.T) {
+	f1 := NewField(NewText(nil).TypeProperty()).NewID().Key(key.New("name")).MustBuild()
+	f2 := NewField(NewURL().TypeProperty()).NewID().Key(key.New("url")).Multiple(true).MustBuild()
+
+	g, err := NewGroup(FieldList{f1, f2})
+	assert.NoError(t, err)
+	assert.Equal(t, FieldList{f1, f2}, g.Fields())
+	assert.Equal(t, f2, g.Field(f2.ID()))
+
+	_, err = NewGroup(FieldList{f1, f1})
+	assert.Same(t, ErrInvalidGroupField, err)
+
+	nested := NewField(g.TypeProperty()).NewID().Key(key.New("nested")).MustBuild()
+	_, err = NewGroup(FieldList{f1, nested})
+	assert.Same(t, ErrInvalidGroupField, err)
+}
+
+func TestFieldGroup_Clone(t *testing.T) {
+	assert.Nil(t, (*FieldGroup)(nil).Clone())
+	g := lo.Must(NewGroup(FieldList{NewField(NewText(nil).TypeProperty()).NewID().RandomKey().MustBuild()}))
+	assert.Equal(t, g, g.Clone())
+}
+
+func TestFieldGroup_ValueFromAndInterface(t *testing.T) {
+	f1 := NewField(NewText(nil).TypeProperty()).NewID().Key(key.New("name")).MustBuild()
+	f2 := NewField(NewURL().TypeProperty()).NewID().Key(key.New("url")).Multiple(true).MustBuild()
+	g := lo.Must(NewGroup(FieldList{f1, f2}))
+
+	v := g.ValueFrom(map[string]any{
+		"name":           "urf",
+		f2.ID().String(): []any{"https://example.com/a", "https://example.com/b"},
+	})
+	assert.Equal(t, value.TypeGroup.Value(value.Group{
+		f1.ID(): value.TypeText.Value("urf").AsMultiple(),
+		f2.ID(): value.NewMultiple(value.TypeURL, []any{"https://example.com/a", "https://example.com/b"}),
+	}), v)
+	assert.Nil(t, g.ValueFrom(map[string]any{"unknown": "a"}))
+	assert.Nil(t, g.ValueFrom("a"))
+
+	gv, _ := v.ValueGroup()
+	assert.Equal(t, map[string]any{
+		"name": "urf",
+		"url":  []any{"https://example.com/a", "https://example.com/b"},
+	}, g.Interface(gv, true))
+	assert.Equal(t, map[string]any{
+		f1.ID().String(): "urf",
+		f2.ID().String(): []any{"https://example.com/a", "https://example.com/b"},
+	}, g.Interface(gv, false))
+}
+
+func TestFieldGroup_Validate(t *testing.T) {
+	f1 := NewField(NewText(lo.ToPtr(3)).TypeProperty()).NewID().Name("name").Key(key.New("name")).Required(true).MustBuild()
+	g := lo.Must(NewGroup(FieldList{f1}))
+
+	assert.NoError(t, g.Validate(g.ValueFrom(map[string]any{"name": "urf"})))
+	assert.EqualError(t, g.Validate(g.ValueFrom(map[string]any{})), "field name: value is required")
+	assert.ErrorContains(t, g.Validate(g.ValueFrom(map[string]any{"name": "abcd"})), "field name: value has 4 characters")
+	assert.Same(t, ErrInvalidValue, g.Validate(value.TypeGroup.Value(value.Group{NewFieldID(): value.TypeText.Value("a").AsMultiple()})))
+	assert.Same(t, ErrInvalidValue, g.Validate(value.TypeText.Value("a")))
+}
+
+func TestField_NewValue(t *testing.T) {
+	f1 := NewField(NewText(nil).TypeProperty()).NewID().Key(key.New("name")).MustBuild()
+	gf := NewField(lo.Must(NewGroup(FieldList{f1})).TypeProperty()).NewID().RandomKey().Multiple(true).MustBuild()
+
+	assert.Equal(t, value.MultipleFrom(value.TypeGroup, []*value.Value{
+		value.TypeGroup.Value(value.Group{f1.ID(): value.TypeText.Value("a").AsMultiple()}),
+		value.TypeGroup.Value(value.Group{f1.ID(): value.TypeText.Value("b").AsMultiple()}),
+	}), gf.NewValue([]any{map[string]any{"name": "a"}, map[string]any{"name": "b"}}))
+	assert.Equal(t, value.TypeText.Value("a").AsMultiple(), f1.NewValue([]any{"a"}))
+}
+
+func TestField_ValueInterface(t *testing.T) {
+	f1 := NewField(NewText(nil).TypeProperty()).NewID().Key(key.New("name")).MustBuild()
+	gf := NewField(lo.Must(NewGroup(FieldList{f1})).TypeProperty()).NewID().RandomKey().Multiple(true).MustBuild()
+	v := gf.NewValue([]any{map[string]any{"name": "a"}})
+
+	assert.Equal(t, []any{map[string]any{"name": "a"}}, gf.ValueInterface(v, true))
+	assert.Equal(t, []any{map[string]any{f1.ID().String(): "a"}}, gf.ValueInterface(v, false))
+	assert.Equal(t, "a", f1.ValueInterface(value.TypeText.Value("a").AsMultiple(), true))
+	assert.Nil(t, f1.ValueInterface(nil, true))
+}
@@ -23,6 +23,14 @@ func (f *FieldMarkdown) MaxLength() *int {
 	return f.s.MaxLength()
 }
 
+func (f *FieldMarkdown) Pattern() *string {
+	return f.s.Pattern()
+}
+
+func (f *FieldMarkdown) SetPattern(p *string) error {
+	return f.s.SetPattern(p)
+}
+
 func (f *FieldMarkdown) Type() value.Type {
 	return f.s.Type()
 }
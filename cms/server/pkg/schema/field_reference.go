@@ -4,10 +4,37 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
 )
 
+var ErrInvalidReferenceOnDelete = rerror.NewE(i18n.T("invalid on-delete behavior of reference"))
+
+const (
+	// ReferenceOnDeleteNullify removes references to the deleted item from the referencing items
+	ReferenceOnDeleteNullify ReferenceOnDelete = "nullify"
+	// ReferenceOnDeleteRestrict prevents referenced items from being deleted
+	ReferenceOnDeleteRestrict ReferenceOnDelete = "restrict"
+	// ReferenceOnDeleteCascade deletes the referencing items together with the deleted item
+	ReferenceOnDeleteCascade ReferenceOnDelete = "cascade"
+)
+
+// ReferenceOnDelete is the behavior of a reference field when the referenced item is deleted
+type ReferenceOnDelete string
+
+func ReferenceOnDeleteFrom(s string) (ReferenceOnDelete, bool) {
+	switch r := ReferenceOnDelete(s); r {
+	case ReferenceOnDeleteNullify, ReferenceOnDeleteRestrict, ReferenceOnDeleteCascade:
+		return r, true
+	case "":
+		return ReferenceOnDeleteNullify, true
+	}
+	return "", false
+}
+
 type FieldReference struct {
-	modelID id.ModelID
+	modelID  id.ModelID
+	onDelete ReferenceOnDelete
 }
 
 func NewReference(id id.ModelID) *FieldReference {
@@ -27,6 +54,23 @@ func (f *FieldReference) Model() model.ID {
 	return f.modelID
 }
 
+// OnDelete returns the behavior when the referenced item is deleted. References are nullified by default.
+func (f *FieldReference) OnDelete() ReferenceOnDelete {
+	if f.onDelete == "" {
+		return ReferenceOnDeleteNullify
+	}
+	return f.onDelete
+}
+
+func (f *FieldReference) SetOnDelete(r ReferenceOnDelete) error {
+	r, ok := ReferenceOnDeleteFrom(string(r))
+	if !ok {
+		return ErrInvalidReferenceOnDelete
+	}
+	f.onDelete = r
+	return nil
+}
+
 func (f *FieldReference) Type() value.Type {
 	return value.TypeReference
 }
@@ -36,7 +80,8 @@ func (f *FieldReference) Clone() *FieldReference {
 		return nil
 	}
 	return &FieldReference{
-		modelID: f.modelID,
+		modelID:  f.modelID,
+		onDelete: f.onDelete,
 	}
 }
 
@@ -13,6 +13,26 @@ func TestNewReference(t *testing.T) {
 	assert.Equal(t, &FieldReference{modelID: m}, NewReference(m))
 }
 
+func TestReferenceOnDeleteFrom(t *testing.T) {
+	r, ok := ReferenceOnDeleteFrom("cascade")
+	assert.True(t, ok)
+	assert.Equal(t, ReferenceOnDeleteCascade, r)
+	r, ok = ReferenceOnDeleteFrom("")
+	assert.True(t, ok)
+	assert.Equal(t, ReferenceOnDeleteNullify, r)
+	_, ok = ReferenceOnDeleteFrom("xxx")
+	assert.False(t, ok)
+}
+
+func TestFieldReference_OnDelete(t *testing.T) {
+	f := NewReference(id.NewModelID())
+	assert.Equal(t, ReferenceOnDeleteNullify, f.OnDelete())
+	assert.NoError(t, f.SetOnDelete(ReferenceOnDeleteRestrict))
+	assert.Equal(t, ReferenceOnDeleteRestrict, f.OnDelete())
+	assert.Equal(t, ErrInvalidReferenceOnDelete, f.SetOnDelete("xxx"))
+	assert.Equal(t, ReferenceOnDeleteRestrict, f.OnDelete())
+}
+
 func TestFieldReference_Type(t *testing.T) {
 	assert.Equal(t, value.TypeReference, (&FieldReference{}).Type())
 }
@@ -20,7 +40,7 @@ func TestFieldReference_Type(t *testing.T) {
 func TestFieldReference_Clone(t *testing.T) {
 	m := id.NewModelID()
 	assert.Nil(t, (*FieldReference)(nil).Clone())
-	assert.Equal(t, &FieldReference{modelID: m}, (&FieldReference{modelID: m}).Clone())
+	assert.Equal(t, &FieldReference{modelID: m, onDelete: ReferenceOnDeleteCascade}, (&FieldReference{modelID: m, onDelete: ReferenceOnDeleteCascade}).Clone())
 }
 
 func TestFieldReference_Validate(t *testing.T) {
@@ -23,6 +23,14 @@ func (f *FieldRichText) MaxLength() *int {
 	return f.s.MaxLength()
 }
 
+func (f *FieldRichText) Pattern() *string {
+	return f.s.Pattern()
+}
+
+func (f *FieldRichText) SetPattern(p *string) error {
+	return f.s.SetPattern(p)
+}
+
 func (f *FieldRichText) Type() value.Type {
 	return f.s.Type()
 }
@@ -2,15 +2,21 @@ package schema
 
 import (
 	"fmt"
+	"regexp"
 	"unicode/utf8"
 
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/util"
 )
 
+var ErrInvalidPattern = rerror.NewE(i18n.T("invalid pattern"))
+
 type FieldString struct {
 	t         value.Type
 	maxLength *int
+	pattern   *regexp.Regexp
 }
 
 func NewString(t value.Type, maxLength *int) *FieldString {
@@ -24,6 +30,20 @@ func (f *FieldString) MaxLength() *int {
 	return util.CloneRef(f.maxLength)
 }
 
+func (f *FieldString) Pattern() *string {
+	return patternString(f.pattern)
+}
+
+// SetPattern sets a regular expression which values should match. nil or an empty string removes the pattern.
+func (f *FieldString) SetPattern(p *string) error {
+	re, err := compilePattern(p)
+	if err != nil {
+		return err
+	}
+	f.pattern = re
+	return nil
+}
+
 func (f *FieldString) Type() value.Type {
 	return f.t
 }
@@ -35,6 +55,7 @@ func (f *FieldString) Clone() *FieldString {
 	return &FieldString{
 		t:         f.t,
 		maxLength: util.CloneRef(f.maxLength),
+		pattern:   f.pattern,
 	}
 }
 
@@ -55,5 +76,33 @@ func (f *FieldString) Validate(v *value.Value) error {
 		}
 	}
 
-	return nil
+	return validatePattern(f.pattern, s)
+}
+
+func compilePattern(p *string) (*regexp.Regexp, error) {
+	if p == nil || *p == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(*p)
+	if err != nil {
+		return nil, &rerror.Error{
+			Label: ErrInvalidPattern,
+			Err:   err,
+		}
+	}
+	return re, nil
+}
+
+func patternString(re *regexp.Regexp) *string {
+	if re == nil {
+		return nil
+	}
+	return util.ToPtrIfNotEmpty(re.String())
+}
+
+func validatePattern(re *regexp.Regexp, s string) error {
+	if re == nil || s == "" || re.MatchString(s) {
+		return nil
+	}
+	return rerror.FmtE(i18n.T("value does not match the pattern %s"), re.String())
 }
@@ -32,3 +32,21 @@ func TestFieldString_Validate(t *testing.T) {
 	assert.NoError(t, (&FieldString{t: value.TypeText}).Validate(value.TypeText.Value("aaa")))
 	assert.Equal(t, ErrInvalidValue, (&FieldString{t: value.TypeText}).Validate(value.TypeNumber.Value(1)))
 }
+
+func TestFieldString_SetPattern(t *testing.T) {
+	f := NewString(value.TypeText, nil)
+	assert.NoError(t, f.SetPattern(lo.ToPtr(`^\d{5}$`)))
+	assert.Equal(t, lo.ToPtr(`^\d{5}$`), f.Pattern())
+	assert.Equal(t, f.Pattern(), f.Clone().Pattern())
+
+	assert.NoError(t, f.Validate(value.TypeText.Value("13101")))
+	assert.NoError(t, f.Validate(value.TypeText.Value("")))
+	assert.EqualError(t, f.Validate(value.TypeText.Value("1310")), `value does not match the pattern ^\d{5}$`)
+
+	err := f.SetPattern(lo.ToPtr("("))
+	assert.ErrorContains(t, err, "invalid pattern")
+	assert.Equal(t, lo.ToPtr(`^\d{5}$`), f.Pattern())
+
+	assert.NoError(t, f.SetPattern(lo.ToPtr("")))
+	assert.Nil(t, f.Pattern())
+}
@@ -74,6 +74,7 @@ func TestField_Clone(t *testing.T) {
 		unique:       true,
 		multiple:     true,
 		required:     true,
+		localized:    true,
 		typeProperty: NewText(nil).TypeProperty(),
 		defaultValue: value.TypeText.Value("aa").AsMultiple(),
 		updatedAt:    time.Now(),
@@ -87,6 +88,13 @@ func TestField_Clone(t *testing.T) {
 	assert.Nil(t, c)
 }
 
+func TestField_SetLocalized(t *testing.T) {
+	f := &Field{}
+	f.SetLocalized(true)
+	assert.True(t, f.Localized())
+	assert.True(t, NewField(NewText(nil).TypeProperty()).NewID().RandomKey().Localized(true).MustBuild().Localized())
+}
+
 func TestField_SetRequired(t *testing.T) {
 	f := &Field{required: false}
 	f.SetRequired(true)
@@ -171,3 +179,35 @@ func TestField_Validate(t *testing.T) {
 
 	assert.ErrorContains(t, f.Validate(value.TypeText.Value("aaa").AsMultiple()), "it sholud be shorter than 1 characters")
 }
+
+func TestField_SetItemCount(t *testing.T) {
+	f := &Field{typeProperty: NewText(nil).TypeProperty(), multiple: true}
+	assert.NoError(t, f.SetItemCount(nil, lo.ToPtr(2)))
+	assert.NoError(t, f.Validate(nil))
+
+	assert.NoError(t, f.SetItemCount(lo.ToPtr(1), lo.ToPtr(2)))
+	assert.Equal(t, lo.ToPtr(1), f.MinItems())
+	assert.Equal(t, lo.ToPtr(2), f.MaxItems())
+
+	assert.EqualError(t, f.Validate(nil), "at least 1 values are required")
+	assert.NoError(t, f.Validate(value.NewMultiple(value.TypeText, []any{"a", "b"})))
+	assert.EqualError(t, f.Validate(value.NewMultiple(value.TypeText, []any{"a", "b", "c"})), "no more than 2 values are allowed")
+
+	assert.NoError(t, f.SetItemCount(lo.ToPtr(2), nil))
+	assert.EqualError(t, f.Validate(value.NewMultiple(value.TypeText, []any{"a"})), "at least 2 values are required")
+	assert.EqualError(t, f.Validate(nil), "at least 2 values are required")
+
+	assert.Same(t, ErrInvalidItemCount, f.SetItemCount(lo.ToPtr(2), lo.ToPtr(1)))
+	assert.Same(t, ErrInvalidItemCount, f.SetItemCount(lo.ToPtr(-1), nil))
+	assert.Equal(t, lo.ToPtr(2), f.MinItems())
+}
+
+func TestField_SetRequiredIf(t *testing.T) {
+	f := &Field{id: NewFieldID()}
+	c := NewRequiredCondition(NewFieldID(), []string{"a"})
+	assert.NoError(t, f.SetRequiredIf(c))
+	assert.Equal(t, c, f.RequiredIf())
+	assert.Same(t, ErrInvalidCondition, f.SetRequiredIf(NewRequiredCondition(f.id, []string{"a"})))
+	assert.NoError(t, f.SetRequiredIf(nil))
+	assert.Nil(t, f.RequiredIf())
+}
@@ -23,6 +23,14 @@ func (f *FieldText) MaxLength() *int {
 	return f.s.MaxLength()
 }
 
+func (f *FieldText) Pattern() *string {
+	return f.s.Pattern()
+}
+
+func (f *FieldText) SetPattern(p *string) error {
+	return f.s.SetPattern(p)
+}
+
 func (f *FieldText) Type() value.Type {
 	return f.s.Type()
 }
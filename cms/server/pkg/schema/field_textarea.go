@@ -23,6 +23,14 @@ func (f *FieldTextArea) MaxLength() *int {
 	return f.s.MaxLength()
 }
 
+func (f *FieldTextArea) Pattern() *string {
+	return f.s.Pattern()
+}
+
+func (f *FieldTextArea) SetPattern(p *string) error {
+	return f.s.SetPattern(p)
+}
+
 func (f *FieldTextArea) Type() value.Type {
 	return f.s.Type()
 }
@@ -1,8 +1,13 @@
 package schema
 
-import "github.com/reearth/reearth-cms/server/pkg/value"
+import (
+	"regexp"
+
+	"github.com/reearth/reearth-cms/server/pkg/value"
+)
 
 type FieldURL struct {
+	pattern *regexp.Regexp
 }
 
 func NewURL() *FieldURL {
@@ -20,17 +25,33 @@ func (*FieldURL) Type() value.Type {
 	return value.TypeURL
 }
 
+func (f *FieldURL) Pattern() *string {
+	return patternString(f.pattern)
+}
+
+// SetPattern sets a regular expression which URLs should match. nil or an empty string removes the pattern.
+func (f *FieldURL) SetPattern(p *string) error {
+	re, err := compilePattern(p)
+	if err != nil {
+		return err
+	}
+	f.pattern = re
+	return nil
+}
+
 func (f *FieldURL) Clone() *FieldURL {
 	if f == nil {
 		return nil
 	}
-	return &FieldURL{}
+	return &FieldURL{
+		pattern: f.pattern,
+	}
 }
 
 func (f *FieldURL) Validate(v *value.Value) (err error) {
 	v.Match(value.Match{
 		URL: func(a value.URL) {
-			// ok
+			err = validatePattern(f.pattern, a.String())
 		},
 		Default: func() {
 			err = ErrInvalidValue
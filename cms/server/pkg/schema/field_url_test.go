@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,3 +25,12 @@ func TestFieldURL_Validate(t *testing.T) {
 	assert.NoError(t, (&FieldURL{}).Validate(value.TypeURL.Value("https://example.com")))
 	assert.Equal(t, ErrInvalidValue, (&FieldURL{}).Validate(value.TypeText.Value("")))
 }
+
+func TestFieldURL_SetPattern(t *testing.T) {
+	f := NewURL()
+	assert.NoError(t, f.SetPattern(lo.ToPtr(`^https://`)))
+	assert.Equal(t, lo.ToPtr(`^https://`), f.Pattern())
+	assert.NoError(t, f.Validate(value.TypeURL.Value("https://example.com")))
+	assert.EqualError(t, f.Validate(value.TypeURL.Value("http://example.com")), "value does not match the pattern ^https://")
+	assert.ErrorContains(t, f.SetPattern(lo.ToPtr("[")), "invalid pattern")
+}
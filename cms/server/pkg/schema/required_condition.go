@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+// RequiredCondition makes a field required when another field has one of the values
+type RequiredCondition struct {
+	field  FieldID
+	values []string
+}
+
+func NewRequiredCondition(field FieldID, values []string) *RequiredCondition {
+	values = lo.Uniq(lo.Filter(values, func(v string, _ int) bool { return v != "" }))
+	if field.IsNil() || len(values) == 0 {
+		return nil
+	}
+	return &RequiredCondition{
+		field:  field,
+		values: values,
+	}
+}
+
+func (c *RequiredCondition) Field() FieldID {
+	return c.field
+}
+
+func (c *RequiredCondition) Values() []string {
+	return slices.Clone(c.values)
+}
+
+func (c *RequiredCondition) Clone() *RequiredCondition {
+	if c == nil {
+		return nil
+	}
+	return &RequiredCondition{
+		field:  c.field,
+		values: slices.Clone(c.values),
+	}
+}
+
+// Match returns true when any of the values equals one of the values of the condition
+func (c *RequiredCondition) Match(m *value.Multiple) bool {
+	if c == nil || m.IsEmpty() {
+		return false
+	}
+	return lo.SomeBy(m.Values(), func(v *value.Value) bool {
+		return !v.IsEmpty() && slices.Contains(c.values, fmt.Sprint(v.Interface()))
+	})
+}
@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequiredCondition(t *testing.T) {
+	fid := NewFieldID()
+	assert.Equal(t, &RequiredCondition{field: fid, values: []string{"a", "b"}}, NewRequiredCondition(fid, []string{"a", "", "b", "a"}))
+	assert.Nil(t, NewRequiredCondition(fid, []string{""}))
+	assert.Nil(t, NewRequiredCondition(FieldID{}, []string{"a"}))
+}
+
+func TestRequiredCondition_Match(t *testing.T) {
+	c := NewRequiredCondition(NewFieldID(), []string{"a", "1"})
+	assert.True(t, c.Match(value.TypeSelect.Value("a").AsMultiple()))
+	assert.True(t, c.Match(value.NewMultiple(value.TypeInteger, []any{2, 1})))
+	assert.False(t, c.Match(value.TypeSelect.Value("b").AsMultiple()))
+	assert.False(t, c.Match(nil))
+	assert.False(t, (*RequiredCondition)(nil).Match(value.TypeSelect.Value("a").AsMultiple()))
+}
@@ -1,8 +1,13 @@
 package schema
 
 import (
+	"strings"
+
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
 	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
 )
@@ -56,6 +61,22 @@ func (s *Schema) Field(fId FieldID) *Field {
 	return f
 }
 
+// FieldOrSubField returns the field, or the sub-field of a group field of the schema
+func (s *Schema) FieldOrSubField(fId FieldID) *Field {
+	if f := s.Field(fId); f != nil {
+		return f
+	}
+	for _, f := range s.fields {
+		if f.typeProperty == nil || f.typeProperty.group == nil {
+			continue
+		}
+		if sf := f.typeProperty.group.Field(fId); sf != nil {
+			return sf
+		}
+	}
+	return nil
+}
+
 func (s *Schema) FieldByIDOrKey(fId *FieldID, key *key.Key) *Field {
 	f, _ := lo.Find(s.fields, func(f *Field) bool {
 		return fId != nil && f.id == *fId || key != nil && key.IsValid() && f.key == *key
@@ -77,6 +98,22 @@ func (s *Schema) RemoveField(fid FieldID) {
 	}
 }
 
+// ValidateRequiredConditions checks that the fields which have a required condition have values when the condition matches
+func (s *Schema) ValidateRequiredConditions(values map[FieldID]*value.Multiple) error {
+	for _, f := range s.Fields() {
+		c := f.RequiredIf()
+		if c == nil || !values[f.ID()].IsEmpty() || !c.Match(values[c.Field()]) {
+			continue
+		}
+		cf := s.Field(c.Field())
+		if cf == nil {
+			continue
+		}
+		return rerror.FmtE(i18n.T("field %s is required when field %s is %s"), f.Name(), cf.Name(), strings.Join(c.Values(), ", "))
+	}
+	return nil
+}
+
 func (s *Schema) Clone() *Schema {
 	if s == nil {
 		return nil
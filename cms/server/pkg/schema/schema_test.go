@@ -5,6 +5,8 @@ import (
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -198,6 +200,18 @@ func TestSchema_Field(t *testing.T) {
 	}
 }
 
+func TestSchema_FieldOrSubField(t *testing.T) {
+	f1 := NewField(NewText(nil).TypeProperty()).NewID().RandomKey().MustBuild()
+	sf := NewField(NewReference(id.NewModelID()).TypeProperty()).NewID().RandomKey().MustBuild()
+	f2 := NewField(lo.Must(NewGroup(FieldList{sf})).TypeProperty()).NewID().RandomKey().MustBuild()
+	s := &Schema{fields: []*Field{{id: NewFieldID()}, f1, f2}}
+
+	assert.Equal(t, f1, s.FieldOrSubField(f1.ID()))
+	assert.Equal(t, f2, s.FieldOrSubField(f2.ID()))
+	assert.Equal(t, sf, s.FieldOrSubField(sf.ID()))
+	assert.Nil(t, s.FieldOrSubField(NewFieldID()))
+}
+
 func TestSchema_FieldByIDOrKey(t *testing.T) {
 	f1 := &Field{id: NewFieldID(), name: "f1"}
 	f2 := &Field{id: NewFieldID(), name: "f2"}
@@ -390,3 +404,21 @@ func TestSchema_HasFieldByKey(t *testing.T) {
 		})
 	}
 }
+
+func TestSchema_ValidateRequiredConditions(t *testing.T) {
+	f1 := NewField(NewSelect([]string{"a", "b"}).TypeProperty()).NewID().Name("type").RandomKey().MustBuild()
+	f2 := NewField(NewText(nil).TypeProperty()).NewID().Name("code").RandomKey().RequiredIf(NewRequiredCondition(f1.ID(), []string{"a"})).MustBuild()
+	s := &Schema{fields: []*Field{f1, f2}}
+
+	assert.NoError(t, s.ValidateRequiredConditions(nil))
+	assert.NoError(t, s.ValidateRequiredConditions(map[FieldID]*value.Multiple{
+		f1.ID(): value.TypeSelect.Value("b").AsMultiple(),
+	}))
+	assert.NoError(t, s.ValidateRequiredConditions(map[FieldID]*value.Multiple{
+		f1.ID(): value.TypeSelect.Value("a").AsMultiple(),
+		f2.ID(): value.TypeText.Value("x").AsMultiple(),
+	}))
+	assert.EqualError(t, s.ValidateRequiredConditions(map[FieldID]*value.Multiple{
+		f1.ID(): value.TypeSelect.Value("a").AsMultiple(),
+	}), "field code is required when field type is a")
+}
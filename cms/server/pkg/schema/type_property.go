@@ -24,6 +24,8 @@ type TypeProperty struct {
 	number    *FieldNumber
 	reference *FieldReference
 	url       *FieldURL
+	geometry  *FieldGeometry
+	group     *FieldGroup
 }
 
 type TypePropertyMatch struct {
@@ -39,6 +41,8 @@ type TypePropertyMatch struct {
 	Number    func(*FieldNumber)
 	Reference func(*FieldReference)
 	URL       func(*FieldURL)
+	Geometry  func(*FieldGeometry)
+	Group     func(*FieldGroup)
 	Default   func()
 }
 
@@ -55,6 +59,8 @@ type TypePropertyMatch1[T any] struct {
 	Number    func(*FieldNumber) T
 	Reference func(*FieldReference) T
 	URL       func(*FieldURL) T
+	Geometry  func(*FieldGeometry) T
+	Group     func(*FieldGroup) T
 	Default   func() T
 }
 
@@ -100,6 +106,12 @@ func (t *TypeProperty) Validate(v *value.Value) error {
 		URL: func(f *FieldURL) error {
 			return f.Validate(v)
 		},
+		Geometry: func(f *FieldGeometry) error {
+			return f.Validate(v)
+		},
+		Group: func(f *FieldGroup) error {
+			return f.Validate(v)
+		},
 	})
 }
 
@@ -172,6 +184,16 @@ func (t *TypeProperty) Match(m TypePropertyMatch) {
 			m.URL(t.url)
 			return
 		}
+	case value.TypeGeometry:
+		if m.Geometry != nil {
+			m.Geometry(t.geometry)
+			return
+		}
+	case value.TypeGroup:
+		if m.Group != nil {
+			m.Group(t.group)
+			return
+		}
 	}
 
 	if m.Default != nil {
@@ -198,6 +220,8 @@ func (t *TypeProperty) Clone() *TypeProperty {
 		integer:   t.integer.Clone(),
 		reference: t.reference.Clone(),
 		url:       t.url.Clone(),
+		geometry:  t.geometry.Clone(),
+		group:     t.group.Clone(),
 	}
 }
 
@@ -258,6 +282,14 @@ func MatchTypeProperty1[T any](t *TypeProperty, m TypePropertyMatch1[T]) (res T)
 		if m.URL != nil {
 			return m.URL(t.url)
 		}
+	case value.TypeGeometry:
+		if m.Geometry != nil {
+			return m.Geometry(t.geometry)
+		}
+	case value.TypeGroup:
+		if m.Group != nil {
+			return m.Group(t.group)
+		}
 	}
 
 	if m.Default != nil {
@@ -0,0 +1,116 @@
+package task
+
+import "time"
+
+type Builder struct {
+	t *Task
+}
+
+func New() *Builder {
+	return &Builder{t: &Task{}}
+}
+
+func (b *Builder) Build() (*Task, error) {
+	if b.t.id.IsNil() || b.t.workspace.IsNil() || b.t.project.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if b.t.typ == "" {
+		return nil, ErrInvalidType
+	}
+	if b.t.typ == TypeImportItems && b.t.importItems == nil || b.t.typ == TypeExportItems && b.t.exportItems == nil ||
+		b.t.typ == TypeMigrateFields && b.t.migrate == nil {
+		return nil, ErrInvalidConfig
+	}
+	if b.t.status == "" {
+		b.t.status = StatusPending
+	}
+	return b.t, nil
+}
+
+func (b *Builder) MustBuild() *Task {
+	r, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (b *Builder) NewID() *Builder {
+	b.t.id = NewID()
+	return b
+}
+
+func (b *Builder) ID(id ID) *Builder {
+	b.t.id = id
+	return b
+}
+
+func (b *Builder) Workspace(wid WorkspaceID) *Builder {
+	b.t.workspace = wid
+	return b
+}
+
+func (b *Builder) Project(pid ProjectID) *Builder {
+	b.t.project = pid
+	return b
+}
+
+func (b *Builder) User(uid *UserID) *Builder {
+	b.t.user = uid.CloneRef()
+	return b
+}
+
+func (b *Builder) Integration(iid *IntegrationID) *Builder {
+	b.t.integration = iid.CloneRef()
+	return b
+}
+
+func (b *Builder) Type(t Type) *Builder {
+	b.t.typ = t
+	return b
+}
+
+func (b *Builder) Status(s Status) *Builder {
+	b.t.status = s
+	return b
+}
+
+func (b *Builder) Progress(p Progress) *Builder {
+	b.t.progress = p
+	return b
+}
+
+func (b *Builder) Errors(errs []Error) *Builder {
+	b.t.errors = errs
+	return b
+}
+
+func (b *Builder) Message(m string) *Builder {
+	b.t.message = m
+	return b
+}
+
+func (b *Builder) ImportItems(c *ImportItemsConfig) *Builder {
+	b.t.importItems = c.Clone()
+	return b
+}
+
+func (b *Builder) ExportItems(c *ExportItemsConfig) *Builder {
+	b.t.exportItems = c.Clone()
+	return b
+}
+
+func (b *Builder) MigrateFields(c *MigrateFieldsConfig) *Builder {
+	b.t.migrate = c.Clone()
+	return b
+}
+
+func (b *Builder) Asset(aid *AssetID) *Builder {
+	b.t.asset = aid.CloneRef()
+	return b
+}
+
+func (b *Builder) UpdatedAt(t time.Time) *Builder {
+	b.t.updatedAt = t
+	return b
+}
@@ -0,0 +1,33 @@
+package task
+
+import "golang.org/x/exp/slices"
+
+// ExportItemsConfig is the configuration of a task which exports items of a model to an asset
+type ExportItemsConfig struct {
+	ModelID ModelID
+	// Format is the format of the asset: csv, jsonl, geojson or xlsx
+	Format string
+	// Keyword narrows down items which have the keyword in their values
+	Keyword string
+	// Filters narrows down items by values of fields
+	Filters []ExportFilter
+	// BBox and Intersects narrow down items by their geometries in the same form of the public API
+	BBox       string
+	Intersects string
+}
+
+// ExportFilter is a condition on a value of a field keyed by the field key
+type ExportFilter struct {
+	Key      string
+	Operator string
+	Value    string
+}
+
+func (c *ExportItemsConfig) Clone() *ExportItemsConfig {
+	if c == nil {
+		return nil
+	}
+	r := *c
+	r.Filters = slices.Clone(c.Filters)
+	return &r
+}
@@ -0,0 +1,30 @@
+package task
+
+import (
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+)
+
+type ID = id.TaskID
+type IDList = id.TaskIDList
+type WorkspaceID = id.WorkspaceID
+type ProjectID = id.ProjectID
+type ModelID = id.ModelID
+type AssetID = id.AssetID
+type ItemID = id.ItemID
+type UserID = id.UserID
+type IntegrationID = id.IntegrationID
+
+var NewID = id.NewTaskID
+var NewWorkspaceID = id.NewWorkspaceID
+var NewProjectID = id.NewProjectID
+var MustID = id.MustTaskID
+var IDFrom = id.TaskIDFrom
+var IDFromRef = id.TaskIDFromRef
+var ErrInvalidID = id.ErrInvalidID
+
+var (
+	ErrInvalidType   = errors.New("invalid task type")
+	ErrInvalidConfig = errors.New("invalid task config")
+)
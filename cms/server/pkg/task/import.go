@@ -0,0 +1,39 @@
+package task
+
+import "github.com/samber/lo"
+
+// ImportItemsConfig is the configuration of a task which imports items from an asset
+type ImportItemsConfig struct {
+	ModelID ModelID
+	AssetID AssetID
+	// Format is the format of the asset: csv, json or geojson
+	Format string
+	// Fields maps the columns of the asset to the keys of the fields. Columns which are not mapped are imported to the field with the same key.
+	Fields map[string]string
+	// KeyField is the key of the field which is used to find an existing item to update. If it is empty, all records are created as new items.
+	KeyField string
+	// DryRun only validates the records without saving any items
+	DryRun bool
+}
+
+func (c *ImportItemsConfig) Clone() *ImportItemsConfig {
+	if c == nil {
+		return nil
+	}
+	r := *c
+	if c.Fields != nil {
+		r.Fields = lo.Assign(c.Fields)
+	}
+	return &r
+}
+
+// FieldKey returns the key of the field which the column is imported to
+func (c *ImportItemsConfig) FieldKey(column string) string {
+	if c == nil {
+		return column
+	}
+	if k, ok := c.Fields[column]; ok {
+		return k
+	}
+	return column
+}
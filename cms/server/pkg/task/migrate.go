@@ -0,0 +1,33 @@
+package task
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+// MigrateFieldsConfig is the configuration of a task which migrates fields of a schema and converts values of items
+type MigrateFieldsConfig struct {
+	ModelID ModelID
+	// Type is how values are converted: convert, split or merge
+	Type string
+	// Sources are the fields to be migrated. They are replaced with the targets in the schema after all items are converted.
+	Sources id.FieldIDList
+	// Targets are the new fields which the converted values are stored in
+	Targets schema.FieldList
+	// Separator is used to split a value into the targets or to join values of the sources
+	Separator string
+	// DryRun only reports items whose values cannot be converted without changing the schema and items
+	DryRun bool
+}
+
+func (c *MigrateFieldsConfig) Clone() *MigrateFieldsConfig {
+	if c == nil {
+		return nil
+	}
+	r := *c
+	r.Sources = slices.Clone(c.Sources)
+	r.Targets = lo.Map(c.Targets, func(f *schema.Field, _ int) *schema.Field { return f.Clone() })
+	return &r
+}
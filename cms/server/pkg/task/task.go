@@ -1,8 +1,12 @@
 package task
 
 import (
+	"time"
+
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/util"
+	"golang.org/x/exp/slices"
 )
 
 type Payload struct {
@@ -22,8 +26,11 @@ func (t *DecompressAssetPayload) Payload() Payload {
 	}
 }
 
+// CompressAssetPayload packs files under Path into a zip archive stored at Output. Both are paths in the asset storage.
 type CompressAssetPayload struct {
 	AssetID string
+	Path    string
+	Output  string
 }
 
 func (t *CompressAssetPayload) Payload() Payload {
@@ -36,6 +43,8 @@ type WebhookPayload struct {
 	Webhook  *integration.Webhook
 	Event    *event.Event[any]
 	Override any
+	// Delivery is set when the delivery is tracked. When it already has a payload, the payload is sent as is instead of rendering the event.
+	Delivery *integration.Delivery
 }
 
 func (t WebhookPayload) Payload() Payload {
@@ -43,3 +52,193 @@ func (t WebhookPayload) Payload() Payload {
 		Webhook: &t,
 	}
 }
+
+type Type string
+
+const (
+	TypeImportItems   Type = "importItems"
+	TypeExportItems   Type = "exportItems"
+	TypeMigrateFields Type = "migrateFields"
+)
+
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) IsFinished() bool {
+	return s == StatusCompleted || s == StatusFailed
+}
+
+// MaxErrors is the maximum number of errors which a task keeps to limit the size of the task
+const MaxErrors = 1000
+
+// Progress is the number of records processed by a task
+type Progress struct {
+	Total     int
+	Processed int
+	Created   int
+	Updated   int
+	Failed    int
+}
+
+// Error is an error of a record processed by a task. Row is the zero-based index of the record.
+// Item is the ID of the item when the record is an existing item.
+type Error struct {
+	Row     int
+	Item    *ItemID
+	Field   string
+	Message string
+}
+
+// Task is a long-running job executed in background, such as importing items. It records the progress so that it can be resumed.
+type Task struct {
+	id          ID
+	workspace   WorkspaceID
+	project     ProjectID
+	user        *UserID
+	integration *IntegrationID
+	typ         Type
+	status      Status
+	progress    Progress
+	errors      []Error
+	message     string
+	importItems *ImportItemsConfig
+	exportItems *ExportItemsConfig
+	migrate     *MigrateFieldsConfig
+	asset       *AssetID
+	updatedAt   time.Time
+}
+
+type List []*Task
+
+func (t *Task) ID() ID {
+	return t.id
+}
+
+func (t *Task) Workspace() WorkspaceID {
+	return t.workspace
+}
+
+func (t *Task) Project() ProjectID {
+	return t.project
+}
+
+func (t *Task) User() *UserID {
+	return t.user.CloneRef()
+}
+
+func (t *Task) Integration() *IntegrationID {
+	return t.integration.CloneRef()
+}
+
+func (t *Task) Type() Type {
+	return t.typ
+}
+
+func (t *Task) Status() Status {
+	return t.status
+}
+
+func (t *Task) Progress() Progress {
+	return t.progress
+}
+
+func (t *Task) Errors() []Error {
+	return slices.Clone(t.errors)
+}
+
+// Message returns the reason why the task has failed
+func (t *Task) Message() string {
+	return t.message
+}
+
+func (t *Task) ImportItems() *ImportItemsConfig {
+	return t.importItems.Clone()
+}
+
+func (t *Task) ExportItems() *ExportItemsConfig {
+	return t.exportItems.Clone()
+}
+
+func (t *Task) MigrateFields() *MigrateFieldsConfig {
+	return t.migrate.Clone()
+}
+
+// Asset returns the asset generated by the task
+func (t *Task) Asset() *AssetID {
+	return t.asset.CloneRef()
+}
+
+func (t *Task) CreatedAt() time.Time {
+	return t.id.Timestamp()
+}
+
+func (t *Task) UpdatedAt() time.Time {
+	if t.updatedAt.IsZero() {
+		return t.id.Timestamp()
+	}
+	return t.updatedAt
+}
+
+// IsStale returns true when the task has been running without any progress since the time, which means the runner may have been stopped
+func (t *Task) IsStale(since time.Time) bool {
+	return t.status == StatusRunning && t.UpdatedAt().Before(since)
+}
+
+// Start marks the task as running. Total is the number of records to be processed.
+func (t *Task) Start(total int) {
+	t.status = StatusRunning
+	t.progress.Total = total
+	t.updatedAt = util.Now()
+}
+
+// ResetProgress discards the progress and the errors to process all records again
+func (t *Task) ResetProgress() {
+	t.progress = Progress{}
+	t.errors = nil
+}
+
+// Record updates the progress with the result of a record
+func (t *Task) Record(row int, created, updated bool, errs ...Error) {
+	t.progress.Processed++
+	switch {
+	case len(errs) > 0:
+		t.progress.Failed++
+		for _, e := range errs {
+			if len(t.errors) >= MaxErrors {
+				break
+			}
+			e.Row = row
+			t.errors = append(t.errors, e)
+		}
+	case created:
+		t.progress.Created++
+	case updated:
+		t.progress.Updated++
+	}
+	t.updatedAt = util.Now()
+}
+
+func (t *Task) Complete() {
+	t.status = StatusCompleted
+	t.updatedAt = util.Now()
+}
+
+// CompleteWithAsset marks the task as completed with the asset generated by the task
+func (t *Task) CompleteWithAsset(aid AssetID) {
+	t.asset = aid.Ref()
+	t.Complete()
+}
+
+func (t *Task) Fail(err error) {
+	t.status = StatusFailed
+	if err != nil {
+		t.message = err.Error()
+	}
+	t.updatedAt = util.Now()
+}
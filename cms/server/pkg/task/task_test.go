@@ -0,0 +1,85 @@
+package task
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/key"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	wid, pid := NewWorkspaceID(), NewProjectID()
+
+	_, err := New().NewID().Workspace(wid).Project(pid).Build()
+	assert.Equal(t, ErrInvalidType, err)
+
+	_, err = New().NewID().Workspace(wid).Project(pid).Type(TypeImportItems).Build()
+	assert.Equal(t, ErrInvalidConfig, err)
+
+	_, err = New().Workspace(wid).Project(pid).Type(TypeImportItems).Build()
+	assert.Equal(t, ErrInvalidID, err)
+
+	cfg := &ImportItemsConfig{Format: "csv", Fields: map[string]string{"a": "b"}}
+	tk, err := New().NewID().Workspace(wid).Project(pid).Type(TypeImportItems).ImportItems(cfg).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, StatusPending, tk.Status())
+	assert.Equal(t, cfg, tk.ImportItems())
+	assert.NotSame(t, cfg, tk.ImportItems())
+	assert.Equal(t, tk.CreatedAt(), tk.UpdatedAt())
+
+	_, err = New().NewID().Workspace(wid).Project(pid).Type(TypeMigrateFields).Build()
+	assert.Equal(t, ErrInvalidConfig, err)
+
+	mcfg := &MigrateFieldsConfig{
+		Type:    "convert",
+		Sources: id.FieldIDList{id.NewFieldID()},
+		Targets: schema.FieldList{schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(key.New("a")).MustBuild()},
+	}
+	tk, err = New().NewID().Workspace(wid).Project(pid).Type(TypeMigrateFields).MigrateFields(mcfg).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, mcfg, tk.MigrateFields())
+	assert.NotSame(t, mcfg.Targets[0], tk.MigrateFields().Targets[0])
+}
+
+func TestTask_Progress(t *testing.T) {
+	tk := New().NewID().Workspace(NewWorkspaceID()).Project(NewProjectID()).Type(TypeImportItems).ImportItems(&ImportItemsConfig{}).MustBuild()
+
+	tk.Start(3)
+	assert.Equal(t, StatusRunning, tk.Status())
+	assert.False(t, tk.IsStale(time.Now().Add(-time.Minute)))
+	assert.True(t, tk.IsStale(time.Now().Add(time.Minute)))
+
+	tk.Record(0, true, false)
+	tk.Record(1, false, true)
+	tk.Record(2, false, false, Error{Field: "a", Message: "invalid"})
+	assert.Equal(t, Progress{Total: 3, Processed: 3, Created: 1, Updated: 1, Failed: 1}, tk.Progress())
+	assert.Equal(t, []Error{{Row: 2, Field: "a", Message: "invalid"}}, tk.Errors())
+
+	tk.Complete()
+	assert.Equal(t, StatusCompleted, tk.Status())
+	assert.True(t, tk.Status().IsFinished())
+
+	tk.Fail(errors.New("failed"))
+	assert.Equal(t, StatusFailed, tk.Status())
+	assert.Equal(t, "failed", tk.Message())
+}
+
+func TestTask_Record_MaxErrors(t *testing.T) {
+	tk := New().NewID().Workspace(NewWorkspaceID()).Project(NewProjectID()).Type(TypeImportItems).ImportItems(&ImportItemsConfig{}).MustBuild()
+	for i := 0; i <= MaxErrors; i++ {
+		tk.Record(i, false, false, Error{Message: "invalid"})
+	}
+	assert.Equal(t, MaxErrors+1, tk.Progress().Failed)
+	assert.Equal(t, MaxErrors, len(tk.Errors()))
+}
+
+func TestImportItemsConfig_FieldKey(t *testing.T) {
+	c := &ImportItemsConfig{Fields: map[string]string{"city": "name"}}
+	assert.Equal(t, "name", c.FieldKey("city"))
+	assert.Equal(t, "pop", c.FieldKey("pop"))
+	assert.Equal(t, "pop", (*ImportItemsConfig)(nil).FieldKey("pop"))
+}
@@ -51,3 +51,8 @@ func (b *Builder) Comments(c []*Comment) *Builder {
 	b.th.comments = slices.Clone(c)
 	return b
 }
+
+func (b *Builder) Resolved(resolved bool) *Builder {
+	b.th.resolved = resolved
+	return b
+}
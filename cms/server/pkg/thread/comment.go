@@ -7,9 +7,10 @@ import (
 )
 
 type Comment struct {
-	id      CommentID
-	author  operator.Operator
-	content string
+	id       CommentID
+	author   operator.Operator
+	content  string
+	mentions *Mentions
 }
 
 func NewComment(id CommentID, author operator.Operator, content string) *Comment {
@@ -32,6 +33,10 @@ func (c *Comment) Content() string {
 	return c.content
 }
 
+func (c *Comment) Mentions() *Mentions {
+	return c.mentions.Clone()
+}
+
 func (c *Comment) CreatedAt() time.Time {
 	return c.id.Timestamp()
 }
@@ -40,14 +45,23 @@ func (c *Comment) SetContent(content string) {
 	c.content = content
 }
 
+func (c *Comment) SetMentions(m *Mentions) {
+	if m.IsEmpty() {
+		c.mentions = nil
+		return
+	}
+	c.mentions = m.Clone()
+}
+
 func (c *Comment) Clone() *Comment {
 	if c == nil {
 		return nil
 	}
 
 	return &Comment{
-		id:      c.id,
-		author:  c.author,
-		content: c.content,
+		id:       c.id,
+		author:   c.author,
+		content:  c.content,
+		mentions: c.mentions.Clone(),
 	}
 }
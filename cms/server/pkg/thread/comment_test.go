@@ -50,3 +50,15 @@ func TestComment_Clone(t *testing.T) {
 	assert.Equal(t, comment, comment.Clone())
 	assert.NotSame(t, comment, comment.Clone())
 }
+
+func TestComment_SetMentions(t *testing.T) {
+	uid := NewUserID()
+	comment := &Comment{}
+	m := &Mentions{Users: UserIDList{uid}}
+	comment.SetMentions(m)
+	assert.Equal(t, m, comment.Mentions())
+	assert.NotSame(t, m, comment.Mentions())
+
+	comment.SetMentions(&Mentions{})
+	assert.Nil(t, comment.Mentions())
+}
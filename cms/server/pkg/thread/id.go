@@ -7,7 +7,10 @@ import (
 type ID = id.ThreadID
 type CommentID = id.CommentID
 type UserID = id.UserID
+type IntegrationID = id.IntegrationID
 type WorkspaceID = id.WorkspaceID
+type UserIDList = id.UserIDList
+type IntegrationIDList = id.IntegrationIDList
 
 var NewID = id.NewThreadID
 var NewCommentID = id.NewCommentID
@@ -0,0 +1,21 @@
+package thread
+
+// Mentions holds the workspace members and integrations mentioned in a comment
+type Mentions struct {
+	Users        UserIDList
+	Integrations IntegrationIDList
+}
+
+func (m *Mentions) IsEmpty() bool {
+	return m == nil || len(m.Users) == 0 && len(m.Integrations) == 0
+}
+
+func (m *Mentions) Clone() *Mentions {
+	if m == nil {
+		return nil
+	}
+	return &Mentions{
+		Users:        m.Users.Clone(),
+		Integrations: m.Integrations.Clone(),
+	}
+}
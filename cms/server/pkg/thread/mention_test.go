@@ -0,0 +1,22 @@
+package thread
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMentions_IsEmpty(t *testing.T) {
+	assert.True(t, (*Mentions)(nil).IsEmpty())
+	assert.True(t, (&Mentions{}).IsEmpty())
+	assert.False(t, (&Mentions{Users: UserIDList{NewUserID()}}).IsEmpty())
+	assert.False(t, (&Mentions{Integrations: IntegrationIDList{id.NewIntegrationID()}}).IsEmpty())
+}
+
+func TestMentions_Clone(t *testing.T) {
+	m := &Mentions{Users: UserIDList{NewUserID()}, Integrations: IntegrationIDList{id.NewIntegrationID()}}
+	assert.Nil(t, (*Mentions)(nil).Clone())
+	assert.Equal(t, m, m.Clone())
+	assert.NotSame(t, m, m.Clone())
+}
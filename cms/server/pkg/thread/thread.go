@@ -11,6 +11,7 @@ type Thread struct {
 	id        ID
 	workspace WorkspaceID
 	comments  []*Comment
+	resolved  bool
 }
 
 func (th *Thread) ID() ID {
@@ -21,6 +22,17 @@ func (th *Thread) Workspace() WorkspaceID {
 	return th.workspace
 }
 
+func (th *Thread) Resolved() bool {
+	if th == nil {
+		return false
+	}
+	return th.resolved
+}
+
+func (th *Thread) SetResolved(resolved bool) {
+	th.resolved = resolved
+}
+
 func (th *Thread) Comments() []*Comment {
 	if th == nil {
 		return nil
@@ -72,6 +84,28 @@ func (th *Thread) Comment(cid id.CommentID) *Comment {
 	return c
 }
 
+// Participants returns the users and integrations who wrote or were mentioned in the comments of the thread
+func (th *Thread) Participants() (UserIDList, IntegrationIDList) {
+	if th == nil {
+		return nil, nil
+	}
+	var users UserIDList
+	var integrations IntegrationIDList
+	for _, c := range th.comments {
+		if u := c.Author().User(); u != nil {
+			users = users.AddUniq(*u)
+		}
+		if i := c.Author().Integration(); i != nil {
+			integrations = integrations.AddUniq(*i)
+		}
+		if m := c.mentions; m != nil {
+			users = users.AddUniq(m.Users...)
+			integrations = integrations.AddUniq(m.Integrations...)
+		}
+	}
+	return users, integrations
+}
+
 func (th *Thread) SetComments(comments ...*Comment) {
 	th.comments = slices.Clone(comments)
 }
@@ -89,5 +123,6 @@ func (th *Thread) Clone() *Thread {
 		id:        th.id.Clone(),
 		workspace: th.workspace.Clone(),
 		comments:  comments,
+		resolved:  th.resolved,
 	}
 }
@@ -134,3 +134,30 @@ func TestThread_Clone(t *testing.T) {
 	assert.Equal(t, thread, thread.Clone())
 	assert.NotSame(t, thread, thread.Clone())
 }
+
+func TestThread_SetResolved(t *testing.T) {
+	thread := &Thread{}
+	assert.False(t, thread.Resolved())
+	thread.SetResolved(true)
+	assert.True(t, thread.Resolved())
+	assert.True(t, thread.Clone().Resolved())
+	assert.False(t, (*Thread)(nil).Resolved())
+}
+
+func TestThread_Participants(t *testing.T) {
+	u1, u2, u3 := NewUserID(), NewUserID(), NewUserID()
+	i1 := id.NewIntegrationID()
+	c1 := NewComment(NewCommentID(), operator.OperatorFromUser(u1), "a")
+	c1.SetMentions(&Mentions{Users: UserIDList{u2}})
+	c2 := NewComment(NewCommentID(), operator.OperatorFromIntegration(i1), "b")
+	c2.SetMentions(&Mentions{Users: UserIDList{u1, u3}})
+	thread := &Thread{comments: []*Comment{c1, c2}}
+
+	users, integrations := thread.Participants()
+	assert.Equal(t, UserIDList{u1, u2, u3}, users)
+	assert.Equal(t, IntegrationIDList{i1}, integrations)
+
+	users, integrations = (*Thread)(nil).Participants()
+	assert.Nil(t, users)
+	assert.Nil(t, integrations)
+}
@@ -0,0 +1,162 @@
+package user
+
+import (
+	"strings"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrInvalidCustomRole  = rerror.NewE(i18n.T("invalid custom role"))
+	ErrCustomRoleNotFound = rerror.NewE(i18n.T("custom role not found"))
+)
+
+// Action is an operation on items which can be allowed by custom roles
+type Action string
+
+const (
+	ActionRead    Action = "read"
+	ActionCreate  Action = "create"
+	ActionUpdate  Action = "update"
+	ActionDelete  Action = "delete"
+	ActionPublish Action = "publish"
+	ActionApprove Action = "approve"
+)
+
+var actions = []Action{
+	ActionRead,
+	ActionCreate,
+	ActionUpdate,
+	ActionDelete,
+	ActionPublish,
+	ActionApprove,
+}
+
+func ActionFrom(s string) (Action, bool) {
+	a := Action(strings.ToLower(s))
+	if lo.Contains(actions, a) {
+		return a, true
+	}
+	return "", false
+}
+
+// Permission allows the actions on items of the model in the project.
+// A permission without a project applies to all projects of the workspace, and one without a model applies to all models of the project.
+type Permission struct {
+	Project *ProjectID
+	Model   *ModelID
+	Actions []Action
+}
+
+// Allows returns whether the permission allows the action on items of the model in the project.
+// When the model is nil, only permissions without a model apply.
+func (p Permission) Allows(a Action, pid ProjectID, mid *ModelID) bool {
+	if p.Project != nil && *p.Project != pid {
+		return false
+	}
+	if p.Model != nil && (mid == nil || *p.Model != *mid) {
+		return false
+	}
+	return lo.Contains(p.Actions, a)
+}
+
+// CustomRole is a role defined in a workspace which allows actions on items per project and per model.
+// Members who have a custom role can do only the actions of the role on items, while their fixed role is still used for other operations.
+type CustomRole struct {
+	id          RoleID
+	name        string
+	permissions []Permission
+}
+
+func NewCustomRole(id RoleID, name string, permissions []Permission) (*CustomRole, error) {
+	r := &CustomRole{id: id}
+	if err := r.Update(name, permissions); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *CustomRole) ID() RoleID {
+	return r.id
+}
+
+func (r *CustomRole) Name() string {
+	return r.name
+}
+
+func (r *CustomRole) Permissions() []Permission {
+	return lo.Map(r.permissions, func(p Permission, _ int) Permission {
+		p.Actions = append([]Action{}, p.Actions...)
+		return p
+	})
+}
+
+func (r *CustomRole) Update(name string, permissions []Permission) error {
+	name = strings.TrimSpace(name)
+	if r.id.IsNil() || name == "" {
+		return ErrInvalidCustomRole
+	}
+	for _, p := range permissions {
+		if p.Model != nil && p.Project == nil {
+			return ErrInvalidCustomRole
+		}
+		if lo.SomeBy(p.Actions, func(a Action) bool { return !lo.Contains(actions, a) }) {
+			return ErrInvalidCustomRole
+		}
+	}
+	r.name = name
+	r.permissions = lo.Map(permissions, func(p Permission, _ int) Permission {
+		p.Actions = lo.Uniq(p.Actions)
+		return p
+	})
+	return nil
+}
+
+// Allows returns whether any permission of the role allows the action on items of the model in the project
+func (r *CustomRole) Allows(a Action, pid ProjectID, mid *ModelID) bool {
+	if r == nil {
+		return false
+	}
+	return lo.SomeBy(r.permissions, func(p Permission) bool { return p.Allows(a, pid, mid) })
+}
+
+// AllowsAny returns whether any permission of the role allows the action on items of some model in the project
+func (r *CustomRole) AllowsAny(a Action, pid ProjectID) bool {
+	if r == nil {
+		return false
+	}
+	return lo.SomeBy(r.permissions, func(p Permission) bool {
+		return (p.Project == nil || *p.Project == pid) && lo.Contains(p.Actions, a)
+	})
+}
+
+// AllowedModels returns the models of the project whose items the role allows the action on.
+// all is true when the action is allowed on items of any model of the project.
+func (r *CustomRole) AllowedModels(a Action, pid ProjectID) (models []ModelID, all bool) {
+	if r == nil {
+		return nil, false
+	}
+	for _, p := range r.permissions {
+		if p.Project != nil && *p.Project != pid || !lo.Contains(p.Actions, a) {
+			continue
+		}
+		if p.Model == nil {
+			return nil, true
+		}
+		models = append(models, *p.Model)
+	}
+	return lo.Uniq(models), false
+}
+
+func (r *CustomRole) Clone() *CustomRole {
+	if r == nil {
+		return nil
+	}
+	return &CustomRole{
+		id:          r.id,
+		name:        r.name,
+		permissions: r.Permissions(),
+	}
+}
@@ -0,0 +1,75 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionFrom(t *testing.T) {
+	a, ok := ActionFrom("PUBLISH")
+	assert.True(t, ok)
+	assert.Equal(t, ActionPublish, a)
+
+	_, ok = ActionFrom("xxx")
+	assert.False(t, ok)
+}
+
+func TestNewCustomRole(t *testing.T) {
+	pid := id.NewProjectID()
+	mid := id.NewModelID()
+
+	r, err := NewCustomRole(NewRoleID(), " staff ", []Permission{
+		{Project: &pid, Model: &mid, Actions: []Action{ActionRead, ActionUpdate, ActionRead}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "staff", r.Name())
+	assert.Equal(t, []Permission{{Project: &pid, Model: &mid, Actions: []Action{ActionRead, ActionUpdate}}}, r.Permissions())
+
+	_, err = NewCustomRole(NewRoleID(), "", nil)
+	assert.Equal(t, ErrInvalidCustomRole, err)
+	_, err = NewCustomRole(RoleID{}, "staff", nil)
+	assert.Equal(t, ErrInvalidCustomRole, err)
+	_, err = NewCustomRole(NewRoleID(), "staff", []Permission{{Model: &mid, Actions: []Action{ActionRead}}})
+	assert.Equal(t, ErrInvalidCustomRole, err)
+	_, err = NewCustomRole(NewRoleID(), "staff", []Permission{{Project: &pid, Actions: []Action{"xxx"}}})
+	assert.Equal(t, ErrInvalidCustomRole, err)
+}
+
+func TestCustomRole_Allows(t *testing.T) {
+	pid1, pid2 := id.NewProjectID(), id.NewProjectID()
+	mid1, mid2 := id.NewModelID(), id.NewModelID()
+
+	r, err := NewCustomRole(NewRoleID(), "staff", []Permission{
+		{Actions: []Action{ActionRead}},
+		{Project: &pid1, Actions: []Action{ActionCreate}},
+		{Project: &pid1, Model: &mid1, Actions: []Action{ActionUpdate, ActionDelete}},
+	})
+	assert.NoError(t, err)
+
+	assert.True(t, r.Allows(ActionRead, pid2, &mid2))
+	assert.True(t, r.Allows(ActionRead, pid2, nil))
+	assert.True(t, r.Allows(ActionCreate, pid1, &mid2))
+	assert.False(t, r.Allows(ActionCreate, pid2, &mid2))
+	assert.True(t, r.Allows(ActionUpdate, pid1, &mid1))
+	assert.False(t, r.Allows(ActionUpdate, pid1, &mid2))
+	assert.False(t, r.Allows(ActionUpdate, pid1, nil))
+	assert.False(t, r.Allows(ActionPublish, pid1, &mid1))
+
+	assert.True(t, r.AllowsAny(ActionUpdate, pid1))
+	assert.False(t, r.AllowsAny(ActionUpdate, pid2))
+
+	models, all := r.AllowedModels(ActionRead, pid1)
+	assert.Nil(t, models)
+	assert.True(t, all)
+	models, all = r.AllowedModels(ActionUpdate, pid1)
+	assert.Equal(t, []ModelID{mid1}, models)
+	assert.False(t, all)
+	models, all = r.AllowedModels(ActionUpdate, pid2)
+	assert.Empty(t, models)
+	assert.False(t, all)
+
+	var nilRole *CustomRole
+	assert.False(t, nilRole.Allows(ActionRead, pid1, nil))
+}
@@ -22,3 +22,10 @@ var ErrInvalidID = id.ErrInvalidID
 
 type WorkspaceIDList = id.WorkspaceIDList
 type IntegrationIDList = id.IntegrationIDList
+
+type RoleID = id.RoleID
+type ProjectID = id.ProjectID
+type ModelID = id.ModelID
+
+var NewRoleID = id.NewRoleID
+var RoleIDFrom = id.RoleIDFrom
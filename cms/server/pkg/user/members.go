@@ -2,11 +2,13 @@ package user
 
 import (
 	"sort"
+	"strings"
 
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/samber/lo"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
 var (
@@ -17,7 +19,10 @@ var (
 )
 
 type Member struct {
-	Role      Role
+	Role       Role
+	CustomRole *RoleID
+	// Groups are the groups of items which the member can access in projects restricting items by groups
+	Groups    []string
 	Disabled  bool
 	InvitedBy ID
 }
@@ -140,6 +145,89 @@ func (m *Members) UpdateIntegrationRole(iId IntegrationID, role Role) error {
 	return nil
 }
 
+// UpdateUserCustomRole assigns the custom role to the user, or unassigns it when the role is nil
+func (m *Members) UpdateUserCustomRole(u ID, role *RoleID) error {
+	if m.fixed {
+		return ErrCannotModifyPersonalWorkspace
+	}
+	mm, ok := m.users[u]
+	if !ok {
+		return ErrTargetUserNotInTheWorkspace
+	}
+	mm.CustomRole = role.CloneRef()
+	m.users[u] = mm
+	return nil
+}
+
+// UpdateIntegrationCustomRole assigns the custom role to the integration, or unassigns it when the role is nil
+func (m *Members) UpdateIntegrationCustomRole(iId IntegrationID, role *RoleID) error {
+	mm, ok := m.integrations[iId]
+	if !ok {
+		return ErrTargetUserNotInTheWorkspace
+	}
+	mm.CustomRole = role.CloneRef()
+	m.integrations[iId] = mm
+	return nil
+}
+
+// UpdateUserGroups replaces the item groups of the user
+func (m *Members) UpdateUserGroups(u ID, groups []string) error {
+	if m.fixed {
+		return ErrCannotModifyPersonalWorkspace
+	}
+	mm, ok := m.users[u]
+	if !ok {
+		return ErrTargetUserNotInTheWorkspace
+	}
+	mm.Groups = normalizeGroups(groups)
+	m.users[u] = mm
+	return nil
+}
+
+// UpdateIntegrationGroups replaces the item groups of the integration
+func (m *Members) UpdateIntegrationGroups(iId IntegrationID, groups []string) error {
+	mm, ok := m.integrations[iId]
+	if !ok {
+		return ErrTargetUserNotInTheWorkspace
+	}
+	mm.Groups = normalizeGroups(groups)
+	m.integrations[iId] = mm
+	return nil
+}
+
+// UserGroups returns the item groups of the user
+func (m *Members) UserGroups(u ID) []string {
+	return slices.Clone(m.users[u].Groups)
+}
+
+// IntegrationGroups returns the item groups of the integration
+func (m *Members) IntegrationGroups(iId IntegrationID) []string {
+	return slices.Clone(m.integrations[iId].Groups)
+}
+
+func normalizeGroups(groups []string) []string {
+	res := lo.Uniq(lo.Compact(lo.Map(groups, func(g string, _ int) string { return strings.TrimSpace(g) })))
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
+func (m *Members) unassignCustomRole(role RoleID) {
+	for u, mm := range m.users {
+		if mm.CustomRole != nil && *mm.CustomRole == role {
+			mm.CustomRole = nil
+			m.users[u] = mm
+		}
+	}
+	for i, mm := range m.integrations {
+		if mm.CustomRole != nil && *mm.CustomRole == role {
+			mm.CustomRole = nil
+			m.integrations[i] = mm
+		}
+	}
+}
+
 func (m *Members) JoinUser(u ID, role Role, i ID) error {
 	if m.fixed {
 		return ErrCannotModifyPersonalWorkspace
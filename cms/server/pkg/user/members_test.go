@@ -422,3 +422,24 @@ func TestMembers_Fixed(t *testing.T) {
 		})
 	}
 }
+
+func TestMembers_UpdateGroups(t *testing.T) {
+	uid := NewID()
+	iid := id.NewIntegrationID()
+	m := &Members{
+		users:        map[ID]Member{uid: {Role: RoleWriter}},
+		integrations: map[IntegrationID]Member{iid: {Role: RoleWriter}},
+	}
+
+	assert.NoError(t, m.UpdateUserGroups(uid, []string{" 13101", "13102", "13101", ""}))
+	assert.Equal(t, []string{"13101", "13102"}, m.UserGroups(uid))
+	assert.NoError(t, m.UpdateUserGroups(uid, nil))
+	assert.Nil(t, m.UserGroups(uid))
+	assert.Same(t, ErrTargetUserNotInTheWorkspace, m.UpdateUserGroups(NewID(), []string{"a"}))
+
+	assert.NoError(t, m.UpdateIntegrationGroups(iid, []string{"13101"}))
+	assert.Equal(t, []string{"13101"}, m.IntegrationGroups(iid))
+	assert.Same(t, ErrTargetUserNotInTheWorkspace, m.UpdateIntegrationGroups(id.NewIntegrationID(), []string{"a"}))
+
+	assert.Same(t, ErrCannotModifyPersonalWorkspace, NewFixedMembers(uid).UpdateUserGroups(uid, []string{"a"}))
+}
@@ -1,9 +1,15 @@
 package user
 
+import (
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
 type Workspace struct {
 	id      WorkspaceID
 	name    string
 	members *Members
+	roles   []*CustomRole
 }
 
 func (t *Workspace) ID() WorkspaceID {
@@ -22,6 +28,65 @@ func (t *Workspace) IsPersonal() bool {
 	return t.members.Fixed()
 }
 
+func (t *Workspace) Roles() []*CustomRole {
+	return slices.Clone(t.roles)
+}
+
+func (t *Workspace) Role(rid RoleID) *CustomRole {
+	r, _ := lo.Find(t.roles, func(r *CustomRole) bool { return r.ID() == rid })
+	return r
+}
+
+func (t *Workspace) AddRole(r *CustomRole) {
+	if r == nil || t.Role(r.ID()) != nil {
+		return
+	}
+	t.roles = append(t.roles, r)
+}
+
+// RemoveRole removes the custom role and unassigns it from all members
+func (t *Workspace) RemoveRole(rid RoleID) error {
+	_, idx, ok := lo.FindIndexOf(t.roles, func(r *CustomRole) bool { return r.ID() == rid })
+	if !ok {
+		return ErrCustomRoleNotFound
+	}
+	t.roles = slices.Delete(t.roles, idx, idx+1)
+	t.members.unassignCustomRole(rid)
+	return nil
+}
+
+// UserCustomRole returns the custom role assigned to the user, or nil when the user follows the fixed role
+func (t *Workspace) UserCustomRole(u ID) *CustomRole {
+	if rid := t.members.users[u].CustomRole; rid != nil {
+		return t.Role(*rid)
+	}
+	return nil
+}
+
+// IntegrationCustomRole returns the custom role assigned to the integration, or nil when the integration follows the fixed role
+func (t *Workspace) IntegrationCustomRole(i IntegrationID) *CustomRole {
+	if rid := t.members.integrations[i].CustomRole; rid != nil {
+		return t.Role(*rid)
+	}
+	return nil
+}
+
+// AssignUserRole assigns the custom role of the workspace to the user, or unassigns it when the role is nil
+func (t *Workspace) AssignUserRole(u ID, rid *RoleID) error {
+	if rid != nil && t.Role(*rid) == nil {
+		return ErrCustomRoleNotFound
+	}
+	return t.members.UpdateUserCustomRole(u, rid)
+}
+
+// AssignIntegrationRole assigns the custom role of the workspace to the integration, or unassigns it when the role is nil
+func (t *Workspace) AssignIntegrationRole(i IntegrationID, rid *RoleID) error {
+	if rid != nil && t.Role(*rid) == nil {
+		return ErrCustomRoleNotFound
+	}
+	return t.members.UpdateIntegrationCustomRole(i, rid)
+}
+
 func (t *Workspace) Rename(name string) {
 	t.name = name
 }
@@ -60,6 +60,11 @@ func (b *WorkspaceBuilder) Integrations(integrations map[IntegrationID]Member) *
 	return b
 }
 
+func (b *WorkspaceBuilder) Roles(roles []*CustomRole) *WorkspaceBuilder {
+	b.t.roles = roles
+	return b
+}
+
 func (b *WorkspaceBuilder) Personal(p bool) *WorkspaceBuilder {
 	b.personal = p
 	return b
@@ -3,6 +3,8 @@ package user
 import (
 	"testing"
 
+	"github.com/reearth/reearth-cms/server/pkg/id"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -35,3 +37,38 @@ func TestWorkspace_Rename(t *testing.T) {
 	tm.Rename("ccc")
 	assert.Equal(t, "ccc", tm.Name())
 }
+
+func TestWorkspace_Roles(t *testing.T) {
+	u1, u2 := NewID(), NewID()
+	i1 := id.NewIntegrationID()
+	ws := NewWorkspace().NewID().
+		Members(map[ID]Member{u1: {Role: RoleOwner}, u2: {Role: RoleReader}}).
+		Integrations(map[IntegrationID]Member{i1: {Role: RoleReader}}).
+		MustBuild()
+
+	r, err := NewCustomRole(NewRoleID(), "staff", []Permission{{Actions: []Action{ActionRead}}})
+	assert.NoError(t, err)
+	rid := r.ID()
+
+	assert.Equal(t, ErrCustomRoleNotFound, ws.AssignUserRole(u2, &rid))
+
+	ws.AddRole(r)
+	ws.AddRole(r)
+	assert.Equal(t, []*CustomRole{r}, ws.Roles())
+	assert.Equal(t, r, ws.Role(rid))
+
+	assert.NoError(t, ws.AssignUserRole(u2, &rid))
+	assert.NoError(t, ws.AssignIntegrationRole(i1, &rid))
+	assert.Equal(t, ErrTargetUserNotInTheWorkspace, ws.AssignUserRole(NewID(), &rid))
+	assert.Nil(t, ws.UserCustomRole(u1))
+	assert.Equal(t, r, ws.UserCustomRole(u2))
+	assert.Equal(t, r, ws.IntegrationCustomRole(i1))
+
+	// members follow their fixed roles again after the role is removed
+	assert.NoError(t, ws.RemoveRole(rid))
+	assert.Empty(t, ws.Roles())
+	assert.Nil(t, ws.UserCustomRole(u2))
+	assert.Nil(t, ws.Members().Users()[u2].CustomRole)
+	assert.Nil(t, ws.Members().Integrations()[i1].CustomRole)
+	assert.Equal(t, ErrCustomRoleNotFound, ws.RemoveRole(rid))
+}
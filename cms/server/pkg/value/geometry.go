@@ -0,0 +1,321 @@
+package value
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+
+	"github.com/samber/lo"
+)
+
+const TypeGeometry Type = "geometry"
+
+type GeometryType string
+
+const (
+	GeometryTypePoint           GeometryType = "Point"
+	GeometryTypeMultiPoint      GeometryType = "MultiPoint"
+	GeometryTypeLineString      GeometryType = "LineString"
+	GeometryTypeMultiLineString GeometryType = "MultiLineString"
+	GeometryTypePolygon         GeometryType = "Polygon"
+	GeometryTypeMultiPolygon    GeometryType = "MultiPolygon"
+)
+
+var GeometryTypes = []GeometryType{
+	GeometryTypePoint,
+	GeometryTypeMultiPoint,
+	GeometryTypeLineString,
+	GeometryTypeMultiLineString,
+	GeometryTypePolygon,
+	GeometryTypeMultiPolygon,
+}
+
+// Position is a pair of longitude and latitude with an optional altitude
+type Position = []float64
+
+// Geometry is a GeoJSON geometry object in WGS84.
+// Coordinates is a Position for Point, []Position for MultiPoint and LineString,
+// [][]Position for MultiLineString and Polygon, and [][][]Position for MultiPolygon.
+type Geometry struct {
+	Type        GeometryType
+	Coordinates any
+}
+
+type propertyGeometry struct{}
+
+func (p *propertyGeometry) ToValue(i any) (any, bool) {
+	switch v := i.(type) {
+	case Geometry:
+		if g, ok := v.normalize(); ok {
+			return g, true
+		}
+	case *Geometry:
+		if v != nil {
+			return p.ToValue(*v)
+		}
+	case map[string]any:
+		t, _ := v["type"].(string)
+		return p.ToValue(Geometry{Type: GeometryType(t), Coordinates: v["coordinates"]})
+	case string:
+		return p.ToValue([]byte(v))
+	case *string:
+		if v != nil {
+			return p.ToValue(*v)
+		}
+	case json.RawMessage:
+		return p.ToValue([]byte(v))
+	case []byte:
+		var m map[string]any
+		if err := json.Unmarshal(v, &m); err == nil {
+			return p.ToValue(m)
+		}
+	}
+	return nil, false
+}
+
+func (*propertyGeometry) ToInterface(v any) (any, bool) {
+	g := v.(Geometry)
+	return map[string]any{
+		"type":        string(g.Type),
+		"coordinates": g.Coordinates,
+		"bbox":        g.BBox(),
+	}, true
+}
+
+func (*propertyGeometry) Validate(i any) bool {
+	_, ok := i.(Geometry)
+	return ok
+}
+
+func (*propertyGeometry) Equal(v, w any) bool {
+	vv := v.(Geometry)
+	ww := w.(Geometry)
+	return vv.Type == ww.Type && reflect.DeepEqual(vv.Coordinates, ww.Coordinates)
+}
+
+func (*propertyGeometry) IsEmpty(v any) bool {
+	return v.(Geometry).Coordinates == nil
+}
+
+// Positions returns all positions of the geometry
+func (g Geometry) Positions() []Position {
+	switch c := g.Coordinates.(type) {
+	case Position:
+		return []Position{c}
+	case []Position:
+		return c
+	case [][]Position:
+		return lo.Flatten(c)
+	case [][][]Position:
+		return lo.Flatten(lo.Flatten(c))
+	}
+	return nil
+}
+
+// BBox returns the bounding box of the geometry as [west, south, east, north]
+func (g Geometry) BBox() []float64 {
+	ps := g.Positions()
+	if len(ps) == 0 {
+		return nil
+	}
+	b := []float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	for _, p := range ps {
+		b[0] = math.Min(b[0], p[0])
+		b[1] = math.Min(b[1], p[1])
+		b[2] = math.Max(b[2], p[0])
+		b[3] = math.Max(b[3], p[1])
+	}
+	return b
+}
+
+// GeometryFromBBox returns a polygon which covers the bounding box of [west, south, east, north]
+func GeometryFromBBox(b []float64) (Geometry, bool) {
+	if len(b) != 4 || b[0] > b[2] || b[1] > b[3] {
+		return Geometry{}, false
+	}
+	return Geometry{
+		Type: GeometryTypePolygon,
+		Coordinates: []any{[]any{
+			[]any{b[0], b[1]},
+			[]any{b[2], b[1]},
+			[]any{b[2], b[3]},
+			[]any{b[0], b[3]},
+			[]any{b[0], b[1]},
+		}},
+	}.normalize()
+}
+
+// IntersectsBBox reports whether the bounding box of the geometry overlaps the bounding box of [west, south, east, north].
+// It is an approximation of an exact geometric intersection.
+func (g Geometry) IntersectsBBox(b []float64) bool {
+	gb := g.BBox()
+	if len(gb) != 4 || len(b) != 4 {
+		return false
+	}
+	return gb[0] <= b[2] && b[0] <= gb[2] && gb[1] <= b[3] && b[1] <= gb[3]
+}
+
+func (g Geometry) normalize() (Geometry, bool) {
+	var c any
+	var ok bool
+
+	switch g.Type {
+	case GeometryTypePoint:
+		c, ok = toPosition(g.Coordinates)
+	case GeometryTypeMultiPoint:
+		c, ok = toPositions(g.Coordinates, 1)
+	case GeometryTypeLineString:
+		c, ok = toPositions(g.Coordinates, 2)
+	case GeometryTypeMultiLineString:
+		c, ok = toList(g.Coordinates, func(i any) ([]Position, bool) { return toPositions(i, 2) })
+	case GeometryTypePolygon:
+		c, ok = toList(g.Coordinates, toRing)
+	case GeometryTypeMultiPolygon:
+		c, ok = toList(g.Coordinates, func(i any) ([][]Position, bool) { return toList(i, toRing) })
+	}
+
+	if !ok {
+		return Geometry{}, false
+	}
+	return Geometry{Type: g.Type, Coordinates: c}, true
+}
+
+// toRing converts a linear ring of a polygon, which is closed, has at least four positions and is simple
+func toRing(i any) ([]Position, bool) {
+	r, ok := toPositions(i, 4)
+	if !ok || !reflect.DeepEqual(r[0], r[len(r)-1]) || !isSimpleRing(r) {
+		return nil, false
+	}
+	return r, true
+}
+
+// isSimpleRing reports whether the closed ring has no repeated consecutive positions and does not intersect itself.
+// Altitudes are ignored.
+func isSimpleRing(r []Position) bool {
+	n := len(r) - 1 // the number of edges
+	for j := 0; j < n; j++ {
+		if r[j][0] == r[j+1][0] && r[j][1] == r[j+1][1] {
+			return false
+		}
+	}
+
+	for j := 0; j < n; j++ {
+		for k := j + 1; k < n; k++ {
+			a, b, c, d := r[j], r[j+1], r[k], r[k+1]
+			var intersects bool
+			switch {
+			case k == j+1:
+				// adjacent edges share a position and intersect only when they turn back on the same line
+				intersects = turnsBack(b, a, d)
+			case j == 0 && k == n-1:
+				intersects = turnsBack(a, b, c)
+			default:
+				intersects = segmentsIntersect(a, b, c, d)
+			}
+			if intersects {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// turnsBack reports whether the two edges from o to p and from o to q overlap
+func turnsBack(o, p, q Position) bool {
+	return cross(o, p, q) == 0 && (p[0]-o[0])*(q[0]-o[0])+(p[1]-o[1])*(q[1]-o[1]) > 0
+}
+
+// segmentsIntersect reports whether the segment ab and the segment cd have a common point
+func segmentsIntersect(a, b, c, d Position) bool {
+	d1, d2 := cross(c, d, a), cross(c, d, b)
+	d3, d4 := cross(a, b, c), cross(a, b, d)
+	if (d1 > 0 && d2 < 0 || d1 < 0 && d2 > 0) && (d3 > 0 && d4 < 0 || d3 < 0 && d4 > 0) {
+		return true
+	}
+	return d1 == 0 && inBox(c, d, a) || d2 == 0 && inBox(c, d, b) || d3 == 0 && inBox(a, b, c) || d4 == 0 && inBox(a, b, d)
+}
+
+// cross returns the cross product of the vectors from o to a and from o to b
+func cross(o, a, b Position) float64 {
+	return (a[0]-o[0])*(b[1]-o[1]) - (a[1]-o[1])*(b[0]-o[0])
+}
+
+// inBox reports whether p is in the bounding box of the segment ab
+func inBox(a, b, p Position) bool {
+	return math.Min(a[0], b[0]) <= p[0] && p[0] <= math.Max(a[0], b[0]) &&
+		math.Min(a[1], b[1]) <= p[1] && p[1] <= math.Max(a[1], b[1])
+}
+
+func toPositions(i any, min int) ([]Position, bool) {
+	r, ok := toList(i, toPosition)
+	if !ok || len(r) < min {
+		return nil, false
+	}
+	return r, true
+}
+
+func toPosition(i any) (Position, bool) {
+	p, ok := toList(i, toFloat)
+	if !ok || len(p) < 2 || len(p) > 3 {
+		return nil, false
+	}
+	if p[0] < -180 || p[0] > 180 || p[1] < -90 || p[1] > 90 {
+		return nil, false
+	}
+	return p, true
+}
+
+func toList[T any](i any, f func(any) (T, bool)) ([]T, bool) {
+	v := reflect.ValueOf(i)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array || v.Len() == 0 {
+		return nil, false
+	}
+	res := make([]T, 0, v.Len())
+	for j := 0; j < v.Len(); j++ {
+		e, ok := f(v.Index(j).Interface())
+		if !ok {
+			return nil, false
+		}
+		res = append(res, e)
+	}
+	return res, true
+}
+
+func toFloat(i any) (float64, bool) {
+	v := reflect.ValueOf(i)
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		return f, !math.IsNaN(f) && !math.IsInf(f, 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	}
+	if n, ok := i.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func (v *Value) ValueGeometry() (vv Geometry, ok bool) {
+	if v == nil {
+		return
+	}
+	vv, ok = v.v.(Geometry)
+	return
+}
+
+func (m *Multiple) ValuesGeometry() (vv []Geometry, ok bool) {
+	if m == nil {
+		return
+	}
+	vv = lo.FilterMap(m.v, func(v *Value, _ int) (Geometry, bool) {
+		return v.ValueGeometry()
+	})
+	if len(vv) != len(m.v) {
+		return nil, false
+	}
+	return
+}
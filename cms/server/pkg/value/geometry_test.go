@@ -0,0 +1,142 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_propertyGeometry_ToValue(t *testing.T) {
+	point := Geometry{Type: GeometryTypePoint, Coordinates: Position{139.7, 35.6}}
+	polygon := Geometry{Type: GeometryTypePolygon, Coordinates: [][]Position{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}}
+
+	tests := []struct {
+		name  string
+		args  []any
+		want1 any
+		want2 bool
+	}{
+		{
+			name: "point",
+			args: []any{
+				point,
+				&point,
+				map[string]any{"type": "Point", "coordinates": []any{139.7, 35.6}},
+				`{"type":"Point","coordinates":[139.7,35.6]}`,
+			},
+			want1: point,
+			want2: true,
+		},
+		{
+			name: "polygon",
+			args: []any{
+				map[string]any{"type": "Polygon", "coordinates": []any{[]any{[]any{0, 0}, []any{1, 0}, []any{1, 1}, []any{0, 0}}}},
+				[]byte(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`),
+			},
+			want1: polygon,
+			want2: true,
+		},
+		{
+			name: "polygon with a hole",
+			args: []any{
+				`{"type":"Polygon","coordinates":[[[0,0],[4,0],[4,4],[0,4],[0,0]],[[1,1],[1,2],[2,2],[2,1],[1,1]]]}`,
+			},
+			want1: Geometry{Type: GeometryTypePolygon, Coordinates: [][]Position{
+				{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+				{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}},
+			}},
+			want2: true,
+		},
+		{
+			name: "invalid",
+			args: []any{
+				nil,
+				"",
+				map[string]any{"type": "Point", "coordinates": []any{200, 0}},
+				map[string]any{"type": "Point", "coordinates": []any{0}},
+				map[string]any{"type": "LineString", "coordinates": []any{[]any{0, 0}}},
+				map[string]any{"type": "Polygon", "coordinates": []any{[]any{[]any{0, 0}, []any{1, 0}, []any{1, 1}, []any{0, 1}}}},
+				// self-intersecting ring (bowtie)
+				`{"type":"Polygon","coordinates":[[[0,0],[1,1],[1,0],[0,1],[0,0]]]}`,
+				// ring which turns back on the same line
+				`{"type":"Polygon","coordinates":[[[0,0],[2,0],[1,0],[1,1],[0,0]]]}`,
+				// ring whose vertex touches another edge
+				`{"type":"Polygon","coordinates":[[[0,0],[2,0],[2,2],[1,0],[0,2],[0,0]]]}`,
+				// repeated consecutive positions
+				`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,0],[1,1],[0,0]]]}`,
+				`{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]],[[[0,0],[1,1],[1,0],[0,1],[0,0]]]]}`,
+				map[string]any{"type": "Circle", "coordinates": []any{0, 0}},
+			},
+			want1: nil,
+			want2: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := &propertyGeometry{}
+			for i, v := range tt.args {
+				got1, got2 := p.ToValue(v)
+				assert.Equal(t, tt.want1, got1, "test %d", i)
+				assert.Equal(t, tt.want2, got2, "test %d", i)
+			}
+		})
+	}
+}
+
+func Test_propertyGeometry_ToInterface(t *testing.T) {
+	g := Geometry{Type: GeometryTypeLineString, Coordinates: []Position{{1, 2}, {3, 0}}}
+	v, ok := (&propertyGeometry{}).ToInterface(g)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]any{
+		"type":        "LineString",
+		"coordinates": []Position{{1, 2}, {3, 0}},
+		"bbox":        []float64{1, 0, 3, 2},
+	}, v)
+}
+
+func Test_propertyGeometry_Equal(t *testing.T) {
+	p := &propertyGeometry{}
+	g := Geometry{Type: GeometryTypePoint, Coordinates: Position{1, 2}}
+	assert.True(t, p.Equal(g, Geometry{Type: GeometryTypePoint, Coordinates: Position{1, 2}}))
+	assert.False(t, p.Equal(g, Geometry{Type: GeometryTypeMultiPoint, Coordinates: []Position{{1, 2}}}))
+}
+
+func TestGeometry_BBox(t *testing.T) {
+	g := Geometry{Type: GeometryTypeMultiPolygon, Coordinates: [][][]Position{
+		{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+		{{{-2, 3}, {-1, 3}, {-1, 4}, {-2, 3}}},
+	}}
+	assert.Equal(t, []float64{-2, 0, 1, 4}, g.BBox())
+	assert.Nil(t, Geometry{}.BBox())
+}
+
+func TestGeometryFromBBox(t *testing.T) {
+	g, ok := GeometryFromBBox([]float64{139, 35, 140, 36})
+	assert.True(t, ok)
+	assert.Equal(t, Geometry{Type: GeometryTypePolygon, Coordinates: [][]Position{
+		{{139, 35}, {140, 35}, {140, 36}, {139, 36}, {139, 35}},
+	}}, g)
+
+	_, ok = GeometryFromBBox([]float64{140, 35, 139, 36})
+	assert.False(t, ok)
+	_, ok = GeometryFromBBox([]float64{139, 35, 140})
+	assert.False(t, ok)
+}
+
+func TestGeometry_IntersectsBBox(t *testing.T) {
+	g := Geometry{Type: GeometryTypeLineString, Coordinates: []Position{{139, 35}, {140, 36}}}
+	assert.True(t, g.IntersectsBBox([]float64{139.5, 35.5, 141, 37}))
+	assert.True(t, g.IntersectsBBox([]float64{140, 36, 141, 37}))
+	assert.False(t, g.IntersectsBBox([]float64{141, 35, 142, 36}))
+	assert.False(t, Geometry{}.IntersectsBBox([]float64{139, 35, 140, 36}))
+}
+
+func TestValue_ValueGeometry(t *testing.T) {
+	g := Geometry{Type: GeometryTypePoint, Coordinates: Position{1, 2}}
+	got, ok := TypeGeometry.Value(g).ValueGeometry()
+	assert.True(t, ok)
+	assert.Equal(t, g, got)
+}
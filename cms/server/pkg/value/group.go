@@ -0,0 +1,101 @@
+package value
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/samber/lo"
+)
+
+const TypeGroup Type = "group"
+
+// Group is a set of values of the sub-fields of a group field, keyed by the IDs of the sub-fields
+type Group = map[id.FieldID]*Multiple
+
+type propertyGroup struct{}
+
+func (p *propertyGroup) ToValue(i any) (any, bool) {
+	switch v := i.(type) {
+	case Group:
+		return lo.MapValues(v, func(m *Multiple, _ id.FieldID) *Multiple { return m.Clone() }), true
+	case *Group:
+		if v != nil {
+			return p.ToValue(*v)
+		}
+	case map[string]any:
+		// the generic representation: {"<field id>": {"t": "<type>", "v": [...]}}
+		g := Group{}
+		for k, w := range v {
+			fid, err := id.FieldIDFrom(k)
+			if err != nil {
+				return nil, false
+			}
+			m, ok := w.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			t, _ := m["t"].(string)
+			vs, ok := m["v"].([]any)
+			if t == "" || !ok && m["v"] != nil {
+				return nil, false
+			}
+			g[fid] = NewMultiple(Type(t), vs)
+		}
+		return g, true
+	}
+	return nil, false
+}
+
+func (*propertyGroup) ToInterface(v any) (any, bool) {
+	g := v.(Group)
+	res := make(map[string]any, len(g))
+	for k, m := range g {
+		res[k.String()] = map[string]any{
+			"t": string(m.Type()),
+			"v": m.Interface(),
+		}
+	}
+	return res, true
+}
+
+func (*propertyGroup) Validate(i any) bool {
+	_, ok := i.(Group)
+	return ok
+}
+
+func (*propertyGroup) Equal(v, w any) bool {
+	vv := v.(Group)
+	ww := w.(Group)
+	if len(vv) != len(ww) {
+		return false
+	}
+	for k, m := range vv {
+		if n, ok := ww[k]; !ok || !m.Equal(n) {
+			return false
+		}
+	}
+	return true
+}
+
+func (*propertyGroup) IsEmpty(v any) bool {
+	return lo.EveryBy(lo.Values(v.(Group)), func(m *Multiple) bool { return m.IsEmpty() })
+}
+
+func (v *Value) ValueGroup() (vv Group, ok bool) {
+	if v == nil {
+		return
+	}
+	vv, ok = v.v.(Group)
+	return
+}
+
+func (m *Multiple) ValuesGroup() (vv []Group, ok bool) {
+	if m == nil {
+		return
+	}
+	vv = lo.FilterMap(m.v, func(v *Value, _ int) (Group, bool) {
+		return v.ValueGroup()
+	})
+	if len(vv) != len(m.v) {
+		return nil, false
+	}
+	return
+}
@@ -0,0 +1,57 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_propertyGroup_ToValue(t *testing.T) {
+	f1, f2 := id.NewFieldID(), id.NewFieldID()
+	g := Group{
+		f1: TypeText.Value("a").AsMultiple(),
+		f2: NewMultiple(TypeInteger, []any{1, 2}),
+	}
+	p := &propertyGroup{}
+
+	got, ok := p.ToValue(g)
+	assert.True(t, ok)
+	assert.Equal(t, g, got)
+
+	got, ok = p.ToValue(map[string]any{
+		f1.String(): map[string]any{"t": "text", "v": []any{"a"}},
+		f2.String(): map[string]any{"t": "integer", "v": []any{1, 2}},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, g, got)
+
+	_, ok = p.ToValue(map[string]any{"x": map[string]any{"t": "text", "v": []any{"a"}}})
+	assert.False(t, ok)
+	_, ok = p.ToValue(map[string]any{f1.String(): "a"})
+	assert.False(t, ok)
+	_, ok = p.ToValue("a")
+	assert.False(t, ok)
+}
+
+func Test_propertyGroup_ToInterface(t *testing.T) {
+	fid := id.NewFieldID()
+	v, ok := (&propertyGroup{}).ToInterface(Group{fid: TypeText.Value("a").AsMultiple()})
+	assert.True(t, ok)
+	assert.Equal(t, map[string]any{fid.String(): map[string]any{"t": "text", "v": []any{"a"}}}, v)
+}
+
+func Test_propertyGroup_Equal(t *testing.T) {
+	fid := id.NewFieldID()
+	p := &propertyGroup{}
+	assert.True(t, p.Equal(Group{fid: TypeText.Value("a").AsMultiple()}, Group{fid: TypeText.Value("a").AsMultiple()}))
+	assert.False(t, p.Equal(Group{fid: TypeText.Value("a").AsMultiple()}, Group{fid: TypeText.Value("b").AsMultiple()}))
+	assert.False(t, p.Equal(Group{fid: TypeText.Value("a").AsMultiple()}, Group{}))
+}
+
+func Test_propertyGroup_IsEmpty(t *testing.T) {
+	p := &propertyGroup{}
+	assert.True(t, p.IsEmpty(Group{}))
+	assert.True(t, p.IsEmpty(Group{id.NewFieldID(): TypeText.Value("").AsMultiple()}))
+	assert.False(t, p.IsEmpty(Group{id.NewFieldID(): TypeText.Value("a").AsMultiple()}))
+}
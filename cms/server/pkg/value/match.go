@@ -14,6 +14,8 @@ type Match struct {
 	Select    func(String)
 	Reference func(Reference)
 	URL       func(URL)
+	Geometry  func(Geometry)
+	Group     func(Group)
 	Default   func()
 }
 
@@ -86,6 +88,16 @@ func (v *Value) Match(m Match) {
 			m.URL(v.v.(URL))
 			return
 		}
+	case TypeGeometry:
+		if m.Geometry != nil {
+			m.Geometry(v.v.(Geometry))
+			return
+		}
+	case TypeGroup:
+		if m.Group != nil {
+			m.Group(v.v.(Group))
+			return
+		}
 	}
 
 	if m.Default != nil {
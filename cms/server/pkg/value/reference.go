@@ -37,7 +37,7 @@ func (*propertyReference) Validate(i any) bool {
 
 func (*propertyReference) Equal(v, w any) bool {
 	vv := v.(Reference)
-	ww := v.(Reference)
+	ww := w.(Reference)
 	return vv == ww
 }
 
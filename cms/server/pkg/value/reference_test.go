@@ -57,6 +57,12 @@ func Test_propertyReference_ToInterface(t *testing.T) {
 	assert.Equal(t, true, ok)
 }
 
+func Test_propertyReference_Equal(t *testing.T) {
+	iId := id.NewItemID()
+	assert.True(t, (&propertyReference{}).Equal(iId, iId))
+	assert.False(t, (&propertyReference{}).Equal(id.NewItemID(), id.NewItemID()))
+}
+
 func Test_propertyReference_IsEmpty(t *testing.T) {
 	assert.True(t, (&propertyReference{}).IsEmpty(id.ItemID{}))
 	assert.False(t, (&propertyReference{}).IsEmpty(id.NewItemID()))
@@ -13,6 +13,8 @@ var defaultTypes = TypeRegistry{
 	TypeSelect:    &propertyString{},
 	TypeReference: &propertyReference{},
 	TypeURL:       &propertyURL{},
+	TypeGeometry:  &propertyGeometry{},
+	TypeGroup:     &propertyGroup{},
 }
 
 type TypeRegistry map[Type]TypeProperty
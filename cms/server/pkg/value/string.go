@@ -23,6 +23,10 @@ func (p *propertyString) ToValue(i any) (any, bool) {
 		return v, true
 	} else if v, ok := i.(float64); ok {
 		return strconv.FormatFloat(v, 'f', -1, 64), true
+	} else if v, ok := i.(int64); ok {
+		return strconv.FormatInt(v, 10), true
+	} else if v, ok := i.(int); ok {
+		return strconv.Itoa(v), true
 	} else if v, ok := i.(bool); ok && v {
 		return "true", true
 	} else if v, ok := i.(bool); ok && !v {
@@ -33,6 +37,8 @@ func (p *propertyString) ToValue(i any) (any, bool) {
 		return p.ToValue(*v)
 	} else if v, ok := i.(*float64); ok && v != nil {
 		return p.ToValue(*v)
+	} else if v, ok := i.(*int64); ok && v != nil {
+		return p.ToValue(*v)
 	} else if v, ok := i.(*bool); ok && v != nil {
 		return p.ToValue(*v)
 	} else if v, ok := i.(*time.Time); ok && v != nil {
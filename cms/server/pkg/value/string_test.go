@@ -31,6 +31,12 @@ func Test_propertyString_ToValue(t *testing.T) {
 			want1: "1.12",
 			want2: true,
 		},
+		{
+			name:  "integer",
+			args:  []any{int64(12), 12, lo.ToPtr(int64(12))},
+			want1: "12",
+			want2: true,
+		},
 		{
 			name:  "url",
 			args:  []any{u},
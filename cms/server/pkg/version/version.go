@@ -13,6 +13,14 @@ func New() Version {
 	return Version(uuid.New())
 }
 
+func Parse(s string) (Version, error) {
+	u, err := uuid.Parse(s)
+	if err != nil {
+		return Zero, err
+	}
+	return Version(u), nil
+}
+
 func (v Version) IsZero() bool {
 	return v == Zero
 }
@@ -11,3 +11,14 @@ func TestVersion_OrRef(t *testing.T) {
 	assert.Equal(t, VersionOrRef{version: v}, v.OrRef())
 	assert.Equal(t, VersionOrRef{}, Zero.OrRef())
 }
+
+func TestParse(t *testing.T) {
+	v := New()
+	got, err := Parse(v.String())
+	assert.NoError(t, err)
+	assert.Equal(t, v, got)
+
+	got, err = Parse("xxx")
+	assert.Error(t, err)
+	assert.Equal(t, Zero, got)
+}
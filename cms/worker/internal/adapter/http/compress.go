@@ -0,0 +1,27 @@
+package http
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/worker/internal/usecase/interactor"
+)
+
+type CompressController struct {
+	usecase *interactor.Usecase
+}
+
+func NewCompressController(u *interactor.Usecase) *CompressController {
+	return &CompressController{
+		usecase: u,
+	}
+}
+
+type CompressInput struct {
+	AssetID string `json:"assetId"`
+	Path    string `json:"path"`
+	Output  string `json:"output"`
+}
+
+func (c *CompressController) Compress(ctx context.Context, input CompressInput) error {
+	return c.usecase.Compress(ctx, input.AssetID, input.Path, input.Output)
+}
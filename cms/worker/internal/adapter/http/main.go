@@ -4,11 +4,13 @@ import "github.com/reearth/reearth-cms/worker/internal/usecase/interactor"
 
 type Controller struct {
 	DecompressController *DecompressController
+	CompressController   *CompressController
 	WebhookController    *WebhookController
 }
 
 func NewController(uc *interactor.Usecase) *Controller {
 	return &Controller{DecompressController: NewDecompressController(uc),
-		WebhookController: NewWebhookController(uc),
+		CompressController: NewCompressController(uc),
+		WebhookController:  NewWebhookController(uc),
 	}
 }
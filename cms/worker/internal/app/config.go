@@ -6,6 +6,7 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
 
+	"github.com/reearth/reearth-cms/worker/internal/infrastructure/s3"
 	"github.com/reearth/reearthx/log"
 )
 
@@ -16,6 +17,7 @@ type Config struct {
 	ServerHost string
 	Dev        bool
 	GCS        GCSConfig
+	S3         s3.Config
 	PubSub     PubSubConfig
 	GCP        GCPConfig `envconfig:"GCP"`
 	DB         string
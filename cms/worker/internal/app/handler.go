@@ -37,6 +37,24 @@ func (h Handler) DecompressHandler() echo.HandlerFunc {
 	}
 }
 
+func (h Handler) CompressHandler() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var input rhttp.CompressInput
+		if err := c.Bind(&input); err != nil {
+			log.Errorf("failed to compress: err=%s", err.Error())
+			return err
+		}
+		log.Infof("compression start: Asset=%s, Path=%s, Output=%s", input.AssetID, input.Path, input.Output)
+
+		if err := h.Controller.CompressController.Compress(c.Request().Context(), input); err != nil {
+			log.Errorf("failed to compress. input: %#v err:%s", input, err.Error())
+			return err
+		}
+		log.Infof("successfully compressed: Asset=%s, Path=%s, Output=%s", input.AssetID, input.Path, input.Output)
+		return c.NoContent(http.StatusOK)
+	}
+}
+
 func (h Handler) WebhookHandler() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		var msg pubsubBody
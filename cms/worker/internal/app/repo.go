@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/reearth/reearth-cms/worker/internal/infrastructure/gcp"
+	"github.com/reearth/reearth-cms/worker/internal/infrastructure/s3"
 	"github.com/reearth/reearth-cms/worker/internal/usecase/gateway"
 	"github.com/reearth/reearthx/log"
 )
@@ -23,6 +24,13 @@ func initReposAndGateways(ctx context.Context, conf *Config, debug bool) *gatewa
 			}
 		}
 		gateways.File = fileRepo
+	} else if conf.S3.BucketName != "" {
+		log.Infof("file: S3 storage is used: %s\n", conf.S3.BucketName)
+		fileRepo, err := s3.NewFile(conf.S3)
+		if err != nil {
+			log.Fatalf("file: failed to init S3 storage: %s\n", err.Error())
+		}
+		gateways.File = fileRepo
 	}
 
 	return gateways
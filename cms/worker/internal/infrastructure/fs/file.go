@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"path/filepath"
 
 	"github.com/kennygrant/sanitize"
 	"github.com/reearth/reearth-cms/worker/internal/usecase/gateway"
@@ -72,3 +73,28 @@ func (f *fileRepo) Upload(ctx context.Context, name string) (io.WriteCloser, err
 	}
 	return dest, nil
 }
+
+// List implements gateway.File
+func (f *fileRepo) List(ctx context.Context, dir string) ([]string, error) {
+	if dir == "" {
+		return nil, rerror.ErrNotFound
+	}
+
+	var files []string
+	err := afero.Walk(f.fs, dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files = append(files, filepath.ToSlash(p))
+		}
+		return nil
+	})
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, rerror.ErrNotFound
+		}
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return files, nil
+}
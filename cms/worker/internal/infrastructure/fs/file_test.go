@@ -5,6 +5,7 @@ import (
 	"io"
 	"testing"
 
+	"github.com/reearth/reearthx/rerror"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
@@ -58,3 +59,17 @@ func Test_fileRepo_Upload(t *testing.T) {
 	c, _ := io.ReadAll(f2)
 	assert.Equal(t, string(byte), string(c))
 }
+
+func Test_fileRepo_List(t *testing.T) {
+	fs := mockFs()
+	_ = afero.WriteFile(fs, "assets/dir/a.txt", []byte("a"), 0644)
+	_ = afero.WriteFile(fs, "assets/dir/b/c.txt", []byte("c"), 0644)
+	f, _ := NewFile(fs, "")
+
+	files, err := f.List(context.Background(), "assets/dir")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"assets/dir/a.txt", "assets/dir/b/c.txt"}, files)
+
+	_, err = f.List(context.Background(), "assets/none")
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+}
@@ -8,11 +8,13 @@ import (
 	"io"
 	"net/url"
 	"path/filepath"
+	"strings"
 
 	"cloud.google.com/go/storage"
 	"github.com/reearth/reearth-cms/worker/internal/usecase/gateway"
 	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
+	"google.golang.org/api/iterator"
 )
 
 const (
@@ -87,6 +89,35 @@ func (f *fileRepo) Upload(ctx context.Context, name string) (io.WriteCloser, err
 	return writer, nil
 }
 
+// List returns paths of all objects under the directory. Returned paths do not include the asset base path.
+func (f *fileRepo) List(ctx context.Context, dir string) ([]string, error) {
+	if dir == "" {
+		return nil, rerror.ErrNotFound
+	}
+
+	bucket, err := f.bucket(ctx)
+	if err != nil {
+		log.Errorf("gcs: list bucket err: %+v\n", err)
+		return nil, rerror.ErrInternalBy(err)
+	}
+
+	prefix := getGCSObjectNameFromURL(gcsAssetBasePath, dir) + "/"
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	var files []string
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			log.Errorf("gcs: list err: %+v\n", err)
+			return nil, rerror.ErrInternalBy(err)
+		}
+		files = append(files, strings.TrimPrefix(attrs.Name, gcsAssetBasePath+"/"))
+	}
+	return files, nil
+}
+
 // GCSReaderAt is a struct which implements io.ReadAt interface
 func (f *fileRepo) NewGCSReaderAt(ctx context.Context, objectName string) (gateway.ReadAtCloser, int64, error) {
 	rowReaderAt, size, err := f.newRawGCSReaderAt(ctx, objectName)
@@ -116,6 +147,9 @@ func (f *fileRepo) readAll(ctx context.Context, objectName string) (gateway.Read
 	obj := bucket.Object(objectName)
 	r, err := obj.NewReader(ctx)
 	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, 0, rerror.ErrNotFound
+		}
 		return nil, 0, err
 	}
 
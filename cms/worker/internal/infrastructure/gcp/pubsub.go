@@ -6,6 +6,8 @@ import (
 
 	"cloud.google.com/go/pubsub"
 	"github.com/reearth/reearth-cms/worker/pkg/asset"
+	"github.com/reearth/reearth-cms/worker/pkg/decompressor"
+	"github.com/reearth/reearth-cms/worker/pkg/webhook"
 	"github.com/reearth/reearthx/log"
 	"github.com/samber/lo"
 )
@@ -29,6 +31,59 @@ func (c *PubSub) NotifyAssetDecompressed(ctx context.Context, assetID string, st
 		"status":  status.String(),
 	}))
 
+	if err := c.publish(ctx, body); err != nil {
+		return err
+	}
+
+	log.Infof("decompress notified via PubSub: Msg=%s", string(body))
+	return nil
+}
+
+func (c *PubSub) NotifyAssetDecompressProgress(ctx context.Context, assetID string, progress decompressor.Progress) error {
+	body := lo.Must(json.Marshal(map[string]any{
+		"type":     "assetDecompressProgress",
+		"assetId":  assetID,
+		"progress": progress,
+	}))
+
+	if err := c.publish(ctx, body); err != nil {
+		return err
+	}
+
+	log.Debugf("decompress progress notified via PubSub: Msg=%s", string(body))
+	return nil
+}
+
+func (c *PubSub) NotifyAssetCompressed(ctx context.Context, assetID string, status *asset.ArchiveExtractionStatus) error {
+	body := lo.Must(json.Marshal(map[string]string{
+		"type":    "assetCompressed",
+		"assetId": assetID,
+		"status":  status.String(),
+	}))
+
+	if err := c.publish(ctx, body); err != nil {
+		return err
+	}
+
+	log.Infof("compress notified via PubSub: Msg=%s", string(body))
+	return nil
+}
+
+func (c *PubSub) NotifyWebhookDelivered(ctx context.Context, result *webhook.Result) error {
+	body := lo.Must(json.Marshal(map[string]any{
+		"type":     "webhookDelivered",
+		"delivery": result,
+	}))
+
+	if err := c.publish(ctx, body); err != nil {
+		return err
+	}
+
+	log.Infof("webhook delivery notified via PubSub: delivery=%s attempt=%d", result.DeliveryID, result.Attempt)
+	return nil
+}
+
+func (c *PubSub) publish(ctx context.Context, body []byte) error {
 	client, err := pubsub.NewClient(ctx, c.project)
 	if err != nil {
 		return err
@@ -39,10 +94,6 @@ func (c *PubSub) NotifyAssetDecompressed(ctx context.Context, assetID string, st
 		Data: body,
 	})
 
-	if _, err := result.Get(ctx); err != nil {
-		return err
-	}
-
-	log.Infof("decompress notified via PubSub: Msg=%s", string(body))
-	return nil
+	_, err = result.Get(ctx)
+	return err
 }
@@ -0,0 +1,219 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/reearth/reearth-cms/worker/internal/usecase/gateway"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+)
+
+const s3AssetBasePath string = "assets"
+
+// Config is the configuration of an S3-compatible storage. Endpoint and UsePathStyle are used to connect to services other than AWS such as MinIO.
+type Config struct {
+	BucketName string
+	Region     string `default:"us-east-1"`
+	Endpoint   string
+	// UsePathStyle puts the bucket name in the path of URLs instead of the host name. MinIO requires it.
+	UsePathStyle bool
+	// AccessKeyID and SecretAccessKey are optional. The default credential chain of AWS SDK is used when they are empty.
+	AccessKeyID             string
+	SecretAccessKey         string
+	PublicationCacheControl string
+}
+
+type fileRepo struct {
+	bucketName   string
+	cacheControl string
+	client       *awss3.Client
+	uploader     *manager.Uploader
+}
+
+func NewFile(conf Config) (gateway.File, error) {
+	if conf.BucketName == "" {
+		return nil, errors.New("bucket name is empty")
+	}
+
+	region := conf.Region
+	if region == "" {
+		// S3-compatible services such as MinIO accept any region
+		region = "us-east-1"
+	}
+
+	opts := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(region)}
+	if conf.AccessKeyID != "" {
+		opts = append(opts, awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(conf.AccessKeyID, conf.SecretAccessKey, "")))
+	}
+
+	awsConf, err := awsconfig.LoadDefaultConfig(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	client := awss3.NewFromConfig(awsConf, func(o *awss3.Options) {
+		o.UsePathStyle = conf.UsePathStyle
+		if conf.Endpoint != "" {
+			o.BaseEndpoint = aws.String(conf.Endpoint)
+		}
+	})
+	return &fileRepo{
+		bucketName:   conf.BucketName,
+		cacheControl: conf.PublicationCacheControl,
+		client:       client,
+		uploader:     manager.NewUploader(client),
+	}, nil
+}
+
+// Read implements gateway.File. Objects are read with range requests so that large archives are not loaded on memory.
+func (f *fileRepo) Read(ctx context.Context, p string) (gateway.ReadAtCloser, int64, error) {
+	if p == "" {
+		return nil, 0, rerror.ErrNotFound
+	}
+
+	key := path.Join(s3AssetBasePath, p)
+	head, err := f.client.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket: aws.String(f.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, 0, rerror.ErrNotFound
+		}
+		log.Errorf("s3: read head err: %+v\n", err)
+		return nil, 0, rerror.ErrInternalBy(err)
+	}
+
+	return &objectReaderAt{ctx: ctx, f: f, key: key}, aws.ToInt64(head.ContentLength), nil
+}
+
+// Upload implements gateway.File. Large files are uploaded in multiple parts while they are written.
+func (f *fileRepo) Upload(ctx context.Context, name string) (io.WriteCloser, error) {
+	if name == "" {
+		return nil, gateway.ErrInvalidFile
+	}
+
+	key := path.Join(s3AssetBasePath, name)
+	in := &awss3.PutObjectInput{
+		Bucket: aws.String(f.bucketName),
+		Key:    aws.String(key),
+	}
+	if f.cacheControl != "" {
+		in.CacheControl = aws.String(f.cacheControl)
+	}
+	if ct := mime.TypeByExtension(path.Ext(key)); ct != "" {
+		in.ContentType = aws.String(ct)
+	}
+
+	pr, pw := io.Pipe()
+	in.Body = pr
+	w := &objectWriter{pw: pw, done: make(chan error, 1)}
+	go func() {
+		_, err := f.uploader.Upload(ctx, in)
+		// unblock the writer when the upload fails in the middle
+		_ = pr.CloseWithError(err)
+		w.done <- err
+	}()
+	return w, nil
+}
+
+// List implements gateway.File
+func (f *fileRepo) List(ctx context.Context, dir string) ([]string, error) {
+	if dir == "" {
+		return nil, rerror.ErrNotFound
+	}
+
+	var files []string
+	p := awss3.NewListObjectsV2Paginator(f.client, &awss3.ListObjectsV2Input{
+		Bucket: aws.String(f.bucketName),
+		Prefix: aws.String(path.Join(s3AssetBasePath, dir) + "/"),
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			log.Errorf("s3: list err: %+v\n", err)
+			return nil, rerror.ErrInternalBy(err)
+		}
+		for _, o := range page.Contents {
+			files = append(files, strings.TrimPrefix(aws.ToString(o.Key), s3AssetBasePath+"/"))
+		}
+	}
+	return files, nil
+}
+
+// objectReaderAt implements io.ReaderAt with range requests to an S3 object
+type objectReaderAt struct {
+	ctx context.Context
+	f   *fileRepo
+	key string
+}
+
+func (r *objectReaderAt) ReadAt(b []byte, off int64) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	res, err := r.f.client.GetObject(r.ctx, &awss3.GetObjectInput{
+		Bucket: aws.String(r.f.bucketName),
+		Key:    aws.String(r.key),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", off, off+int64(len(b))-1)),
+	})
+	if err != nil {
+		var aerr smithy.APIError
+		if errors.As(err, &aerr) && aerr.ErrorCode() == "InvalidRange" {
+			return 0, io.EOF
+		}
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	n, err := io.ReadFull(res.Body, b)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (r *objectReaderAt) Close() error {
+	return nil
+}
+
+// objectWriter streams written data to the uploader. Close waits for the upload to be completed.
+type objectWriter struct {
+	pw   *io.PipeWriter
+	done chan error
+}
+
+func (w *objectWriter) Write(b []byte) (int, error) {
+	return w.pw.Write(b)
+}
+
+func (w *objectWriter) Close() error {
+	if err := w.pw.Close(); err != nil {
+		return err
+	}
+	if err := <-w.done; err != nil {
+		log.Errorf("s3: upload err: %+v\n", err)
+		return gateway.ErrFailedToUploadFile
+	}
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var nsk *types.NoSuchKey
+	var nf *types.NotFound
+	return errors.As(err, &nsk) || errors.As(err, &nf)
+}
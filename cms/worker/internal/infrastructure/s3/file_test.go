@@ -0,0 +1,147 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFile(t *testing.T) {
+	_, err := NewFile(Config{})
+	assert.Error(t, err)
+
+	f, err := NewFile(Config{BucketName: "bucket"})
+	assert.NoError(t, err)
+	assert.NotNil(t, f)
+}
+
+func Test_fileRepo(t *testing.T) {
+	ctx := context.Background()
+	s := newFakeS3()
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	f, err := NewFile(Config{
+		BucketName:              "bucket",
+		Endpoint:                ts.URL,
+		UsePathStyle:            true,
+		AccessKeyID:             "key",
+		SecretAccessKey:         "secret",
+		PublicationCacheControl: "no-cache",
+	})
+	require.NoError(t, err)
+
+	w, err := f.Upload(ctx, "xx/yyyy/aaa/b.txt")
+	assert.NoError(t, err)
+	_, _ = w.Write([]byte("hello"))
+	assert.NoError(t, w.Close())
+	assert.Equal(t, "no-cache", s.header("assets/xx/yyyy/aaa/b.txt").Get("Cache-Control"))
+	assert.Equal(t, "text/plain; charset=utf-8", s.header("assets/xx/yyyy/aaa/b.txt").Get("Content-Type"))
+
+	r, size, err := f.Read(ctx, "xx/yyyy/aaa/b.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), size)
+	buf := make([]byte, 3)
+	n, err := r.ReadAt(buf, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "ell", string(buf[:n]))
+	n, err = r.ReadAt(buf, 3)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, "lo", string(buf[:n]))
+	assert.NoError(t, r.Close())
+
+	_, _, err = f.Read(ctx, "xx/yyyy/none")
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	files, err := f.List(ctx, "xx/yyyy")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"xx/yyyy/aaa/b.txt"}, files)
+}
+
+// fakeS3 is an in-memory S3-compatible server which supports path-style requests of single-part uploads, reads and listing
+type fakeS3 struct {
+	lock    sync.Mutex
+	objects map[string][]byte
+	headers map[string]http.Header
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: map[string][]byte{}, headers: map[string]http.Header{}}
+}
+
+func (s *fakeS3) header(key string) http.Header {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.headers[key]
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// /bucket/key
+	_, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+	switch {
+	case r.Method == http.MethodPut:
+		b, _ := io.ReadAll(r.Body)
+		s.objects[key] = b
+		s.headers[key] = r.Header.Clone()
+	case r.Method == http.MethodGet && key == "" && r.URL.Query().Get("list-type") == "2":
+		s.list(w, r.URL.Query().Get("prefix"))
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		b, ok := s.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if rg := r.Header.Get("Range"); rg != "" {
+			var start, end int
+			_, _ = fmt.Sscanf(rg, "bytes=%d-%d", &start, &end)
+			if end >= len(b) {
+				end = len(b) - 1
+			}
+			b = b[start : end+1]
+			w.Header().Set("Content-Length", strconv.Itoa(len(b)))
+			w.WriteHeader(http.StatusPartialContent)
+		} else {
+			w.Header().Set("Content-Length", strconv.Itoa(len(b)))
+		}
+		if r.Method == http.MethodGet {
+			_, _ = io.Copy(w, bytes.NewReader(b))
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *fakeS3) list(w http.ResponseWriter, prefix string) {
+	type content struct {
+		Key  string
+		Size int
+	}
+	res := struct {
+		XMLName  xml.Name `xml:"ListBucketResult"`
+		Contents []content
+	}{}
+	for k, b := range s.objects {
+		if strings.HasPrefix(k, prefix) {
+			res.Contents = append(res.Contents, content{Key: k, Size: len(b)})
+		}
+	}
+	sort.Slice(res.Contents, func(i, j int) bool { return res.Contents[i].Key < res.Contents[j].Key })
+	_ = xml.NewEncoder(w).Encode(res)
+}
@@ -4,8 +4,13 @@ import (
 	"context"
 
 	"github.com/reearth/reearth-cms/worker/pkg/asset"
+	"github.com/reearth/reearth-cms/worker/pkg/decompressor"
+	"github.com/reearth/reearth-cms/worker/pkg/webhook"
 )
 
 type CMS interface {
 	NotifyAssetDecompressed(ctx context.Context, assetID string, status *asset.ArchiveExtractionStatus) error
+	NotifyAssetDecompressProgress(ctx context.Context, assetID string, progress decompressor.Progress) error
+	NotifyAssetCompressed(ctx context.Context, assetID string, status *asset.ArchiveExtractionStatus) error
+	NotifyWebhookDelivered(ctx context.Context, result *webhook.Result) error
 }
@@ -22,4 +22,6 @@ type ReadAtCloser interface {
 type File interface {
 	Read(ctx context.Context, path string) (ReadAtCloser, int64, error)
 	Upload(ctx context.Context, name string) (io.WriteCloser, error)
+	// List returns paths of all files under the directory recursively
+	List(ctx context.Context, dir string) ([]string, error)
 }
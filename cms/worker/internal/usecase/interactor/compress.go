@@ -0,0 +1,60 @@
+package interactor
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/reearth/reearth-cms/worker/pkg/asset"
+	"github.com/reearth/reearth-cms/worker/pkg/compressor"
+	"github.com/reearth/reearth-cms/worker/pkg/decompressor"
+	"github.com/reearth/reearthx/log"
+	"github.com/samber/lo"
+)
+
+// Compress packs files under dir into a zip archive stored at output and notifies the result to CMS.
+func (u *Usecase) Compress(ctx context.Context, assetID, dir, output string) error {
+	if err := u.compress(ctx, dir, output); err != nil {
+		log.Errorf("failed to compress, Asset=%s, Dir=%s, Err=%s", assetID, dir, err.Error())
+		return u.gateways.CMS.NotifyAssetCompressed(ctx, assetID, lo.ToPtr(asset.ArchiveExtractionStatusFailed))
+	}
+	return u.gateways.CMS.NotifyAssetCompressed(ctx, assetID, lo.ToPtr(asset.ArchiveExtractionStatusDone))
+}
+
+func (u *Usecase) compress(ctx context.Context, dir, output string) error {
+	dir = strings.Trim(dir, "/")
+	output = strings.TrimPrefix(output, "/")
+
+	files, err := u.gateways.File.List(ctx, dir)
+	if err != nil {
+		return err
+	}
+	// the output, archives of other folders and manifests are not included in the archive
+	files = lo.Filter(files, func(f string, _ int) bool {
+		return f != output && !strings.HasSuffix(f, asset.CompressedSuffix) && !strings.HasSuffix(f, decompressor.ManifestSuffix)
+	})
+
+	w, err := u.gateways.File.Upload(ctx, output)
+	if err != nil {
+		return err
+	}
+
+	if err := compressor.Compress(w, dir, files, u.openFile(ctx)); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (u *Usecase) openFile(ctx context.Context) func(string) (io.ReadCloser, error) {
+	return func(name string) (io.ReadCloser, error) {
+		r, size, err := u.gateways.File.Read(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return struct {
+			io.Reader
+			io.Closer
+		}{io.NewSectionReader(r, 0, size), r}, nil
+	}
+}
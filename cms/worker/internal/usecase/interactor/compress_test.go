@@ -0,0 +1,63 @@
+package interactor
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	wfs "github.com/reearth/reearth-cms/worker/internal/infrastructure/fs"
+	"github.com/reearth/reearth-cms/worker/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/worker/pkg/asset"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_Compress(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	files := map[string]string{
+		"aa/bbb/test/a.txt":             "a",
+		"aa/bbb/test/b/c.txt":           "c",
+		"aa/bbb/test.zip":               "zip",
+		"aa/bbb/other/d.txt":            "d",
+		"aa/bbb/test.zip.manifest.json": "{}",
+	}
+	for name, content := range files {
+		require.NoError(t, afero.WriteFile(fs, name, []byte(content), 0644))
+	}
+
+	mCMS := NewCMS()
+	fileGateway, err := wfs.NewFile(fs, "")
+	require.NoError(t, err)
+	uc := NewUsecase(gateway.NewGateway(fileGateway, mCMS), nil)
+
+	assert.NoError(t, uc.Compress(context.Background(), "aaa", "aa/bbb/test", "aa/bbb/test.compressed.zip"))
+	assert.Equal(t, asset.ArchiveExtractionStatusDone, mCMS.compressed["aaa"])
+
+	b := lo.Must(afero.ReadFile(fs, "aa/bbb/test.compressed.zip"))
+	zr := lo.Must(zip.NewReader(bytes.NewReader(b), int64(len(b))))
+	got := map[string]string{}
+	for _, f := range zr.File {
+		r := lo.Must(f.Open())
+		got[f.Name] = string(lo.Must(io.ReadAll(r)))
+		_ = r.Close()
+	}
+	assert.Equal(t, map[string]string{"test/a.txt": "a", "test/b/c.txt": "c"}, got)
+
+	// sub folder
+	assert.NoError(t, uc.Compress(context.Background(), "bbb", "aa/bbb/test/b", "aa/bbb/test/b.compressed.zip"))
+	assert.Equal(t, asset.ArchiveExtractionStatusDone, mCMS.compressed["bbb"])
+
+	// archives of sub folders are not included
+	assert.NoError(t, uc.Compress(context.Background(), "aaa", "aa/bbb/test", "aa/bbb/test.compressed.zip"))
+	b = lo.Must(afero.ReadFile(fs, "aa/bbb/test.compressed.zip"))
+	zr = lo.Must(zip.NewReader(bytes.NewReader(b), int64(len(b))))
+	assert.Equal(t, []string{"test/a.txt", "test/b/c.txt"}, lo.Map(zr.File, func(f *zip.File, _ int) string { return f.Name }))
+
+	// missing folder
+	assert.NoError(t, uc.Compress(context.Background(), "ccc", "aa/bbb/none", "aa/bbb/none.compressed.zip"))
+	assert.Equal(t, asset.ArchiveExtractionStatusFailed, mCMS.compressed["ccc"])
+}
@@ -2,10 +2,13 @@ package interactor
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"path"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/reearth/reearth-cms/worker/pkg/asset"
 	"github.com/reearth/reearth-cms/worker/pkg/decompressor"
@@ -13,6 +16,9 @@ import (
 	"github.com/samber/lo"
 )
 
+// decompressProgressInterval is the minimum interval between progress notifications
+const decompressProgressInterval = 10 * time.Second
+
 func (u *Usecase) Decompress(ctx context.Context, assetID, assetPath string) error {
 	err := u.decompress(ctx, assetID, assetPath)
 	if err != nil {
@@ -23,7 +29,7 @@ func (u *Usecase) Decompress(ctx context.Context, assetID, assetPath string) err
 }
 
 func (u *Usecase) decompress(ctx context.Context, assetID, assetPath string) error {
-	ext := strings.TrimPrefix(path.Ext(assetPath), ".")
+	ext := decompressor.Ext(assetPath)
 	base := strings.TrimPrefix(strings.TrimSuffix(assetPath, "."+ext), "/")
 
 	compressedFile, size, err := u.gateways.File.Read(ctx, assetPath)
@@ -31,9 +37,10 @@ func (u *Usecase) decompress(ctx context.Context, assetID, assetPath string) err
 		log.Errorf("failed to load zip file from storage, Asset=%s, Path=%s, Err=%s", assetID, assetPath, err.Error())
 		return err
 	}
+	defer func() { _ = compressedFile.Close() }()
 
 	uploadFunc := func(name string) (io.WriteCloser, error) {
-		w, err := u.gateways.File.Upload(ctx, path.Join(base, name))
+		w, err := u.gateways.File.Upload(ctx, name)
 		if err != nil {
 			return nil, err
 		}
@@ -49,9 +56,50 @@ func (u *Usecase) decompress(ctx context.Context, assetID, assetPath string) err
 		return err
 	}
 
-	if err = de.Decompress(base); err != nil {
-		return err
+	de.OnProgress(u.notifyDecompressProgress(ctx, assetID))
+	err = de.Decompress(base)
+
+	// the manifest is saved even when some files fail so that CMS can list the extracted files exactly
+	if merr := u.saveManifest(ctx, strings.TrimPrefix(assetPath, "/"), de.Manifest()); merr != nil {
+		log.Errorf("failed to save manifest, Asset=%s, Path=%s, Err=%s", assetID, assetPath, merr.Error())
+		if err == nil {
+			err = merr
+		}
+	}
+
+	return err
+}
+
+// notifyDecompressProgress returns a function which notifies the progress to CMS at most once per decompressProgressInterval
+func (u *Usecase) notifyDecompressProgress(ctx context.Context, assetID string) func(decompressor.Progress) {
+	var lock sync.Mutex
+	var last time.Time
+	return func(p decompressor.Progress) {
+		// other files are extracted without waiting for the notification
+		if !lock.TryLock() {
+			return
+		}
+		defer lock.Unlock()
+
+		if time.Since(last) < decompressProgressInterval {
+			return
+		}
+		last = time.Now()
+		if err := u.gateways.CMS.NotifyAssetDecompressProgress(ctx, assetID, p); err != nil {
+			log.Warnf("failed to notify decompression progress, Asset=%s, Err=%s", assetID, err.Error())
+		}
 	}
+}
 
-	return nil
+// saveManifest stores the manifest next to the archive. Paths in the manifest are relative to the directory of the archive.
+func (u *Usecase) saveManifest(ctx context.Context, assetPath string, m *decompressor.Manifest) error {
+	w, err := u.gateways.File.Upload(ctx, decompressor.ManifestPath(assetPath))
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(w).Encode(m.Rel(path.Dir(assetPath))); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
 }
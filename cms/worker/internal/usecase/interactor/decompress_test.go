@@ -1,44 +1,70 @@
 package interactor
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"hash/crc32"
 	"io"
 	"os"
-	// "testing"
+	"testing"
 
-	// wfs "github.com/reearth/reearth-cms/worker/internal/infrastructure/fs"
-	// "github.com/reearth/reearth-cms/worker/internal/usecase/gateway"
+	wfs "github.com/reearth/reearth-cms/worker/internal/infrastructure/fs"
+	"github.com/reearth/reearth-cms/worker/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/worker/pkg/asset"
+	"github.com/reearth/reearth-cms/worker/pkg/decompressor"
+	"github.com/reearth/reearth-cms/worker/pkg/webhook"
 
 	"github.com/samber/lo"
 	"github.com/spf13/afero"
-	// "github.com/stretchr/testify/assert"
-	// "github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-// func TestUsecase_Decompress(t *testing.T) {
-// 	fs := mockFs()
-// 	mCMS := NewCMS()
-// 	fileGateway, err := wfs.NewFile(fs, "")
-// 	require.NoError(t, err)
-
-// 	uc := NewUsecase(gateway.NewGateway(fileGateway, mCMS))
-
-// 	assert.NoError(t, uc.Decompress(context.Background(), "aaa", "test.zip"))
-
-// 	f := lo.Must(fs.Open("test/test1.txt"))
-// 	content := lo.Must(io.ReadAll(f))
-// 	_ = f.Close()
-// 	assert.Equal(t, "hello1", string(content))
-
-// 	f = lo.Must(fs.Open("test/test2.txt"))
-// 	content = lo.Must(io.ReadAll(f))
-// 	_ = f.Close()
-// 	assert.Equal(t, "hello2", string(content))
-
-// 	// unsupported extenstion doesn't return error
-// 	assert.NoError(t, uc.Decompress(context.Background(), "aaa", "test.tar.gz"))
-// }
+func TestUsecase_Decompress(t *testing.T) {
+	fs := mockFs()
+	mCMS := NewCMS()
+	fileGateway, err := wfs.NewFile(fs, "")
+	require.NoError(t, err)
+
+	uc := NewUsecase(gateway.NewGateway(fileGateway, mCMS), nil)
+
+	assert.NoError(t, uc.Decompress(context.Background(), "aaa", "test.zip"))
+
+	f := lo.Must(fs.Open("test/test1.txt"))
+	content := lo.Must(io.ReadAll(f))
+	_ = f.Close()
+	assert.Equal(t, "hello1", string(content))
+
+	f = lo.Must(fs.Open("test/test2.txt"))
+	content = lo.Must(io.ReadAll(f))
+	_ = f.Close()
+	assert.Equal(t, "hello2", string(content))
+
+	f = lo.Must(fs.Open("test.zip.manifest.json"))
+	var m decompressor.Manifest
+	require.NoError(t, json.NewDecoder(f).Decode(&m))
+	_ = f.Close()
+	assert.Equal(t, []decompressor.Entry{
+		{Path: "test/test1.txt", Size: 6, CRC32: crc32.ChecksumIEEE([]byte("hello1")), ContentType: "text/plain; charset=utf-8"},
+		{Path: "test/test2.txt", Size: 6, CRC32: crc32.ChecksumIEEE([]byte("hello2")), ContentType: "text/plain; charset=utf-8"},
+	}, m.Entries)
+	assert.Empty(t, m.Failed)
+	assert.Equal(t, []decompressor.Progress{{Files: 1, TotalFiles: 2, Bytes: 6, TotalBytes: 12}}, mCMS.progress["aaa"])
+
+	// tar.gz
+	assert.NoError(t, uc.Decompress(context.Background(), "ccc", "test.tar.gz"))
+
+	f = lo.Must(fs.Open("test/test3.txt"))
+	content = lo.Must(io.ReadAll(f))
+	_ = f.Close()
+	assert.Equal(t, "hello3", string(content))
+
+	// unsupported extenstion doesn't return error
+	assert.NoError(t, uc.Decompress(context.Background(), "aaa", "test.rar"))
+}
 
 func mockFs() afero.Fs {
 	fs := afero.NewMemMapFs()
@@ -48,20 +74,46 @@ func mockFs() afero.Fs {
 	_ = lo.Must(io.Copy(zf2, zf))
 	_ = zf2.Close()
 
-	zf3 := lo.Must(fs.Create("test.tar.gz"))
+	zf3 := lo.Must(fs.Create("test.rar"))
 	_ = lo.Must(io.Copy(zf3, zf))
 	_ = zf3.Close()
 
 	_ = zf.Close()
 
+	// tar.gz archive
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	lo.Must0(tw.WriteHeader(&tar.Header{Name: "test3.txt", Mode: 0644, Size: 6, Typeflag: tar.TypeReg}))
+	_ = lo.Must(tw.Write([]byte("hello3")))
+	lo.Must0(tw.Close())
+	lo.Must0(gw.Close())
+	lo.Must0(afero.WriteFile(fs, "test.tar.gz", buf.Bytes(), 0644))
+
 	return fs
 }
 
 type mockCMS struct {
+	progress   map[string][]decompressor.Progress
+	compressed map[string]asset.ArchiveExtractionStatus
 }
 
 func NewCMS() *mockCMS {
-	return &mockCMS{}
+	return &mockCMS{progress: map[string][]decompressor.Progress{}, compressed: map[string]asset.ArchiveExtractionStatus{}}
+}
+
+func (c *mockCMS) NotifyAssetDecompressProgress(ctx context.Context, assetID string, progress decompressor.Progress) error {
+	c.progress[assetID] = append(c.progress[assetID], progress)
+	return nil
+}
+
+func (c *mockCMS) NotifyWebhookDelivered(ctx context.Context, result *webhook.Result) error {
+	return nil
+}
+
+func (c *mockCMS) NotifyAssetCompressed(ctx context.Context, assetID string, status *asset.ArchiveExtractionStatus) error {
+	c.compressed[assetID] = *status
+	return nil
 }
 
 func (c *mockCMS) NotifyAssetDecompressed(ctx context.Context, assetId string, status *asset.ArchiveExtractionStatus) error {
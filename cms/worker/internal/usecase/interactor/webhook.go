@@ -9,6 +9,10 @@ import (
 )
 
 func (u *Usecase) SendWebhook(ctx context.Context, w *webhook.Webhook) error {
+	if w.DeliveryID != "" {
+		return u.deliverWebhook(ctx, w)
+	}
+
 	eid := fmt.Sprintf("%s_%s", w.EventID, w.WebhookID)
 	found, err := u.webhook.GetAndSet(ctx, eid)
 	if err != nil {
@@ -30,3 +34,32 @@ func (u *Usecase) SendWebhook(ctx context.Context, w *webhook.Webhook) error {
 
 	return nil
 }
+
+// deliverWebhook sends a webhook whose delivery is tracked by the CMS. Each attempt is sent only once and its result is reported to the CMS, which schedules retries.
+func (u *Usecase) deliverWebhook(ctx context.Context, w *webhook.Webhook) error {
+	key := fmt.Sprintf("%s_%d", w.DeliveryID, w.Attempt)
+	found, err := u.webhook.GetAndSet(ctx, key)
+	if err != nil {
+		log.Errorf("webhook usecase: failed to get webhook sent: %v", err)
+	}
+	if found {
+		return nil
+	}
+
+	log.Infof("webhook usecase: deliver: delivery=%s attempt=%d", w.DeliveryID, w.Attempt)
+
+	r := webhook.Deliver(ctx, w)
+	if r.Error != "" {
+		log.Errorf("webhook usecase: error response: delivery=%s attempt=%d err=%s", w.DeliveryID, w.Attempt, r.Error)
+	}
+
+	if err := u.gateways.CMS.NotifyWebhookDelivered(ctx, r); err != nil {
+		log.Errorf("webhook usecase: failed to notify delivery: %v", err)
+		if err2 := u.webhook.Delete(ctx, key); err2 != nil {
+			log.Errorf("webhook usecase: failed to set webhook sent: %v", err2)
+		}
+		return err
+	}
+
+	return nil
+}
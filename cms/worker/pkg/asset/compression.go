@@ -0,0 +1,4 @@
+package asset
+
+// CompressedSuffix is appended to the path of a folder to name the zip archive generated from the folder
+const CompressedSuffix = ".compressed.zip"
@@ -0,0 +1,58 @@
+package compressor
+
+import (
+	"archive/zip"
+	"errors"
+	"io"
+	"path"
+	"sort"
+	"strings"
+)
+
+var ErrNoFiles = errors.New("no files to compress")
+
+// Compress writes files under dir to w as a zip archive. Entries are named relative to the parent of dir,
+// so the archive contains dir itself as its top-level folder, in the same way as archives uploaded to CMS.
+// Files which are not under dir are ignored.
+func Compress(w io.Writer, dir string, files []string, open func(name string) (io.ReadCloser, error)) error {
+	dir = strings.TrimSuffix(dir, "/")
+	parent := path.Dir(dir)
+
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		if strings.HasPrefix(f, dir+"/") {
+			names = append(names, f)
+		}
+	}
+	if len(names) == 0 {
+		return ErrNoFiles
+	}
+	sort.Strings(names)
+
+	zw := zip.NewWriter(w)
+	for _, name := range names {
+		entry := name
+		if parent != "." {
+			entry = strings.TrimPrefix(name, parent+"/")
+		}
+		if err := add(zw, entry, name, open); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func add(zw *zip.Writer, entry, name string, open func(name string) (io.ReadCloser, error)) error {
+	r, err := open(name)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = r.Close() }()
+
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: entry, Method: zip.Deflate})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	return err
+}
@@ -0,0 +1,53 @@
+package compressor
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompress(t *testing.T) {
+	files := map[string]string{
+		"aa/bbb/data/tileset.json":  "{}",
+		"aa/bbb/data/tiles/0.b3dm":  "b3dm",
+		"aa/bbb/data2/tileset.json": "other",
+		"aa/bbb/data.zip":           "zip",
+	}
+	open := func(name string) (io.ReadCloser, error) {
+		c, ok := files[name]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return io.NopCloser(bytes.NewBufferString(c)), nil
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Compress(&buf, "aa/bbb/data", lo.Keys(files), open))
+
+	zr := lo.Must(zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len())))
+	got := map[string]string{}
+	for _, f := range zr.File {
+		r := lo.Must(f.Open())
+		got[f.Name] = string(lo.Must(io.ReadAll(r)))
+		_ = r.Close()
+	}
+	assert.Equal(t, map[string]string{
+		"data/tileset.json": "{}",
+		"data/tiles/0.b3dm": "b3dm",
+	}, got)
+
+	// a sub folder
+	buf.Reset()
+	require.NoError(t, Compress(&buf, "aa/bbb/data/tiles/", lo.Keys(files), open))
+	zr = lo.Must(zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len())))
+	assert.Equal(t, []string{"tiles/0.b3dm"}, lo.Map(zr.File, func(f *zip.File, _ int) string { return f.Name }))
+
+	assert.Same(t, ErrNoFiles, Compress(&buf, "aa/bbb/unknown", lo.Keys(files), open))
+	assert.EqualError(t, Compress(&buf, "aa/bbb/data", []string{"aa/bbb/data/missing"}, open), "not found")
+}
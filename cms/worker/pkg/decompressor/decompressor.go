@@ -1,21 +1,23 @@
 package decompressor
 
 import (
+	"archive/tar"
 	"archive/zip"
-	"context"
+	"compress/gzip"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
-	"os"
+	"path"
 	"path/filepath"
 	"strings"
 	"sync"
+	"unicode/utf8"
 
-	"cloud.google.com/go/storage"
 	"github.com/bodgit/sevenzip"
-	"github.com/joho/godotenv"
-	"github.com/kelseyhightower/envconfig"
+	"github.com/klauspost/compress/zstd"
 	"github.com/reearth/reearthx/log"
+	"golang.org/x/text/encoding/japanese"
 )
 
 var (
@@ -24,24 +26,42 @@ var (
 
 const limit = 1024 * 1024 * 1024 * 30 // 30GB
 
-const (
-	configPrefix            = "REEARTH_CMS_WORKER"
-	gcsAssetBasePath string = "assets"
-)
-
 const (
 	workersNumber    = 500
 	workerQueueDepth = 20000
 )
 
+// compoundExts are extensions which consist of multiple parts.
+var compoundExts = []string{"tar.gz", "tar.zst"}
+
+// Ext returns the extension of the archive without the leading dot, including compound extensions such as "tar.gz".
+func Ext(name string) string {
+	lower := strings.ToLower(name)
+	for _, e := range compoundExts {
+		if strings.HasSuffix(lower, "."+e) {
+			return name[len(name)-len(e):]
+		}
+	}
+	return strings.TrimPrefix(path.Ext(name), ".")
+}
+
 type decompressor struct {
 	zr  *zip.Reader
 	sr  *sevenzip.Reader
+	tr  *tar.Reader
+	tc  io.Closer
 	wFn func(name string) (io.WriteCloser, error)
+
+	progressFn func(Progress)
+	lock       sync.Mutex
+	progress   Progress
+	manifest   Manifest
 }
 
+// New returns a decompressor of zip, 7z, tar, tar.gz and tar.zst archives.
 func New(r io.ReaderAt, size int64, ext string, wFn func(name string) (io.WriteCloser, error)) (*decompressor, error) {
-	if ext == "zip" {
+	switch strings.ToLower(ext) {
+	case "zip":
 		zr, err := zip.NewReader(r, size)
 		if err != nil {
 			return nil, err
@@ -50,7 +70,7 @@ func New(r io.ReaderAt, size int64, ext string, wFn func(name string) (io.WriteC
 			zr:  zr,
 			wFn: wFn,
 		}, nil
-	} else if ext == "7z" {
+	case "7z":
 		sr, err := sevenzip.NewReader(r, size)
 		if err != nil {
 			return nil, err
@@ -59,30 +79,79 @@ func New(r io.ReaderAt, size int64, ext string, wFn func(name string) (io.WriteC
 			sr:  sr,
 			wFn: wFn,
 		}, nil
+	case "tar":
+		return &decompressor{
+			tr:  tar.NewReader(io.NewSectionReader(r, 0, size)),
+			wFn: wFn,
+		}, nil
+	case "tar.gz", "tgz":
+		gr, err := gzip.NewReader(io.NewSectionReader(r, 0, size))
+		if err != nil {
+			return nil, err
+		}
+		return &decompressor{
+			tr:  tar.NewReader(gr),
+			tc:  gr,
+			wFn: wFn,
+		}, nil
+	case "tar.zst", "tzst":
+		zr, err := zstd.NewReader(io.NewSectionReader(r, 0, size), zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return &decompressor{
+			tr:  tar.NewReader(zr),
+			tc:  zr.IOReadCloser(),
+			wFn: wFn,
+		}, nil
 	}
 	return nil, ErrUnsupportedExtention
 }
 
+// OnProgress sets a function which is called every time a file is extracted or fails to be extracted.
+// The function may be called from multiple goroutines.
+func (uz *decompressor) OnProgress(fn func(Progress)) {
+	uz.progressFn = fn
+}
+
+// Manifest returns the files extracted so far and the files which failed to be extracted, sorted by their paths.
+func (uz *decompressor) Manifest() *Manifest {
+	uz.lock.Lock()
+	defer uz.lock.Unlock()
+
+	m := &Manifest{
+		Entries: append([]Entry{}, uz.manifest.Entries...),
+		Failed:  append([]FailedEntry(nil), uz.manifest.Failed...),
+	}
+	m.sort()
+	return m
+}
+
+// Decompress extracts all files into assetBasePath. Files which fail to be extracted are recorded in the manifest
+// and the other files are still extracted, but the first error is returned.
 func (uz *decompressor) Decompress(assetBasePath string) error {
+	if uz.tr != nil {
+		return uz.readTar(assetBasePath)
+	}
+
 	var archivedFiles []ArchivedFile
+	var totalBytes int64
 	if uz.zr != nil {
 		for _, f := range uz.zr.File {
+			f.Name = entryName(f.Name)
 			fn := f.Name
 			if strings.HasSuffix(fn, "/") {
 				continue
 			}
-			if f.NonUTF8 {
-				continue
-			}
 			if strings.HasPrefix(fn, "/") {
 				continue
 			}
 			archivedFiles = append(archivedFiles, &ZipFile{f})
+			totalBytes += int64(f.UncompressedSize64)
 		}
-		base := filepath.Join(gcsAssetBasePath, assetBasePath)
-		uz.readConcurrentGCSFile(archivedFiles, base)
 	} else if uz.sr != nil {
 		for _, f := range uz.sr.File {
+			f.Name = entryName(f.Name)
 			fn := f.Name
 			if strings.HasSuffix(fn, "/") {
 				continue
@@ -91,75 +160,144 @@ func (uz *decompressor) Decompress(assetBasePath string) error {
 				continue
 			}
 			archivedFiles = append(archivedFiles, &SevenZipFile{f})
+			totalBytes += int64(f.UncompressedSize)
+		}
+	}
+
+	uz.lock.Lock()
+	uz.progress.TotalFiles = len(archivedFiles)
+	uz.progress.TotalBytes = totalBytes
+	uz.lock.Unlock()
+
+	return uz.readConcurrent(archivedFiles, assetBasePath)
+}
+
+// readTar writes files in a tar archive sequentially because entries of a tar archive cannot be read randomly.
+func (uz *decompressor) readTar(assetBasePath string) error {
+	if uz.tc != nil {
+		defer func() { _ = uz.tc.Close() }()
+	}
+
+	var firstErr error
+	for {
+		h, err := uz.tr.Next()
+		if errors.Is(err, io.EOF) {
+			return firstErr
+		}
+		if err != nil {
+			return err
+		}
+		if !h.FileInfo().Mode().IsRegular() {
+			continue
+		}
+		fn := entryName(h.Name)
+		if strings.HasPrefix(fn, "/") {
+			continue
+		}
+		// the reader skips the rest of the entry, so the following entries can be read even if this one fails
+		if err := uz.read(getFileDestinationPath(assetBasePath, strings.TrimPrefix(fn, "./")), uz.tr); err != nil {
+			log.Errorf("decompressor: failed to extract file File=%s, Err=%s", fn, err.Error())
+			if firstErr == nil {
+				firstErr = err
+			}
 		}
-		base := filepath.Join(gcsAssetBasePath, assetBasePath)
-		uz.readConcurrentGCSFile(archivedFiles, base)
 	}
-	return nil
 }
 
 func (uz *decompressor) read(name string, r io.Reader) error {
 	w, err := uz.wFn(name)
 	if err != nil {
+		uz.fail(name, err)
 		return err
 	}
-	_, err = io.CopyN(w, r, limit)
-	_ = w.Close()
-	if !errors.Is(err, io.EOF) && err != nil {
-		for _, f := range uz.sr.File {
-			return &LimitError{Path: f.FileInfo().Name()}
-		}
+	h := crc32.NewIEEE()
+	n, err := io.CopyN(io.MultiWriter(w, h), r, limit)
+	cerr := w.Close()
+	if err == nil {
+		err = &LimitError{Path: name}
+	} else if errors.Is(err, io.EOF) {
+		err = cerr
 	}
+	if err != nil {
+		uz.fail(name, err)
+		return err
+	}
+
+	uz.record(func() {
+		uz.manifest.Entries = append(uz.manifest.Entries, Entry{
+			Path:        name,
+			Size:        n,
+			CRC32:       h.Sum32(),
+			ContentType: contentType(name),
+		})
+		uz.progress.Files++
+		uz.progress.Bytes += n
+	})
 	return nil
 }
 
-func (uz *decompressor) readConcurrentGCSFile(zfs []ArchivedFile, assetBasePath string) {
-	conf, cerr := ReadDecompressorConfig()
-	if cerr != nil {
-		log.Fatal(cerr)
+func (uz *decompressor) fail(name string, err error) {
+	uz.record(func() {
+		uz.manifest.Failed = append(uz.manifest.Failed, FailedEntry{Path: name, Error: err.Error()})
+		uz.progress.Failed++
+	})
+}
+
+// record updates the manifest and the progress with f and notifies the progress
+func (uz *decompressor) record(f func()) {
+	uz.lock.Lock()
+	f()
+	p := uz.progress
+	uz.lock.Unlock()
+
+	if uz.progressFn != nil {
+		uz.progressFn(p)
 	}
+}
+
+// readConcurrent writes archived files to wFn using a bounded pool of workers and returns the first error that occurred.
+func (uz *decompressor) readConcurrent(files []ArchivedFile, assetBasePath string) error {
 	var wg sync.WaitGroup
-	ctx := context.Background()
-	client, _ := storage.NewClient(ctx)
-	db := client.Bucket(conf.BucketName)
+	var once sync.Once
+	var firstErr error
+
+	workers := workersNumber
+	if len(files) < workers {
+		workers = len(files)
+	}
+
 	workQueue := make(chan ArchivedFile, workerQueueDepth)
-	for i := 0; i < workersNumber; i++ {
+	for i := 0; i < workers; i++ {
 		wg.Add(1)
-		go func(i int) {
+		go func() {
 			defer wg.Done()
-			log.Infof("worker %d says hello!", i)
 			for f := range workQueue {
-				func() {
-					fn := f.Name()
-					x, err := f.Open()
-					if err != nil {
-						log.Errorf("failed to open file File=%s, Err=%s", fn, err.Error())
-						log.Fatal(err)
-					}
-					defer x.Close()
-					name := getFileDestinationPath(assetBasePath, fn)
-					w := db.Object(name).NewWriter(ctx)
-
-					if _, err := io.Copy(w, x); err != nil {
-						log.Errorf("failed to copy file to Storage File=%s, Err=%s", fn, err.Error())
-						return
-					}
-					if err = w.Close(); err != nil {
-						log.Infof("boom %s failed with %s", fn, err)
-						return
-					}
-					log.Infof(" worker %d wrote %s!", i, fn)
-				}()
+				if err := uz.readFile(f, assetBasePath); err != nil {
+					log.Errorf("decompressor: failed to extract file File=%s, Err=%s", f.Name(), err.Error())
+					once.Do(func() { firstErr = err })
+				}
 			}
-			log.Infof("Worker %d says bye!", i)
-		}(i)
+		}()
 	}
 
-	for _, f := range zfs {
+	for _, f := range files {
 		workQueue <- f
 	}
 	close(workQueue)
 	wg.Wait()
+	return firstErr
+}
+
+func (uz *decompressor) readFile(f ArchivedFile, assetBasePath string) error {
+	name := getFileDestinationPath(assetBasePath, f.Name())
+	r, err := f.Open()
+	if err != nil {
+		uz.fail(name, err)
+		return err
+	}
+	defer r.Close()
+
+	return uz.read(name, r)
 }
 
 type ArchivedFile interface {
@@ -191,6 +329,18 @@ func (f *SevenZipFile) Open() (io.ReadCloser, error) {
 	return f.File.Open()
 }
 
+// entryName returns the name of an archived file in UTF-8.
+// Names which are not valid UTF-8 are decoded as Shift_JIS, which is used by archivers on Japanese Windows.
+func entryName(name string) string {
+	if utf8.ValidString(name) {
+		return name
+	}
+	if n, err := japanese.ShiftJIS.NewDecoder().String(name); err == nil {
+		return n
+	}
+	return name
+}
+
 func getFileDestinationPath(firstPath, secondPath string) string {
 	lastElementOfFirstPath := filepath.Base(firstPath)
 	tempArray := strings.Split(secondPath, "/")
@@ -203,23 +353,6 @@ func getFileDestinationPath(firstPath, secondPath string) string {
 	return filepath.Join(firstPath, secondPath)
 }
 
-type DecompressorConfig struct {
-	BucketName string `envconfig:"GCS_BUCKET_NAME"`
-}
-
-func ReadDecompressorConfig() (*DecompressorConfig, error) {
-	if err := godotenv.Load(".env"); err != nil && !os.IsNotExist(err) {
-		return nil, err
-	} else if err == nil {
-		log.Infof("config: .env loaded for decompressor")
-	}
-
-	var c DecompressorConfig
-	err := envconfig.Process(configPrefix, &c)
-
-	return &c, err
-}
-
 type LimitError struct {
 	Path string
 }
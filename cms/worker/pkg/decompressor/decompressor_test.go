@@ -1,13 +1,21 @@
 package decompressor
 
 import (
+	"archive/tar"
+	"archive/zip"
 	"bytes"
+	"compress/gzip"
+	"errors"
+	"hash/crc32"
 	"io"
 	"os"
+	"sync"
 	"testing"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Buffer implements io.ReadAtCloser and io.WriteCloser
@@ -46,61 +54,232 @@ func TestNewUnzipper(t *testing.T) {
 	assert.Same(t, ErrUnsupportedExtention, err2)
 }
 
-// func TestDecompressor_Decompress(t *testing.T) {
-// 	zf := lo.Must(os.Open("testdata/test.zip"))
-// 	szf := lo.Must(os.Open("testdata/test.7z"))
-
-// 	fInfo, err := zf.Stat()
-// 	if err != nil {
-// 		t.Fatal(err)
-// 	}
-
-// 	expectedFiles := map[string][]byte{
-// 		"test1.txt": []byte("hello1"),
-// 		"test2.txt": []byte("hello2"),
-// 	}
-
-// 	// map of buffers which will keep unzipped data
-// 	files := map[string]*Buffer{
-// 		"test1.txt": {bytes.Buffer{}},
-// 		"test2.txt": {bytes.Buffer{}},
-// 	}
-
-// 	// normal scenario
-// 	uz, err := New(zf, fInfo.Size(), "zip", func(name string) (io.WriteCloser, error) {
-// 		return files[name], nil
-// 	})
-// 	require.NoError(t, err)
-
-// 	assert.NoError(t, uz.Decompress("testdata"))
-// 	for k, v := range files {
-// 		assert.Equal(t, expectedFiles[k], v.Bytes())
-// 	}
-
-// 	// Redefine files because buffer overwriting will occur.
-// 	files = map[string]*Buffer{
-// 		"test1.txt": {bytes.Buffer{}},
-// 		"test2.txt": {bytes.Buffer{}},
-// 	}
-// 	uz2, err := New(szf, fInfo.Size(), "7z", func(name string) (io.WriteCloser, error) {
-// 		return files[name], nil
-// 	})
-// 	require.NoError(t, err)
-// 	assert.NoError(t, uz2.Decompress("testdata"))
-// 	for k, v := range files {
-// 		assert.Equal(t, expectedFiles[k], v.Bytes())
-// 	}
-
-// 	// exception: test if  wFn's error is same as what Unzip returns
-// 	uz, err = New(zf, fInfo.Size(), "zip", func(name string) (io.WriteCloser, error) {
-// 		return nil, errors.New("test")
-// 	})
-// 	require.NoError(t, err)
-// 	assert.Equal(t, errors.New("test"), uz.Decompress("testdata"))
-
-// 	uz, err = New(szf, fInfo.Size(), "7z", func(name string) (io.WriteCloser, error) {
-// 		return nil, errors.New("test")
-// 	})
-// 	require.NoError(t, err)
-// 	assert.Equal(t, errors.New("test"), uz.Decompress("testdata"))
-// }
+func TestDecompressor_Decompress(t *testing.T) {
+	zf := lo.Must(os.Open("testdata/test.zip"))
+	szf := lo.Must(os.Open("testdata/test.7z"))
+
+	fInfo, err := zf.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedFiles := map[string][]byte{
+		"testdata/test1.txt": []byte("hello1"),
+		"testdata/test2.txt": []byte("hello2"),
+	}
+
+	// map of buffers which will keep unzipped data
+	files := map[string]*Buffer{
+		"testdata/test1.txt": {bytes.Buffer{}},
+		"testdata/test2.txt": {bytes.Buffer{}},
+	}
+
+	// normal scenario
+	uz, err := New(zf, fInfo.Size(), "zip", func(name string) (io.WriteCloser, error) {
+		return files[name], nil
+	})
+	require.NoError(t, err)
+
+	assert.NoError(t, uz.Decompress("testdata"))
+	for k, v := range files {
+		assert.Equal(t, expectedFiles[k], v.Bytes())
+	}
+
+	// Redefine files because buffer overwriting will occur.
+	files = map[string]*Buffer{
+		"testdata/test1.txt": {bytes.Buffer{}},
+		"testdata/test2.txt": {bytes.Buffer{}},
+	}
+	uz2, err := New(szf, fInfo.Size(), "7z", func(name string) (io.WriteCloser, error) {
+		return files[name], nil
+	})
+	require.NoError(t, err)
+	assert.NoError(t, uz2.Decompress("testdata"))
+	for k, v := range files {
+		assert.Equal(t, expectedFiles[k], v.Bytes())
+	}
+
+	// exception: test if  wFn's error is same as what Unzip returns
+	uz, err = New(zf, fInfo.Size(), "zip", func(name string) (io.WriteCloser, error) {
+		return nil, errors.New("test")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, errors.New("test"), uz.Decompress("testdata"))
+
+	uz, err = New(szf, fInfo.Size(), "7z", func(name string) (io.WriteCloser, error) {
+		return nil, errors.New("test")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, errors.New("test"), uz.Decompress("testdata"))
+}
+
+func TestExt(t *testing.T) {
+	assert.Equal(t, "zip", Ext("a/b.zip"))
+	assert.Equal(t, "7z", Ext("b.7z"))
+	assert.Equal(t, "tar", Ext("b.tar"))
+	assert.Equal(t, "tar.gz", Ext("a.b/c.tar.gz"))
+	assert.Equal(t, "TAR.GZ", Ext("c.TAR.GZ"))
+	assert.Equal(t, "tgz", Ext("c.tgz"))
+	assert.Equal(t, "tar.zst", Ext("c.tar.zst"))
+	assert.Equal(t, "001", Ext("c.zip.001"))
+	assert.Equal(t, "gz", Ext("c.gz"))
+	assert.Equal(t, "", Ext("c"))
+}
+
+func TestDecompressor_Decompress_Tar(t *testing.T) {
+	tarData := testTar(t)
+
+	var gzData bytes.Buffer
+	gw := gzip.NewWriter(&gzData)
+	_ = lo.Must(gw.Write(tarData))
+	require.NoError(t, gw.Close())
+
+	zw := lo.Must(zstd.NewWriter(nil))
+	zstData := zw.EncodeAll(tarData, nil)
+	require.NoError(t, zw.Close())
+
+	tests := []struct {
+		ext  string
+		data []byte
+	}{
+		{ext: "tar", data: tarData},
+		{ext: "tar.gz", data: gzData.Bytes()},
+		{ext: "tgz", data: gzData.Bytes()},
+		{ext: "tar.zst", data: zstData},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.ext, func(t *testing.T) {
+			t.Parallel()
+
+			files := newTestFiles()
+			uz, err := New(bytes.NewReader(tt.data), int64(len(tt.data)), tt.ext, files.writer)
+			require.NoError(t, err)
+			assert.NoError(t, uz.Decompress("test"))
+			assert.Equal(t, map[string]string{
+				"test/test1.txt":     "hello1",
+				"test/dir/test2.txt": "hello2",
+				"test/地物/建築物.gml":    "bldg",
+			}, files.contents())
+		})
+	}
+
+	_, err := New(bytes.NewReader(tarData), int64(len(tarData)), "tar.gz", newTestFiles().writer)
+	assert.Error(t, err)
+}
+
+func TestDecompressor_Decompress_ShiftJIS(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range []struct {
+		name    string
+		content string
+	}{
+		// "地物/建築物.gml" in Shift_JIS
+		{name: "\x92\x6e\x95\xa8/\x8c\x9a\x92\x7a\x95\xa8.gml", content: "sjis"},
+		{name: "utf8/建築物.gml", content: "utf8"},
+	} {
+		w := lo.Must(zw.CreateHeader(&zip.FileHeader{Name: f.name, NonUTF8: true}))
+		_ = lo.Must(w.Write([]byte(f.content)))
+	}
+	require.NoError(t, zw.Close())
+
+	files := newTestFiles()
+	uz, err := New(bytes.NewReader(buf.Bytes()), int64(buf.Len()), "zip", files.writer)
+	require.NoError(t, err)
+	assert.NoError(t, uz.Decompress("test"))
+	assert.Equal(t, map[string]string{
+		"test/地物/建築物.gml":   "sjis",
+		"test/utf8/建築物.gml": "utf8",
+	}, files.contents())
+}
+
+func testTar(t *testing.T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, f := range []struct {
+		name    string
+		content string
+	}{
+		{name: "dir/"},
+		{name: "./test1.txt", content: "hello1"},
+		{name: "dir/test2.txt", content: "hello2"},
+		// "地物/建築物.gml" in Shift_JIS
+		{name: "\x92\x6e\x95\xa8/\x8c\x9a\x92\x7a\x95\xa8.gml", content: "bldg"},
+		{name: "/abs.txt", content: "abs"},
+	} {
+		h := &tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.content)), Typeflag: tar.TypeReg}
+		if f.content == "" {
+			h.Typeflag = tar.TypeDir
+		}
+		require.NoError(t, tw.WriteHeader(h))
+		_ = lo.Must(tw.Write([]byte(f.content)))
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+type testFiles struct {
+	lock  sync.Mutex
+	files map[string]*Buffer
+}
+
+func newTestFiles() *testFiles {
+	return &testFiles{files: map[string]*Buffer{}}
+}
+
+func (f *testFiles) writer(name string) (io.WriteCloser, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	b := &Buffer{}
+	f.files[name] = b
+	return b, nil
+}
+
+func (f *testFiles) contents() map[string]string {
+	return lo.MapValues(f.files, func(b *Buffer, _ string) string { return b.String() })
+}
+
+func TestDecompressor_Manifest(t *testing.T) {
+	zf := lo.Must(os.Open("testdata/test.zip"))
+	fInfo := lo.Must(zf.Stat())
+
+	var lock sync.Mutex
+	var progress []Progress
+	uz, err := New(zf, fInfo.Size(), "zip", func(name string) (io.WriteCloser, error) {
+		if name == "testdata/test2.txt" {
+			return nil, errors.New("test")
+		}
+		return &Buffer{}, nil
+	})
+	require.NoError(t, err)
+	uz.OnProgress(func(p Progress) {
+		lock.Lock()
+		defer lock.Unlock()
+		progress = append(progress, p)
+	})
+
+	assert.Equal(t, errors.New("test"), uz.Decompress("testdata"))
+	assert.Equal(t, &Manifest{
+		Entries: []Entry{
+			{Path: "testdata/test1.txt", Size: 6, CRC32: crc32.ChecksumIEEE([]byte("hello1")), ContentType: "text/plain; charset=utf-8"},
+		},
+		Failed: []FailedEntry{
+			{Path: "testdata/test2.txt", Error: "test"},
+		},
+	}, uz.Manifest())
+	// progress may be notified out of order because files are extracted concurrently
+	assert.Equal(t, 2, len(progress))
+	assert.Contains(t, progress, Progress{Files: 1, TotalFiles: 2, Failed: 1, Bytes: 6, TotalBytes: 12})
+
+	assert.Equal(t, &Manifest{
+		Entries: []Entry{{Path: "test1.txt", Size: 6}},
+		Failed:  []FailedEntry{{Path: "x/test2.txt"}},
+	}, (&Manifest{
+		Entries: []Entry{{Path: "testdata/test1.txt", Size: 6}},
+		Failed:  []FailedEntry{{Path: "x/test2.txt"}},
+	}).Rel("testdata"))
+}
@@ -0,0 +1,85 @@
+package decompressor
+
+import (
+	"mime"
+	"path"
+	"sort"
+)
+
+// ManifestSuffix is appended to the path of an archive to get the path of its manifest
+const ManifestSuffix = ".manifest.json"
+
+// ManifestPath returns the path where the manifest of the archive is stored
+func ManifestPath(archivePath string) string {
+	return archivePath + ManifestSuffix
+}
+
+// Manifest lists the files extracted from an archive and the files which failed to be extracted
+type Manifest struct {
+	Entries []Entry       `json:"entries"`
+	Failed  []FailedEntry `json:"failed,omitempty"`
+}
+
+type Entry struct {
+	Path        string `json:"path"`
+	Size        int64  `json:"size"`
+	CRC32       uint32 `json:"crc32"`
+	ContentType string `json:"contentType,omitempty"`
+}
+
+type FailedEntry struct {
+	Path  string `json:"path"`
+	Error string `json:"error"`
+}
+
+// Progress is the progress of a decompression. Totals are zero when they are unknown until the end, as in tar archives.
+type Progress struct {
+	Files      int   `json:"files"`
+	TotalFiles int   `json:"totalFiles"`
+	Failed     int   `json:"failed"`
+	Bytes      int64 `json:"bytes"`
+	TotalBytes int64 `json:"totalBytes"`
+}
+
+// Rel returns the manifest whose paths are relative to dir
+func (m *Manifest) Rel(dir string) *Manifest {
+	rel := func(p string) string {
+		if r, ok := cutPrefixDir(p, dir); ok {
+			return r
+		}
+		return p
+	}
+
+	res := &Manifest{
+		Entries: make([]Entry, 0, len(m.Entries)),
+	}
+	for _, e := range m.Entries {
+		e.Path = rel(e.Path)
+		res.Entries = append(res.Entries, e)
+	}
+	for _, f := range m.Failed {
+		f.Path = rel(f.Path)
+		res.Failed = append(res.Failed, f)
+	}
+	return res
+}
+
+func (m *Manifest) sort() {
+	sort.Slice(m.Entries, func(i, j int) bool { return m.Entries[i].Path < m.Entries[j].Path })
+	sort.Slice(m.Failed, func(i, j int) bool { return m.Failed[i].Path < m.Failed[j].Path })
+}
+
+func cutPrefixDir(p, dir string) (string, bool) {
+	if dir == "" || dir == "." {
+		return p, true
+	}
+	prefix := path.Clean(dir) + "/"
+	if len(p) > len(prefix) && p[:len(prefix)] == prefix {
+		return p[len(prefix):], true
+	}
+	return "", false
+}
+
+func contentType(name string) string {
+	return mime.TypeByExtension(path.Ext(name))
+}
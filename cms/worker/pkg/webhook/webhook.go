@@ -23,6 +23,22 @@ type Webhook struct {
 	EventType string    `json:"type"`
 	EventData any       `json:"data"`
 	Operator  any       `json:"operator"`
+	// DeliveryID and Attempt are set when the CMS tracks the delivery. The result is reported back to the CMS instead of being retried by the task queue.
+	DeliveryID string `json:"deliveryId,omitempty"`
+	Attempt    int    `json:"attempt,omitempty"`
+	// Body is the request body rendered at the first attempt. It is used as is for redeliveries.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// Result is the result of a single delivery attempt
+type Result struct {
+	DeliveryID string        `json:"deliveryId"`
+	Attempt    int           `json:"attempt"`
+	At         time.Time     `json:"at"`
+	Body       string        `json:"body,omitempty"`
+	StatusCode int           `json:"statusCode,omitempty"`
+	Latency    time.Duration `json:"latency"`
+	Error      string        `json:"error,omitempty"`
 }
 
 type requestBody struct {
@@ -34,35 +50,64 @@ type requestBody struct {
 }
 
 func Send(ctx context.Context, w *Webhook) error {
-	b, err := w.requestBody()
+	_, err := send(ctx, w)
+	return err
+}
+
+// Deliver sends the webhook once and returns the result of the attempt. Errors are recorded in the result.
+func Deliver(ctx context.Context, w *Webhook) *Result {
+	r, err := send(ctx, w)
+	if err != nil {
+		r.Error = err.Error()
+	}
+	return r
+}
+
+func send(ctx context.Context, w *Webhook) (*Result, error) {
+	now := util.Now()
+	r := &Result{
+		DeliveryID: w.DeliveryID,
+		Attempt:    w.Attempt,
+		At:         now,
+	}
+
+	b, err := w.RequestBody()
 	if err != nil {
-		return fmt.Errorf("failed to marshal request body: %w", err)
+		return r, fmt.Errorf("failed to marshal request body: %w", err)
 	}
+	r.Body = string(b)
 
 	req, err := http.NewRequestWithContext(ctx, "POST", w.URL, bytes.NewBuffer(b))
 	if err != nil {
-		return fmt.Errorf("failed to create a request: %w", err)
+		return r, fmt.Errorf("failed to create a request: %w", err)
 	}
 
-	now := util.Now()
 	signature := Sign(b, []byte(w.Secret), now, "v1")
 
 	req.Header.Set("Reearth-Signature", signature)
 
+	start := time.Now()
 	res, err := http.DefaultClient.Do(req)
+	r.Latency = time.Since(start)
 	if err != nil {
-		return fmt.Errorf("failed to send a request: %w", err)
+		return r, fmt.Errorf("failed to send a request: %w", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode > 300 {
-		return fmt.Errorf("ERROR: id=%s, url=%s, status=%d", w.EventID, w.URL, res.StatusCode)
+	r.StatusCode = res.StatusCode
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return r, fmt.Errorf("ERROR: id=%s, url=%s, status=%d", w.EventID, w.URL, res.StatusCode)
 	}
 
-	return nil
+	return r, nil
 }
 
-func (w Webhook) requestBody() ([]byte, error) {
+// RequestBody returns the body of the request. Body is returned as is when it is set.
+func (w Webhook) RequestBody() ([]byte, error) {
+	if len(w.Body) > 0 {
+		return w.Body, nil
+	}
+
 	b := requestBody{
 		ID:        w.EventID,
 		Timestamp: w.Timestamp,
@@ -70,7 +70,52 @@ func TestSend(t *testing.T) {
 	assert.Equal(t, 1, info["POST "+endpoint2])
 }
 
-func TestWebhook_requestBody(t *testing.T) {
+func TestDeliver(t *testing.T) {
+	now := time.Date(2022, 10, 10, 1, 1, 1, 1, time.UTC)
+	defer util.MockNow(now)
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	endpoint1 := "https://example.com"
+	endpoint2 := "https://example.com/fail"
+	httpmock.RegisterResponder("POST", endpoint1, func(req *http.Request) (*http.Response, error) {
+		if string(lo.Must(io.ReadAll(req.Body))) != `{"a":1}` {
+			return nil, errors.New("invalid body")
+		}
+		return httpmock.NewStringResponse(200, "hello"), nil
+	})
+	httpmock.RegisterResponder("POST", endpoint2, httpmock.NewStringResponder(500, `error`))
+
+	w := &Webhook{
+		URL:        endpoint1,
+		Secret:     "secret",
+		EventID:    "event",
+		DeliveryID: "delivery",
+		Attempt:    2,
+		Body:       json.RawMessage(`{"a":1}`),
+	}
+
+	r := Deliver(context.Background(), w)
+	assert.Equal(t, "delivery", r.DeliveryID)
+	assert.Equal(t, 2, r.Attempt)
+	assert.Equal(t, now, r.At)
+	assert.Equal(t, `{"a":1}`, r.Body)
+	assert.Equal(t, 200, r.StatusCode)
+	assert.Empty(t, r.Error)
+
+	w.URL = endpoint2
+	r = Deliver(context.Background(), w)
+	assert.Equal(t, 500, r.StatusCode)
+	assert.Equal(t, "ERROR: id=event, url=https://example.com/fail, status=500", r.Error)
+
+	// responses of 3xx are failures as well as the server regards them
+	httpmock.RegisterResponder("POST", endpoint2, httpmock.NewStringResponder(300, ``))
+	r = Deliver(context.Background(), w)
+	assert.Equal(t, 300, r.StatusCode)
+	assert.Equal(t, "ERROR: id=event, url=https://example.com/fail, status=300", r.Error)
+}
+
+func TestWebhook_RequestBody(t *testing.T) {
 	time := time.Date(2022, 10, 10, 1, 1, 1, 1, time.UTC)
 
 	rawExpected := requestBody{
@@ -97,7 +142,7 @@ func TestWebhook_requestBody(t *testing.T) {
 		}`,
 	}
 
-	res, err := w.requestBody()
+	res, err := w.RequestBody()
 	assert.NoError(t, err)
 	assert.Equal(t, expected, res)
 